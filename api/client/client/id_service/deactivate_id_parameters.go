@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// NewDeactivateIDParams creates a new DeactivateIDParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeactivateIDParams() *DeactivateIDParams {
+	return &DeactivateIDParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeactivateIDParamsWithTimeout creates a new DeactivateIDParams object
+// with the ability to set a timeout on a request.
+func NewDeactivateIDParamsWithTimeout(timeout time.Duration) *DeactivateIDParams {
+	return &DeactivateIDParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeactivateIDParamsWithContext creates a new DeactivateIDParams object
+// with the ability to set a context for a request.
+func NewDeactivateIDParamsWithContext(ctx context.Context) *DeactivateIDParams {
+	return &DeactivateIDParams{
+		Context: ctx,
+	}
+}
+
+// NewDeactivateIDParamsWithHTTPClient creates a new DeactivateIDParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeactivateIDParamsWithHTTPClient(client *http.Client) *DeactivateIDParams {
+	return &DeactivateIDParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DeactivateIDParams contains all the parameters to send to the API endpoint
+
+	for the deactivate Id operation.
+
+	Typically these are written to a http.Request.
+*/
+type DeactivateIDParams struct {
+
+	// Body.
+	Body *models.V1alpha1DeactivateRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the deactivate Id params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeactivateIDParams) WithDefaults() *DeactivateIDParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the deactivate Id params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeactivateIDParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the deactivate Id params
+func (o *DeactivateIDParams) WithTimeout(timeout time.Duration) *DeactivateIDParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the deactivate Id params
+func (o *DeactivateIDParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the deactivate Id params
+func (o *DeactivateIDParams) WithContext(ctx context.Context) *DeactivateIDParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the deactivate Id params
+func (o *DeactivateIDParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the deactivate Id params
+func (o *DeactivateIDParams) WithHTTPClient(client *http.Client) *DeactivateIDParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the deactivate Id params
+func (o *DeactivateIDParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the deactivate Id params
+func (o *DeactivateIDParams) WithBody(body *models.V1alpha1DeactivateRequest) *DeactivateIDParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the deactivate Id params
+func (o *DeactivateIDParams) SetBody(body *models.V1alpha1DeactivateRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeactivateIDParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// DeactivateIDReader is a Reader for the DeactivateID structure.
+type DeactivateIDReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeactivateIDReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDeactivateIDOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewDeactivateIDDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeactivateIDOK creates a DeactivateIDOK with default headers values
+func NewDeactivateIDOK() *DeactivateIDOK {
+	return &DeactivateIDOK{}
+}
+
+/*
+DeactivateIDOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type DeactivateIDOK struct {
+	Payload any
+}
+
+// IsSuccess returns true when this deactivate Id o k response has a 2xx status code
+func (o *DeactivateIDOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this deactivate Id o k response has a 3xx status code
+func (o *DeactivateIDOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this deactivate Id o k response has a 4xx status code
+func (o *DeactivateIDOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this deactivate Id o k response has a 5xx status code
+func (o *DeactivateIDOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this deactivate Id o k response a status code equal to that given
+func (o *DeactivateIDOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the deactivate Id o k response
+func (o *DeactivateIDOK) Code() int {
+	return 200
+}
+
+func (o *DeactivateIDOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/id/deactivate][%d] deactivateIdOK %s", 200, payload)
+}
+
+func (o *DeactivateIDOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/id/deactivate][%d] deactivateIdOK %s", 200, payload)
+}
+
+func (o *DeactivateIDOK) GetPayload() any {
+	return o.Payload
+}
+
+func (o *DeactivateIDOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeactivateIDDefault creates a DeactivateIDDefault with default headers values
+func NewDeactivateIDDefault(code int) *DeactivateIDDefault {
+	return &DeactivateIDDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+DeactivateIDDefault describes a response with status code -1, with default header values.
+
+An unexpected error response.
+*/
+type DeactivateIDDefault struct {
+	_statusCode int
+
+	Payload *models.RPCStatus
+}
+
+// IsSuccess returns true when this deactivate Id default response has a 2xx status code
+func (o *DeactivateIDDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this deactivate Id default response has a 3xx status code
+func (o *DeactivateIDDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this deactivate Id default response has a 4xx status code
+func (o *DeactivateIDDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this deactivate Id default response has a 5xx status code
+func (o *DeactivateIDDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this deactivate Id default response a status code equal to that given
+func (o *DeactivateIDDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the deactivate Id default response
+func (o *DeactivateIDDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeactivateIDDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/id/deactivate][%d] DeactivateId default %s", o._statusCode, payload)
+}
+
+func (o *DeactivateIDDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/id/deactivate][%d] DeactivateId default %s", o._statusCode, payload)
+}
+
+func (o *DeactivateIDDefault) GetPayload() *models.RPCStatus {
+	return o.Payload
+}
+
+func (o *DeactivateIDDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RPCStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
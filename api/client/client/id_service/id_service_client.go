@@ -28,13 +28,54 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	DeactivateID(params *DeactivateIDParams, opts ...ClientOption) (*DeactivateIDOK, error)
+
 	GenerateID(params *GenerateIDParams, opts ...ClientOption) (*GenerateIDOK, error)
 
 	ResolveID(params *ResolveIDParams, opts ...ClientOption) (*ResolveIDOK, error)
 
+	UpdateID(params *UpdateIDParams, opts ...ClientOption) (*UpdateIDOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+DeactivateID permanentlies deactivate an Id
+*/
+func (a *Client) DeactivateID(params *DeactivateIDParams, opts ...ClientOption) (*DeactivateIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeactivateIDParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "DeactivateId",
+		Method:             "POST",
+		PathPattern:        "/v1alpha1/id/deactivate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeactivateIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeactivateIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeactivateIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GenerateID generates an Id and its corresponding resolver metadata for a specified issuer
 */
@@ -109,6 +150,43 @@ func (a *Client) ResolveID(params *ResolveIDParams, opts ...ClientOption) (*Reso
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+UpdateID updates the verification methods and the services of the resolver metadata of an Id
+*/
+func (a *Client) UpdateID(params *UpdateIDParams, opts ...ClientOption) (*UpdateIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateIDParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "UpdateId",
+		Method:             "POST",
+		PathPattern:        "/v1alpha1/id/update",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdateIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UpdateIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// NewUpdateIDParams creates a new UpdateIDParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewUpdateIDParams() *UpdateIDParams {
+	return &UpdateIDParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateIDParamsWithTimeout creates a new UpdateIDParams object
+// with the ability to set a timeout on a request.
+func NewUpdateIDParamsWithTimeout(timeout time.Duration) *UpdateIDParams {
+	return &UpdateIDParams{
+		timeout: timeout,
+	}
+}
+
+// NewUpdateIDParamsWithContext creates a new UpdateIDParams object
+// with the ability to set a context for a request.
+func NewUpdateIDParamsWithContext(ctx context.Context) *UpdateIDParams {
+	return &UpdateIDParams{
+		Context: ctx,
+	}
+}
+
+// NewUpdateIDParamsWithHTTPClient creates a new UpdateIDParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewUpdateIDParamsWithHTTPClient(client *http.Client) *UpdateIDParams {
+	return &UpdateIDParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpdateIDParams contains all the parameters to send to the API endpoint
+
+	for the update Id operation.
+
+	Typically these are written to a http.Request.
+*/
+type UpdateIDParams struct {
+
+	// Body.
+	Body *models.V1alpha1UpdateRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the update Id params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateIDParams) WithDefaults() *UpdateIDParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the update Id params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateIDParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the update Id params
+func (o *UpdateIDParams) WithTimeout(timeout time.Duration) *UpdateIDParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update Id params
+func (o *UpdateIDParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update Id params
+func (o *UpdateIDParams) WithContext(ctx context.Context) *UpdateIDParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update Id params
+func (o *UpdateIDParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update Id params
+func (o *UpdateIDParams) WithHTTPClient(client *http.Client) *UpdateIDParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update Id params
+func (o *UpdateIDParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update Id params
+func (o *UpdateIDParams) WithBody(body *models.V1alpha1UpdateRequest) *UpdateIDParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update Id params
+func (o *UpdateIDParams) SetBody(body *models.V1alpha1UpdateRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateIDParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
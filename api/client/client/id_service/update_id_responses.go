@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// UpdateIDReader is a Reader for the UpdateID structure.
+type UpdateIDReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateIDReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateIDOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewUpdateIDDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdateIDOK creates a UpdateIDOK with default headers values
+func NewUpdateIDOK() *UpdateIDOK {
+	return &UpdateIDOK{}
+}
+
+/*
+UpdateIDOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type UpdateIDOK struct {
+	Payload *models.V1alpha1UpdateResponse
+}
+
+// IsSuccess returns true when this update Id o k response has a 2xx status code
+func (o *UpdateIDOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this update Id o k response has a 3xx status code
+func (o *UpdateIDOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update Id o k response has a 4xx status code
+func (o *UpdateIDOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update Id o k response has a 5xx status code
+func (o *UpdateIDOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update Id o k response a status code equal to that given
+func (o *UpdateIDOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the update Id o k response
+func (o *UpdateIDOK) Code() int {
+	return 200
+}
+
+func (o *UpdateIDOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/id/update][%d] updateIdOK %s", 200, payload)
+}
+
+func (o *UpdateIDOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/id/update][%d] updateIdOK %s", 200, payload)
+}
+
+func (o *UpdateIDOK) GetPayload() *models.V1alpha1UpdateResponse {
+	return o.Payload
+}
+
+func (o *UpdateIDOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.V1alpha1UpdateResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateIDDefault creates a UpdateIDDefault with default headers values
+func NewUpdateIDDefault(code int) *UpdateIDDefault {
+	return &UpdateIDDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+UpdateIDDefault describes a response with status code -1, with default header values.
+
+An unexpected error response.
+*/
+type UpdateIDDefault struct {
+	_statusCode int
+
+	Payload *models.RPCStatus
+}
+
+// IsSuccess returns true when this update Id default response has a 2xx status code
+func (o *UpdateIDDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this update Id default response has a 3xx status code
+func (o *UpdateIDDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this update Id default response has a 4xx status code
+func (o *UpdateIDDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this update Id default response has a 5xx status code
+func (o *UpdateIDDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this update Id default response a status code equal to that given
+func (o *UpdateIDDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the update Id default response
+func (o *UpdateIDDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdateIDDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/id/update][%d] UpdateId default %s", o._statusCode, payload)
+}
+
+func (o *UpdateIDDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/id/update][%d] UpdateId default %s", o._statusCode, payload)
+}
+
+func (o *UpdateIDDefault) GetPayload() *models.RPCStatus {
+	return o.Payload
+}
+
+func (o *UpdateIDDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RPCStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
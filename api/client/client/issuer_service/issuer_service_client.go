@@ -32,6 +32,8 @@ type ClientService interface {
 
 	RegisterIssuer(params *RegisterIssuerParams, opts ...ClientOption) (*RegisterIssuerOK, error)
 
+	RotateIssuerKey(params *RotateIssuerKeyParams, opts ...ClientOption) (*RotateIssuerKeyOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -109,6 +111,43 @@ func (a *Client) RegisterIssuer(params *RegisterIssuerParams, opts ...ClientOpti
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+RotateIssuerKey rotates the key of an issuer by providing a proof signed by the current key and the new public key
+*/
+func (a *Client) RotateIssuerKey(params *RotateIssuerKeyParams, opts ...ClientOption) (*RotateIssuerKeyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRotateIssuerKeyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RotateIssuerKey",
+		Method:             "POST",
+		PathPattern:        "/v1alpha1/issuer/{commonName}/rotate-key",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RotateIssuerKeyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RotateIssuerKeyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RotateIssuerKeyDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
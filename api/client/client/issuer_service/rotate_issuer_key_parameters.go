@@ -0,0 +1,172 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issuer_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// NewRotateIssuerKeyParams creates a new RotateIssuerKeyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRotateIssuerKeyParams() *RotateIssuerKeyParams {
+	return &RotateIssuerKeyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRotateIssuerKeyParamsWithTimeout creates a new RotateIssuerKeyParams object
+// with the ability to set a timeout on a request.
+func NewRotateIssuerKeyParamsWithTimeout(timeout time.Duration) *RotateIssuerKeyParams {
+	return &RotateIssuerKeyParams{
+		timeout: timeout,
+	}
+}
+
+// NewRotateIssuerKeyParamsWithContext creates a new RotateIssuerKeyParams object
+// with the ability to set a context for a request.
+func NewRotateIssuerKeyParamsWithContext(ctx context.Context) *RotateIssuerKeyParams {
+	return &RotateIssuerKeyParams{
+		Context: ctx,
+	}
+}
+
+// NewRotateIssuerKeyParamsWithHTTPClient creates a new RotateIssuerKeyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRotateIssuerKeyParamsWithHTTPClient(client *http.Client) *RotateIssuerKeyParams {
+	return &RotateIssuerKeyParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RotateIssuerKeyParams contains all the parameters to send to the API endpoint
+
+	for the rotate issuer key operation.
+
+	Typically these are written to a http.Request.
+*/
+type RotateIssuerKeyParams struct {
+
+	// Body.
+	Body *models.IssuerServiceRotateKeyBody
+
+	/* CommonName.
+
+	   The common name of the issuer
+	*/
+	CommonName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the rotate issuer key params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RotateIssuerKeyParams) WithDefaults() *RotateIssuerKeyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the rotate issuer key params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RotateIssuerKeyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the rotate issuer key params
+func (o *RotateIssuerKeyParams) WithTimeout(timeout time.Duration) *RotateIssuerKeyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the rotate issuer key params
+func (o *RotateIssuerKeyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the rotate issuer key params
+func (o *RotateIssuerKeyParams) WithContext(ctx context.Context) *RotateIssuerKeyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the rotate issuer key params
+func (o *RotateIssuerKeyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the rotate issuer key params
+func (o *RotateIssuerKeyParams) WithHTTPClient(client *http.Client) *RotateIssuerKeyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the rotate issuer key params
+func (o *RotateIssuerKeyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the rotate issuer key params
+func (o *RotateIssuerKeyParams) WithBody(body *models.IssuerServiceRotateKeyBody) *RotateIssuerKeyParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the rotate issuer key params
+func (o *RotateIssuerKeyParams) SetBody(body *models.IssuerServiceRotateKeyBody) {
+	o.Body = body
+}
+
+// WithCommonName adds the commonName to the rotate issuer key params
+func (o *RotateIssuerKeyParams) WithCommonName(commonName string) *RotateIssuerKeyParams {
+	o.SetCommonName(commonName)
+	return o
+}
+
+// SetCommonName adds the commonName to the rotate issuer key params
+func (o *RotateIssuerKeyParams) SetCommonName(commonName string) {
+	o.CommonName = commonName
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RotateIssuerKeyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param commonName
+	if err := r.SetPathParam("commonName", o.CommonName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
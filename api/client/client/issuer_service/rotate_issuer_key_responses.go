@@ -0,0 +1,186 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issuer_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// RotateIssuerKeyReader is a Reader for the RotateIssuerKey structure.
+type RotateIssuerKeyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RotateIssuerKeyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRotateIssuerKeyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewRotateIssuerKeyDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRotateIssuerKeyOK creates a RotateIssuerKeyOK with default headers values
+func NewRotateIssuerKeyOK() *RotateIssuerKeyOK {
+	return &RotateIssuerKeyOK{}
+}
+
+/*
+RotateIssuerKeyOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type RotateIssuerKeyOK struct {
+	Payload models.V1alpha1RotateIssuerKeyResponse
+}
+
+// IsSuccess returns true when this rotate issuer key o k response has a 2xx status code
+func (o *RotateIssuerKeyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this rotate issuer key o k response has a 3xx status code
+func (o *RotateIssuerKeyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this rotate issuer key o k response has a 4xx status code
+func (o *RotateIssuerKeyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this rotate issuer key o k response has a 5xx status code
+func (o *RotateIssuerKeyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this rotate issuer key o k response a status code equal to that given
+func (o *RotateIssuerKeyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the rotate issuer key o k response
+func (o *RotateIssuerKeyOK) Code() int {
+	return 200
+}
+
+func (o *RotateIssuerKeyOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/issuer/{commonName}/rotate-key][%d] rotateIssuerKeyOK %s", 200, payload)
+}
+
+func (o *RotateIssuerKeyOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/issuer/{commonName}/rotate-key][%d] rotateIssuerKeyOK %s", 200, payload)
+}
+
+func (o *RotateIssuerKeyOK) GetPayload() models.V1alpha1RotateIssuerKeyResponse {
+	return o.Payload
+}
+
+func (o *RotateIssuerKeyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewRotateIssuerKeyDefault creates a RotateIssuerKeyDefault with default headers values
+func NewRotateIssuerKeyDefault(code int) *RotateIssuerKeyDefault {
+	return &RotateIssuerKeyDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+RotateIssuerKeyDefault describes a response with status code -1, with default header values.
+
+An unexpected error response.
+*/
+type RotateIssuerKeyDefault struct {
+	_statusCode int
+
+	Payload *models.RPCStatus
+}
+
+// IsSuccess returns true when this rotate issuer key default response has a 2xx status code
+func (o *RotateIssuerKeyDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this rotate issuer key default response has a 3xx status code
+func (o *RotateIssuerKeyDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this rotate issuer key default response has a 4xx status code
+func (o *RotateIssuerKeyDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this rotate issuer key default response has a 5xx status code
+func (o *RotateIssuerKeyDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this rotate issuer key default response a status code equal to that given
+func (o *RotateIssuerKeyDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the rotate issuer key default response
+func (o *RotateIssuerKeyDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *RotateIssuerKeyDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/issuer/{commonName}/rotate-key][%d] RotateIssuerKey default %s", o._statusCode, payload)
+}
+
+func (o *RotateIssuerKeyDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/issuer/{commonName}/rotate-key][%d] RotateIssuerKey default %s", o._statusCode, payload)
+}
+
+func (o *RotateIssuerKeyDefault) GetPayload() *models.RPCStatus {
+	return o.Payload
+}
+
+func (o *RotateIssuerKeyDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RPCStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
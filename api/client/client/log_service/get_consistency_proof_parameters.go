@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetConsistencyProofParams creates a new GetConsistencyProofParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetConsistencyProofParams() *GetConsistencyProofParams {
+	return &GetConsistencyProofParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetConsistencyProofParamsWithTimeout creates a new GetConsistencyProofParams object
+// with the ability to set a timeout on a request.
+func NewGetConsistencyProofParamsWithTimeout(timeout time.Duration) *GetConsistencyProofParams {
+	return &GetConsistencyProofParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetConsistencyProofParamsWithContext creates a new GetConsistencyProofParams object
+// with the ability to set a context for a request.
+func NewGetConsistencyProofParamsWithContext(ctx context.Context) *GetConsistencyProofParams {
+	return &GetConsistencyProofParams{
+		Context: ctx,
+	}
+}
+
+// NewGetConsistencyProofParamsWithHTTPClient creates a new GetConsistencyProofParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetConsistencyProofParamsWithHTTPClient(client *http.Client) *GetConsistencyProofParams {
+	return &GetConsistencyProofParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetConsistencyProofParams contains all the parameters to send to the API endpoint
+
+	for the get consistency proof operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetConsistencyProofParams struct {
+
+	/* FirstSize.
+
+	   The size of the first tree
+
+	   Format: uint64
+	*/
+	FirstSize *string
+
+	/* SecondSize.
+
+	     The size of the second tree,
+	the current tree is used when omitted
+
+	     Format: uint64
+	*/
+	SecondSize *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get consistency proof params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetConsistencyProofParams) WithDefaults() *GetConsistencyProofParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get consistency proof params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetConsistencyProofParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get consistency proof params
+func (o *GetConsistencyProofParams) WithTimeout(timeout time.Duration) *GetConsistencyProofParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get consistency proof params
+func (o *GetConsistencyProofParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get consistency proof params
+func (o *GetConsistencyProofParams) WithContext(ctx context.Context) *GetConsistencyProofParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get consistency proof params
+func (o *GetConsistencyProofParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get consistency proof params
+func (o *GetConsistencyProofParams) WithHTTPClient(client *http.Client) *GetConsistencyProofParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get consistency proof params
+func (o *GetConsistencyProofParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFirstSize adds the firstSize to the get consistency proof params
+func (o *GetConsistencyProofParams) WithFirstSize(firstSize *string) *GetConsistencyProofParams {
+	o.SetFirstSize(firstSize)
+	return o
+}
+
+// SetFirstSize adds the firstSize to the get consistency proof params
+func (o *GetConsistencyProofParams) SetFirstSize(firstSize *string) {
+	o.FirstSize = firstSize
+}
+
+// WithSecondSize adds the secondSize to the get consistency proof params
+func (o *GetConsistencyProofParams) WithSecondSize(secondSize *string) *GetConsistencyProofParams {
+	o.SetSecondSize(secondSize)
+	return o
+}
+
+// SetSecondSize adds the secondSize to the get consistency proof params
+func (o *GetConsistencyProofParams) SetSecondSize(secondSize *string) {
+	o.SecondSize = secondSize
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetConsistencyProofParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.FirstSize != nil {
+
+		// query param firstSize
+		var qrFirstSize string
+
+		if o.FirstSize != nil {
+			qrFirstSize = *o.FirstSize
+		}
+		qFirstSize := qrFirstSize
+		if qFirstSize != "" {
+
+			if err := r.SetQueryParam("firstSize", qFirstSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.SecondSize != nil {
+
+		// query param secondSize
+		var qrSecondSize string
+
+		if o.SecondSize != nil {
+			qrSecondSize = *o.SecondSize
+		}
+		qSecondSize := qrSecondSize
+		if qSecondSize != "" {
+
+			if err := r.SetQueryParam("secondSize", qSecondSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// GetConsistencyProofReader is a Reader for the GetConsistencyProof structure.
+type GetConsistencyProofReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetConsistencyProofReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetConsistencyProofOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetConsistencyProofDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetConsistencyProofOK creates a GetConsistencyProofOK with default headers values
+func NewGetConsistencyProofOK() *GetConsistencyProofOK {
+	return &GetConsistencyProofOK{}
+}
+
+/*
+GetConsistencyProofOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetConsistencyProofOK struct {
+	Payload *models.V1alpha1GetConsistencyProofResponse
+}
+
+// IsSuccess returns true when this get consistency proof o k response has a 2xx status code
+func (o *GetConsistencyProofOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get consistency proof o k response has a 3xx status code
+func (o *GetConsistencyProofOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get consistency proof o k response has a 4xx status code
+func (o *GetConsistencyProofOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get consistency proof o k response has a 5xx status code
+func (o *GetConsistencyProofOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get consistency proof o k response a status code equal to that given
+func (o *GetConsistencyProofOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get consistency proof o k response
+func (o *GetConsistencyProofOK) Code() int {
+	return 200
+}
+
+func (o *GetConsistencyProofOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/proof/consistency][%d] getConsistencyProofOK %s", 200, payload)
+}
+
+func (o *GetConsistencyProofOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/proof/consistency][%d] getConsistencyProofOK %s", 200, payload)
+}
+
+func (o *GetConsistencyProofOK) GetPayload() *models.V1alpha1GetConsistencyProofResponse {
+	return o.Payload
+}
+
+func (o *GetConsistencyProofOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.V1alpha1GetConsistencyProofResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetConsistencyProofDefault creates a GetConsistencyProofDefault with default headers values
+func NewGetConsistencyProofDefault(code int) *GetConsistencyProofDefault {
+	return &GetConsistencyProofDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetConsistencyProofDefault describes a response with status code -1, with default header values.
+
+An unexpected error response.
+*/
+type GetConsistencyProofDefault struct {
+	_statusCode int
+
+	Payload *models.RPCStatus
+}
+
+// IsSuccess returns true when this get consistency proof default response has a 2xx status code
+func (o *GetConsistencyProofDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get consistency proof default response has a 3xx status code
+func (o *GetConsistencyProofDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get consistency proof default response has a 4xx status code
+func (o *GetConsistencyProofDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get consistency proof default response has a 5xx status code
+func (o *GetConsistencyProofDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get consistency proof default response a status code equal to that given
+func (o *GetConsistencyProofDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get consistency proof default response
+func (o *GetConsistencyProofDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetConsistencyProofDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/proof/consistency][%d] GetConsistencyProof default %s", o._statusCode, payload)
+}
+
+func (o *GetConsistencyProofDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/proof/consistency][%d] GetConsistencyProof default %s", o._statusCode, payload)
+}
+
+func (o *GetConsistencyProofDefault) GetPayload() *models.RPCStatus {
+	return o.Payload
+}
+
+func (o *GetConsistencyProofDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RPCStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
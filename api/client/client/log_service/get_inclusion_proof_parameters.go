@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetInclusionProofParams creates a new GetInclusionProofParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetInclusionProofParams() *GetInclusionProofParams {
+	return &GetInclusionProofParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetInclusionProofParamsWithTimeout creates a new GetInclusionProofParams object
+// with the ability to set a timeout on a request.
+func NewGetInclusionProofParamsWithTimeout(timeout time.Duration) *GetInclusionProofParams {
+	return &GetInclusionProofParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetInclusionProofParamsWithContext creates a new GetInclusionProofParams object
+// with the ability to set a context for a request.
+func NewGetInclusionProofParamsWithContext(ctx context.Context) *GetInclusionProofParams {
+	return &GetInclusionProofParams{
+		Context: ctx,
+	}
+}
+
+// NewGetInclusionProofParamsWithHTTPClient creates a new GetInclusionProofParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetInclusionProofParamsWithHTTPClient(client *http.Client) *GetInclusionProofParams {
+	return &GetInclusionProofParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetInclusionProofParams contains all the parameters to send to the API endpoint
+
+	for the get inclusion proof operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetInclusionProofParams struct {
+
+	/* LeafHash.
+
+	   The hash of the leaf
+
+	   Format: byte
+	*/
+	LeafHash *strfmt.Base64
+
+	/* TreeSize.
+
+	     The size of the tree to prove the inclusion in,
+	the current tree is used when omitted
+
+	     Format: uint64
+	*/
+	TreeSize *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get inclusion proof params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetInclusionProofParams) WithDefaults() *GetInclusionProofParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get inclusion proof params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetInclusionProofParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get inclusion proof params
+func (o *GetInclusionProofParams) WithTimeout(timeout time.Duration) *GetInclusionProofParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get inclusion proof params
+func (o *GetInclusionProofParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get inclusion proof params
+func (o *GetInclusionProofParams) WithContext(ctx context.Context) *GetInclusionProofParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get inclusion proof params
+func (o *GetInclusionProofParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get inclusion proof params
+func (o *GetInclusionProofParams) WithHTTPClient(client *http.Client) *GetInclusionProofParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get inclusion proof params
+func (o *GetInclusionProofParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLeafHash adds the leafHash to the get inclusion proof params
+func (o *GetInclusionProofParams) WithLeafHash(leafHash *strfmt.Base64) *GetInclusionProofParams {
+	o.SetLeafHash(leafHash)
+	return o
+}
+
+// SetLeafHash adds the leafHash to the get inclusion proof params
+func (o *GetInclusionProofParams) SetLeafHash(leafHash *strfmt.Base64) {
+	o.LeafHash = leafHash
+}
+
+// WithTreeSize adds the treeSize to the get inclusion proof params
+func (o *GetInclusionProofParams) WithTreeSize(treeSize *string) *GetInclusionProofParams {
+	o.SetTreeSize(treeSize)
+	return o
+}
+
+// SetTreeSize adds the treeSize to the get inclusion proof params
+func (o *GetInclusionProofParams) SetTreeSize(treeSize *string) {
+	o.TreeSize = treeSize
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetInclusionProofParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.LeafHash != nil {
+
+		// query param leafHash
+		var qrLeafHash strfmt.Base64
+
+		if o.LeafHash != nil {
+			qrLeafHash = *o.LeafHash
+		}
+		qLeafHash := qrLeafHash.String()
+		if qLeafHash != "" {
+
+			if err := r.SetQueryParam("leafHash", qLeafHash); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.TreeSize != nil {
+
+		// query param treeSize
+		var qrTreeSize string
+
+		if o.TreeSize != nil {
+			qrTreeSize = *o.TreeSize
+		}
+		qTreeSize := qrTreeSize
+		if qTreeSize != "" {
+
+			if err := r.SetQueryParam("treeSize", qTreeSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
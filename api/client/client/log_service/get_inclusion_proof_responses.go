@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// GetInclusionProofReader is a Reader for the GetInclusionProof structure.
+type GetInclusionProofReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetInclusionProofReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetInclusionProofOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetInclusionProofDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetInclusionProofOK creates a GetInclusionProofOK with default headers values
+func NewGetInclusionProofOK() *GetInclusionProofOK {
+	return &GetInclusionProofOK{}
+}
+
+/*
+GetInclusionProofOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetInclusionProofOK struct {
+	Payload *models.V1alpha1GetInclusionProofResponse
+}
+
+// IsSuccess returns true when this get inclusion proof o k response has a 2xx status code
+func (o *GetInclusionProofOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get inclusion proof o k response has a 3xx status code
+func (o *GetInclusionProofOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get inclusion proof o k response has a 4xx status code
+func (o *GetInclusionProofOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get inclusion proof o k response has a 5xx status code
+func (o *GetInclusionProofOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get inclusion proof o k response a status code equal to that given
+func (o *GetInclusionProofOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get inclusion proof o k response
+func (o *GetInclusionProofOK) Code() int {
+	return 200
+}
+
+func (o *GetInclusionProofOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/proof/inclusion][%d] getInclusionProofOK %s", 200, payload)
+}
+
+func (o *GetInclusionProofOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/proof/inclusion][%d] getInclusionProofOK %s", 200, payload)
+}
+
+func (o *GetInclusionProofOK) GetPayload() *models.V1alpha1GetInclusionProofResponse {
+	return o.Payload
+}
+
+func (o *GetInclusionProofOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.V1alpha1GetInclusionProofResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetInclusionProofDefault creates a GetInclusionProofDefault with default headers values
+func NewGetInclusionProofDefault(code int) *GetInclusionProofDefault {
+	return &GetInclusionProofDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetInclusionProofDefault describes a response with status code -1, with default header values.
+
+An unexpected error response.
+*/
+type GetInclusionProofDefault struct {
+	_statusCode int
+
+	Payload *models.RPCStatus
+}
+
+// IsSuccess returns true when this get inclusion proof default response has a 2xx status code
+func (o *GetInclusionProofDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get inclusion proof default response has a 3xx status code
+func (o *GetInclusionProofDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get inclusion proof default response has a 4xx status code
+func (o *GetInclusionProofDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get inclusion proof default response has a 5xx status code
+func (o *GetInclusionProofDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get inclusion proof default response a status code equal to that given
+func (o *GetInclusionProofDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get inclusion proof default response
+func (o *GetInclusionProofDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetInclusionProofDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/proof/inclusion][%d] GetInclusionProof default %s", o._statusCode, payload)
+}
+
+func (o *GetInclusionProofDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/proof/inclusion][%d] GetInclusionProof default %s", o._statusCode, payload)
+}
+
+func (o *GetInclusionProofDefault) GetPayload() *models.RPCStatus {
+	return o.Payload
+}
+
+func (o *GetInclusionProofDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RPCStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
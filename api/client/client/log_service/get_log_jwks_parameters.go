@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetLogJwksParams creates a new GetLogJwksParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetLogJwksParams() *GetLogJwksParams {
+	return &GetLogJwksParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetLogJwksParamsWithTimeout creates a new GetLogJwksParams object
+// with the ability to set a timeout on a request.
+func NewGetLogJwksParamsWithTimeout(timeout time.Duration) *GetLogJwksParams {
+	return &GetLogJwksParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetLogJwksParamsWithContext creates a new GetLogJwksParams object
+// with the ability to set a context for a request.
+func NewGetLogJwksParamsWithContext(ctx context.Context) *GetLogJwksParams {
+	return &GetLogJwksParams{
+		Context: ctx,
+	}
+}
+
+// NewGetLogJwksParamsWithHTTPClient creates a new GetLogJwksParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetLogJwksParamsWithHTTPClient(client *http.Client) *GetLogJwksParams {
+	return &GetLogJwksParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetLogJwksParams contains all the parameters to send to the API endpoint
+
+	for the get log jwks operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetLogJwksParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get log jwks params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetLogJwksParams) WithDefaults() *GetLogJwksParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get log jwks params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetLogJwksParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get log jwks params
+func (o *GetLogJwksParams) WithTimeout(timeout time.Duration) *GetLogJwksParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get log jwks params
+func (o *GetLogJwksParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get log jwks params
+func (o *GetLogJwksParams) WithContext(ctx context.Context) *GetLogJwksParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get log jwks params
+func (o *GetLogJwksParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get log jwks params
+func (o *GetLogJwksParams) WithHTTPClient(client *http.Client) *GetLogJwksParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get log jwks params
+func (o *GetLogJwksParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetLogJwksParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
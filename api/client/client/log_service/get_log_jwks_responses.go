@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// GetLogJwksReader is a Reader for the GetLogJwks structure.
+type GetLogJwksReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetLogJwksReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetLogJwksOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetLogJwksDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetLogJwksOK creates a GetLogJwksOK with default headers values
+func NewGetLogJwksOK() *GetLogJwksOK {
+	return &GetLogJwksOK{}
+}
+
+/*
+GetLogJwksOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetLogJwksOK struct {
+	Payload *models.V1alpha1GetLogJwksResponse
+}
+
+// IsSuccess returns true when this get log jwks o k response has a 2xx status code
+func (o *GetLogJwksOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get log jwks o k response has a 3xx status code
+func (o *GetLogJwksOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get log jwks o k response has a 4xx status code
+func (o *GetLogJwksOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get log jwks o k response has a 5xx status code
+func (o *GetLogJwksOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get log jwks o k response a status code equal to that given
+func (o *GetLogJwksOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get log jwks o k response
+func (o *GetLogJwksOK) Code() int {
+	return 200
+}
+
+func (o *GetLogJwksOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/.well-known/jwks.json][%d] getLogJwksOK %s", 200, payload)
+}
+
+func (o *GetLogJwksOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/.well-known/jwks.json][%d] getLogJwksOK %s", 200, payload)
+}
+
+func (o *GetLogJwksOK) GetPayload() *models.V1alpha1GetLogJwksResponse {
+	return o.Payload
+}
+
+func (o *GetLogJwksOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.V1alpha1GetLogJwksResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetLogJwksDefault creates a GetLogJwksDefault with default headers values
+func NewGetLogJwksDefault(code int) *GetLogJwksDefault {
+	return &GetLogJwksDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetLogJwksDefault describes a response with status code -1, with default header values.
+
+An unexpected error response.
+*/
+type GetLogJwksDefault struct {
+	_statusCode int
+
+	Payload *models.RPCStatus
+}
+
+// IsSuccess returns true when this get log jwks default response has a 2xx status code
+func (o *GetLogJwksDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get log jwks default response has a 3xx status code
+func (o *GetLogJwksDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get log jwks default response has a 4xx status code
+func (o *GetLogJwksDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get log jwks default response has a 5xx status code
+func (o *GetLogJwksDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get log jwks default response a status code equal to that given
+func (o *GetLogJwksDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get log jwks default response
+func (o *GetLogJwksDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetLogJwksDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/.well-known/jwks.json][%d] GetLogJwks default %s", o._statusCode, payload)
+}
+
+func (o *GetLogJwksDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/.well-known/jwks.json][%d] GetLogJwks default %s", o._statusCode, payload)
+}
+
+func (o *GetLogJwksDefault) GetPayload() *models.RPCStatus {
+	return o.Payload
+}
+
+func (o *GetLogJwksDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RPCStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetSignedTreeHeadParams creates a new GetSignedTreeHeadParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetSignedTreeHeadParams() *GetSignedTreeHeadParams {
+	return &GetSignedTreeHeadParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetSignedTreeHeadParamsWithTimeout creates a new GetSignedTreeHeadParams object
+// with the ability to set a timeout on a request.
+func NewGetSignedTreeHeadParamsWithTimeout(timeout time.Duration) *GetSignedTreeHeadParams {
+	return &GetSignedTreeHeadParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetSignedTreeHeadParamsWithContext creates a new GetSignedTreeHeadParams object
+// with the ability to set a context for a request.
+func NewGetSignedTreeHeadParamsWithContext(ctx context.Context) *GetSignedTreeHeadParams {
+	return &GetSignedTreeHeadParams{
+		Context: ctx,
+	}
+}
+
+// NewGetSignedTreeHeadParamsWithHTTPClient creates a new GetSignedTreeHeadParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetSignedTreeHeadParamsWithHTTPClient(client *http.Client) *GetSignedTreeHeadParams {
+	return &GetSignedTreeHeadParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetSignedTreeHeadParams contains all the parameters to send to the API endpoint
+
+	for the get signed tree head operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetSignedTreeHeadParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get signed tree head params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetSignedTreeHeadParams) WithDefaults() *GetSignedTreeHeadParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get signed tree head params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetSignedTreeHeadParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get signed tree head params
+func (o *GetSignedTreeHeadParams) WithTimeout(timeout time.Duration) *GetSignedTreeHeadParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get signed tree head params
+func (o *GetSignedTreeHeadParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get signed tree head params
+func (o *GetSignedTreeHeadParams) WithContext(ctx context.Context) *GetSignedTreeHeadParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get signed tree head params
+func (o *GetSignedTreeHeadParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get signed tree head params
+func (o *GetSignedTreeHeadParams) WithHTTPClient(client *http.Client) *GetSignedTreeHeadParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get signed tree head params
+func (o *GetSignedTreeHeadParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetSignedTreeHeadParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// GetSignedTreeHeadReader is a Reader for the GetSignedTreeHead structure.
+type GetSignedTreeHeadReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetSignedTreeHeadReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetSignedTreeHeadOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetSignedTreeHeadDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetSignedTreeHeadOK creates a GetSignedTreeHeadOK with default headers values
+func NewGetSignedTreeHeadOK() *GetSignedTreeHeadOK {
+	return &GetSignedTreeHeadOK{}
+}
+
+/*
+GetSignedTreeHeadOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetSignedTreeHeadOK struct {
+	Payload *models.V1alpha1GetSignedTreeHeadResponse
+}
+
+// IsSuccess returns true when this get signed tree head o k response has a 2xx status code
+func (o *GetSignedTreeHeadOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get signed tree head o k response has a 3xx status code
+func (o *GetSignedTreeHeadOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get signed tree head o k response has a 4xx status code
+func (o *GetSignedTreeHeadOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get signed tree head o k response has a 5xx status code
+func (o *GetSignedTreeHeadOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get signed tree head o k response a status code equal to that given
+func (o *GetSignedTreeHeadOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get signed tree head o k response
+func (o *GetSignedTreeHeadOK) Code() int {
+	return 200
+}
+
+func (o *GetSignedTreeHeadOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/sth][%d] getSignedTreeHeadOK %s", 200, payload)
+}
+
+func (o *GetSignedTreeHeadOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/sth][%d] getSignedTreeHeadOK %s", 200, payload)
+}
+
+func (o *GetSignedTreeHeadOK) GetPayload() *models.V1alpha1GetSignedTreeHeadResponse {
+	return o.Payload
+}
+
+func (o *GetSignedTreeHeadOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.V1alpha1GetSignedTreeHeadResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetSignedTreeHeadDefault creates a GetSignedTreeHeadDefault with default headers values
+func NewGetSignedTreeHeadDefault(code int) *GetSignedTreeHeadDefault {
+	return &GetSignedTreeHeadDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetSignedTreeHeadDefault describes a response with status code -1, with default header values.
+
+An unexpected error response.
+*/
+type GetSignedTreeHeadDefault struct {
+	_statusCode int
+
+	Payload *models.RPCStatus
+}
+
+// IsSuccess returns true when this get signed tree head default response has a 2xx status code
+func (o *GetSignedTreeHeadDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get signed tree head default response has a 3xx status code
+func (o *GetSignedTreeHeadDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get signed tree head default response has a 4xx status code
+func (o *GetSignedTreeHeadDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get signed tree head default response has a 5xx status code
+func (o *GetSignedTreeHeadDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get signed tree head default response a status code equal to that given
+func (o *GetSignedTreeHeadDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get signed tree head default response
+func (o *GetSignedTreeHeadDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetSignedTreeHeadDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/sth][%d] GetSignedTreeHead default %s", o._statusCode, payload)
+}
+
+func (o *GetSignedTreeHeadDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/log/sth][%d] GetSignedTreeHead default %s", o._statusCode, payload)
+}
+
+func (o *GetSignedTreeHeadDefault) GetPayload() *models.RPCStatus {
+	return o.Payload
+}
+
+func (o *GetSignedTreeHeadDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RPCStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
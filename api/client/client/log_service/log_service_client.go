@@ -0,0 +1,193 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package log_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new log service API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for log service API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption may be used to customize the behavior of Client methods.
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	GetConsistencyProof(params *GetConsistencyProofParams, opts ...ClientOption) (*GetConsistencyProofOK, error)
+
+	GetInclusionProof(params *GetInclusionProofParams, opts ...ClientOption) (*GetInclusionProofOK, error)
+
+	GetLogJwks(params *GetLogJwksParams, opts ...ClientOption) (*GetLogJwksOK, error)
+
+	GetSignedTreeHead(params *GetSignedTreeHeadParams, opts ...ClientOption) (*GetSignedTreeHeadOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+GetConsistencyProof returns the proof that a tree of the transparency log is a prefix of a larger tree
+*/
+func (a *Client) GetConsistencyProof(params *GetConsistencyProofParams, opts ...ClientOption) (*GetConsistencyProofOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetConsistencyProofParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetConsistencyProof",
+		Method:             "GET",
+		PathPattern:        "/v1alpha1/log/proof/consistency",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetConsistencyProofReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetConsistencyProofOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetConsistencyProofDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetInclusionProof returns the proof that a leaf is included in the transparency log
+*/
+func (a *Client) GetInclusionProof(params *GetInclusionProofParams, opts ...ClientOption) (*GetInclusionProofOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetInclusionProofParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetInclusionProof",
+		Method:             "GET",
+		PathPattern:        "/v1alpha1/log/proof/inclusion",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetInclusionProofReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetInclusionProofOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetInclusionProofDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetLogJwks returns the public keys used by the node to sign the tree heads in Json web key set j w k s format
+*/
+func (a *Client) GetLogJwks(params *GetLogJwksParams, opts ...ClientOption) (*GetLogJwksOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetLogJwksParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetLogJwks",
+		Method:             "GET",
+		PathPattern:        "/v1alpha1/log/.well-known/jwks.json",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetLogJwksReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetLogJwksOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetLogJwksDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetSignedTreeHead returns the current tree head of the transparency log signed by the node
+*/
+func (a *Client) GetSignedTreeHead(params *GetSignedTreeHeadParams, opts ...ClientOption) (*GetSignedTreeHeadOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetSignedTreeHeadParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetSignedTreeHead",
+		Method:             "GET",
+		PathPattern:        "/v1alpha1/log/sth",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetSignedTreeHeadReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetSignedTreeHeadOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetSignedTreeHeadDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vc_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetStatusListParams creates a new GetStatusListParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetStatusListParams() *GetStatusListParams {
+	return &GetStatusListParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetStatusListParamsWithTimeout creates a new GetStatusListParams object
+// with the ability to set a timeout on a request.
+func NewGetStatusListParamsWithTimeout(timeout time.Duration) *GetStatusListParams {
+	return &GetStatusListParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetStatusListParamsWithContext creates a new GetStatusListParams object
+// with the ability to set a context for a request.
+func NewGetStatusListParamsWithContext(ctx context.Context) *GetStatusListParams {
+	return &GetStatusListParams{
+		Context: ctx,
+	}
+}
+
+// NewGetStatusListParamsWithHTTPClient creates a new GetStatusListParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetStatusListParamsWithHTTPClient(client *http.Client) *GetStatusListParams {
+	return &GetStatusListParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetStatusListParams contains all the parameters to send to the API endpoint
+
+	for the get status list operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetStatusListParams struct {
+
+	/* Issuer.
+
+	   The common name of the Issuer
+	*/
+	Issuer string
+
+	/* Purpose.
+
+	   The purpose of the status list (revocation, suspension)
+	*/
+	Purpose string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get status list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetStatusListParams) WithDefaults() *GetStatusListParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get status list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetStatusListParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get status list params
+func (o *GetStatusListParams) WithTimeout(timeout time.Duration) *GetStatusListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get status list params
+func (o *GetStatusListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get status list params
+func (o *GetStatusListParams) WithContext(ctx context.Context) *GetStatusListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get status list params
+func (o *GetStatusListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get status list params
+func (o *GetStatusListParams) WithHTTPClient(client *http.Client) *GetStatusListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get status list params
+func (o *GetStatusListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIssuer adds the issuer to the get status list params
+func (o *GetStatusListParams) WithIssuer(issuer string) *GetStatusListParams {
+	o.SetIssuer(issuer)
+	return o
+}
+
+// SetIssuer adds the issuer to the get status list params
+func (o *GetStatusListParams) SetIssuer(issuer string) {
+	o.Issuer = issuer
+}
+
+// WithPurpose adds the purpose to the get status list params
+func (o *GetStatusListParams) WithPurpose(purpose string) *GetStatusListParams {
+	o.SetPurpose(purpose)
+	return o
+}
+
+// SetPurpose adds the purpose to the get status list params
+func (o *GetStatusListParams) SetPurpose(purpose string) {
+	o.Purpose = purpose
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetStatusListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param issuer
+	if err := r.SetPathParam("issuer", o.Issuer); err != nil {
+		return err
+	}
+
+	// path param purpose
+	if err := r.SetPathParam("purpose", o.Purpose); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
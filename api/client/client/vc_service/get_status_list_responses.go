@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vc_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// GetStatusListReader is a Reader for the GetStatusList structure.
+type GetStatusListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetStatusListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetStatusListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetStatusListDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetStatusListOK creates a GetStatusListOK with default headers values
+func NewGetStatusListOK() *GetStatusListOK {
+	return &GetStatusListOK{}
+}
+
+/*
+GetStatusListOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetStatusListOK struct {
+	Payload *models.V1alpha1EnvelopedCredential
+}
+
+// IsSuccess returns true when this get status list o k response has a 2xx status code
+func (o *GetStatusListOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get status list o k response has a 3xx status code
+func (o *GetStatusListOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get status list o k response has a 4xx status code
+func (o *GetStatusListOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get status list o k response has a 5xx status code
+func (o *GetStatusListOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get status list o k response a status code equal to that given
+func (o *GetStatusListOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get status list o k response
+func (o *GetStatusListOK) Code() int {
+	return 200
+}
+
+func (o *GetStatusListOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/vc/status/{issuer}/{purpose}][%d] getStatusListOK %s", 200, payload)
+}
+
+func (o *GetStatusListOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/vc/status/{issuer}/{purpose}][%d] getStatusListOK %s", 200, payload)
+}
+
+func (o *GetStatusListOK) GetPayload() *models.V1alpha1EnvelopedCredential {
+	return o.Payload
+}
+
+func (o *GetStatusListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.V1alpha1EnvelopedCredential)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetStatusListDefault creates a GetStatusListDefault with default headers values
+func NewGetStatusListDefault(code int) *GetStatusListDefault {
+	return &GetStatusListDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetStatusListDefault describes a response with status code -1, with default header values.
+
+An unexpected error response.
+*/
+type GetStatusListDefault struct {
+	_statusCode int
+
+	Payload *models.RPCStatus
+}
+
+// IsSuccess returns true when this get status list default response has a 2xx status code
+func (o *GetStatusListDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get status list default response has a 3xx status code
+func (o *GetStatusListDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get status list default response has a 4xx status code
+func (o *GetStatusListDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get status list default response has a 5xx status code
+func (o *GetStatusListDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get status list default response a status code equal to that given
+func (o *GetStatusListDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get status list default response
+func (o *GetStatusListDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetStatusListDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/vc/status/{issuer}/{purpose}][%d] GetStatusList default %s", o._statusCode, payload)
+}
+
+func (o *GetStatusListDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/vc/status/{issuer}/{purpose}][%d] GetStatusList default %s", o._statusCode, payload)
+}
+
+func (o *GetStatusListDefault) GetPayload() *models.RPCStatus {
+	return o.Payload
+}
+
+func (o *GetStatusListDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RPCStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vc_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetStatusListWellKnownParams creates a new GetStatusListWellKnownParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetStatusListWellKnownParams() *GetStatusListWellKnownParams {
+	return &GetStatusListWellKnownParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetStatusListWellKnownParamsWithTimeout creates a new GetStatusListWellKnownParams object
+// with the ability to set a timeout on a request.
+func NewGetStatusListWellKnownParamsWithTimeout(timeout time.Duration) *GetStatusListWellKnownParams {
+	return &GetStatusListWellKnownParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetStatusListWellKnownParamsWithContext creates a new GetStatusListWellKnownParams object
+// with the ability to set a context for a request.
+func NewGetStatusListWellKnownParamsWithContext(ctx context.Context) *GetStatusListWellKnownParams {
+	return &GetStatusListWellKnownParams{
+		Context: ctx,
+	}
+}
+
+// NewGetStatusListWellKnownParamsWithHTTPClient creates a new GetStatusListWellKnownParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetStatusListWellKnownParamsWithHTTPClient(client *http.Client) *GetStatusListWellKnownParams {
+	return &GetStatusListWellKnownParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetStatusListWellKnownParams contains all the parameters to send to the API endpoint
+
+	for the get status list well known operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetStatusListWellKnownParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get status list well known params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetStatusListWellKnownParams) WithDefaults() *GetStatusListWellKnownParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get status list well known params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetStatusListWellKnownParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get status list well known params
+func (o *GetStatusListWellKnownParams) WithTimeout(timeout time.Duration) *GetStatusListWellKnownParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get status list well known params
+func (o *GetStatusListWellKnownParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get status list well known params
+func (o *GetStatusListWellKnownParams) WithContext(ctx context.Context) *GetStatusListWellKnownParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get status list well known params
+func (o *GetStatusListWellKnownParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get status list well known params
+func (o *GetStatusListWellKnownParams) WithHTTPClient(client *http.Client) *GetStatusListWellKnownParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get status list well known params
+func (o *GetStatusListWellKnownParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetStatusListWellKnownParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vc_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// GetStatusListWellKnownReader is a Reader for the GetStatusListWellKnown structure.
+type GetStatusListWellKnownReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetStatusListWellKnownReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetStatusListWellKnownOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetStatusListWellKnownDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetStatusListWellKnownOK creates a GetStatusListWellKnownOK with default headers values
+func NewGetStatusListWellKnownOK() *GetStatusListWellKnownOK {
+	return &GetStatusListWellKnownOK{}
+}
+
+/*
+GetStatusListWellKnownOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type GetStatusListWellKnownOK struct {
+	Payload *models.V1alpha1GetStatusListWellKnownResponse
+}
+
+// IsSuccess returns true when this get status list well known o k response has a 2xx status code
+func (o *GetStatusListWellKnownOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get status list well known o k response has a 3xx status code
+func (o *GetStatusListWellKnownOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get status list well known o k response has a 4xx status code
+func (o *GetStatusListWellKnownOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get status list well known o k response has a 5xx status code
+func (o *GetStatusListWellKnownOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get status list well known o k response a status code equal to that given
+func (o *GetStatusListWellKnownOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get status list well known o k response
+func (o *GetStatusListWellKnownOK) Code() int {
+	return 200
+}
+
+func (o *GetStatusListWellKnownOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/vc/.well-known/jwks.json][%d] getStatusListWellKnownOK %s", 200, payload)
+}
+
+func (o *GetStatusListWellKnownOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/vc/.well-known/jwks.json][%d] getStatusListWellKnownOK %s", 200, payload)
+}
+
+func (o *GetStatusListWellKnownOK) GetPayload() *models.V1alpha1GetStatusListWellKnownResponse {
+	return o.Payload
+}
+
+func (o *GetStatusListWellKnownOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.V1alpha1GetStatusListWellKnownResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetStatusListWellKnownDefault creates a GetStatusListWellKnownDefault with default headers values
+func NewGetStatusListWellKnownDefault(code int) *GetStatusListWellKnownDefault {
+	return &GetStatusListWellKnownDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+GetStatusListWellKnownDefault describes a response with status code -1, with default header values.
+
+An unexpected error response.
+*/
+type GetStatusListWellKnownDefault struct {
+	_statusCode int
+
+	Payload *models.RPCStatus
+}
+
+// IsSuccess returns true when this get status list well known default response has a 2xx status code
+func (o *GetStatusListWellKnownDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this get status list well known default response has a 3xx status code
+func (o *GetStatusListWellKnownDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this get status list well known default response has a 4xx status code
+func (o *GetStatusListWellKnownDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this get status list well known default response has a 5xx status code
+func (o *GetStatusListWellKnownDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this get status list well known default response a status code equal to that given
+func (o *GetStatusListWellKnownDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the get status list well known default response
+func (o *GetStatusListWellKnownDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetStatusListWellKnownDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/vc/.well-known/jwks.json][%d] GetStatusListWellKnown default %s", o._statusCode, payload)
+}
+
+func (o *GetStatusListWellKnownDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/vc/.well-known/jwks.json][%d] GetStatusListWellKnown default %s", o._statusCode, payload)
+}
+
+func (o *GetStatusListWellKnownDefault) GetPayload() *models.RPCStatus {
+	return o.Payload
+}
+
+func (o *GetStatusListWellKnownDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RPCStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
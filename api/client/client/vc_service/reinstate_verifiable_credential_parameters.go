@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vc_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// NewReinstateVerifiableCredentialParams creates a new ReinstateVerifiableCredentialParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReinstateVerifiableCredentialParams() *ReinstateVerifiableCredentialParams {
+	return &ReinstateVerifiableCredentialParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReinstateVerifiableCredentialParamsWithTimeout creates a new ReinstateVerifiableCredentialParams object
+// with the ability to set a timeout on a request.
+func NewReinstateVerifiableCredentialParamsWithTimeout(timeout time.Duration) *ReinstateVerifiableCredentialParams {
+	return &ReinstateVerifiableCredentialParams{
+		timeout: timeout,
+	}
+}
+
+// NewReinstateVerifiableCredentialParamsWithContext creates a new ReinstateVerifiableCredentialParams object
+// with the ability to set a context for a request.
+func NewReinstateVerifiableCredentialParamsWithContext(ctx context.Context) *ReinstateVerifiableCredentialParams {
+	return &ReinstateVerifiableCredentialParams{
+		Context: ctx,
+	}
+}
+
+// NewReinstateVerifiableCredentialParamsWithHTTPClient creates a new ReinstateVerifiableCredentialParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReinstateVerifiableCredentialParamsWithHTTPClient(client *http.Client) *ReinstateVerifiableCredentialParams {
+	return &ReinstateVerifiableCredentialParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReinstateVerifiableCredentialParams contains all the parameters to send to the API endpoint
+
+	for the reinstate verifiable credential operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReinstateVerifiableCredentialParams struct {
+
+	// Body.
+	Body *models.V1alpha1ReinstateRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reinstate verifiable credential params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReinstateVerifiableCredentialParams) WithDefaults() *ReinstateVerifiableCredentialParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reinstate verifiable credential params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReinstateVerifiableCredentialParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the reinstate verifiable credential params
+func (o *ReinstateVerifiableCredentialParams) WithTimeout(timeout time.Duration) *ReinstateVerifiableCredentialParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reinstate verifiable credential params
+func (o *ReinstateVerifiableCredentialParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reinstate verifiable credential params
+func (o *ReinstateVerifiableCredentialParams) WithContext(ctx context.Context) *ReinstateVerifiableCredentialParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reinstate verifiable credential params
+func (o *ReinstateVerifiableCredentialParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reinstate verifiable credential params
+func (o *ReinstateVerifiableCredentialParams) WithHTTPClient(client *http.Client) *ReinstateVerifiableCredentialParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reinstate verifiable credential params
+func (o *ReinstateVerifiableCredentialParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the reinstate verifiable credential params
+func (o *ReinstateVerifiableCredentialParams) WithBody(body *models.V1alpha1ReinstateRequest) *ReinstateVerifiableCredentialParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the reinstate verifiable credential params
+func (o *ReinstateVerifiableCredentialParams) SetBody(body *models.V1alpha1ReinstateRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReinstateVerifiableCredentialParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
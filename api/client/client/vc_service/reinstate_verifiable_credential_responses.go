@@ -0,0 +1,186 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vc_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// ReinstateVerifiableCredentialReader is a Reader for the ReinstateVerifiableCredential structure.
+type ReinstateVerifiableCredentialReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReinstateVerifiableCredentialReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReinstateVerifiableCredentialOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewReinstateVerifiableCredentialDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewReinstateVerifiableCredentialOK creates a ReinstateVerifiableCredentialOK with default headers values
+func NewReinstateVerifiableCredentialOK() *ReinstateVerifiableCredentialOK {
+	return &ReinstateVerifiableCredentialOK{}
+}
+
+/*
+ReinstateVerifiableCredentialOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type ReinstateVerifiableCredentialOK struct {
+	Payload any
+}
+
+// IsSuccess returns true when this reinstate verifiable credential o k response has a 2xx status code
+func (o *ReinstateVerifiableCredentialOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reinstate verifiable credential o k response has a 3xx status code
+func (o *ReinstateVerifiableCredentialOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reinstate verifiable credential o k response has a 4xx status code
+func (o *ReinstateVerifiableCredentialOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reinstate verifiable credential o k response has a 5xx status code
+func (o *ReinstateVerifiableCredentialOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reinstate verifiable credential o k response a status code equal to that given
+func (o *ReinstateVerifiableCredentialOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the reinstate verifiable credential o k response
+func (o *ReinstateVerifiableCredentialOK) Code() int {
+	return 200
+}
+
+func (o *ReinstateVerifiableCredentialOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/vc/reinstate][%d] reinstateVerifiableCredentialOK %s", 200, payload)
+}
+
+func (o *ReinstateVerifiableCredentialOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/vc/reinstate][%d] reinstateVerifiableCredentialOK %s", 200, payload)
+}
+
+func (o *ReinstateVerifiableCredentialOK) GetPayload() any {
+	return o.Payload
+}
+
+func (o *ReinstateVerifiableCredentialOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewReinstateVerifiableCredentialDefault creates a ReinstateVerifiableCredentialDefault with default headers values
+func NewReinstateVerifiableCredentialDefault(code int) *ReinstateVerifiableCredentialDefault {
+	return &ReinstateVerifiableCredentialDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+ReinstateVerifiableCredentialDefault describes a response with status code -1, with default header values.
+
+An unexpected error response.
+*/
+type ReinstateVerifiableCredentialDefault struct {
+	_statusCode int
+
+	Payload *models.RPCStatus
+}
+
+// IsSuccess returns true when this reinstate verifiable credential default response has a 2xx status code
+func (o *ReinstateVerifiableCredentialDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this reinstate verifiable credential default response has a 3xx status code
+func (o *ReinstateVerifiableCredentialDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this reinstate verifiable credential default response has a 4xx status code
+func (o *ReinstateVerifiableCredentialDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this reinstate verifiable credential default response has a 5xx status code
+func (o *ReinstateVerifiableCredentialDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this reinstate verifiable credential default response a status code equal to that given
+func (o *ReinstateVerifiableCredentialDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the reinstate verifiable credential default response
+func (o *ReinstateVerifiableCredentialDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ReinstateVerifiableCredentialDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/vc/reinstate][%d] ReinstateVerifiableCredential default %s", o._statusCode, payload)
+}
+
+func (o *ReinstateVerifiableCredentialDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/vc/reinstate][%d] ReinstateVerifiableCredential default %s", o._statusCode, payload)
+}
+
+func (o *ReinstateVerifiableCredentialDefault) GetPayload() *models.RPCStatus {
+	return o.Payload
+}
+
+func (o *ReinstateVerifiableCredentialDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RPCStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
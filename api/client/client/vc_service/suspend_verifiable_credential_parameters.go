@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vc_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// NewSuspendVerifiableCredentialParams creates a new SuspendVerifiableCredentialParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSuspendVerifiableCredentialParams() *SuspendVerifiableCredentialParams {
+	return &SuspendVerifiableCredentialParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSuspendVerifiableCredentialParamsWithTimeout creates a new SuspendVerifiableCredentialParams object
+// with the ability to set a timeout on a request.
+func NewSuspendVerifiableCredentialParamsWithTimeout(timeout time.Duration) *SuspendVerifiableCredentialParams {
+	return &SuspendVerifiableCredentialParams{
+		timeout: timeout,
+	}
+}
+
+// NewSuspendVerifiableCredentialParamsWithContext creates a new SuspendVerifiableCredentialParams object
+// with the ability to set a context for a request.
+func NewSuspendVerifiableCredentialParamsWithContext(ctx context.Context) *SuspendVerifiableCredentialParams {
+	return &SuspendVerifiableCredentialParams{
+		Context: ctx,
+	}
+}
+
+// NewSuspendVerifiableCredentialParamsWithHTTPClient creates a new SuspendVerifiableCredentialParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSuspendVerifiableCredentialParamsWithHTTPClient(client *http.Client) *SuspendVerifiableCredentialParams {
+	return &SuspendVerifiableCredentialParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SuspendVerifiableCredentialParams contains all the parameters to send to the API endpoint
+
+	for the suspend verifiable credential operation.
+
+	Typically these are written to a http.Request.
+*/
+type SuspendVerifiableCredentialParams struct {
+
+	// Body.
+	Body *models.V1alpha1SuspendRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the suspend verifiable credential params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SuspendVerifiableCredentialParams) WithDefaults() *SuspendVerifiableCredentialParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the suspend verifiable credential params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SuspendVerifiableCredentialParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the suspend verifiable credential params
+func (o *SuspendVerifiableCredentialParams) WithTimeout(timeout time.Duration) *SuspendVerifiableCredentialParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the suspend verifiable credential params
+func (o *SuspendVerifiableCredentialParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the suspend verifiable credential params
+func (o *SuspendVerifiableCredentialParams) WithContext(ctx context.Context) *SuspendVerifiableCredentialParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the suspend verifiable credential params
+func (o *SuspendVerifiableCredentialParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the suspend verifiable credential params
+func (o *SuspendVerifiableCredentialParams) WithHTTPClient(client *http.Client) *SuspendVerifiableCredentialParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the suspend verifiable credential params
+func (o *SuspendVerifiableCredentialParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the suspend verifiable credential params
+func (o *SuspendVerifiableCredentialParams) WithBody(body *models.V1alpha1SuspendRequest) *SuspendVerifiableCredentialParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the suspend verifiable credential params
+func (o *SuspendVerifiableCredentialParams) SetBody(body *models.V1alpha1SuspendRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SuspendVerifiableCredentialParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
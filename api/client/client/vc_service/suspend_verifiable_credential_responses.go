@@ -0,0 +1,186 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vc_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// SuspendVerifiableCredentialReader is a Reader for the SuspendVerifiableCredential structure.
+type SuspendVerifiableCredentialReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SuspendVerifiableCredentialReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSuspendVerifiableCredentialOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewSuspendVerifiableCredentialDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSuspendVerifiableCredentialOK creates a SuspendVerifiableCredentialOK with default headers values
+func NewSuspendVerifiableCredentialOK() *SuspendVerifiableCredentialOK {
+	return &SuspendVerifiableCredentialOK{}
+}
+
+/*
+SuspendVerifiableCredentialOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type SuspendVerifiableCredentialOK struct {
+	Payload any
+}
+
+// IsSuccess returns true when this suspend verifiable credential o k response has a 2xx status code
+func (o *SuspendVerifiableCredentialOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this suspend verifiable credential o k response has a 3xx status code
+func (o *SuspendVerifiableCredentialOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this suspend verifiable credential o k response has a 4xx status code
+func (o *SuspendVerifiableCredentialOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this suspend verifiable credential o k response has a 5xx status code
+func (o *SuspendVerifiableCredentialOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this suspend verifiable credential o k response a status code equal to that given
+func (o *SuspendVerifiableCredentialOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the suspend verifiable credential o k response
+func (o *SuspendVerifiableCredentialOK) Code() int {
+	return 200
+}
+
+func (o *SuspendVerifiableCredentialOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/vc/suspend][%d] suspendVerifiableCredentialOK %s", 200, payload)
+}
+
+func (o *SuspendVerifiableCredentialOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/vc/suspend][%d] suspendVerifiableCredentialOK %s", 200, payload)
+}
+
+func (o *SuspendVerifiableCredentialOK) GetPayload() any {
+	return o.Payload
+}
+
+func (o *SuspendVerifiableCredentialOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewSuspendVerifiableCredentialDefault creates a SuspendVerifiableCredentialDefault with default headers values
+func NewSuspendVerifiableCredentialDefault(code int) *SuspendVerifiableCredentialDefault {
+	return &SuspendVerifiableCredentialDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+SuspendVerifiableCredentialDefault describes a response with status code -1, with default header values.
+
+An unexpected error response.
+*/
+type SuspendVerifiableCredentialDefault struct {
+	_statusCode int
+
+	Payload *models.RPCStatus
+}
+
+// IsSuccess returns true when this suspend verifiable credential default response has a 2xx status code
+func (o *SuspendVerifiableCredentialDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this suspend verifiable credential default response has a 3xx status code
+func (o *SuspendVerifiableCredentialDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this suspend verifiable credential default response has a 4xx status code
+func (o *SuspendVerifiableCredentialDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this suspend verifiable credential default response has a 5xx status code
+func (o *SuspendVerifiableCredentialDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this suspend verifiable credential default response a status code equal to that given
+func (o *SuspendVerifiableCredentialDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the suspend verifiable credential default response
+func (o *SuspendVerifiableCredentialDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SuspendVerifiableCredentialDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/vc/suspend][%d] SuspendVerifiableCredential default %s", o._statusCode, payload)
+}
+
+func (o *SuspendVerifiableCredentialDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/vc/suspend][%d] SuspendVerifiableCredential default %s", o._statusCode, payload)
+}
+
+func (o *SuspendVerifiableCredentialDefault) GetPayload() *models.RPCStatus {
+	return o.Payload
+}
+
+func (o *SuspendVerifiableCredentialDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RPCStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -28,19 +28,105 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	GetStatusList(params *GetStatusListParams, opts ...ClientOption) (*GetStatusListOK, error)
+
+	GetStatusListWellKnown(params *GetStatusListWellKnownParams, opts ...ClientOption) (*GetStatusListWellKnownOK, error)
+
 	GetVcWellKnown(params *GetVcWellKnownParams, opts ...ClientOption) (*GetVcWellKnownOK, error)
 
 	PublishVerifiableCredential(params *PublishVerifiableCredentialParams, opts ...ClientOption) (*PublishVerifiableCredentialOK, error)
 
+	ReinstateVerifiableCredential(params *ReinstateVerifiableCredentialParams, opts ...ClientOption) (*ReinstateVerifiableCredentialOK, error)
+
 	RevokeVerifiableCredential(params *RevokeVerifiableCredentialParams, opts ...ClientOption) (*RevokeVerifiableCredentialOK, error)
 
 	SearchVerifiableCredentials(params *SearchVerifiableCredentialsParams, opts ...ClientOption) (*SearchVerifiableCredentialsOK, error)
 
+	SuspendVerifiableCredential(params *SuspendVerifiableCredentialParams, opts ...ClientOption) (*SuspendVerifiableCredentialOK, error)
+
 	VerifyVerifiableCredential(params *VerifyVerifiableCredentialParams, opts ...ClientOption) (*VerifyVerifiableCredentialOK, error)
 
+	VerifyVerifiablePresentation(params *VerifyVerifiablePresentationParams, opts ...ClientOption) (*VerifyVerifiablePresentationOK, error)
+
+	WatchVerifiableCredentials(params *WatchVerifiableCredentialsParams, opts ...ClientOption) (*WatchVerifiableCredentialsOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+GetStatusList returns the signed bitstring status list credential of an issuer
+*/
+func (a *Client) GetStatusList(params *GetStatusListParams, opts ...ClientOption) (*GetStatusListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetStatusListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetStatusList",
+		Method:             "GET",
+		PathPattern:        "/v1alpha1/vc/status/{issuer}/{purpose}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetStatusListReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetStatusListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetStatusListDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetStatusListWellKnown returns the public keys used to verify the status list credentials
+*/
+func (a *Client) GetStatusListWellKnown(params *GetStatusListWellKnownParams, opts ...ClientOption) (*GetStatusListWellKnownOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetStatusListWellKnownParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetStatusListWellKnown",
+		Method:             "GET",
+		PathPattern:        "/v1alpha1/vc/.well-known/jwks.json",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetStatusListWellKnownReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetStatusListWellKnownOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetStatusListWellKnownDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetVcWellKnown returns the well known verifiable credentials for the specified Id
 */
@@ -115,6 +201,43 @@ func (a *Client) PublishVerifiableCredential(params *PublishVerifiableCredential
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+ReinstateVerifiableCredential reinstates a suspended verifiable credential
+*/
+func (a *Client) ReinstateVerifiableCredential(params *ReinstateVerifiableCredentialParams, opts ...ClientOption) (*ReinstateVerifiableCredentialOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReinstateVerifiableCredentialParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReinstateVerifiableCredential",
+		Method:             "POST",
+		PathPattern:        "/v1alpha1/vc/reinstate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReinstateVerifiableCredentialReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReinstateVerifiableCredentialOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ReinstateVerifiableCredentialDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 RevokeVerifiableCredential revokes a verifiable credential t h i s a c t i o n i s n o t r e v e r s i b l e
 */
@@ -189,6 +312,43 @@ func (a *Client) SearchVerifiableCredentials(params *SearchVerifiableCredentials
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+SuspendVerifiableCredential suspends a verifiable credential the credential can be reinstated later
+*/
+func (a *Client) SuspendVerifiableCredential(params *SuspendVerifiableCredentialParams, opts ...ClientOption) (*SuspendVerifiableCredentialOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSuspendVerifiableCredentialParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "SuspendVerifiableCredential",
+		Method:             "POST",
+		PathPattern:        "/v1alpha1/vc/suspend",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SuspendVerifiableCredentialReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SuspendVerifiableCredentialOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SuspendVerifiableCredentialDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 VerifyVerifiableCredential verifies a verifiable credential
 */
@@ -226,6 +386,80 @@ func (a *Client) VerifyVerifiableCredential(params *VerifyVerifiableCredentialPa
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+VerifyVerifiablePresentation verifies a verifiable presentation and the verifiable credentials it contains
+*/
+func (a *Client) VerifyVerifiablePresentation(params *VerifyVerifiablePresentationParams, opts ...ClientOption) (*VerifyVerifiablePresentationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewVerifyVerifiablePresentationParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "VerifyVerifiablePresentation",
+		Method:             "POST",
+		PathPattern:        "/v1alpha1/vc/presentation/verify",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &VerifyVerifiablePresentationReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*VerifyVerifiablePresentationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*VerifyVerifiablePresentationDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+WatchVerifiableCredentials streams the publication and the status changes of the verifiable credentials
+*/
+func (a *Client) WatchVerifiableCredentials(params *WatchVerifiableCredentialsParams, opts ...ClientOption) (*WatchVerifiableCredentialsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewWatchVerifiableCredentialsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "WatchVerifiableCredentials",
+		Method:             "GET",
+		PathPattern:        "/v1alpha1/vc/watch",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &WatchVerifiableCredentialsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*WatchVerifiableCredentialsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*WatchVerifiableCredentialsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
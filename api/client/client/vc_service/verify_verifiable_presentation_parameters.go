@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vc_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// NewVerifyVerifiablePresentationParams creates a new VerifyVerifiablePresentationParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewVerifyVerifiablePresentationParams() *VerifyVerifiablePresentationParams {
+	return &VerifyVerifiablePresentationParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewVerifyVerifiablePresentationParamsWithTimeout creates a new VerifyVerifiablePresentationParams object
+// with the ability to set a timeout on a request.
+func NewVerifyVerifiablePresentationParamsWithTimeout(timeout time.Duration) *VerifyVerifiablePresentationParams {
+	return &VerifyVerifiablePresentationParams{
+		timeout: timeout,
+	}
+}
+
+// NewVerifyVerifiablePresentationParamsWithContext creates a new VerifyVerifiablePresentationParams object
+// with the ability to set a context for a request.
+func NewVerifyVerifiablePresentationParamsWithContext(ctx context.Context) *VerifyVerifiablePresentationParams {
+	return &VerifyVerifiablePresentationParams{
+		Context: ctx,
+	}
+}
+
+// NewVerifyVerifiablePresentationParamsWithHTTPClient creates a new VerifyVerifiablePresentationParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewVerifyVerifiablePresentationParamsWithHTTPClient(client *http.Client) *VerifyVerifiablePresentationParams {
+	return &VerifyVerifiablePresentationParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+VerifyVerifiablePresentationParams contains all the parameters to send to the API endpoint
+
+	for the verify verifiable presentation operation.
+
+	Typically these are written to a http.Request.
+*/
+type VerifyVerifiablePresentationParams struct {
+
+	// Body.
+	Body *models.V1alpha1VerifyPresentationRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the verify verifiable presentation params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *VerifyVerifiablePresentationParams) WithDefaults() *VerifyVerifiablePresentationParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the verify verifiable presentation params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *VerifyVerifiablePresentationParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the verify verifiable presentation params
+func (o *VerifyVerifiablePresentationParams) WithTimeout(timeout time.Duration) *VerifyVerifiablePresentationParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the verify verifiable presentation params
+func (o *VerifyVerifiablePresentationParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the verify verifiable presentation params
+func (o *VerifyVerifiablePresentationParams) WithContext(ctx context.Context) *VerifyVerifiablePresentationParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the verify verifiable presentation params
+func (o *VerifyVerifiablePresentationParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the verify verifiable presentation params
+func (o *VerifyVerifiablePresentationParams) WithHTTPClient(client *http.Client) *VerifyVerifiablePresentationParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the verify verifiable presentation params
+func (o *VerifyVerifiablePresentationParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the verify verifiable presentation params
+func (o *VerifyVerifiablePresentationParams) WithBody(body *models.V1alpha1VerifyPresentationRequest) *VerifyVerifiablePresentationParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the verify verifiable presentation params
+func (o *VerifyVerifiablePresentationParams) SetBody(body *models.V1alpha1VerifyPresentationRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *VerifyVerifiablePresentationParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vc_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// VerifyVerifiablePresentationReader is a Reader for the VerifyVerifiablePresentation structure.
+type VerifyVerifiablePresentationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *VerifyVerifiablePresentationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewVerifyVerifiablePresentationOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewVerifyVerifiablePresentationDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewVerifyVerifiablePresentationOK creates a VerifyVerifiablePresentationOK with default headers values
+func NewVerifyVerifiablePresentationOK() *VerifyVerifiablePresentationOK {
+	return &VerifyVerifiablePresentationOK{}
+}
+
+/*
+VerifyVerifiablePresentationOK describes a response with status code 200, with default header values.
+
+A successful response.
+*/
+type VerifyVerifiablePresentationOK struct {
+	Payload *models.V1alpha1PresentationVerificationResult
+}
+
+// IsSuccess returns true when this verify verifiable presentation o k response has a 2xx status code
+func (o *VerifyVerifiablePresentationOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this verify verifiable presentation o k response has a 3xx status code
+func (o *VerifyVerifiablePresentationOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this verify verifiable presentation o k response has a 4xx status code
+func (o *VerifyVerifiablePresentationOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this verify verifiable presentation o k response has a 5xx status code
+func (o *VerifyVerifiablePresentationOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this verify verifiable presentation o k response a status code equal to that given
+func (o *VerifyVerifiablePresentationOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the verify verifiable presentation o k response
+func (o *VerifyVerifiablePresentationOK) Code() int {
+	return 200
+}
+
+func (o *VerifyVerifiablePresentationOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/vc/presentation/verify][%d] verifyVerifiablePresentationOK %s", 200, payload)
+}
+
+func (o *VerifyVerifiablePresentationOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/vc/presentation/verify][%d] verifyVerifiablePresentationOK %s", 200, payload)
+}
+
+func (o *VerifyVerifiablePresentationOK) GetPayload() *models.V1alpha1PresentationVerificationResult {
+	return o.Payload
+}
+
+func (o *VerifyVerifiablePresentationOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.V1alpha1PresentationVerificationResult)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewVerifyVerifiablePresentationDefault creates a VerifyVerifiablePresentationDefault with default headers values
+func NewVerifyVerifiablePresentationDefault(code int) *VerifyVerifiablePresentationDefault {
+	return &VerifyVerifiablePresentationDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+VerifyVerifiablePresentationDefault describes a response with status code -1, with default header values.
+
+An unexpected error response.
+*/
+type VerifyVerifiablePresentationDefault struct {
+	_statusCode int
+
+	Payload *models.RPCStatus
+}
+
+// IsSuccess returns true when this verify verifiable presentation default response has a 2xx status code
+func (o *VerifyVerifiablePresentationDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this verify verifiable presentation default response has a 3xx status code
+func (o *VerifyVerifiablePresentationDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this verify verifiable presentation default response has a 4xx status code
+func (o *VerifyVerifiablePresentationDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this verify verifiable presentation default response has a 5xx status code
+func (o *VerifyVerifiablePresentationDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this verify verifiable presentation default response a status code equal to that given
+func (o *VerifyVerifiablePresentationDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the verify verifiable presentation default response
+func (o *VerifyVerifiablePresentationDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *VerifyVerifiablePresentationDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/vc/presentation/verify][%d] VerifyVerifiablePresentation default %s", o._statusCode, payload)
+}
+
+func (o *VerifyVerifiablePresentationDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /v1alpha1/vc/presentation/verify][%d] VerifyVerifiablePresentation default %s", o._statusCode, payload)
+}
+
+func (o *VerifyVerifiablePresentationDefault) GetPayload() *models.RPCStatus {
+	return o.Payload
+}
+
+func (o *VerifyVerifiablePresentationDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RPCStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,234 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vc_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewWatchVerifiableCredentialsParams creates a new WatchVerifiableCredentialsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewWatchVerifiableCredentialsParams() *WatchVerifiableCredentialsParams {
+	return &WatchVerifiableCredentialsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewWatchVerifiableCredentialsParamsWithTimeout creates a new WatchVerifiableCredentialsParams object
+// with the ability to set a timeout on a request.
+func NewWatchVerifiableCredentialsParamsWithTimeout(timeout time.Duration) *WatchVerifiableCredentialsParams {
+	return &WatchVerifiableCredentialsParams{
+		timeout: timeout,
+	}
+}
+
+// NewWatchVerifiableCredentialsParamsWithContext creates a new WatchVerifiableCredentialsParams object
+// with the ability to set a context for a request.
+func NewWatchVerifiableCredentialsParamsWithContext(ctx context.Context) *WatchVerifiableCredentialsParams {
+	return &WatchVerifiableCredentialsParams{
+		Context: ctx,
+	}
+}
+
+// NewWatchVerifiableCredentialsParamsWithHTTPClient creates a new WatchVerifiableCredentialsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewWatchVerifiableCredentialsParamsWithHTTPClient(client *http.Client) *WatchVerifiableCredentialsParams {
+	return &WatchVerifiableCredentialsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+WatchVerifiableCredentialsParams contains all the parameters to send to the API endpoint
+
+	for the watch verifiable credentials operation.
+
+	Typically these are written to a http.Request.
+*/
+type WatchVerifiableCredentialsParams struct {
+
+	/* AfterSequence.
+
+	     Resume watching after the event with this sequence, the events still
+	retained by the Node are sent first. Only the new events are sent when unset
+
+	     Format: uint64
+	*/
+	AfterSequence *string
+
+	/* Issuer.
+
+	   The common name of the Issuer of the Verifiable Credentials
+	*/
+	Issuer *string
+
+	/* ResolverMetadataID.
+
+	   The resolver metadata ID the Verifiable Credentials are attached to
+	*/
+	ResolverMetadataID *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the watch verifiable credentials params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WatchVerifiableCredentialsParams) WithDefaults() *WatchVerifiableCredentialsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the watch verifiable credentials params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *WatchVerifiableCredentialsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the watch verifiable credentials params
+func (o *WatchVerifiableCredentialsParams) WithTimeout(timeout time.Duration) *WatchVerifiableCredentialsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the watch verifiable credentials params
+func (o *WatchVerifiableCredentialsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the watch verifiable credentials params
+func (o *WatchVerifiableCredentialsParams) WithContext(ctx context.Context) *WatchVerifiableCredentialsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the watch verifiable credentials params
+func (o *WatchVerifiableCredentialsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the watch verifiable credentials params
+func (o *WatchVerifiableCredentialsParams) WithHTTPClient(client *http.Client) *WatchVerifiableCredentialsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the watch verifiable credentials params
+func (o *WatchVerifiableCredentialsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAfterSequence adds the afterSequence to the watch verifiable credentials params
+func (o *WatchVerifiableCredentialsParams) WithAfterSequence(afterSequence *string) *WatchVerifiableCredentialsParams {
+	o.SetAfterSequence(afterSequence)
+	return o
+}
+
+// SetAfterSequence adds the afterSequence to the watch verifiable credentials params
+func (o *WatchVerifiableCredentialsParams) SetAfterSequence(afterSequence *string) {
+	o.AfterSequence = afterSequence
+}
+
+// WithIssuer adds the issuer to the watch verifiable credentials params
+func (o *WatchVerifiableCredentialsParams) WithIssuer(issuer *string) *WatchVerifiableCredentialsParams {
+	o.SetIssuer(issuer)
+	return o
+}
+
+// SetIssuer adds the issuer to the watch verifiable credentials params
+func (o *WatchVerifiableCredentialsParams) SetIssuer(issuer *string) {
+	o.Issuer = issuer
+}
+
+// WithResolverMetadataID adds the resolverMetadataID to the watch verifiable credentials params
+func (o *WatchVerifiableCredentialsParams) WithResolverMetadataID(resolverMetadataID *string) *WatchVerifiableCredentialsParams {
+	o.SetResolverMetadataID(resolverMetadataID)
+	return o
+}
+
+// SetResolverMetadataID adds the resolverMetadataId to the watch verifiable credentials params
+func (o *WatchVerifiableCredentialsParams) SetResolverMetadataID(resolverMetadataID *string) {
+	o.ResolverMetadataID = resolverMetadataID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *WatchVerifiableCredentialsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AfterSequence != nil {
+
+		// query param afterSequence
+		var qrAfterSequence string
+
+		if o.AfterSequence != nil {
+			qrAfterSequence = *o.AfterSequence
+		}
+		qAfterSequence := qrAfterSequence
+		if qAfterSequence != "" {
+
+			if err := r.SetQueryParam("afterSequence", qAfterSequence); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Issuer != nil {
+
+		// query param issuer
+		var qrIssuer string
+
+		if o.Issuer != nil {
+			qrIssuer = *o.Issuer
+		}
+		qIssuer := qrIssuer
+		if qIssuer != "" {
+
+			if err := r.SetQueryParam("issuer", qIssuer); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.ResolverMetadataID != nil {
+
+		// query param resolverMetadataId
+		var qrResolverMetadataID string
+
+		if o.ResolverMetadataID != nil {
+			qrResolverMetadataID = *o.ResolverMetadataID
+		}
+		qResolverMetadataID := qrResolverMetadataID
+		if qResolverMetadataID != "" {
+
+			if err := r.SetQueryParam("resolverMetadataId", qResolverMetadataID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,354 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vc_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/agntcy/identity/api/client/models"
+)
+
+// WatchVerifiableCredentialsReader is a Reader for the WatchVerifiableCredentials structure.
+type WatchVerifiableCredentialsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *WatchVerifiableCredentialsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (any, error) {
+	switch response.Code() {
+	case 200:
+		result := NewWatchVerifiableCredentialsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewWatchVerifiableCredentialsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewWatchVerifiableCredentialsOK creates a WatchVerifiableCredentialsOK with default headers values
+func NewWatchVerifiableCredentialsOK() *WatchVerifiableCredentialsOK {
+	return &WatchVerifiableCredentialsOK{}
+}
+
+/*
+WatchVerifiableCredentialsOK describes a response with status code 200, with default header values.
+
+A successful response.(streaming responses)
+*/
+type WatchVerifiableCredentialsOK struct {
+	Payload *WatchVerifiableCredentialsOKBody
+}
+
+// IsSuccess returns true when this watch verifiable credentials o k response has a 2xx status code
+func (o *WatchVerifiableCredentialsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this watch verifiable credentials o k response has a 3xx status code
+func (o *WatchVerifiableCredentialsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this watch verifiable credentials o k response has a 4xx status code
+func (o *WatchVerifiableCredentialsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this watch verifiable credentials o k response has a 5xx status code
+func (o *WatchVerifiableCredentialsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this watch verifiable credentials o k response a status code equal to that given
+func (o *WatchVerifiableCredentialsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the watch verifiable credentials o k response
+func (o *WatchVerifiableCredentialsOK) Code() int {
+	return 200
+}
+
+func (o *WatchVerifiableCredentialsOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/vc/watch][%d] watchVerifiableCredentialsOK %s", 200, payload)
+}
+
+func (o *WatchVerifiableCredentialsOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/vc/watch][%d] watchVerifiableCredentialsOK %s", 200, payload)
+}
+
+func (o *WatchVerifiableCredentialsOK) GetPayload() *WatchVerifiableCredentialsOKBody {
+	return o.Payload
+}
+
+func (o *WatchVerifiableCredentialsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(WatchVerifiableCredentialsOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+// NewWatchVerifiableCredentialsDefault creates a WatchVerifiableCredentialsDefault with default headers values
+func NewWatchVerifiableCredentialsDefault(code int) *WatchVerifiableCredentialsDefault {
+	return &WatchVerifiableCredentialsDefault{
+		_statusCode: code,
+	}
+}
+
+/*
+WatchVerifiableCredentialsDefault describes a response with status code -1, with default header values.
+
+An unexpected error response.
+*/
+type WatchVerifiableCredentialsDefault struct {
+	_statusCode int
+
+	Payload *models.RPCStatus
+}
+
+// IsSuccess returns true when this watch verifiable credentials default response has a 2xx status code
+func (o *WatchVerifiableCredentialsDefault) IsSuccess() bool {
+	return o._statusCode/100 == 2
+}
+
+// IsRedirect returns true when this watch verifiable credentials default response has a 3xx status code
+func (o *WatchVerifiableCredentialsDefault) IsRedirect() bool {
+	return o._statusCode/100 == 3
+}
+
+// IsClientError returns true when this watch verifiable credentials default response has a 4xx status code
+func (o *WatchVerifiableCredentialsDefault) IsClientError() bool {
+	return o._statusCode/100 == 4
+}
+
+// IsServerError returns true when this watch verifiable credentials default response has a 5xx status code
+func (o *WatchVerifiableCredentialsDefault) IsServerError() bool {
+	return o._statusCode/100 == 5
+}
+
+// IsCode returns true when this watch verifiable credentials default response a status code equal to that given
+func (o *WatchVerifiableCredentialsDefault) IsCode(code int) bool {
+	return o._statusCode == code
+}
+
+// Code gets the status code for the watch verifiable credentials default response
+func (o *WatchVerifiableCredentialsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *WatchVerifiableCredentialsDefault) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/vc/watch][%d] WatchVerifiableCredentials default %s", o._statusCode, payload)
+}
+
+func (o *WatchVerifiableCredentialsDefault) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /v1alpha1/vc/watch][%d] WatchVerifiableCredentials default %s", o._statusCode, payload)
+}
+
+func (o *WatchVerifiableCredentialsDefault) GetPayload() *models.RPCStatus {
+	return o.Payload
+}
+
+func (o *WatchVerifiableCredentialsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RPCStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && !stderrors.Is(err, io.EOF) {
+		return err
+	}
+
+	return nil
+}
+
+/*
+WatchVerifiableCredentialsOKBody Stream result of v1alpha1WatchResponse
+swagger:model WatchVerifiableCredentialsOKBody
+*/
+type WatchVerifiableCredentialsOKBody struct {
+
+	// error
+	Error *models.RPCStatus `json:"error,omitempty"`
+
+	// result
+	Result *models.V1alpha1WatchResponse `json:"result,omitempty"`
+}
+
+// Validate validates this watch verifiable credentials o k body
+func (o *WatchVerifiableCredentialsOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateError(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateResult(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *WatchVerifiableCredentialsOKBody) validateError(formats strfmt.Registry) error {
+	if swag.IsZero(o.Error) { // not required
+		return nil
+	}
+
+	if o.Error != nil {
+		if err := o.Error.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("watchVerifiableCredentialsOK" + "." + "error")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("watchVerifiableCredentialsOK" + "." + "error")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *WatchVerifiableCredentialsOKBody) validateResult(formats strfmt.Registry) error {
+	if swag.IsZero(o.Result) { // not required
+		return nil
+	}
+
+	if o.Result != nil {
+		if err := o.Result.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("watchVerifiableCredentialsOK" + "." + "result")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("watchVerifiableCredentialsOK" + "." + "result")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this watch verifiable credentials o k body based on the context it is used
+func (o *WatchVerifiableCredentialsOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateError(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateResult(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *WatchVerifiableCredentialsOKBody) contextValidateError(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Error != nil {
+
+		if swag.IsZero(o.Error) { // not required
+			return nil
+		}
+
+		if err := o.Error.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("watchVerifiableCredentialsOK" + "." + "error")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("watchVerifiableCredentialsOK" + "." + "error")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *WatchVerifiableCredentialsOKBody) contextValidateResult(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Result != nil {
+
+		if swag.IsZero(o.Result) { // not required
+			return nil
+		}
+
+		if err := o.Result.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("watchVerifiableCredentialsOK" + "." + "result")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("watchVerifiableCredentialsOK" + "." + "result")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *WatchVerifiableCredentialsOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *WatchVerifiableCredentialsOKBody) UnmarshalBinary(b []byte) error {
+	var res WatchVerifiableCredentialsOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,181 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// IssuerServiceRotateKeyBody Request to rotate the key of an issuer
+//
+// swagger:model IssuerServiceRotateKeyBody
+type IssuerServiceRotateKeyBody struct {
+
+	// A self-issued JWT signed by the current key of the issuer
+	Proof *V1alpha1Proof `json:"proof,omitempty"`
+
+	// The new public key of the issuer, the key ID must be set
+	PublicKey *V1alpha1Jwk `json:"publicKey,omitempty"`
+
+	// Retire the current key immediately instead of keeping it active
+	// during the retirement period, for instance when the key is compromised
+	RetireImmediately bool `json:"retireImmediately,omitempty"`
+}
+
+// Validate validates this issuer service rotate key body
+func (m *IssuerServiceRotateKeyBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateProof(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePublicKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *IssuerServiceRotateKeyBody) validateProof(formats strfmt.Registry) error {
+	if swag.IsZero(m.Proof) { // not required
+		return nil
+	}
+
+	if m.Proof != nil {
+		if err := m.Proof.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("proof")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("proof")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *IssuerServiceRotateKeyBody) validatePublicKey(formats strfmt.Registry) error {
+	if swag.IsZero(m.PublicKey) { // not required
+		return nil
+	}
+
+	if m.PublicKey != nil {
+		if err := m.PublicKey.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("publicKey")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("publicKey")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this issuer service rotate key body based on the context it is used
+func (m *IssuerServiceRotateKeyBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateProof(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePublicKey(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *IssuerServiceRotateKeyBody) contextValidateProof(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Proof != nil {
+
+		if swag.IsZero(m.Proof) { // not required
+			return nil
+		}
+
+		if err := m.Proof.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("proof")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("proof")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *IssuerServiceRotateKeyBody) contextValidatePublicKey(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.PublicKey != nil {
+
+		if swag.IsZero(m.PublicKey) { // not required
+			return nil
+		}
+
+		if err := m.PublicKey.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("publicKey")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("publicKey")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *IssuerServiceRotateKeyBody) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *IssuerServiceRotateKeyBody) UnmarshalBinary(b []byte) error {
+	var res IssuerServiceRotateKeyBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
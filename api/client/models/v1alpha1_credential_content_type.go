@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// V1alpha1CredentialContentType The content of the Credential.
+// Multiple content types can be supported: AgentBadge, etc.
+//
+//   - CREDENTIAL_CONTENT_TYPE_UNSPECIFIED: Unspecified Content Type.
+//   - CREDENTIAL_CONTENT_TYPE_AGENT_BADGE: AgentBadge Content Type.
+//
+// The Agent content representation following a defined schema
+// OASF: https://schema.oasf.agntcy.org/schema/objects/agent
+// Google A2A: https://github.com/google/A2A/blob/main/specification/json/a2a.json
+//   - CREDENTIAL_CONTENT_TYPE_MCP_BADGE: McpBadge Content Type.
+//
+// The MCP content representation following a defined schema
+// The schema is defined in the MCP specification as the MCPServer type
+//
+// swagger:model v1alpha1CredentialContentType
+type V1alpha1CredentialContentType string
+
+func NewV1alpha1CredentialContentType(value V1alpha1CredentialContentType) *V1alpha1CredentialContentType {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated V1alpha1CredentialContentType.
+func (m V1alpha1CredentialContentType) Pointer() *V1alpha1CredentialContentType {
+	return &m
+}
+
+const (
+
+	// V1alpha1CredentialContentTypeCREDENTIALCONTENTTYPEUNSPECIFIED captures enum value "CREDENTIAL_CONTENT_TYPE_UNSPECIFIED"
+	V1alpha1CredentialContentTypeCREDENTIALCONTENTTYPEUNSPECIFIED V1alpha1CredentialContentType = "CREDENTIAL_CONTENT_TYPE_UNSPECIFIED"
+
+	// V1alpha1CredentialContentTypeCREDENTIALCONTENTTYPEAGENTBADGE captures enum value "CREDENTIAL_CONTENT_TYPE_AGENT_BADGE"
+	V1alpha1CredentialContentTypeCREDENTIALCONTENTTYPEAGENTBADGE V1alpha1CredentialContentType = "CREDENTIAL_CONTENT_TYPE_AGENT_BADGE"
+
+	// V1alpha1CredentialContentTypeCREDENTIALCONTENTTYPEMCPBADGE captures enum value "CREDENTIAL_CONTENT_TYPE_MCP_BADGE"
+	V1alpha1CredentialContentTypeCREDENTIALCONTENTTYPEMCPBADGE V1alpha1CredentialContentType = "CREDENTIAL_CONTENT_TYPE_MCP_BADGE"
+)
+
+// for schema
+var v1alpha1CredentialContentTypeEnum []any
+
+func init() {
+	var res []V1alpha1CredentialContentType
+	if err := json.Unmarshal([]byte(`["CREDENTIAL_CONTENT_TYPE_UNSPECIFIED","CREDENTIAL_CONTENT_TYPE_AGENT_BADGE","CREDENTIAL_CONTENT_TYPE_MCP_BADGE"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		v1alpha1CredentialContentTypeEnum = append(v1alpha1CredentialContentTypeEnum, v)
+	}
+}
+
+func (m V1alpha1CredentialContentType) validateV1alpha1CredentialContentTypeEnum(path, location string, value V1alpha1CredentialContentType) error {
+	if err := validate.EnumCase(path, location, value, v1alpha1CredentialContentTypeEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this v1alpha1 credential content type
+func (m V1alpha1CredentialContentType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateV1alpha1CredentialContentTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this v1alpha1 credential content type based on context it is used
+func (m V1alpha1CredentialContentType) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -20,6 +20,8 @@ import (
 //   - CREDENTIAL_ENVELOPE_TYPE_UNSPECIFIED: Unspecified Envelope Type.
 //   - CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF: Embedded Proof Envelope Type.
 //   - CREDENTIAL_ENVELOPE_TYPE_JOSE: JOSE Envelope Type.
+//   - CREDENTIAL_ENVELOPE_TYPE_COSE: COSE Envelope Type (COSE_Sign1).
+//   - CREDENTIAL_ENVELOPE_TYPE_SD_JWT: SD-JWT Envelope Type with selectively disclosable claims.
 //
 // swagger:model v1alpha1CredentialEnvelopeType
 type V1alpha1CredentialEnvelopeType string
@@ -43,6 +45,12 @@ const (
 
 	// V1alpha1CredentialEnvelopeTypeCREDENTIALENVELOPETYPEJOSE captures enum value "CREDENTIAL_ENVELOPE_TYPE_JOSE"
 	V1alpha1CredentialEnvelopeTypeCREDENTIALENVELOPETYPEJOSE V1alpha1CredentialEnvelopeType = "CREDENTIAL_ENVELOPE_TYPE_JOSE"
+
+	// V1alpha1CredentialEnvelopeTypeCREDENTIALENVELOPETYPECOSE captures enum value "CREDENTIAL_ENVELOPE_TYPE_COSE"
+	V1alpha1CredentialEnvelopeTypeCREDENTIALENVELOPETYPECOSE V1alpha1CredentialEnvelopeType = "CREDENTIAL_ENVELOPE_TYPE_COSE"
+
+	// V1alpha1CredentialEnvelopeTypeCREDENTIALENVELOPETYPESDJWT captures enum value "CREDENTIAL_ENVELOPE_TYPE_SD_JWT"
+	V1alpha1CredentialEnvelopeTypeCREDENTIALENVELOPETYPESDJWT V1alpha1CredentialEnvelopeType = "CREDENTIAL_ENVELOPE_TYPE_SD_JWT"
 )
 
 // for schema
@@ -50,7 +58,7 @@ var v1alpha1CredentialEnvelopeTypeEnum []any
 
 func init() {
 	var res []V1alpha1CredentialEnvelopeType
-	if err := json.Unmarshal([]byte(`["CREDENTIAL_ENVELOPE_TYPE_UNSPECIFIED","CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF","CREDENTIAL_ENVELOPE_TYPE_JOSE"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["CREDENTIAL_ENVELOPE_TYPE_UNSPECIFIED","CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF","CREDENTIAL_ENVELOPE_TYPE_JOSE","CREDENTIAL_ENVELOPE_TYPE_COSE","CREDENTIAL_ENVELOPE_TYPE_SD_JWT"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
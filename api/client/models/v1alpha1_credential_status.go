@@ -31,6 +31,12 @@ type V1alpha1CredentialStatus struct {
 	// The value of the purpose for the status entry
 	Purpose *V1alpha1CredentialStatusPurpose `json:"purpose,omitempty"`
 
+	// The URL of the Bitstring Status List Credential
+	StatusListCredential string `json:"statusListCredential,omitempty"`
+
+	// The position of the credential in the Bitstring Status List
+	StatusListIndex string `json:"statusListIndex,omitempty"`
+
 	// Type specifies the type of the file
 	Type string `json:"type,omitempty"`
 }
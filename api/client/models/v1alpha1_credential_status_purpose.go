@@ -20,6 +20,9 @@ import (
 //   - CREDENTIAL_STATUS_PURPOSE_REVOCATION: Used to cancel the validity of a verifiable credential.
 //
 // This status is not reversible.
+//   - CREDENTIAL_STATUS_PURPOSE_SUSPENSION: Used to temporarily prevent the acceptance of a verifiable credential.
+//
+// This status is reversible.
 //
 // swagger:model v1alpha1CredentialStatusPurpose
 type V1alpha1CredentialStatusPurpose string
@@ -40,6 +43,9 @@ const (
 
 	// V1alpha1CredentialStatusPurposeCREDENTIALSTATUSPURPOSEREVOCATION captures enum value "CREDENTIAL_STATUS_PURPOSE_REVOCATION"
 	V1alpha1CredentialStatusPurposeCREDENTIALSTATUSPURPOSEREVOCATION V1alpha1CredentialStatusPurpose = "CREDENTIAL_STATUS_PURPOSE_REVOCATION"
+
+	// V1alpha1CredentialStatusPurposeCREDENTIALSTATUSPURPOSESUSPENSION captures enum value "CREDENTIAL_STATUS_PURPOSE_SUSPENSION"
+	V1alpha1CredentialStatusPurposeCREDENTIALSTATUSPURPOSESUSPENSION V1alpha1CredentialStatusPurpose = "CREDENTIAL_STATUS_PURPOSE_SUSPENSION"
 )
 
 // for schema
@@ -47,7 +53,7 @@ var v1alpha1CredentialStatusPurposeEnum []any
 
 func init() {
 	var res []V1alpha1CredentialStatusPurpose
-	if err := json.Unmarshal([]byte(`["CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED","CREDENTIAL_STATUS_PURPOSE_REVOCATION"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED","CREDENTIAL_STATUS_PURPOSE_REVOCATION","CREDENTIAL_STATUS_PURPOSE_SUSPENSION"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -0,0 +1,123 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// V1alpha1DeactivateRequest Permanently deactivate an Id
+// The Proof must be issued for the subject of the Id by its controller
+//
+// swagger:model v1alpha1DeactivateRequest
+type V1alpha1DeactivateRequest struct {
+
+	// Id is the identifier.
+	ID string `json:"id,omitempty"`
+
+	// The Proof of ownership of the Id
+	// Example: a signed JWT
+	Proof *V1alpha1Proof `json:"proof,omitempty"`
+}
+
+// Validate validates this v1alpha1 deactivate request
+func (m *V1alpha1DeactivateRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateProof(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1alpha1DeactivateRequest) validateProof(formats strfmt.Registry) error {
+	if swag.IsZero(m.Proof) { // not required
+		return nil
+	}
+
+	if m.Proof != nil {
+		if err := m.Proof.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("proof")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("proof")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this v1alpha1 deactivate request based on the context it is used
+func (m *V1alpha1DeactivateRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateProof(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1alpha1DeactivateRequest) contextValidateProof(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Proof != nil {
+
+		if swag.IsZero(m.Proof) { // not required
+			return nil
+		}
+
+		if err := m.Proof.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("proof")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("proof")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1alpha1DeactivateRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1alpha1DeactivateRequest) UnmarshalBinary(b []byte) error {
+	var res V1alpha1DeactivateRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
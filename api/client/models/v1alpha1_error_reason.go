@@ -39,6 +39,21 @@ import (
 //   - ERROR_REASON_UNKNOWN_IDP: Unknown Identity Provider
 //   - ERROR_REASON_ID_ALREADY_REGISTERED: The ID and Resolver Metadata are already registered in the system
 //   - ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED: The Verifiable Credential is revoked
+//   - ERROR_REASON_INVALID_SEARCH_CRITERIA: The search criteria contains one or more invalid fields
+//   - ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED: The Verifiable Credential is suspended
+//   - ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED: The Verifiable Credential is expired
+//   - ERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALID: The Verifiable Credential is not yet valid
+//   - ERROR_REASON_INVALID_VERIFIABLE_PRESENTATION: The Verifiable Presentation is invalid or its proof cannot be verified
+//   - ERROR_REASON_INVALID_PRESENTATION_CHALLENGE: The nonce or the audience of the Verifiable Presentation does not match the challenge
+//   - ERROR_REASON_ID_DEACTIVATED: The ID has been deactivated by its controller
+//   - ERROR_REASON_INVALID_RESOLVER_METADATA: The Resolver Metadata contains one or more invalid verification methods or services
+//   - ERROR_REASON_TRANSPARENCY_LOG_ENTRY_NOT_FOUND: The entry is not in the transparency log
+//   - ERROR_REASON_INVALID_TREE_SIZE: The tree size is invalid or larger than the transparency log
+//   - ERROR_REASON_RATE_LIMIT_EXCEEDED: The client sent too many requests
+//   - ERROR_REASON_UNAUTHENTICATED: The client is not authenticated to call the RPC
+//   - ERROR_REASON_PAYLOAD_TOO_LARGE: The request payload exceeds the configured limits
+//   - ERROR_REASON_UNTRUSTED_ISSUER: The issuer or its identity provider is not trusted by the trust policy of the Node
+//   - ERROR_REASON_STATUS_LIST_INDEX_IN_USE: The status list index of the credential is already allocated to another credential
 //
 // swagger:model v1alpha1ErrorReason
 type V1alpha1ErrorReason string
@@ -95,6 +110,51 @@ const (
 
 	// V1alpha1ErrorReasonERRORREASONVERIFIABLECREDENTIALREVOKED captures enum value "ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED"
 	V1alpha1ErrorReasonERRORREASONVERIFIABLECREDENTIALREVOKED V1alpha1ErrorReason = "ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED"
+
+	// V1alpha1ErrorReasonERRORREASONINVALIDSEARCHCRITERIA captures enum value "ERROR_REASON_INVALID_SEARCH_CRITERIA"
+	V1alpha1ErrorReasonERRORREASONINVALIDSEARCHCRITERIA V1alpha1ErrorReason = "ERROR_REASON_INVALID_SEARCH_CRITERIA"
+
+	// V1alpha1ErrorReasonERRORREASONVERIFIABLECREDENTIALSUSPENDED captures enum value "ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED"
+	V1alpha1ErrorReasonERRORREASONVERIFIABLECREDENTIALSUSPENDED V1alpha1ErrorReason = "ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED"
+
+	// V1alpha1ErrorReasonERRORREASONVERIFIABLECREDENTIALEXPIRED captures enum value "ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED"
+	V1alpha1ErrorReasonERRORREASONVERIFIABLECREDENTIALEXPIRED V1alpha1ErrorReason = "ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED"
+
+	// V1alpha1ErrorReasonERRORREASONVERIFIABLECREDENTIALNOTYETVALID captures enum value "ERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALID"
+	V1alpha1ErrorReasonERRORREASONVERIFIABLECREDENTIALNOTYETVALID V1alpha1ErrorReason = "ERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALID"
+
+	// V1alpha1ErrorReasonERRORREASONINVALIDVERIFIABLEPRESENTATION captures enum value "ERROR_REASON_INVALID_VERIFIABLE_PRESENTATION"
+	V1alpha1ErrorReasonERRORREASONINVALIDVERIFIABLEPRESENTATION V1alpha1ErrorReason = "ERROR_REASON_INVALID_VERIFIABLE_PRESENTATION"
+
+	// V1alpha1ErrorReasonERRORREASONINVALIDPRESENTATIONCHALLENGE captures enum value "ERROR_REASON_INVALID_PRESENTATION_CHALLENGE"
+	V1alpha1ErrorReasonERRORREASONINVALIDPRESENTATIONCHALLENGE V1alpha1ErrorReason = "ERROR_REASON_INVALID_PRESENTATION_CHALLENGE"
+
+	// V1alpha1ErrorReasonERRORREASONIDDEACTIVATED captures enum value "ERROR_REASON_ID_DEACTIVATED"
+	V1alpha1ErrorReasonERRORREASONIDDEACTIVATED V1alpha1ErrorReason = "ERROR_REASON_ID_DEACTIVATED"
+
+	// V1alpha1ErrorReasonERRORREASONINVALIDRESOLVERMETADATA captures enum value "ERROR_REASON_INVALID_RESOLVER_METADATA"
+	V1alpha1ErrorReasonERRORREASONINVALIDRESOLVERMETADATA V1alpha1ErrorReason = "ERROR_REASON_INVALID_RESOLVER_METADATA"
+
+	// V1alpha1ErrorReasonERRORREASONTRANSPARENCYLOGENTRYNOTFOUND captures enum value "ERROR_REASON_TRANSPARENCY_LOG_ENTRY_NOT_FOUND"
+	V1alpha1ErrorReasonERRORREASONTRANSPARENCYLOGENTRYNOTFOUND V1alpha1ErrorReason = "ERROR_REASON_TRANSPARENCY_LOG_ENTRY_NOT_FOUND"
+
+	// V1alpha1ErrorReasonERRORREASONINVALIDTREESIZE captures enum value "ERROR_REASON_INVALID_TREE_SIZE"
+	V1alpha1ErrorReasonERRORREASONINVALIDTREESIZE V1alpha1ErrorReason = "ERROR_REASON_INVALID_TREE_SIZE"
+
+	// V1alpha1ErrorReasonERRORREASONRATELIMITEXCEEDED captures enum value "ERROR_REASON_RATE_LIMIT_EXCEEDED"
+	V1alpha1ErrorReasonERRORREASONRATELIMITEXCEEDED V1alpha1ErrorReason = "ERROR_REASON_RATE_LIMIT_EXCEEDED"
+
+	// V1alpha1ErrorReasonERRORREASONUNAUTHENTICATED captures enum value "ERROR_REASON_UNAUTHENTICATED"
+	V1alpha1ErrorReasonERRORREASONUNAUTHENTICATED V1alpha1ErrorReason = "ERROR_REASON_UNAUTHENTICATED"
+
+	// V1alpha1ErrorReasonERRORREASONPAYLOADTOOLARGE captures enum value "ERROR_REASON_PAYLOAD_TOO_LARGE"
+	V1alpha1ErrorReasonERRORREASONPAYLOADTOOLARGE V1alpha1ErrorReason = "ERROR_REASON_PAYLOAD_TOO_LARGE"
+
+	// V1alpha1ErrorReasonERRORREASONUNTRUSTEDISSUER captures enum value "ERROR_REASON_UNTRUSTED_ISSUER"
+	V1alpha1ErrorReasonERRORREASONUNTRUSTEDISSUER V1alpha1ErrorReason = "ERROR_REASON_UNTRUSTED_ISSUER"
+
+	// V1alpha1ErrorReasonERRORREASONSTATUSLISTINDEXINUSE captures enum value "ERROR_REASON_STATUS_LIST_INDEX_IN_USE"
+	V1alpha1ErrorReasonERRORREASONSTATUSLISTINDEXINUSE V1alpha1ErrorReason = "ERROR_REASON_STATUS_LIST_INDEX_IN_USE"
 )
 
 // for schema
@@ -102,7 +162,7 @@ var v1alpha1ErrorReasonEnum []any
 
 func init() {
 	var res []V1alpha1ErrorReason
-	if err := json.Unmarshal([]byte(`["ERROR_REASON_UNSPECIFIED","ERROR_REASON_INTERNAL","ERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_TYPE","ERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_VALUE_FORMAT","ERROR_REASON_INVALID_ISSUER","ERROR_REASON_ISSUER_NOT_REGISTERED","ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL","ERROR_REASON_IDP_REQUIRED","ERROR_REASON_INVALID_PROOF","ERROR_REASON_UNSUPPORTED_PROOF","ERROR_REASON_RESOLVER_METADATA_NOT_FOUND","ERROR_REASON_UNKNOWN_IDP","ERROR_REASON_ID_ALREADY_REGISTERED","ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["ERROR_REASON_UNSPECIFIED","ERROR_REASON_INTERNAL","ERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_TYPE","ERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_VALUE_FORMAT","ERROR_REASON_INVALID_ISSUER","ERROR_REASON_ISSUER_NOT_REGISTERED","ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL","ERROR_REASON_IDP_REQUIRED","ERROR_REASON_INVALID_PROOF","ERROR_REASON_UNSUPPORTED_PROOF","ERROR_REASON_RESOLVER_METADATA_NOT_FOUND","ERROR_REASON_UNKNOWN_IDP","ERROR_REASON_ID_ALREADY_REGISTERED","ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED","ERROR_REASON_INVALID_SEARCH_CRITERIA","ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED","ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED","ERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALID","ERROR_REASON_INVALID_VERIFIABLE_PRESENTATION","ERROR_REASON_INVALID_PRESENTATION_CHALLENGE","ERROR_REASON_ID_DEACTIVATED","ERROR_REASON_INVALID_RESOLVER_METADATA","ERROR_REASON_TRANSPARENCY_LOG_ENTRY_NOT_FOUND","ERROR_REASON_INVALID_TREE_SIZE","ERROR_REASON_RATE_LIMIT_EXCEEDED","ERROR_REASON_UNAUTHENTICATED","ERROR_REASON_PAYLOAD_TOO_LARGE","ERROR_REASON_UNTRUSTED_ISSUER","ERROR_REASON_STATUS_LIST_INDEX_IN_USE"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// V1alpha1GetConsistencyProofResponse Returns the consistency proof between two trees
+//
+// swagger:model v1alpha1GetConsistencyProofResponse
+type V1alpha1GetConsistencyProofResponse struct {
+
+	// The size of the first tree
+	FirstSize string `json:"firstSize,omitempty"`
+
+	// The consistency proof
+	Path []strfmt.Base64 `json:"path"`
+
+	// The size of the second tree
+	SecondSize string `json:"secondSize,omitempty"`
+}
+
+// Validate validates this v1alpha1 get consistency proof response
+func (m *V1alpha1GetConsistencyProofResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this v1alpha1 get consistency proof response based on context it is used
+func (m *V1alpha1GetConsistencyProofResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1alpha1GetConsistencyProofResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1alpha1GetConsistencyProofResponse) UnmarshalBinary(b []byte) error {
+	var res V1alpha1GetConsistencyProofResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
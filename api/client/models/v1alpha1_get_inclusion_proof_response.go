@@ -0,0 +1,180 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// V1alpha1GetInclusionProofResponse Returns the inclusion proof of a leaf
+//
+// swagger:model v1alpha1GetInclusionProofResponse
+type V1alpha1GetInclusionProofResponse struct {
+
+	// The audit path from the leaf to the root of the tree
+	AuditPath []strfmt.Base64 `json:"auditPath"`
+
+	// The leaf
+	Leaf *V1alpha1LogLeaf `json:"leaf,omitempty"`
+
+	// The signed head of the tree including the leaf
+	SignedTreeHead *V1alpha1SignedTreeHead `json:"signedTreeHead,omitempty"`
+}
+
+// Validate validates this v1alpha1 get inclusion proof response
+func (m *V1alpha1GetInclusionProofResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLeaf(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSignedTreeHead(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1alpha1GetInclusionProofResponse) validateLeaf(formats strfmt.Registry) error {
+	if swag.IsZero(m.Leaf) { // not required
+		return nil
+	}
+
+	if m.Leaf != nil {
+		if err := m.Leaf.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("leaf")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("leaf")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1alpha1GetInclusionProofResponse) validateSignedTreeHead(formats strfmt.Registry) error {
+	if swag.IsZero(m.SignedTreeHead) { // not required
+		return nil
+	}
+
+	if m.SignedTreeHead != nil {
+		if err := m.SignedTreeHead.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("signedTreeHead")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("signedTreeHead")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this v1alpha1 get inclusion proof response based on the context it is used
+func (m *V1alpha1GetInclusionProofResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateLeaf(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSignedTreeHead(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1alpha1GetInclusionProofResponse) contextValidateLeaf(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Leaf != nil {
+
+		if swag.IsZero(m.Leaf) { // not required
+			return nil
+		}
+
+		if err := m.Leaf.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("leaf")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("leaf")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1alpha1GetInclusionProofResponse) contextValidateSignedTreeHead(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.SignedTreeHead != nil {
+
+		if swag.IsZero(m.SignedTreeHead) { // not required
+			return nil
+		}
+
+		if err := m.SignedTreeHead.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("signedTreeHead")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("signedTreeHead")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1alpha1GetInclusionProofResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1alpha1GetInclusionProofResponse) UnmarshalBinary(b []byte) error {
+	var res V1alpha1GetInclusionProofResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// V1alpha1GetLogJwksResponse Returns the keys signing the tree heads
+//
+// swagger:model v1alpha1GetLogJwksResponse
+type V1alpha1GetLogJwksResponse struct {
+
+	// The Json Web Key Set (JWKS) of the node
+	Jwks *V1alpha1Jwks `json:"jwks,omitempty"`
+}
+
+// Validate validates this v1alpha1 get log jwks response
+func (m *V1alpha1GetLogJwksResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateJwks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1alpha1GetLogJwksResponse) validateJwks(formats strfmt.Registry) error {
+	if swag.IsZero(m.Jwks) { // not required
+		return nil
+	}
+
+	if m.Jwks != nil {
+		if err := m.Jwks.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("jwks")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("jwks")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this v1alpha1 get log jwks response based on the context it is used
+func (m *V1alpha1GetLogJwksResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateJwks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1alpha1GetLogJwksResponse) contextValidateJwks(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Jwks != nil {
+
+		if swag.IsZero(m.Jwks) { // not required
+			return nil
+		}
+
+		if err := m.Jwks.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("jwks")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("jwks")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1alpha1GetLogJwksResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1alpha1GetLogJwksResponse) UnmarshalBinary(b []byte) error {
+	var res V1alpha1GetLogJwksResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
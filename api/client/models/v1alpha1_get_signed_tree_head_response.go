@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// V1alpha1GetSignedTreeHeadResponse Returns the current signed tree head
+//
+// swagger:model v1alpha1GetSignedTreeHeadResponse
+type V1alpha1GetSignedTreeHeadResponse struct {
+
+	// The signed tree head
+	SignedTreeHead *V1alpha1SignedTreeHead `json:"signedTreeHead,omitempty"`
+}
+
+// Validate validates this v1alpha1 get signed tree head response
+func (m *V1alpha1GetSignedTreeHeadResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSignedTreeHead(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1alpha1GetSignedTreeHeadResponse) validateSignedTreeHead(formats strfmt.Registry) error {
+	if swag.IsZero(m.SignedTreeHead) { // not required
+		return nil
+	}
+
+	if m.SignedTreeHead != nil {
+		if err := m.SignedTreeHead.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("signedTreeHead")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("signedTreeHead")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this v1alpha1 get signed tree head response based on the context it is used
+func (m *V1alpha1GetSignedTreeHeadResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSignedTreeHead(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1alpha1GetSignedTreeHeadResponse) contextValidateSignedTreeHead(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.SignedTreeHead != nil {
+
+		if swag.IsZero(m.SignedTreeHead) { // not required
+			return nil
+		}
+
+		if err := m.SignedTreeHead.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("signedTreeHead")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("signedTreeHead")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1alpha1GetSignedTreeHeadResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1alpha1GetSignedTreeHeadResponse) UnmarshalBinary(b []byte) error {
+	var res V1alpha1GetSignedTreeHeadResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -13,7 +13,7 @@ import (
 )
 
 // V1alpha1Jwk JWK represents:
-// - a JSON Web Key (JWK) with the respective fields specific to RSA algorithms.
+// - a JSON Web Key (JWK) with the respective fields specific to RSA, EC and OKP algorithms.
 // - a Quantum JSON Web Key (QJWK) with the respective fields specific to AKP algorithms.
 //
 // swagger:model v1alpha1Jwk
@@ -25,6 +25,10 @@ type V1alpha1Jwk struct {
 	// Some example algorithms are "RS256", "RS384", "RS512" for RSA algorithms.
 	Alg string `json:"alg,omitempty"`
 
+	// The curve for the EC and OKP ktys.
+	// Some example values are "P-256", "P-384" and "Ed25519".
+	Crv string `json:"crv,omitempty"`
+
 	// The private exponent for the RSA kty.
 	D string `json:"d,omitempty"`
 
@@ -71,6 +75,12 @@ type V1alpha1Jwk struct {
 	// Use represents the intended use of the key.
 	// Some example values are "enc" and "sig".
 	Use string `json:"use,omitempty"`
+
+	// The x coordinate for the EC kty or the public key for the OKP kty.
+	X string `json:"x,omitempty"`
+
+	// The y coordinate for the EC kty.
+	Y string `json:"y,omitempty"`
 }
 
 // Validate validates this v1alpha1 jwk
@@ -0,0 +1,65 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// V1alpha1LogLeaf An entry of the transparency log
+//
+// swagger:model v1alpha1LogLeaf
+type V1alpha1LogLeaf struct {
+
+	// The hex encoded SHA-256 digest of the content of the mutation
+	Digest string `json:"digest,omitempty"`
+
+	// The hash of the leaf
+	// Format: byte
+	Hash strfmt.Base64 `json:"hash,omitempty"`
+
+	// The position of the leaf in the log, starting at 0
+	Index string `json:"index,omitempty"`
+
+	// The Issuer common name, the ID or the Verifiable Credential ID
+	// affected by the mutation
+	Subject string `json:"subject,omitempty"`
+
+	// The mutation recorded by the leaf.
+	// Example: "vc.publish"
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this v1alpha1 log leaf
+func (m *V1alpha1LogLeaf) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this v1alpha1 log leaf based on context it is used
+func (m *V1alpha1LogLeaf) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1alpha1LogLeaf) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1alpha1LogLeaf) UnmarshalBinary(b []byte) error {
+	var res V1alpha1LogLeaf
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
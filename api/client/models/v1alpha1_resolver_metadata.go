@@ -32,6 +32,10 @@ type V1alpha1ResolverMetadata struct {
 	// A controller is an entity that is authorized to make changes to a Resolver Metadata.
 	Controller string `json:"controller,omitempty"`
 
+	// Deactivated is true when the ID has been permanently deactivated by its controller.
+	// A deactivated ID can no longer be updated and the VCs bound to it fail verification.
+	Deactivated bool `json:"deactivated,omitempty"`
+
 	// The ID
 	// The metadata below is related as claims to the ID
 	ID string `json:"id,omitempty"`
@@ -0,0 +1,13 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+// V1alpha1RotateIssuerKeyResponse Returns a key rotation response for the issuer
+//
+// # Empty response
+//
+// swagger:model v1alpha1RotateIssuerKeyResponse
+type V1alpha1RotateIssuerKeyResponse any
@@ -15,25 +15,48 @@ import (
 )
 
 // V1alpha1SearchRequest Request to search for VCs based on the specified criteria
+// All the criteria are optional and combined with a logical AND
 //
 // swagger:model v1alpha1SearchRequest
 type V1alpha1SearchRequest struct {
 
-	// The credential content representation in JSON-LD format
-	Content string `json:"content,omitempty"`
+	// The type of the credential content (AgentBadge, MCPServerBadge)
+	ContentType *V1alpha1CredentialContentType `json:"contentType,omitempty"`
 
-	// ID is the identifier.
+	// Only VCs expiring at or after this date (RFC3339).
+	// VCs without an expiration date are included.
+	ExpiresAfter string `json:"expiresAfter,omitempty"`
+
+	// Only VCs expiring before this date (RFC3339)
+	ExpiresBefore string `json:"expiresBefore,omitempty"`
+
+	// The resolver metadata ID the VCs are attached to
 	ID string `json:"id,omitempty"`
 
-	// Schema is the schema of the credential contained in the VC
-	Schema *V1alpha1CredentialSchema `json:"schema,omitempty"`
+	// Only VCs issued at or after this date (RFC3339)
+	IssuedAfter string `json:"issuedAfter,omitempty"`
+
+	// Only VCs issued before this date (RFC3339)
+	IssuedBefore string `json:"issuedBefore,omitempty"`
+
+	// The common name of the Issuer of the VCs
+	Issuer string `json:"issuer,omitempty"`
+
+	// The maximum number of VCs to return (default 20, max 100)
+	PageSize int32 `json:"pageSize,omitempty"`
+
+	// The page token returned by a previous search to retrieve the next page
+	PageToken string `json:"pageToken,omitempty"`
+
+	// Filter on the revocation state of the VCs
+	Revoked bool `json:"revoked,omitempty"`
 }
 
 // Validate validates this v1alpha1 search request
 func (m *V1alpha1SearchRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
-	if err := m.validateSchema(formats); err != nil {
+	if err := m.validateContentType(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -43,20 +66,20 @@ func (m *V1alpha1SearchRequest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *V1alpha1SearchRequest) validateSchema(formats strfmt.Registry) error {
-	if swag.IsZero(m.Schema) { // not required
+func (m *V1alpha1SearchRequest) validateContentType(formats strfmt.Registry) error {
+	if swag.IsZero(m.ContentType) { // not required
 		return nil
 	}
 
-	if m.Schema != nil {
-		if err := m.Schema.Validate(formats); err != nil {
+	if m.ContentType != nil {
+		if err := m.ContentType.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("schema")
+				return ve.ValidateName("contentType")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("schema")
+				return ce.ValidateName("contentType")
 			}
 
 			return err
@@ -70,7 +93,7 @@ func (m *V1alpha1SearchRequest) validateSchema(formats strfmt.Registry) error {
 func (m *V1alpha1SearchRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
-	if err := m.contextValidateSchema(ctx, formats); err != nil {
+	if err := m.contextValidateContentType(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -80,22 +103,22 @@ func (m *V1alpha1SearchRequest) ContextValidate(ctx context.Context, formats str
 	return nil
 }
 
-func (m *V1alpha1SearchRequest) contextValidateSchema(ctx context.Context, formats strfmt.Registry) error {
+func (m *V1alpha1SearchRequest) contextValidateContentType(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.Schema != nil {
+	if m.ContentType != nil {
 
-		if swag.IsZero(m.Schema) { // not required
+		if swag.IsZero(m.ContentType) { // not required
 			return nil
 		}
 
-		if err := m.Schema.ContextValidate(ctx, formats); err != nil {
+		if err := m.ContentType.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("schema")
+				return ve.ValidateName("contentType")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("schema")
+				return ce.ValidateName("contentType")
 			}
 
 			return err
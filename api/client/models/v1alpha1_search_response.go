@@ -20,6 +20,9 @@ import (
 // swagger:model v1alpha1SearchResponse
 type V1alpha1SearchResponse struct {
 
+	// The token to retrieve the next page, empty if there are no more results
+	NextPageToken string `json:"nextPageToken,omitempty"`
+
 	// The list of VCs that match the search criteria
 	Vcs []*V1alpha1EnvelopedCredential `json:"vcs"`
 }
@@ -0,0 +1,60 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// V1alpha1SignedTreeHead A tree head of the transparency log signed by the node
+//
+// swagger:model v1alpha1SignedTreeHead
+type V1alpha1SignedTreeHead struct {
+
+	// The Merkle Tree Hash of the tree as defined in RFC 6962
+	// Format: byte
+	RootHash strfmt.Base64 `json:"rootHash,omitempty"`
+
+	// The tree head signed as a JWS in the compact serialization
+	Signature string `json:"signature,omitempty"`
+
+	// The time when the tree head has been signed in milliseconds since the epoch
+	Timestamp string `json:"timestamp,omitempty"`
+
+	// The number of leaves of the tree
+	TreeSize string `json:"treeSize,omitempty"`
+}
+
+// Validate validates this v1alpha1 signed tree head
+func (m *V1alpha1SignedTreeHead) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this v1alpha1 signed tree head based on context it is used
+func (m *V1alpha1SignedTreeHead) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1alpha1SignedTreeHead) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1alpha1SignedTreeHead) UnmarshalBinary(b []byte) error {
+	var res V1alpha1SignedTreeHead
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
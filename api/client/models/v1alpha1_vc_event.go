@@ -0,0 +1,196 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// V1alpha1VcEvent A change of a Verifiable Credential
+//
+// swagger:model v1alpha1VcEvent
+type V1alpha1VcEvent struct {
+
+	// The unique identifier of the event
+	ID string `json:"id,omitempty"`
+
+	// The common name of the Issuer of the Verifiable Credential
+	Issuer string `json:"issuer,omitempty"`
+
+	// The resolver metadata ID the Verifiable Credential is attached to
+	ResolverMetadataID string `json:"resolverMetadataId,omitempty"`
+
+	// The position of the event in the events of the Node,
+	// pass it as after_sequence to resume watching after the event
+	Sequence string `json:"sequence,omitempty"`
+
+	// The time of the change in milliseconds since the epoch
+	Timestamp string `json:"timestamp,omitempty"`
+
+	// The change of the Verifiable Credential
+	Type *V1alpha1VcEventType `json:"type,omitempty"`
+
+	// The Verifiable Credential as submitted with the change
+	Vc *V1alpha1EnvelopedCredential `json:"vc,omitempty"`
+
+	// The ID of the Verifiable Credential
+	VcID string `json:"vcId,omitempty"`
+}
+
+// Validate validates this v1alpha1 vc event
+func (m *V1alpha1VcEvent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVc(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1alpha1VcEvent) validateType(formats strfmt.Registry) error {
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	if m.Type != nil {
+		if err := m.Type.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("type")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("type")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1alpha1VcEvent) validateVc(formats strfmt.Registry) error {
+	if swag.IsZero(m.Vc) { // not required
+		return nil
+	}
+
+	if m.Vc != nil {
+		if err := m.Vc.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("vc")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("vc")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this v1alpha1 vc event based on the context it is used
+func (m *V1alpha1VcEvent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateType(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVc(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1alpha1VcEvent) contextValidateType(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Type != nil {
+
+		if swag.IsZero(m.Type) { // not required
+			return nil
+		}
+
+		if err := m.Type.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("type")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("type")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1alpha1VcEvent) contextValidateVc(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Vc != nil {
+
+		if swag.IsZero(m.Vc) { // not required
+			return nil
+		}
+
+		if err := m.Vc.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("vc")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("vc")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1alpha1VcEvent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1alpha1VcEvent) UnmarshalBinary(b []byte) error {
+	var res V1alpha1VcEvent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
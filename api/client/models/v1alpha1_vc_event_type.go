@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// V1alpha1VcEventType The change of a Verifiable Credential
+//
+// - VC_EVENT_TYPE_UNSPECIFIED: Unspecified event type.
+//   - VC_EVENT_TYPE_PUBLISHED: The Verifiable Credential has been published
+//   - VC_EVENT_TYPE_REVOKED: The Verifiable Credential has been revoked
+//   - VC_EVENT_TYPE_SUSPENDED: The Verifiable Credential has been suspended
+//   - VC_EVENT_TYPE_REINSTATED: The Verifiable Credential has been reinstated
+//
+// swagger:model v1alpha1VcEventType
+type V1alpha1VcEventType string
+
+func NewV1alpha1VcEventType(value V1alpha1VcEventType) *V1alpha1VcEventType {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated V1alpha1VcEventType.
+func (m V1alpha1VcEventType) Pointer() *V1alpha1VcEventType {
+	return &m
+}
+
+const (
+
+	// V1alpha1VcEventTypeVCEVENTTYPEUNSPECIFIED captures enum value "VC_EVENT_TYPE_UNSPECIFIED"
+	V1alpha1VcEventTypeVCEVENTTYPEUNSPECIFIED V1alpha1VcEventType = "VC_EVENT_TYPE_UNSPECIFIED"
+
+	// V1alpha1VcEventTypeVCEVENTTYPEPUBLISHED captures enum value "VC_EVENT_TYPE_PUBLISHED"
+	V1alpha1VcEventTypeVCEVENTTYPEPUBLISHED V1alpha1VcEventType = "VC_EVENT_TYPE_PUBLISHED"
+
+	// V1alpha1VcEventTypeVCEVENTTYPEREVOKED captures enum value "VC_EVENT_TYPE_REVOKED"
+	V1alpha1VcEventTypeVCEVENTTYPEREVOKED V1alpha1VcEventType = "VC_EVENT_TYPE_REVOKED"
+
+	// V1alpha1VcEventTypeVCEVENTTYPESUSPENDED captures enum value "VC_EVENT_TYPE_SUSPENDED"
+	V1alpha1VcEventTypeVCEVENTTYPESUSPENDED V1alpha1VcEventType = "VC_EVENT_TYPE_SUSPENDED"
+
+	// V1alpha1VcEventTypeVCEVENTTYPEREINSTATED captures enum value "VC_EVENT_TYPE_REINSTATED"
+	V1alpha1VcEventTypeVCEVENTTYPEREINSTATED V1alpha1VcEventType = "VC_EVENT_TYPE_REINSTATED"
+)
+
+// for schema
+var v1alpha1VcEventTypeEnum []any
+
+func init() {
+	var res []V1alpha1VcEventType
+	if err := json.Unmarshal([]byte(`["VC_EVENT_TYPE_UNSPECIFIED","VC_EVENT_TYPE_PUBLISHED","VC_EVENT_TYPE_REVOKED","VC_EVENT_TYPE_SUSPENDED","VC_EVENT_TYPE_REINSTATED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		v1alpha1VcEventTypeEnum = append(v1alpha1VcEventTypeEnum, v)
+	}
+}
+
+func (m V1alpha1VcEventType) validateV1alpha1VcEventTypeEnum(path, location string, value V1alpha1VcEventType) error {
+	if err := validate.EnumCase(path, location, value, v1alpha1VcEventTypeEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this v1alpha1 vc event type
+func (m V1alpha1VcEventType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateV1alpha1VcEventTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this v1alpha1 vc event type based on context it is used
+func (m V1alpha1VcEventType) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
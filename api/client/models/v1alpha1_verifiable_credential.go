@@ -51,6 +51,12 @@ type V1alpha1VerifiableCredential struct {
 
 	// https://www.w3.org/TR/vc-data-model/#dfn-type
 	Type []string `json:"type"`
+
+	// https://www.w3.org/TR/vc-data-model-2.0/#validity-period
+	ValidFrom string `json:"validFrom,omitempty"`
+
+	// https://www.w3.org/TR/vc-data-model-2.0/#validity-period
+	ValidUntil string `json:"validUntil,omitempty"`
 }
 
 // Validate validates this v1alpha1 verifiable credential
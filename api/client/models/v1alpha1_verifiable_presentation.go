@@ -0,0 +1,200 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// V1alpha1VerifiablePresentation DataModel represents the W3C Verifiable Presentation Data Model defined [here]
+//
+// swagger:model v1alpha1VerifiablePresentation
+//
+// [here]: https://www.w3.org/TR/vc-data-model/
+type V1alpha1VerifiablePresentation struct {
+
+	// https://www.w3.org/TR/vc-data-model/#contexts
+	Context []string `json:"context"`
+
+	// https://www.w3.org/TR/vc-data-model-2.0/#defn-holder
+	Holder string `json:"holder,omitempty"`
+
+	// https://w3id.org/security#proof
+	Proof *V1alpha1Proof `json:"proof,omitempty"`
+
+	// https://www.w3.org/TR/vc-data-model/#dfn-type
+	Type []string `json:"type"`
+
+	// https://www.w3.org/2018/credentials#verifiableCredential
+	VerifiableCredential []*V1alpha1VerifiableCredential `json:"verifiableCredential"`
+}
+
+// Validate validates this v1alpha1 verifiable presentation
+func (m *V1alpha1VerifiablePresentation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateProof(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVerifiableCredential(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1alpha1VerifiablePresentation) validateProof(formats strfmt.Registry) error {
+	if swag.IsZero(m.Proof) { // not required
+		return nil
+	}
+
+	if m.Proof != nil {
+		if err := m.Proof.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("proof")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("proof")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1alpha1VerifiablePresentation) validateVerifiableCredential(formats strfmt.Registry) error {
+	if swag.IsZero(m.VerifiableCredential) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.VerifiableCredential); i++ {
+		if swag.IsZero(m.VerifiableCredential[i]) { // not required
+			continue
+		}
+
+		if m.VerifiableCredential[i] != nil {
+			if err := m.VerifiableCredential[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("verifiableCredential" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("verifiableCredential" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this v1alpha1 verifiable presentation based on the context it is used
+func (m *V1alpha1VerifiablePresentation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateProof(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVerifiableCredential(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1alpha1VerifiablePresentation) contextValidateProof(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Proof != nil {
+
+		if swag.IsZero(m.Proof) { // not required
+			return nil
+		}
+
+		if err := m.Proof.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("proof")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("proof")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *V1alpha1VerifiablePresentation) contextValidateVerifiableCredential(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.VerifiableCredential); i++ {
+
+		if m.VerifiableCredential[i] != nil {
+
+			if swag.IsZero(m.VerifiableCredential[i]) { // not required
+				return nil
+			}
+
+			if err := m.VerifiableCredential[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("verifiableCredential" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("verifiableCredential" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1alpha1VerifiablePresentation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1alpha1VerifiablePresentation) UnmarshalBinary(b []byte) error {
+	var res V1alpha1VerifiablePresentation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -26,6 +26,10 @@ type V1alpha1VerificationMethod struct {
 
 	// The public key used for the verification method.
 	PublicKeyJwk *V1alpha1Jwk `json:"publicKeyJwk,omitempty"`
+
+	// The date and time after which the key of a rotated verification
+	// method can no longer be used.
+	RetiredAt V1alpha1Time `json:"retiredAt,omitempty"`
 }
 
 // Validate validates this v1alpha1 verification method
@@ -0,0 +1,124 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// V1alpha1VerifyPresentationRequest Request to verify a Verifiable Presentation
+//
+// swagger:model v1alpha1VerifyPresentationRequest
+type V1alpha1VerifyPresentationRequest struct {
+
+	// The optional intended audience of the presentation
+	Audience string `json:"audience,omitempty"`
+
+	// The nonce provided by the verifier to the holder
+	Nonce string `json:"nonce,omitempty"`
+
+	// The Verifiable Presentation to verify, secured with JOSE
+	Vp *V1alpha1EnvelopedCredential `json:"vp,omitempty"`
+}
+
+// Validate validates this v1alpha1 verify presentation request
+func (m *V1alpha1VerifyPresentationRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateVp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1alpha1VerifyPresentationRequest) validateVp(formats strfmt.Registry) error {
+	if swag.IsZero(m.Vp) { // not required
+		return nil
+	}
+
+	if m.Vp != nil {
+		if err := m.Vp.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("vp")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("vp")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this v1alpha1 verify presentation request based on the context it is used
+func (m *V1alpha1VerifyPresentationRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateVp(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *V1alpha1VerifyPresentationRequest) contextValidateVp(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Vp != nil {
+
+		if swag.IsZero(m.Vp) { // not required
+			return nil
+		}
+
+		if err := m.Vp.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("vp")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("vp")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *V1alpha1VerifyPresentationRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *V1alpha1VerifyPresentationRequest) UnmarshalBinary(b []byte) error {
+	var res V1alpha1VerifyPresentationRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
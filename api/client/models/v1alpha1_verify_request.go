@@ -19,6 +19,13 @@ import (
 // swagger:model v1alpha1VerifyRequest
 type V1alpha1VerifyRequest struct {
 
+	// The optional intended audience of the credentials bound to a holder key
+	Audience string `json:"audience,omitempty"`
+
+	// The nonce provided by the verifier to the holder,
+	// required for the credentials bound to a holder key
+	Nonce string `json:"nonce,omitempty"`
+
 	// The Verifiable Credential to verify
 	Vc *V1alpha1EnvelopedCredential `json:"vc,omitempty"`
 }
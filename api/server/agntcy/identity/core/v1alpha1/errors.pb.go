@@ -60,6 +60,36 @@ const (
 	ErrorReason_ERROR_REASON_ID_ALREADY_REGISTERED ErrorReason = 12
 	// The Verifiable Credential is revoked
 	ErrorReason_ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED ErrorReason = 13
+	// The search criteria contains one or more invalid fields
+	ErrorReason_ERROR_REASON_INVALID_SEARCH_CRITERIA ErrorReason = 14
+	// The Verifiable Credential is suspended
+	ErrorReason_ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED ErrorReason = 15
+	// The Verifiable Credential is expired
+	ErrorReason_ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED ErrorReason = 16
+	// The Verifiable Credential is not yet valid
+	ErrorReason_ERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALID ErrorReason = 17
+	// The Verifiable Presentation is invalid or its proof cannot be verified
+	ErrorReason_ERROR_REASON_INVALID_VERIFIABLE_PRESENTATION ErrorReason = 18
+	// The nonce or the audience of the Verifiable Presentation does not match the challenge
+	ErrorReason_ERROR_REASON_INVALID_PRESENTATION_CHALLENGE ErrorReason = 19
+	// The ID has been deactivated by its controller
+	ErrorReason_ERROR_REASON_ID_DEACTIVATED ErrorReason = 20
+	// The Resolver Metadata contains one or more invalid verification methods or services
+	ErrorReason_ERROR_REASON_INVALID_RESOLVER_METADATA ErrorReason = 21
+	// The entry is not in the transparency log
+	ErrorReason_ERROR_REASON_TRANSPARENCY_LOG_ENTRY_NOT_FOUND ErrorReason = 22
+	// The tree size is invalid or larger than the transparency log
+	ErrorReason_ERROR_REASON_INVALID_TREE_SIZE ErrorReason = 23
+	// The client sent too many requests
+	ErrorReason_ERROR_REASON_RATE_LIMIT_EXCEEDED ErrorReason = 24
+	// The client is not authenticated to call the RPC
+	ErrorReason_ERROR_REASON_UNAUTHENTICATED ErrorReason = 25
+	// The request payload exceeds the configured limits
+	ErrorReason_ERROR_REASON_PAYLOAD_TOO_LARGE ErrorReason = 26
+	// The issuer or its identity provider is not trusted by the trust policy of the Node
+	ErrorReason_ERROR_REASON_UNTRUSTED_ISSUER ErrorReason = 27
+	// The status list index of the credential is already allocated to another credential
+	ErrorReason_ERROR_REASON_STATUS_LIST_INDEX_IN_USE ErrorReason = 28
 )
 
 // Enum value maps for ErrorReason.
@@ -79,6 +109,21 @@ var (
 		11: "ERROR_REASON_UNKNOWN_IDP",
 		12: "ERROR_REASON_ID_ALREADY_REGISTERED",
 		13: "ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED",
+		14: "ERROR_REASON_INVALID_SEARCH_CRITERIA",
+		15: "ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED",
+		16: "ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED",
+		17: "ERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALID",
+		18: "ERROR_REASON_INVALID_VERIFIABLE_PRESENTATION",
+		19: "ERROR_REASON_INVALID_PRESENTATION_CHALLENGE",
+		20: "ERROR_REASON_ID_DEACTIVATED",
+		21: "ERROR_REASON_INVALID_RESOLVER_METADATA",
+		22: "ERROR_REASON_TRANSPARENCY_LOG_ENTRY_NOT_FOUND",
+		23: "ERROR_REASON_INVALID_TREE_SIZE",
+		24: "ERROR_REASON_RATE_LIMIT_EXCEEDED",
+		25: "ERROR_REASON_UNAUTHENTICATED",
+		26: "ERROR_REASON_PAYLOAD_TOO_LARGE",
+		27: "ERROR_REASON_UNTRUSTED_ISSUER",
+		28: "ERROR_REASON_STATUS_LIST_INDEX_IN_USE",
 	}
 	ErrorReason_value = map[string]int32{
 		"ERROR_REASON_UNSPECIFIED":                              0,
@@ -95,6 +140,21 @@ var (
 		"ERROR_REASON_UNKNOWN_IDP":                              11,
 		"ERROR_REASON_ID_ALREADY_REGISTERED":                    12,
 		"ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED":            13,
+		"ERROR_REASON_INVALID_SEARCH_CRITERIA":                  14,
+		"ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED":          15,
+		"ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED":            16,
+		"ERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALID":      17,
+		"ERROR_REASON_INVALID_VERIFIABLE_PRESENTATION":          18,
+		"ERROR_REASON_INVALID_PRESENTATION_CHALLENGE":           19,
+		"ERROR_REASON_ID_DEACTIVATED":                           20,
+		"ERROR_REASON_INVALID_RESOLVER_METADATA":                21,
+		"ERROR_REASON_TRANSPARENCY_LOG_ENTRY_NOT_FOUND":         22,
+		"ERROR_REASON_INVALID_TREE_SIZE":                        23,
+		"ERROR_REASON_RATE_LIMIT_EXCEEDED":                      24,
+		"ERROR_REASON_UNAUTHENTICATED":                          25,
+		"ERROR_REASON_PAYLOAD_TOO_LARGE":                        26,
+		"ERROR_REASON_UNTRUSTED_ISSUER":                         27,
+		"ERROR_REASON_STATUS_LIST_INDEX_IN_USE":                 28,
 	}
 )
 
@@ -193,7 +253,7 @@ const file_agntcy_identity_core_v1alpha1_errors_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tH\x01R\amessage\x88\x01\x01B\t\n" +
 	"\a_reasonB\n" +
 	"\n" +
-	"\b_message*\xb4\x04\n" +
+	"\b_message*\xb7\t\n" +
 	"\vErrorReason\x12\x1c\n" +
 	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15ERROR_REASON_INTERNAL\x10\x01\x121\n" +
@@ -209,7 +269,22 @@ const file_agntcy_identity_core_v1alpha1_errors_proto_rawDesc = "" +
 	"\x12\x1c\n" +
 	"\x18ERROR_REASON_UNKNOWN_IDP\x10\v\x12&\n" +
 	"\"ERROR_REASON_ID_ALREADY_REGISTERED\x10\f\x12.\n" +
-	"*ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED\x10\rBZZXgithub.com/agntcy/identity/api/server/agntcy/identity/core/v1alpha1;identity_core_sdk_gob\x06proto3"
+	"*ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED\x10\r\x12(\n" +
+	"$ERROR_REASON_INVALID_SEARCH_CRITERIA\x10\x0e\x120\n" +
+	",ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED\x10\x0f\x12.\n" +
+	"*ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED\x10\x10\x124\n" +
+	"0ERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALID\x10\x11\x120\n" +
+	",ERROR_REASON_INVALID_VERIFIABLE_PRESENTATION\x10\x12\x12/\n" +
+	"+ERROR_REASON_INVALID_PRESENTATION_CHALLENGE\x10\x13\x12\x1f\n" +
+	"\x1bERROR_REASON_ID_DEACTIVATED\x10\x14\x12*\n" +
+	"&ERROR_REASON_INVALID_RESOLVER_METADATA\x10\x15\x121\n" +
+	"-ERROR_REASON_TRANSPARENCY_LOG_ENTRY_NOT_FOUND\x10\x16\x12\"\n" +
+	"\x1eERROR_REASON_INVALID_TREE_SIZE\x10\x17\x12$\n" +
+	" ERROR_REASON_RATE_LIMIT_EXCEEDED\x10\x18\x12 \n" +
+	"\x1cERROR_REASON_UNAUTHENTICATED\x10\x19\x12\"\n" +
+	"\x1eERROR_REASON_PAYLOAD_TOO_LARGE\x10\x1a\x12!\n" +
+	"\x1dERROR_REASON_UNTRUSTED_ISSUER\x10\x1b\x12)\n" +
+	"%ERROR_REASON_STATUS_LIST_INDEX_IN_USE\x10\x1cBZZXgithub.com/agntcy/identity/api/server/agntcy/identity/core/v1alpha1;identity_core_sdk_gob\x06proto3"
 
 var (
 	file_agntcy_identity_core_v1alpha1_errors_proto_rawDescOnce sync.Once
@@ -44,7 +44,10 @@ type ResolverMetadata struct {
 	// is expected to express claims, such as for the purposes of issuing a VCs.
 	AssertionMethod []string `protobuf:"bytes,4,rep,name=assertion_method,json=assertionMethod,proto3" json:"assertion_method,omitempty"`
 	// A controller is an entity that is authorized to make changes to a Resolver Metadata.
-	Controller    *string `protobuf:"bytes,5,opt,name=controller,proto3,oneof" json:"controller,omitempty"`
+	Controller *string `protobuf:"bytes,5,opt,name=controller,proto3,oneof" json:"controller,omitempty"`
+	// Deactivated is true when the ID has been permanently deactivated by its controller.
+	// A deactivated ID can no longer be updated and the VCs bound to it fail verification.
+	Deactivated   *bool `protobuf:"varint,6,opt,name=deactivated,proto3,oneof" json:"deactivated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,6 +117,13 @@ func (x *ResolverMetadata) GetController() string {
 	return ""
 }
 
+func (x *ResolverMetadata) GetDeactivated() bool {
+	if x != nil && x.Deactivated != nil {
+		return *x.Deactivated
+	}
+	return false
+}
+
 // Service is used in ResolverMetadata to express ways of communicating with
 // the node that published the document.
 type Service struct {
@@ -170,7 +180,10 @@ type VerificationMethod struct {
 	// A unique id of the verification method.
 	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
 	// The public key used for the verification method.
-	PublicKeyJwk  *Jwk `protobuf:"bytes,2,opt,name=public_key_jwk,json=publicKeyJwk,proto3,oneof" json:"public_key_jwk,omitempty"`
+	PublicKeyJwk *Jwk `protobuf:"bytes,2,opt,name=public_key_jwk,json=publicKeyJwk,proto3,oneof" json:"public_key_jwk,omitempty"`
+	// The date and time after which the key of a rotated verification
+	// method can no longer be used.
+	RetiredAt     *Time `protobuf:"bytes,3,opt,name=retired_at,json=retiredAt,proto3,oneof" json:"retired_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -219,11 +232,18 @@ func (x *VerificationMethod) GetPublicKeyJwk() *Jwk {
 	return nil
 }
 
+func (x *VerificationMethod) GetRetiredAt() *Time {
+	if x != nil {
+		return x.RetiredAt
+	}
+	return nil
+}
+
 var File_agntcy_identity_core_v1alpha1_id_proto protoreflect.FileDescriptor
 
 const file_agntcy_identity_core_v1alpha1_id_proto_rawDesc = "" +
 	"\n" +
-	"&agntcy/identity/core/v1alpha1/id.proto\x12\x1dagntcy.identity.core.v1alpha1\x1a'agntcy/identity/core/v1alpha1/jwk.proto\"\xb3\x02\n" +
+	"&agntcy/identity/core/v1alpha1/id.proto\x12\x1dagntcy.identity.core.v1alpha1\x1a'agntcy/identity/core/v1alpha1/jwk.proto\x1a&agntcy/identity/core/v1alpha1/vc.proto\"\xea\x02\n" +
 	"\x10ResolverMetadata\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12b\n" +
 	"\x13verification_method\x18\x02 \x03(\v21.agntcy.identity.core.v1alpha1.VerificationMethodR\x12verificationMethod\x12@\n" +
@@ -231,16 +251,21 @@ const file_agntcy_identity_core_v1alpha1_id_proto_rawDesc = "" +
 	"\x10assertion_method\x18\x04 \x03(\tR\x0fassertionMethod\x12#\n" +
 	"\n" +
 	"controller\x18\x05 \x01(\tH\x01R\n" +
-	"controller\x88\x01\x01B\x05\n" +
+	"controller\x88\x01\x01\x12%\n" +
+	"\vdeactivated\x18\x06 \x01(\bH\x02R\vdeactivated\x88\x01\x01B\x05\n" +
 	"\x03_idB\r\n" +
-	"\v_controller\"4\n" +
+	"\v_controllerB\x0e\n" +
+	"\f_deactivated\"4\n" +
 	"\aService\x12)\n" +
-	"\x10service_endpoint\x18\x01 \x03(\tR\x0fserviceEndpoint\"\x92\x01\n" +
+	"\x10service_endpoint\x18\x01 \x03(\tR\x0fserviceEndpoint\"\xea\x01\n" +
 	"\x12VerificationMethod\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12M\n" +
-	"\x0epublic_key_jwk\x18\x02 \x01(\v2\".agntcy.identity.core.v1alpha1.JwkH\x01R\fpublicKeyJwk\x88\x01\x01B\x05\n" +
+	"\x0epublic_key_jwk\x18\x02 \x01(\v2\".agntcy.identity.core.v1alpha1.JwkH\x01R\fpublicKeyJwk\x88\x01\x01\x12G\n" +
+	"\n" +
+	"retired_at\x18\x03 \x01(\v2#.agntcy.identity.core.v1alpha1.TimeH\x02R\tretiredAt\x88\x01\x01B\x05\n" +
 	"\x03_idB\x11\n" +
-	"\x0f_public_key_jwkBZZXgithub.com/agntcy/identity/api/server/agntcy/identity/core/v1alpha1;identity_core_sdk_gob\x06proto3"
+	"\x0f_public_key_jwkB\r\n" +
+	"\v_retired_atBZZXgithub.com/agntcy/identity/api/server/agntcy/identity/core/v1alpha1;identity_core_sdk_gob\x06proto3"
 
 var (
 	file_agntcy_identity_core_v1alpha1_id_proto_rawDescOnce sync.Once
@@ -260,16 +285,18 @@ var file_agntcy_identity_core_v1alpha1_id_proto_goTypes = []any{
 	(*Service)(nil),            // 1: agntcy.identity.core.v1alpha1.Service
 	(*VerificationMethod)(nil), // 2: agntcy.identity.core.v1alpha1.VerificationMethod
 	(*Jwk)(nil),                // 3: agntcy.identity.core.v1alpha1.Jwk
+	(*Time)(nil),               // 4: agntcy.identity.core.v1alpha1.Time
 }
 var file_agntcy_identity_core_v1alpha1_id_proto_depIdxs = []int32{
 	2, // 0: agntcy.identity.core.v1alpha1.ResolverMetadata.verification_method:type_name -> agntcy.identity.core.v1alpha1.VerificationMethod
 	1, // 1: agntcy.identity.core.v1alpha1.ResolverMetadata.service:type_name -> agntcy.identity.core.v1alpha1.Service
 	3, // 2: agntcy.identity.core.v1alpha1.VerificationMethod.public_key_jwk:type_name -> agntcy.identity.core.v1alpha1.Jwk
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: agntcy.identity.core.v1alpha1.VerificationMethod.retired_at:type_name -> agntcy.identity.core.v1alpha1.Time
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_agntcy_identity_core_v1alpha1_id_proto_init() }
@@ -278,6 +305,7 @@ func file_agntcy_identity_core_v1alpha1_id_proto_init() {
 		return
 	}
 	file_agntcy_identity_core_v1alpha1_jwk_proto_init()
+	file_agntcy_identity_core_v1alpha1_vc_proto_init()
 	file_agntcy_identity_core_v1alpha1_id_proto_msgTypes[0].OneofWrappers = []any{}
 	file_agntcy_identity_core_v1alpha1_id_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
@@ -24,7 +24,7 @@ const (
 )
 
 // JWK represents:
-// - a JSON Web Key (JWK) with the respective fields specific to RSA algorithms.
+// - a JSON Web Key (JWK) with the respective fields specific to RSA, EC and OKP algorithms.
 // - a Quantum JSON Web Key (QJWK) with the respective fields specific to AKP algorithms.
 type Jwk struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -65,7 +65,14 @@ type Jwk struct {
 	// The second factor CRT exponent for the RSA private key.
 	Dq *string `protobuf:"bytes,14,opt,name=dq,proto3,oneof" json:"dq,omitempty"`
 	// The first CRT coefficient for the RSA private key.
-	Qi            *string `protobuf:"bytes,15,opt,name=qi,proto3,oneof" json:"qi,omitempty"`
+	Qi *string `protobuf:"bytes,15,opt,name=qi,proto3,oneof" json:"qi,omitempty"`
+	// The curve for the EC and OKP ktys.
+	// Some example values are "P-256", "P-384" and "Ed25519".
+	Crv *string `protobuf:"bytes,16,opt,name=crv,proto3,oneof" json:"crv,omitempty"`
+	// The x coordinate for the EC kty or the public key for the OKP kty.
+	X *string `protobuf:"bytes,17,opt,name=x,proto3,oneof" json:"x,omitempty"`
+	// The y coordinate for the EC kty.
+	Y             *string `protobuf:"bytes,18,opt,name=y,proto3,oneof" json:"y,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -205,6 +212,27 @@ func (x *Jwk) GetQi() string {
 	return ""
 }
 
+func (x *Jwk) GetCrv() string {
+	if x != nil && x.Crv != nil {
+		return *x.Crv
+	}
+	return ""
+}
+
+func (x *Jwk) GetX() string {
+	if x != nil && x.X != nil {
+		return *x.X
+	}
+	return ""
+}
+
+func (x *Jwk) GetY() string {
+	if x != nil && x.Y != nil {
+		return *x.Y
+	}
+	return ""
+}
+
 // JWKS represents a set of JSON Web Keys (JWKs).
 type Jwks struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -255,7 +283,7 @@ var File_agntcy_identity_core_v1alpha1_jwk_proto protoreflect.FileDescriptor
 
 const file_agntcy_identity_core_v1alpha1_jwk_proto_rawDesc = "" +
 	"\n" +
-	"'agntcy/identity/core/v1alpha1/jwk.proto\x12\x1dagntcy.identity.core.v1alpha1\"\xb5\x03\n" +
+	"'agntcy/identity/core/v1alpha1/jwk.proto\x12\x1dagntcy.identity.core.v1alpha1\"\x86\x04\n" +
 	"\x03Jwk\x12\x15\n" +
 	"\x03alg\x18\x01 \x01(\tH\x00R\x03alg\x88\x01\x01\x12\x15\n" +
 	"\x03kty\x18\x02 \x01(\tH\x01R\x03kty\x88\x01\x01\x12\x15\n" +
@@ -273,7 +301,10 @@ const file_agntcy_identity_core_v1alpha1_jwk_proto_rawDesc = "" +
 	"\x01q\x18\f \x01(\tH\vR\x01q\x88\x01\x01\x12\x13\n" +
 	"\x02dp\x18\r \x01(\tH\fR\x02dp\x88\x01\x01\x12\x13\n" +
 	"\x02dq\x18\x0e \x01(\tH\rR\x02dq\x88\x01\x01\x12\x13\n" +
-	"\x02qi\x18\x0f \x01(\tH\x0eR\x02qi\x88\x01\x01B\x06\n" +
+	"\x02qi\x18\x0f \x01(\tH\x0eR\x02qi\x88\x01\x01\x12\x15\n" +
+	"\x03crv\x18\x10 \x01(\tH\x0fR\x03crv\x88\x01\x01\x12\x11\n" +
+	"\x01x\x18\x11 \x01(\tH\x10R\x01x\x88\x01\x01\x12\x11\n" +
+	"\x01y\x18\x12 \x01(\tH\x11R\x01y\x88\x01\x01B\x06\n" +
 	"\x04_algB\x06\n" +
 	"\x04_ktyB\x06\n" +
 	"\x04_useB\x06\n" +
@@ -288,7 +319,10 @@ const file_agntcy_identity_core_v1alpha1_jwk_proto_rawDesc = "" +
 	"\x02_qB\x05\n" +
 	"\x03_dpB\x05\n" +
 	"\x03_dqB\x05\n" +
-	"\x03_qi\">\n" +
+	"\x03_qiB\x06\n" +
+	"\x04_crvB\x04\n" +
+	"\x02_xB\x04\n" +
+	"\x02_y\">\n" +
 	"\x04Jwks\x126\n" +
 	"\x04keys\x18\x01 \x03(\v2\".agntcy.identity.core.v1alpha1.JwkR\x04keysBZZXgithub.com/agntcy/identity/api/server/agntcy/identity/core/v1alpha1;identity_core_sdk_gob\x06proto3"
 
@@ -94,6 +94,10 @@ const (
 	CredentialEnvelopeType_CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF CredentialEnvelopeType = 1
 	// JOSE Envelope Type.
 	CredentialEnvelopeType_CREDENTIAL_ENVELOPE_TYPE_JOSE CredentialEnvelopeType = 2
+	// COSE Envelope Type (COSE_Sign1).
+	CredentialEnvelopeType_CREDENTIAL_ENVELOPE_TYPE_COSE CredentialEnvelopeType = 3
+	// SD-JWT Envelope Type with selectively disclosable claims.
+	CredentialEnvelopeType_CREDENTIAL_ENVELOPE_TYPE_SD_JWT CredentialEnvelopeType = 4
 )
 
 // Enum value maps for CredentialEnvelopeType.
@@ -102,11 +106,15 @@ var (
 		0: "CREDENTIAL_ENVELOPE_TYPE_UNSPECIFIED",
 		1: "CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF",
 		2: "CREDENTIAL_ENVELOPE_TYPE_JOSE",
+		3: "CREDENTIAL_ENVELOPE_TYPE_COSE",
+		4: "CREDENTIAL_ENVELOPE_TYPE_SD_JWT",
 	}
 	CredentialEnvelopeType_value = map[string]int32{
 		"CREDENTIAL_ENVELOPE_TYPE_UNSPECIFIED":    0,
 		"CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF": 1,
 		"CREDENTIAL_ENVELOPE_TYPE_JOSE":           2,
+		"CREDENTIAL_ENVELOPE_TYPE_COSE":           3,
+		"CREDENTIAL_ENVELOPE_TYPE_SD_JWT":         4,
 	}
 )
 
@@ -146,6 +154,9 @@ const (
 	// Used to cancel the validity of a verifiable credential.
 	// This status is not reversible.
 	CredentialStatusPurpose_CREDENTIAL_STATUS_PURPOSE_REVOCATION CredentialStatusPurpose = 1
+	// Used to temporarily prevent the acceptance of a verifiable credential.
+	// This status is reversible.
+	CredentialStatusPurpose_CREDENTIAL_STATUS_PURPOSE_SUSPENSION CredentialStatusPurpose = 2
 )
 
 // Enum value maps for CredentialStatusPurpose.
@@ -153,10 +164,12 @@ var (
 	CredentialStatusPurpose_name = map[int32]string{
 		0: "CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED",
 		1: "CREDENTIAL_STATUS_PURPOSE_REVOCATION",
+		2: "CREDENTIAL_STATUS_PURPOSE_SUSPENSION",
 	}
 	CredentialStatusPurpose_value = map[string]int32{
 		"CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED": 0,
 		"CREDENTIAL_STATUS_PURPOSE_REVOCATION":  1,
+		"CREDENTIAL_STATUS_PURPOSE_SUSPENSION":  2,
 	}
 )
 
@@ -372,9 +385,13 @@ type CredentialStatus struct {
 	// The creation date and time of the status
 	CreatedAt *Time `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
 	// The value of the purpose for the status entry
-	Purpose       *CredentialStatusPurpose `protobuf:"varint,4,opt,name=purpose,proto3,enum=agntcy.identity.core.v1alpha1.CredentialStatusPurpose,oneof" json:"purpose,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Purpose *CredentialStatusPurpose `protobuf:"varint,4,opt,name=purpose,proto3,enum=agntcy.identity.core.v1alpha1.CredentialStatusPurpose,oneof" json:"purpose,omitempty"`
+	// The position of the credential in the Bitstring Status List
+	StatusListIndex *string `protobuf:"bytes,5,opt,name=status_list_index,json=statusListIndex,proto3,oneof" json:"status_list_index,omitempty"`
+	// The URL of the Bitstring Status List Credential
+	StatusListCredential *string `protobuf:"bytes,6,opt,name=status_list_credential,json=statusListCredential,proto3,oneof" json:"status_list_credential,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *CredentialStatus) Reset() {
@@ -435,6 +452,20 @@ func (x *CredentialStatus) GetPurpose() CredentialStatusPurpose {
 	return CredentialStatusPurpose_CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED
 }
 
+func (x *CredentialStatus) GetStatusListIndex() string {
+	if x != nil && x.StatusListIndex != nil {
+		return *x.StatusListIndex
+	}
+	return ""
+}
+
+func (x *CredentialStatus) GetStatusListCredential() string {
+	if x != nil && x.StatusListCredential != nil {
+		return *x.StatusListCredential
+	}
+	return ""
+}
+
 // EnvelopedCredential represents a Credential enveloped in a specific format.
 type EnvelopedCredential struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -579,7 +610,11 @@ type VerifiableCredential struct {
 	// https://www.w3.org/TR/vc-data-model-2.0/#status
 	CredentialStatus []*CredentialStatus `protobuf:"bytes,9,rep,name=credential_status,json=credentialStatus,proto3" json:"credential_status,omitempty"`
 	// https://w3id.org/security#proof
-	Proof         *Proof `protobuf:"bytes,10,opt,name=proof,proto3,oneof" json:"proof,omitempty"`
+	Proof *Proof `protobuf:"bytes,10,opt,name=proof,proto3,oneof" json:"proof,omitempty"`
+	// https://www.w3.org/TR/vc-data-model-2.0/#validity-period
+	ValidFrom *string `protobuf:"bytes,11,opt,name=valid_from,json=validFrom,proto3,oneof" json:"valid_from,omitempty"`
+	// https://www.w3.org/TR/vc-data-model-2.0/#validity-period
+	ValidUntil    *string `protobuf:"bytes,12,opt,name=valid_until,json=validUntil,proto3,oneof" json:"valid_until,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -684,6 +719,20 @@ func (x *VerifiableCredential) GetProof() *Proof {
 	return nil
 }
 
+func (x *VerifiableCredential) GetValidFrom() string {
+	if x != nil && x.ValidFrom != nil {
+		return *x.ValidFrom
+	}
+	return ""
+}
+
+func (x *VerifiableCredential) GetValidUntil() string {
+	if x != nil && x.ValidUntil != nil {
+		return *x.ValidUntil
+	}
+	return ""
+}
+
 // DataModel represents the W3C Verifiable Presentation Data Model defined [here]
 //
 // [here]: https://www.w3.org/TR/vc-data-model/
@@ -696,7 +745,9 @@ type VerifiablePresentation struct {
 	// https://www.w3.org/2018/credentials#verifiableCredential
 	VerifiableCredential []*VerifiableCredential `protobuf:"bytes,3,rep,name=verifiable_credential,json=verifiableCredential,proto3" json:"verifiable_credential,omitempty"`
 	// https://w3id.org/security#proof
-	Proof         *Proof `protobuf:"bytes,4,opt,name=proof,proto3,oneof" json:"proof,omitempty"`
+	Proof *Proof `protobuf:"bytes,4,opt,name=proof,proto3,oneof" json:"proof,omitempty"`
+	// https://www.w3.org/TR/vc-data-model-2.0/#defn-holder
+	Holder        *string `protobuf:"bytes,5,opt,name=holder,proto3,oneof" json:"holder,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -759,6 +810,87 @@ func (x *VerifiablePresentation) GetProof() *Proof {
 	return nil
 }
 
+func (x *VerifiablePresentation) GetHolder() string {
+	if x != nil && x.Holder != nil {
+		return *x.Holder
+	}
+	return ""
+}
+
+// The result returned from the verification process of a Verifiable Presentation
+// and of each of the Verifiable Credentials it contains
+type PresentationVerificationResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A boolean status, true when the presentation and all its credentials are valid
+	Status *bool `protobuf:"varint,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	// A conforming document which represents the Verifiable Presentation
+	Document *VerifiablePresentation `protobuf:"bytes,2,opt,name=document,proto3,oneof" json:"document,omitempty"`
+	// The verification results of the Verifiable Credentials of the presentation
+	Results []*VerificationResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	// A list represents zero or more errors generated by the verification of the presentation
+	Errors        []*ErrorInfo `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresentationVerificationResult) Reset() {
+	*x = PresentationVerificationResult{}
+	mi := &file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresentationVerificationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresentationVerificationResult) ProtoMessage() {}
+
+func (x *PresentationVerificationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresentationVerificationResult.ProtoReflect.Descriptor instead.
+func (*PresentationVerificationResult) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_core_v1alpha1_vc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PresentationVerificationResult) GetStatus() bool {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return false
+}
+
+func (x *PresentationVerificationResult) GetDocument() *VerifiablePresentation {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *PresentationVerificationResult) GetResults() []*VerificationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *PresentationVerificationResult) GetErrors() []*ErrorInfo {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
 // The result returned from the verification process defined [here]
 //
 // [here]: https://www.w3.org/TR/vc-data-model-2.0/#verification
@@ -786,7 +918,7 @@ type VerificationResult struct {
 
 func (x *VerificationResult) Reset() {
 	*x = VerificationResult{}
-	mi := &file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes[8]
+	mi := &file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -798,7 +930,7 @@ func (x *VerificationResult) String() string {
 func (*VerificationResult) ProtoMessage() {}
 
 func (x *VerificationResult) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes[8]
+	mi := &file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -811,7 +943,7 @@ func (x *VerificationResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerificationResult.ProtoReflect.Descriptor instead.
 func (*VerificationResult) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_core_v1alpha1_vc_proto_rawDescGZIP(), []int{8}
+	return file_agntcy_identity_core_v1alpha1_vc_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *VerificationResult) GetStatus() bool {
@@ -871,7 +1003,7 @@ type Time struct {
 
 func (x *Time) Reset() {
 	*x = Time{}
-	mi := &file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes[9]
+	mi := &file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -883,7 +1015,7 @@ func (x *Time) String() string {
 func (*Time) ProtoMessage() {}
 
 func (x *Time) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes[9]
+	mi := &file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -896,7 +1028,7 @@ func (x *Time) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Time.ProtoReflect.Descriptor instead.
 func (*Time) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_core_v1alpha1_vc_proto_rawDescGZIP(), []int{9}
+	return file_agntcy_identity_core_v1alpha1_vc_proto_rawDescGZIP(), []int{10}
 }
 
 var File_agntcy_identity_core_v1alpha1_vc_proto protoreflect.FileDescriptor
@@ -919,18 +1051,22 @@ const file_agntcy_identity_core_v1alpha1_vc_proto_rawDesc = "" +
 	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12\x13\n" +
 	"\x02id\x18\x02 \x01(\tH\x01R\x02id\x88\x01\x01B\a\n" +
 	"\x05_typeB\x05\n" +
-	"\x03_id\"\x8b\x02\n" +
+	"\x03_id\"\xa8\x03\n" +
 	"\x10CredentialStatus\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x17\n" +
 	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12G\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\v2#.agntcy.identity.core.v1alpha1.TimeH\x02R\tcreatedAt\x88\x01\x01\x12U\n" +
-	"\apurpose\x18\x04 \x01(\x0e26.agntcy.identity.core.v1alpha1.CredentialStatusPurposeH\x03R\apurpose\x88\x01\x01B\x05\n" +
+	"\apurpose\x18\x04 \x01(\x0e26.agntcy.identity.core.v1alpha1.CredentialStatusPurposeH\x03R\apurpose\x88\x01\x01\x12/\n" +
+	"\x11status_list_index\x18\x05 \x01(\tH\x04R\x0fstatusListIndex\x88\x01\x01\x129\n" +
+	"\x16status_list_credential\x18\x06 \x01(\tH\x05R\x14statusListCredential\x88\x01\x01B\x05\n" +
 	"\x03_idB\a\n" +
 	"\x05_typeB\r\n" +
 	"\v_created_atB\n" +
 	"\n" +
-	"\b_purpose\"\xad\x01\n" +
+	"\b_purposeB\x14\n" +
+	"\x12_status_list_indexB\x19\n" +
+	"\x17_status_list_credential\"\xad\x01\n" +
 	"\x13EnvelopedCredential\x12_\n" +
 	"\renvelope_type\x18\x01 \x01(\x0e25.agntcy.identity.core.v1alpha1.CredentialEnvelopeTypeH\x00R\fenvelopeType\x88\x01\x01\x12\x19\n" +
 	"\x05value\x18\x02 \x01(\tH\x01R\x05value\x88\x01\x01B\x10\n" +
@@ -943,7 +1079,7 @@ const file_agntcy_identity_core_v1alpha1_vc_proto_rawDesc = "" +
 	"proofValue\x88\x01\x01B\a\n" +
 	"\x05_typeB\x10\n" +
 	"\x0e_proof_purposeB\x0e\n" +
-	"\f_proof_value\"\xd1\x04\n" +
+	"\f_proof_value\"\xba\x05\n" +
 	"\x14VerifiableCredential\x12\x18\n" +
 	"\acontext\x18\x01 \x03(\tR\acontext\x12\x12\n" +
 	"\x04type\x18\x02 \x03(\tR\x04type\x12\x1b\n" +
@@ -955,20 +1091,35 @@ const file_agntcy_identity_core_v1alpha1_vc_proto_rawDesc = "" +
 	"\x11credential_schema\x18\b \x03(\v2/.agntcy.identity.core.v1alpha1.CredentialSchemaR\x10credentialSchema\x12\\\n" +
 	"\x11credential_status\x18\t \x03(\v2/.agntcy.identity.core.v1alpha1.CredentialStatusR\x10credentialStatus\x12?\n" +
 	"\x05proof\x18\n" +
-	" \x01(\v2$.agntcy.identity.core.v1alpha1.ProofH\x05R\x05proof\x88\x01\x01B\t\n" +
+	" \x01(\v2$.agntcy.identity.core.v1alpha1.ProofH\x05R\x05proof\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"valid_from\x18\v \x01(\tH\x06R\tvalidFrom\x88\x01\x01\x12$\n" +
+	"\vvalid_until\x18\f \x01(\tH\aR\n" +
+	"validUntil\x88\x01\x01B\t\n" +
 	"\a_issuerB\n" +
 	"\n" +
 	"\b_contentB\x05\n" +
 	"\x03_idB\x10\n" +
 	"\x0e_issuance_dateB\x12\n" +
 	"\x10_expiration_dateB\b\n" +
-	"\x06_proof\"\xfb\x01\n" +
+	"\x06_proofB\r\n" +
+	"\v_valid_fromB\x0e\n" +
+	"\f_valid_until\"\xa3\x02\n" +
 	"\x16VerifiablePresentation\x12\x18\n" +
 	"\acontext\x18\x01 \x03(\tR\acontext\x12\x12\n" +
 	"\x04type\x18\x02 \x03(\tR\x04type\x12h\n" +
 	"\x15verifiable_credential\x18\x03 \x03(\v23.agntcy.identity.core.v1alpha1.VerifiableCredentialR\x14verifiableCredential\x12?\n" +
-	"\x05proof\x18\x04 \x01(\v2$.agntcy.identity.core.v1alpha1.ProofH\x00R\x05proof\x88\x01\x01B\b\n" +
-	"\x06_proof\"\xfc\x03\n" +
+	"\x05proof\x18\x04 \x01(\v2$.agntcy.identity.core.v1alpha1.ProofH\x00R\x05proof\x88\x01\x01\x12\x1b\n" +
+	"\x06holder\x18\x05 \x01(\tH\x01R\x06holder\x88\x01\x01B\b\n" +
+	"\x06_proofB\t\n" +
+	"\a_holder\"\xbc\x02\n" +
+	"\x1ePresentationVerificationResult\x12\x1b\n" +
+	"\x06status\x18\x01 \x01(\bH\x00R\x06status\x88\x01\x01\x12V\n" +
+	"\bdocument\x18\x02 \x01(\v25.agntcy.identity.core.v1alpha1.VerifiablePresentationH\x01R\bdocument\x88\x01\x01\x12K\n" +
+	"\aresults\x18\x03 \x03(\v21.agntcy.identity.core.v1alpha1.VerificationResultR\aresults\x12@\n" +
+	"\x06errors\x18\x04 \x03(\v2(.agntcy.identity.core.v1alpha1.ErrorInfoR\x06errorsB\t\n" +
+	"\a_statusB\v\n" +
+	"\t_document\"\xfc\x03\n" +
 	"\x12VerificationResult\x12\x1b\n" +
 	"\x06status\x18\x01 \x01(\bH\x00R\x06status\x88\x01\x01\x12T\n" +
 	"\bdocument\x18\x02 \x01(\v23.agntcy.identity.core.v1alpha1.VerifiableCredentialH\x01R\bdocument\x88\x01\x01\x12\"\n" +
@@ -989,14 +1140,17 @@ const file_agntcy_identity_core_v1alpha1_vc_proto_rawDesc = "" +
 	"\x15CredentialContentType\x12'\n" +
 	"#CREDENTIAL_CONTENT_TYPE_UNSPECIFIED\x10\x00\x12'\n" +
 	"#CREDENTIAL_CONTENT_TYPE_AGENT_BADGE\x10\x01\x12%\n" +
-	"!CREDENTIAL_CONTENT_TYPE_MCP_BADGE\x10\x02*\x92\x01\n" +
+	"!CREDENTIAL_CONTENT_TYPE_MCP_BADGE\x10\x02*\xda\x01\n" +
 	"\x16CredentialEnvelopeType\x12(\n" +
 	"$CREDENTIAL_ENVELOPE_TYPE_UNSPECIFIED\x10\x00\x12+\n" +
 	"'CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF\x10\x01\x12!\n" +
-	"\x1dCREDENTIAL_ENVELOPE_TYPE_JOSE\x10\x02*n\n" +
+	"\x1dCREDENTIAL_ENVELOPE_TYPE_JOSE\x10\x02\x12!\n" +
+	"\x1dCREDENTIAL_ENVELOPE_TYPE_COSE\x10\x03\x12#\n" +
+	"\x1fCREDENTIAL_ENVELOPE_TYPE_SD_JWT\x10\x04*\x98\x01\n" +
 	"\x17CredentialStatusPurpose\x12)\n" +
 	"%CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED\x10\x00\x12(\n" +
-	"$CREDENTIAL_STATUS_PURPOSE_REVOCATION\x10\x01BZZXgithub.com/agntcy/identity/api/server/agntcy/identity/core/v1alpha1;identity_core_sdk_gob\x06proto3"
+	"$CREDENTIAL_STATUS_PURPOSE_REVOCATION\x10\x01\x12(\n" +
+	"$CREDENTIAL_STATUS_PURPOSE_SUSPENSION\x10\x02BZZXgithub.com/agntcy/identity/api/server/agntcy/identity/core/v1alpha1;identity_core_sdk_gob\x06proto3"
 
 var (
 	file_agntcy_identity_core_v1alpha1_vc_proto_rawDescOnce sync.Once
@@ -1011,44 +1165,48 @@ func file_agntcy_identity_core_v1alpha1_vc_proto_rawDescGZIP() []byte {
 }
 
 var file_agntcy_identity_core_v1alpha1_vc_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_agntcy_identity_core_v1alpha1_vc_proto_goTypes = []any{
-	(CredentialContentType)(0),     // 0: agntcy.identity.core.v1alpha1.CredentialContentType
-	(CredentialEnvelopeType)(0),    // 1: agntcy.identity.core.v1alpha1.CredentialEnvelopeType
-	(CredentialStatusPurpose)(0),   // 2: agntcy.identity.core.v1alpha1.CredentialStatusPurpose
-	(*BadgeClaims)(nil),            // 3: agntcy.identity.core.v1alpha1.BadgeClaims
-	(*CredentialContent)(nil),      // 4: agntcy.identity.core.v1alpha1.CredentialContent
-	(*CredentialSchema)(nil),       // 5: agntcy.identity.core.v1alpha1.CredentialSchema
-	(*CredentialStatus)(nil),       // 6: agntcy.identity.core.v1alpha1.CredentialStatus
-	(*EnvelopedCredential)(nil),    // 7: agntcy.identity.core.v1alpha1.EnvelopedCredential
-	(*Proof)(nil),                  // 8: agntcy.identity.core.v1alpha1.Proof
-	(*VerifiableCredential)(nil),   // 9: agntcy.identity.core.v1alpha1.VerifiableCredential
-	(*VerifiablePresentation)(nil), // 10: agntcy.identity.core.v1alpha1.VerifiablePresentation
-	(*VerificationResult)(nil),     // 11: agntcy.identity.core.v1alpha1.VerificationResult
-	(*Time)(nil),                   // 12: agntcy.identity.core.v1alpha1.Time
-	(*structpb.Struct)(nil),        // 13: google.protobuf.Struct
-	(*ErrorInfo)(nil),              // 14: agntcy.identity.core.v1alpha1.ErrorInfo
+	(CredentialContentType)(0),             // 0: agntcy.identity.core.v1alpha1.CredentialContentType
+	(CredentialEnvelopeType)(0),            // 1: agntcy.identity.core.v1alpha1.CredentialEnvelopeType
+	(CredentialStatusPurpose)(0),           // 2: agntcy.identity.core.v1alpha1.CredentialStatusPurpose
+	(*BadgeClaims)(nil),                    // 3: agntcy.identity.core.v1alpha1.BadgeClaims
+	(*CredentialContent)(nil),              // 4: agntcy.identity.core.v1alpha1.CredentialContent
+	(*CredentialSchema)(nil),               // 5: agntcy.identity.core.v1alpha1.CredentialSchema
+	(*CredentialStatus)(nil),               // 6: agntcy.identity.core.v1alpha1.CredentialStatus
+	(*EnvelopedCredential)(nil),            // 7: agntcy.identity.core.v1alpha1.EnvelopedCredential
+	(*Proof)(nil),                          // 8: agntcy.identity.core.v1alpha1.Proof
+	(*VerifiableCredential)(nil),           // 9: agntcy.identity.core.v1alpha1.VerifiableCredential
+	(*VerifiablePresentation)(nil),         // 10: agntcy.identity.core.v1alpha1.VerifiablePresentation
+	(*PresentationVerificationResult)(nil), // 11: agntcy.identity.core.v1alpha1.PresentationVerificationResult
+	(*VerificationResult)(nil),             // 12: agntcy.identity.core.v1alpha1.VerificationResult
+	(*Time)(nil),                           // 13: agntcy.identity.core.v1alpha1.Time
+	(*structpb.Struct)(nil),                // 14: google.protobuf.Struct
+	(*ErrorInfo)(nil),                      // 15: agntcy.identity.core.v1alpha1.ErrorInfo
 }
 var file_agntcy_identity_core_v1alpha1_vc_proto_depIdxs = []int32{
 	0,  // 0: agntcy.identity.core.v1alpha1.CredentialContent.content_type:type_name -> agntcy.identity.core.v1alpha1.CredentialContentType
-	13, // 1: agntcy.identity.core.v1alpha1.CredentialContent.content:type_name -> google.protobuf.Struct
-	12, // 2: agntcy.identity.core.v1alpha1.CredentialStatus.created_at:type_name -> agntcy.identity.core.v1alpha1.Time
+	14, // 1: agntcy.identity.core.v1alpha1.CredentialContent.content:type_name -> google.protobuf.Struct
+	13, // 2: agntcy.identity.core.v1alpha1.CredentialStatus.created_at:type_name -> agntcy.identity.core.v1alpha1.Time
 	2,  // 3: agntcy.identity.core.v1alpha1.CredentialStatus.purpose:type_name -> agntcy.identity.core.v1alpha1.CredentialStatusPurpose
 	1,  // 4: agntcy.identity.core.v1alpha1.EnvelopedCredential.envelope_type:type_name -> agntcy.identity.core.v1alpha1.CredentialEnvelopeType
-	13, // 5: agntcy.identity.core.v1alpha1.VerifiableCredential.content:type_name -> google.protobuf.Struct
+	14, // 5: agntcy.identity.core.v1alpha1.VerifiableCredential.content:type_name -> google.protobuf.Struct
 	5,  // 6: agntcy.identity.core.v1alpha1.VerifiableCredential.credential_schema:type_name -> agntcy.identity.core.v1alpha1.CredentialSchema
 	6,  // 7: agntcy.identity.core.v1alpha1.VerifiableCredential.credential_status:type_name -> agntcy.identity.core.v1alpha1.CredentialStatus
 	8,  // 8: agntcy.identity.core.v1alpha1.VerifiableCredential.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
 	9,  // 9: agntcy.identity.core.v1alpha1.VerifiablePresentation.verifiable_credential:type_name -> agntcy.identity.core.v1alpha1.VerifiableCredential
 	8,  // 10: agntcy.identity.core.v1alpha1.VerifiablePresentation.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
-	9,  // 11: agntcy.identity.core.v1alpha1.VerificationResult.document:type_name -> agntcy.identity.core.v1alpha1.VerifiableCredential
-	14, // 12: agntcy.identity.core.v1alpha1.VerificationResult.warnings:type_name -> agntcy.identity.core.v1alpha1.ErrorInfo
-	14, // 13: agntcy.identity.core.v1alpha1.VerificationResult.errors:type_name -> agntcy.identity.core.v1alpha1.ErrorInfo
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	10, // 11: agntcy.identity.core.v1alpha1.PresentationVerificationResult.document:type_name -> agntcy.identity.core.v1alpha1.VerifiablePresentation
+	12, // 12: agntcy.identity.core.v1alpha1.PresentationVerificationResult.results:type_name -> agntcy.identity.core.v1alpha1.VerificationResult
+	15, // 13: agntcy.identity.core.v1alpha1.PresentationVerificationResult.errors:type_name -> agntcy.identity.core.v1alpha1.ErrorInfo
+	9,  // 14: agntcy.identity.core.v1alpha1.VerificationResult.document:type_name -> agntcy.identity.core.v1alpha1.VerifiableCredential
+	15, // 15: agntcy.identity.core.v1alpha1.VerificationResult.warnings:type_name -> agntcy.identity.core.v1alpha1.ErrorInfo
+	15, // 16: agntcy.identity.core.v1alpha1.VerificationResult.errors:type_name -> agntcy.identity.core.v1alpha1.ErrorInfo
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_agntcy_identity_core_v1alpha1_vc_proto_init() }
@@ -1066,13 +1224,14 @@ func file_agntcy_identity_core_v1alpha1_vc_proto_init() {
 	file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes[6].OneofWrappers = []any{}
 	file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes[7].OneofWrappers = []any{}
 	file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes[8].OneofWrappers = []any{}
+	file_agntcy_identity_core_v1alpha1_vc_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_identity_core_v1alpha1_vc_proto_rawDesc), len(file_agntcy_identity_core_v1alpha1_vc_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   10,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
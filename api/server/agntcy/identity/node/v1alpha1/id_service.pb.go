@@ -15,6 +15,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -224,11 +225,171 @@ func (x *ResolveResponse) GetResolverMetadata() *v1alpha1.ResolverMetadata {
 	return nil
 }
 
+// Update the ResolverMetadata of an Id
+// The Proof must be issued for the subject of the Id by its controller
+type UpdateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ResolverMetadata containing the new verification methods and services
+	ResolverMetadata *v1alpha1.ResolverMetadata `protobuf:"bytes,1,opt,name=resolver_metadata,json=resolverMetadata,proto3" json:"resolver_metadata,omitempty"`
+	// The Proof of ownership of the Id
+	// Example: a signed JWT
+	Proof         *v1alpha1.Proof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRequest) Reset() {
+	*x = UpdateRequest{}
+	mi := &file_agntcy_identity_node_v1alpha1_id_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequest) ProtoMessage() {}
+
+func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_id_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_id_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateRequest) GetResolverMetadata() *v1alpha1.ResolverMetadata {
+	if x != nil {
+		return x.ResolverMetadata
+	}
+	return nil
+}
+
+func (x *UpdateRequest) GetProof() *v1alpha1.Proof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+// Returns the updated ResolverMetadata
+type UpdateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated ResolverMetadata
+	ResolverMetadata *v1alpha1.ResolverMetadata `protobuf:"bytes,1,opt,name=resolver_metadata,json=resolverMetadata,proto3" json:"resolver_metadata,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateResponse) Reset() {
+	*x = UpdateResponse{}
+	mi := &file_agntcy_identity_node_v1alpha1_id_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResponse) ProtoMessage() {}
+
+func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_id_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_id_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateResponse) GetResolverMetadata() *v1alpha1.ResolverMetadata {
+	if x != nil {
+		return x.ResolverMetadata
+	}
+	return nil
+}
+
+// Permanently deactivate an Id
+// The Proof must be issued for the subject of the Id by its controller
+type DeactivateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Id is the identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The Proof of ownership of the Id
+	// Example: a signed JWT
+	Proof         *v1alpha1.Proof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateRequest) Reset() {
+	*x = DeactivateRequest{}
+	mi := &file_agntcy_identity_node_v1alpha1_id_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateRequest) ProtoMessage() {}
+
+func (x *DeactivateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_id_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_id_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeactivateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeactivateRequest) GetProof() *v1alpha1.Proof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
 var File_agntcy_identity_node_v1alpha1_id_service_proto protoreflect.FileDescriptor
 
 const file_agntcy_identity_node_v1alpha1_id_service_proto_rawDesc = "" +
 	"\n" +
-	".agntcy/identity/node/v1alpha1/id_service.proto\x12\x1dagntcy.identity.node.v1alpha1\x1a&agntcy/identity/core/v1alpha1/id.proto\x1a*agntcy/identity/core/v1alpha1/issuer.proto\x1a&agntcy/identity/core/v1alpha1/vc.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9b\x01\n" +
+	".agntcy/identity/node/v1alpha1/id_service.proto\x12\x1dagntcy.identity.node.v1alpha1\x1a&agntcy/identity/core/v1alpha1/id.proto\x1a*agntcy/identity/core/v1alpha1/issuer.proto\x1a&agntcy/identity/core/v1alpha1/vc.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9b\x01\n" +
 	"\x0fGenerateRequest\x12=\n" +
 	"\x06issuer\x18\x01 \x01(\v2%.agntcy.identity.core.v1alpha1.IssuerR\x06issuer\x12?\n" +
 	"\x05proof\x18\x02 \x01(\v2$.agntcy.identity.core.v1alpha1.ProofH\x00R\x05proof\x88\x01\x01B\b\n" +
@@ -238,11 +399,22 @@ const file_agntcy_identity_node_v1alpha1_id_service_proto_rawDesc = "" +
 	"\x0eResolveRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"o\n" +
 	"\x0fResolveResponse\x12\\\n" +
-	"\x11resolver_metadata\x18\x01 \x01(\v2/.agntcy.identity.core.v1alpha1.ResolverMetadataR\x10resolverMetadata2\xd7\x03\n" +
+	"\x11resolver_metadata\x18\x01 \x01(\v2/.agntcy.identity.core.v1alpha1.ResolverMetadataR\x10resolverMetadata\"\xa9\x01\n" +
+	"\rUpdateRequest\x12\\\n" +
+	"\x11resolver_metadata\x18\x01 \x01(\v2/.agntcy.identity.core.v1alpha1.ResolverMetadataR\x10resolverMetadata\x12:\n" +
+	"\x05proof\x18\x02 \x01(\v2$.agntcy.identity.core.v1alpha1.ProofR\x05proof\"n\n" +
+	"\x0eUpdateResponse\x12\\\n" +
+	"\x11resolver_metadata\x18\x01 \x01(\v2/.agntcy.identity.core.v1alpha1.ResolverMetadataR\x10resolverMetadata\"_\n" +
+	"\x11DeactivateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12:\n" +
+	"\x05proof\x18\x02 \x01(\v2$.agntcy.identity.core.v1alpha1.ProofR\x05proof2\xeb\x06\n" +
 	"\tIdService\x12\xea\x01\n" +
 	"\bGenerate\x12..agntcy.identity.node.v1alpha1.GenerateRequest\x1a/.agntcy.identity.node.v1alpha1.GenerateResponse\"}\x92AZ\x12LGenerate an Id and its corresponding ResolverMetadata for a specified Issuer*\n" +
 	"GenerateId\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1alpha1/id/generate\x12\xcc\x01\n" +
-	"\aResolve\x12-.agntcy.identity.node.v1alpha1.ResolveRequest\x1a..agntcy.identity.node.v1alpha1.ResolveResponse\"b\x92A@\x123Resolve an Id to its corresponding ResolverMetadata*\tResolveId\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1alpha1/id/resolve\x1a\x0e\x92A\v\n" +
+	"\aResolve\x12-.agntcy.identity.node.v1alpha1.ResolveRequest\x1a..agntcy.identity.node.v1alpha1.ResolveResponse\"b\x92A@\x123Resolve an Id to its corresponding ResolverMetadata*\tResolveId\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1alpha1/id/resolve\x12\xe5\x01\n" +
+	"\x06Update\x12,.agntcy.identity.node.v1alpha1.UpdateRequest\x1a-.agntcy.identity.node.v1alpha1.UpdateResponse\"~\x92A]\x12QUpdate the verification methods and the services of the ResolverMetadata of an Id*\bUpdateId\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1alpha1/id/update\x12\xa9\x01\n" +
+	"\n" +
+	"Deactivate\x120.agntcy.identity.node.v1alpha1.DeactivateRequest\x1a\x16.google.protobuf.Empty\"Q\x92A,\x12\x1cPermanently deactivate an Id*\fDeactivateId\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1alpha1/id/deactivate\x1a\x0e\x92A\v\n" +
 	"\tIdServiceBZZXgithub.com/agntcy/identity/api/server/agntcy/identity/node/v1alpha1;identity_node_sdk_gob\x06proto3"
 
 var (
@@ -257,30 +429,42 @@ func file_agntcy_identity_node_v1alpha1_id_service_proto_rawDescGZIP() []byte {
 	return file_agntcy_identity_node_v1alpha1_id_service_proto_rawDescData
 }
 
-var file_agntcy_identity_node_v1alpha1_id_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_agntcy_identity_node_v1alpha1_id_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_agntcy_identity_node_v1alpha1_id_service_proto_goTypes = []any{
 	(*GenerateRequest)(nil),           // 0: agntcy.identity.node.v1alpha1.GenerateRequest
 	(*GenerateResponse)(nil),          // 1: agntcy.identity.node.v1alpha1.GenerateResponse
 	(*ResolveRequest)(nil),            // 2: agntcy.identity.node.v1alpha1.ResolveRequest
 	(*ResolveResponse)(nil),           // 3: agntcy.identity.node.v1alpha1.ResolveResponse
-	(*v1alpha1.Issuer)(nil),           // 4: agntcy.identity.core.v1alpha1.Issuer
-	(*v1alpha1.Proof)(nil),            // 5: agntcy.identity.core.v1alpha1.Proof
-	(*v1alpha1.ResolverMetadata)(nil), // 6: agntcy.identity.core.v1alpha1.ResolverMetadata
+	(*UpdateRequest)(nil),             // 4: agntcy.identity.node.v1alpha1.UpdateRequest
+	(*UpdateResponse)(nil),            // 5: agntcy.identity.node.v1alpha1.UpdateResponse
+	(*DeactivateRequest)(nil),         // 6: agntcy.identity.node.v1alpha1.DeactivateRequest
+	(*v1alpha1.Issuer)(nil),           // 7: agntcy.identity.core.v1alpha1.Issuer
+	(*v1alpha1.Proof)(nil),            // 8: agntcy.identity.core.v1alpha1.Proof
+	(*v1alpha1.ResolverMetadata)(nil), // 9: agntcy.identity.core.v1alpha1.ResolverMetadata
+	(*emptypb.Empty)(nil),             // 10: google.protobuf.Empty
 }
 var file_agntcy_identity_node_v1alpha1_id_service_proto_depIdxs = []int32{
-	4, // 0: agntcy.identity.node.v1alpha1.GenerateRequest.issuer:type_name -> agntcy.identity.core.v1alpha1.Issuer
-	5, // 1: agntcy.identity.node.v1alpha1.GenerateRequest.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
-	6, // 2: agntcy.identity.node.v1alpha1.GenerateResponse.resolver_metadata:type_name -> agntcy.identity.core.v1alpha1.ResolverMetadata
-	6, // 3: agntcy.identity.node.v1alpha1.ResolveResponse.resolver_metadata:type_name -> agntcy.identity.core.v1alpha1.ResolverMetadata
-	0, // 4: agntcy.identity.node.v1alpha1.IdService.Generate:input_type -> agntcy.identity.node.v1alpha1.GenerateRequest
-	2, // 5: agntcy.identity.node.v1alpha1.IdService.Resolve:input_type -> agntcy.identity.node.v1alpha1.ResolveRequest
-	1, // 6: agntcy.identity.node.v1alpha1.IdService.Generate:output_type -> agntcy.identity.node.v1alpha1.GenerateResponse
-	3, // 7: agntcy.identity.node.v1alpha1.IdService.Resolve:output_type -> agntcy.identity.node.v1alpha1.ResolveResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	7,  // 0: agntcy.identity.node.v1alpha1.GenerateRequest.issuer:type_name -> agntcy.identity.core.v1alpha1.Issuer
+	8,  // 1: agntcy.identity.node.v1alpha1.GenerateRequest.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
+	9,  // 2: agntcy.identity.node.v1alpha1.GenerateResponse.resolver_metadata:type_name -> agntcy.identity.core.v1alpha1.ResolverMetadata
+	9,  // 3: agntcy.identity.node.v1alpha1.ResolveResponse.resolver_metadata:type_name -> agntcy.identity.core.v1alpha1.ResolverMetadata
+	9,  // 4: agntcy.identity.node.v1alpha1.UpdateRequest.resolver_metadata:type_name -> agntcy.identity.core.v1alpha1.ResolverMetadata
+	8,  // 5: agntcy.identity.node.v1alpha1.UpdateRequest.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
+	9,  // 6: agntcy.identity.node.v1alpha1.UpdateResponse.resolver_metadata:type_name -> agntcy.identity.core.v1alpha1.ResolverMetadata
+	8,  // 7: agntcy.identity.node.v1alpha1.DeactivateRequest.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
+	0,  // 8: agntcy.identity.node.v1alpha1.IdService.Generate:input_type -> agntcy.identity.node.v1alpha1.GenerateRequest
+	2,  // 9: agntcy.identity.node.v1alpha1.IdService.Resolve:input_type -> agntcy.identity.node.v1alpha1.ResolveRequest
+	4,  // 10: agntcy.identity.node.v1alpha1.IdService.Update:input_type -> agntcy.identity.node.v1alpha1.UpdateRequest
+	6,  // 11: agntcy.identity.node.v1alpha1.IdService.Deactivate:input_type -> agntcy.identity.node.v1alpha1.DeactivateRequest
+	1,  // 12: agntcy.identity.node.v1alpha1.IdService.Generate:output_type -> agntcy.identity.node.v1alpha1.GenerateResponse
+	3,  // 13: agntcy.identity.node.v1alpha1.IdService.Resolve:output_type -> agntcy.identity.node.v1alpha1.ResolveResponse
+	5,  // 14: agntcy.identity.node.v1alpha1.IdService.Update:output_type -> agntcy.identity.node.v1alpha1.UpdateResponse
+	10, // 15: agntcy.identity.node.v1alpha1.IdService.Deactivate:output_type -> google.protobuf.Empty
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_agntcy_identity_node_v1alpha1_id_service_proto_init() }
@@ -295,7 +479,7 @@ func file_agntcy_identity_node_v1alpha1_id_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_identity_node_v1alpha1_id_service_proto_rawDesc), len(file_agntcy_identity_node_v1alpha1_id_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
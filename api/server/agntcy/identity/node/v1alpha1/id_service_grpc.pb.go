@@ -14,6 +14,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -22,8 +23,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IdService_Generate_FullMethodName = "/agntcy.identity.node.v1alpha1.IdService/Generate"
-	IdService_Resolve_FullMethodName  = "/agntcy.identity.node.v1alpha1.IdService/Resolve"
+	IdService_Generate_FullMethodName   = "/agntcy.identity.node.v1alpha1.IdService/Generate"
+	IdService_Resolve_FullMethodName    = "/agntcy.identity.node.v1alpha1.IdService/Resolve"
+	IdService_Update_FullMethodName     = "/agntcy.identity.node.v1alpha1.IdService/Update"
+	IdService_Deactivate_FullMethodName = "/agntcy.identity.node.v1alpha1.IdService/Deactivate"
 )
 
 // IdServiceClient is the client API for IdService service.
@@ -36,6 +39,10 @@ type IdServiceClient interface {
 	Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GenerateResponse, error)
 	// Resolve a specified Id to its corresponding ResolverMetadata
 	Resolve(ctx context.Context, in *ResolveRequest, opts ...grpc.CallOption) (*ResolveResponse, error)
+	// Update the verification methods and the services of the ResolverMetadata of an Id
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	// Permanently deactivate an Id
+	Deactivate(ctx context.Context, in *DeactivateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type idServiceClient struct {
@@ -66,6 +73,26 @@ func (c *idServiceClient) Resolve(ctx context.Context, in *ResolveRequest, opts
 	return out, nil
 }
 
+func (c *idServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, IdService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idServiceClient) Deactivate(ctx context.Context, in *DeactivateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, IdService_Deactivate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IdServiceServer is the server API for IdService service.
 // All implementations should embed UnimplementedIdServiceServer
 // for forward compatibility.
@@ -76,6 +103,10 @@ type IdServiceServer interface {
 	Generate(context.Context, *GenerateRequest) (*GenerateResponse, error)
 	// Resolve a specified Id to its corresponding ResolverMetadata
 	Resolve(context.Context, *ResolveRequest) (*ResolveResponse, error)
+	// Update the verification methods and the services of the ResolverMetadata of an Id
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	// Permanently deactivate an Id
+	Deactivate(context.Context, *DeactivateRequest) (*emptypb.Empty, error)
 }
 
 // UnimplementedIdServiceServer should be embedded to have
@@ -91,6 +122,12 @@ func (UnimplementedIdServiceServer) Generate(context.Context, *GenerateRequest)
 func (UnimplementedIdServiceServer) Resolve(context.Context, *ResolveRequest) (*ResolveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Resolve not implemented")
 }
+func (UnimplementedIdServiceServer) Update(context.Context, *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedIdServiceServer) Deactivate(context.Context, *DeactivateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deactivate not implemented")
+}
 func (UnimplementedIdServiceServer) testEmbeddedByValue() {}
 
 // UnsafeIdServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -147,6 +184,42 @@ func _IdService_Resolve_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IdService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdServiceServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdService_Deactivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdServiceServer).Deactivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdService_Deactivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdServiceServer).Deactivate(ctx, req.(*DeactivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IdService_ServiceDesc is the grpc.ServiceDesc for IdService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -162,6 +235,14 @@ var IdService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Resolve",
 			Handler:    _IdService_Resolve_Handler,
 		},
+		{
+			MethodName: "Update",
+			Handler:    _IdService_Update_Handler,
+		},
+		{
+			MethodName: "Deactivate",
+			Handler:    _IdService_Deactivate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "agntcy/identity/node/v1alpha1/id_service.proto",
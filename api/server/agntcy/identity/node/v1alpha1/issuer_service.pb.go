@@ -213,6 +213,117 @@ func (x *GetIssuerWellKnownResponse) GetJwks() *v1alpha1.Jwks {
 	return nil
 }
 
+// Request to rotate the key of an issuer
+type RotateIssuerKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The common name of the issuer
+	CommonName string `protobuf:"bytes,1,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	// The new public key of the issuer, the key ID must be set
+	PublicKey *v1alpha1.Jwk `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// A self-issued JWT signed by the current key of the issuer
+	Proof *v1alpha1.Proof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// Retire the current key immediately instead of keeping it active
+	// during the retirement period, for instance when the key is compromised
+	RetireImmediately bool `protobuf:"varint,4,opt,name=retire_immediately,json=retireImmediately,proto3" json:"retire_immediately,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RotateIssuerKeyRequest) Reset() {
+	*x = RotateIssuerKeyRequest{}
+	mi := &file_agntcy_identity_node_v1alpha1_issuer_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateIssuerKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateIssuerKeyRequest) ProtoMessage() {}
+
+func (x *RotateIssuerKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_issuer_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateIssuerKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateIssuerKeyRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_issuer_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RotateIssuerKeyRequest) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *RotateIssuerKeyRequest) GetPublicKey() *v1alpha1.Jwk {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *RotateIssuerKeyRequest) GetProof() *v1alpha1.Proof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *RotateIssuerKeyRequest) GetRetireImmediately() bool {
+	if x != nil {
+		return x.RetireImmediately
+	}
+	return false
+}
+
+// Returns a key rotation response for the issuer
+type RotateIssuerKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateIssuerKeyResponse) Reset() {
+	*x = RotateIssuerKeyResponse{}
+	mi := &file_agntcy_identity_node_v1alpha1_issuer_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateIssuerKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateIssuerKeyResponse) ProtoMessage() {}
+
+func (x *RotateIssuerKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_issuer_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateIssuerKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateIssuerKeyResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_issuer_service_proto_rawDescGZIP(), []int{5}
+}
+
 var File_agntcy_identity_node_v1alpha1_issuer_service_proto protoreflect.FileDescriptor
 
 const file_agntcy_identity_node_v1alpha1_issuer_service_proto_rawDesc = "" +
@@ -227,10 +338,19 @@ const file_agntcy_identity_node_v1alpha1_issuer_service_proto_rawDesc = "" +
 	"\vcommon_name\x18\x01 \x01(\tR\n" +
 	"commonName\"U\n" +
 	"\x1aGetIssuerWellKnownResponse\x127\n" +
-	"\x04jwks\x18\x01 \x01(\v2#.agntcy.identity.core.v1alpha1.JwksR\x04jwks2\xb7\x04\n" +
+	"\x04jwks\x18\x01 \x01(\v2#.agntcy.identity.core.v1alpha1.JwksR\x04jwks\"\xe7\x01\n" +
+	"\x16RotateIssuerKeyRequest\x12\x1f\n" +
+	"\vcommon_name\x18\x01 \x01(\tR\n" +
+	"commonName\x12A\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\v2\".agntcy.identity.core.v1alpha1.JwkR\tpublicKey\x12:\n" +
+	"\x05proof\x18\x03 \x01(\v2$.agntcy.identity.core.v1alpha1.ProofR\x05proof\x12-\n" +
+	"\x12retire_immediately\x18\x04 \x01(\bR\x11retireImmediately\"\x19\n" +
+	"\x17RotateIssuerKeyResponse2\xe2\x06\n" +
 	"\rIssuerService\x12\xe4\x01\n" +
 	"\bRegister\x124.agntcy.identity.node.v1alpha1.RegisterIssuerRequest\x1a5.agntcy.identity.node.v1alpha1.RegisterIssuerResponse\"k\x92AD\x122Register an issuer by providing the issuer details*\x0eRegisterIssuer\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1alpha1/issuer/register\x12\xaa\x02\n" +
-	"\fGetWellKnown\x128.agntcy.identity.node.v1alpha1.GetIssuerWellKnownRequest\x1a9.agntcy.identity.node.v1alpha1.GetIssuerWellKnownResponse\"\xa4\x01\x92Ae\x12OReturns the well-known document for an issuer in Json Web Key Set (JWKS) format*\x12GetIssuerWellKnown\x82\xd3\xe4\x93\x026\x124/v1alpha1/issuer/{common_name}/.well-known/jwks.json\x1a\x12\x92A\x0f\n" +
+	"\fGetWellKnown\x128.agntcy.identity.node.v1alpha1.GetIssuerWellKnownRequest\x1a9.agntcy.identity.node.v1alpha1.GetIssuerWellKnownResponse\"\xa4\x01\x92Ae\x12OReturns the well-known document for an issuer in Json Web Key Set (JWKS) format*\x12GetIssuerWellKnown\x82\xd3\xe4\x93\x026\x124/v1alpha1/issuer/{common_name}/.well-known/jwks.json\x12\xa8\x02\n" +
+	"\tRotateKey\x125.agntcy.identity.node.v1alpha1.RotateIssuerKeyRequest\x1a6.agntcy.identity.node.v1alpha1.RotateIssuerKeyResponse\"\xab\x01\x92At\x12aRotate the key of an issuer by providing a proof signed by the current key and the new public key*\x0fRotateIssuerKey\x82\xd3\xe4\x93\x02.:\x01*\")/v1alpha1/issuer/{common_name}/rotate-key\x1a\x12\x92A\x0f\n" +
 	"\rIssuerServiceBZZXgithub.com/agntcy/identity/api/server/agntcy/identity/node/v1alpha1;identity_node_sdk_gob\x06proto3"
 
 var (
@@ -245,29 +365,36 @@ func file_agntcy_identity_node_v1alpha1_issuer_service_proto_rawDescGZIP() []byt
 	return file_agntcy_identity_node_v1alpha1_issuer_service_proto_rawDescData
 }
 
-var file_agntcy_identity_node_v1alpha1_issuer_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_agntcy_identity_node_v1alpha1_issuer_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_agntcy_identity_node_v1alpha1_issuer_service_proto_goTypes = []any{
 	(*RegisterIssuerRequest)(nil),      // 0: agntcy.identity.node.v1alpha1.RegisterIssuerRequest
 	(*RegisterIssuerResponse)(nil),     // 1: agntcy.identity.node.v1alpha1.RegisterIssuerResponse
 	(*GetIssuerWellKnownRequest)(nil),  // 2: agntcy.identity.node.v1alpha1.GetIssuerWellKnownRequest
 	(*GetIssuerWellKnownResponse)(nil), // 3: agntcy.identity.node.v1alpha1.GetIssuerWellKnownResponse
-	(*v1alpha1.Issuer)(nil),            // 4: agntcy.identity.core.v1alpha1.Issuer
-	(*v1alpha1.Proof)(nil),             // 5: agntcy.identity.core.v1alpha1.Proof
-	(*v1alpha1.Jwks)(nil),              // 6: agntcy.identity.core.v1alpha1.Jwks
+	(*RotateIssuerKeyRequest)(nil),     // 4: agntcy.identity.node.v1alpha1.RotateIssuerKeyRequest
+	(*RotateIssuerKeyResponse)(nil),    // 5: agntcy.identity.node.v1alpha1.RotateIssuerKeyResponse
+	(*v1alpha1.Issuer)(nil),            // 6: agntcy.identity.core.v1alpha1.Issuer
+	(*v1alpha1.Proof)(nil),             // 7: agntcy.identity.core.v1alpha1.Proof
+	(*v1alpha1.Jwks)(nil),              // 8: agntcy.identity.core.v1alpha1.Jwks
+	(*v1alpha1.Jwk)(nil),               // 9: agntcy.identity.core.v1alpha1.Jwk
 }
 var file_agntcy_identity_node_v1alpha1_issuer_service_proto_depIdxs = []int32{
-	4, // 0: agntcy.identity.node.v1alpha1.RegisterIssuerRequest.issuer:type_name -> agntcy.identity.core.v1alpha1.Issuer
-	5, // 1: agntcy.identity.node.v1alpha1.RegisterIssuerRequest.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
-	6, // 2: agntcy.identity.node.v1alpha1.GetIssuerWellKnownResponse.jwks:type_name -> agntcy.identity.core.v1alpha1.Jwks
-	0, // 3: agntcy.identity.node.v1alpha1.IssuerService.Register:input_type -> agntcy.identity.node.v1alpha1.RegisterIssuerRequest
-	2, // 4: agntcy.identity.node.v1alpha1.IssuerService.GetWellKnown:input_type -> agntcy.identity.node.v1alpha1.GetIssuerWellKnownRequest
-	1, // 5: agntcy.identity.node.v1alpha1.IssuerService.Register:output_type -> agntcy.identity.node.v1alpha1.RegisterIssuerResponse
-	3, // 6: agntcy.identity.node.v1alpha1.IssuerService.GetWellKnown:output_type -> agntcy.identity.node.v1alpha1.GetIssuerWellKnownResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	6, // 0: agntcy.identity.node.v1alpha1.RegisterIssuerRequest.issuer:type_name -> agntcy.identity.core.v1alpha1.Issuer
+	7, // 1: agntcy.identity.node.v1alpha1.RegisterIssuerRequest.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
+	8, // 2: agntcy.identity.node.v1alpha1.GetIssuerWellKnownResponse.jwks:type_name -> agntcy.identity.core.v1alpha1.Jwks
+	9, // 3: agntcy.identity.node.v1alpha1.RotateIssuerKeyRequest.public_key:type_name -> agntcy.identity.core.v1alpha1.Jwk
+	7, // 4: agntcy.identity.node.v1alpha1.RotateIssuerKeyRequest.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
+	0, // 5: agntcy.identity.node.v1alpha1.IssuerService.Register:input_type -> agntcy.identity.node.v1alpha1.RegisterIssuerRequest
+	2, // 6: agntcy.identity.node.v1alpha1.IssuerService.GetWellKnown:input_type -> agntcy.identity.node.v1alpha1.GetIssuerWellKnownRequest
+	4, // 7: agntcy.identity.node.v1alpha1.IssuerService.RotateKey:input_type -> agntcy.identity.node.v1alpha1.RotateIssuerKeyRequest
+	1, // 8: agntcy.identity.node.v1alpha1.IssuerService.Register:output_type -> agntcy.identity.node.v1alpha1.RegisterIssuerResponse
+	3, // 9: agntcy.identity.node.v1alpha1.IssuerService.GetWellKnown:output_type -> agntcy.identity.node.v1alpha1.GetIssuerWellKnownResponse
+	5, // 10: agntcy.identity.node.v1alpha1.IssuerService.RotateKey:output_type -> agntcy.identity.node.v1alpha1.RotateIssuerKeyResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_agntcy_identity_node_v1alpha1_issuer_service_proto_init() }
@@ -282,7 +409,7 @@ func file_agntcy_identity_node_v1alpha1_issuer_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_identity_node_v1alpha1_issuer_service_proto_rawDesc), len(file_agntcy_identity_node_v1alpha1_issuer_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	IssuerService_Register_FullMethodName     = "/agntcy.identity.node.v1alpha1.IssuerService/Register"
 	IssuerService_GetWellKnown_FullMethodName = "/agntcy.identity.node.v1alpha1.IssuerService/GetWellKnown"
+	IssuerService_RotateKey_FullMethodName    = "/agntcy.identity.node.v1alpha1.IssuerService/RotateKey"
 )
 
 // IssuerServiceClient is the client API for IssuerService service.
@@ -37,6 +38,9 @@ type IssuerServiceClient interface {
 	// Returns the well-known document content for an issuer in
 	// Json Web Key Set (JWKS) format
 	GetWellKnown(ctx context.Context, in *GetIssuerWellKnownRequest, opts ...grpc.CallOption) (*GetIssuerWellKnownResponse, error)
+	// Rotate the key of an issuer by providing a proof signed by the current key
+	// and the new public key
+	RotateKey(ctx context.Context, in *RotateIssuerKeyRequest, opts ...grpc.CallOption) (*RotateIssuerKeyResponse, error)
 }
 
 type issuerServiceClient struct {
@@ -67,6 +71,16 @@ func (c *issuerServiceClient) GetWellKnown(ctx context.Context, in *GetIssuerWel
 	return out, nil
 }
 
+func (c *issuerServiceClient) RotateKey(ctx context.Context, in *RotateIssuerKeyRequest, opts ...grpc.CallOption) (*RotateIssuerKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateIssuerKeyResponse)
+	err := c.cc.Invoke(ctx, IssuerService_RotateKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IssuerServiceServer is the server API for IssuerService service.
 // All implementations should embed UnimplementedIssuerServiceServer
 // for forward compatibility.
@@ -78,6 +92,9 @@ type IssuerServiceServer interface {
 	// Returns the well-known document content for an issuer in
 	// Json Web Key Set (JWKS) format
 	GetWellKnown(context.Context, *GetIssuerWellKnownRequest) (*GetIssuerWellKnownResponse, error)
+	// Rotate the key of an issuer by providing a proof signed by the current key
+	// and the new public key
+	RotateKey(context.Context, *RotateIssuerKeyRequest) (*RotateIssuerKeyResponse, error)
 }
 
 // UnimplementedIssuerServiceServer should be embedded to have
@@ -93,6 +110,9 @@ func (UnimplementedIssuerServiceServer) Register(context.Context, *RegisterIssue
 func (UnimplementedIssuerServiceServer) GetWellKnown(context.Context, *GetIssuerWellKnownRequest) (*GetIssuerWellKnownResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWellKnown not implemented")
 }
+func (UnimplementedIssuerServiceServer) RotateKey(context.Context, *RotateIssuerKeyRequest) (*RotateIssuerKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateKey not implemented")
+}
 func (UnimplementedIssuerServiceServer) testEmbeddedByValue() {}
 
 // UnsafeIssuerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -149,6 +169,24 @@ func _IssuerService_GetWellKnown_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssuerService_RotateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateIssuerKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuerServiceServer).RotateKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssuerService_RotateKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuerServiceServer).RotateKey(ctx, req.(*RotateIssuerKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IssuerService_ServiceDesc is the grpc.ServiceDesc for IssuerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -164,6 +202,10 @@ var IssuerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWellKnown",
 			Handler:    _IssuerService_GetWellKnown_Handler,
 		},
+		{
+			MethodName: "RotateKey",
+			Handler:    _IssuerService_RotateKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "agntcy/identity/node/v1alpha1/issuer_service.proto",
@@ -0,0 +1,712 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: agntcy/identity/node/v1alpha1/log_service.proto
+
+package identity_node_sdk_go
+
+import (
+	v1alpha1 "github.com/agntcy/identity/api/server/agntcy/identity/core/v1alpha1"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A tree head of the transparency log signed by the node
+type SignedTreeHead struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of leaves of the tree
+	TreeSize uint64 `protobuf:"varint,1,opt,name=tree_size,json=treeSize,proto3" json:"tree_size,omitempty"`
+	// The Merkle Tree Hash of the tree as defined in RFC 6962
+	RootHash []byte `protobuf:"bytes,2,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	// The time when the tree head has been signed in milliseconds since the epoch
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The tree head signed as a JWS in the compact serialization
+	Signature     string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignedTreeHead) Reset() {
+	*x = SignedTreeHead{}
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignedTreeHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedTreeHead) ProtoMessage() {}
+
+func (x *SignedTreeHead) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedTreeHead.ProtoReflect.Descriptor instead.
+func (*SignedTreeHead) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SignedTreeHead) GetTreeSize() uint64 {
+	if x != nil {
+		return x.TreeSize
+	}
+	return 0
+}
+
+func (x *SignedTreeHead) GetRootHash() []byte {
+	if x != nil {
+		return x.RootHash
+	}
+	return nil
+}
+
+func (x *SignedTreeHead) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *SignedTreeHead) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// An entry of the transparency log
+type LogLeaf struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The position of the leaf in the log, starting at 0
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// The mutation recorded by the leaf.
+	// Example: "vc.publish"
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// The Issuer common name, the ID or the Verifiable Credential ID
+	// affected by the mutation
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// The hex encoded SHA-256 digest of the content of the mutation
+	Digest string `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+	// The hash of the leaf
+	Hash          []byte `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogLeaf) Reset() {
+	*x = LogLeaf{}
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogLeaf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLeaf) ProtoMessage() {}
+
+func (x *LogLeaf) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLeaf.ProtoReflect.Descriptor instead.
+func (*LogLeaf) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LogLeaf) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *LogLeaf) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LogLeaf) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *LogLeaf) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *LogLeaf) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+// Request to get the current signed tree head
+type GetSignedTreeHeadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSignedTreeHeadRequest) Reset() {
+	*x = GetSignedTreeHeadRequest{}
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignedTreeHeadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignedTreeHeadRequest) ProtoMessage() {}
+
+func (x *GetSignedTreeHeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignedTreeHeadRequest.ProtoReflect.Descriptor instead.
+func (*GetSignedTreeHeadRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescGZIP(), []int{2}
+}
+
+// Returns the current signed tree head
+type GetSignedTreeHeadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The signed tree head
+	SignedTreeHead *SignedTreeHead `protobuf:"bytes,1,opt,name=signed_tree_head,json=signedTreeHead,proto3" json:"signed_tree_head,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSignedTreeHeadResponse) Reset() {
+	*x = GetSignedTreeHeadResponse{}
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignedTreeHeadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignedTreeHeadResponse) ProtoMessage() {}
+
+func (x *GetSignedTreeHeadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignedTreeHeadResponse.ProtoReflect.Descriptor instead.
+func (*GetSignedTreeHeadResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSignedTreeHeadResponse) GetSignedTreeHead() *SignedTreeHead {
+	if x != nil {
+		return x.SignedTreeHead
+	}
+	return nil
+}
+
+// Request to get the inclusion proof of a leaf
+type GetInclusionProofRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The hash of the leaf
+	LeafHash []byte `protobuf:"bytes,1,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	// The size of the tree to prove the inclusion in,
+	// the current tree is used when omitted
+	TreeSize      uint64 `protobuf:"varint,2,opt,name=tree_size,json=treeSize,proto3" json:"tree_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInclusionProofRequest) Reset() {
+	*x = GetInclusionProofRequest{}
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInclusionProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInclusionProofRequest) ProtoMessage() {}
+
+func (x *GetInclusionProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInclusionProofRequest.ProtoReflect.Descriptor instead.
+func (*GetInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetInclusionProofRequest) GetLeafHash() []byte {
+	if x != nil {
+		return x.LeafHash
+	}
+	return nil
+}
+
+func (x *GetInclusionProofRequest) GetTreeSize() uint64 {
+	if x != nil {
+		return x.TreeSize
+	}
+	return 0
+}
+
+// Returns the inclusion proof of a leaf
+type GetInclusionProofResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The leaf
+	Leaf *LogLeaf `protobuf:"bytes,1,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	// The audit path from the leaf to the root of the tree
+	AuditPath [][]byte `protobuf:"bytes,2,rep,name=audit_path,json=auditPath,proto3" json:"audit_path,omitempty"`
+	// The signed head of the tree including the leaf
+	SignedTreeHead *SignedTreeHead `protobuf:"bytes,3,opt,name=signed_tree_head,json=signedTreeHead,proto3" json:"signed_tree_head,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetInclusionProofResponse) Reset() {
+	*x = GetInclusionProofResponse{}
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInclusionProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInclusionProofResponse) ProtoMessage() {}
+
+func (x *GetInclusionProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInclusionProofResponse.ProtoReflect.Descriptor instead.
+func (*GetInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetInclusionProofResponse) GetLeaf() *LogLeaf {
+	if x != nil {
+		return x.Leaf
+	}
+	return nil
+}
+
+func (x *GetInclusionProofResponse) GetAuditPath() [][]byte {
+	if x != nil {
+		return x.AuditPath
+	}
+	return nil
+}
+
+func (x *GetInclusionProofResponse) GetSignedTreeHead() *SignedTreeHead {
+	if x != nil {
+		return x.SignedTreeHead
+	}
+	return nil
+}
+
+// Request to get the consistency proof between two trees
+type GetConsistencyProofRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The size of the first tree
+	FirstSize uint64 `protobuf:"varint,1,opt,name=first_size,json=firstSize,proto3" json:"first_size,omitempty"`
+	// The size of the second tree,
+	// the current tree is used when omitted
+	SecondSize    uint64 `protobuf:"varint,2,opt,name=second_size,json=secondSize,proto3" json:"second_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsistencyProofRequest) Reset() {
+	*x = GetConsistencyProofRequest{}
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsistencyProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsistencyProofRequest) ProtoMessage() {}
+
+func (x *GetConsistencyProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsistencyProofRequest.ProtoReflect.Descriptor instead.
+func (*GetConsistencyProofRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetConsistencyProofRequest) GetFirstSize() uint64 {
+	if x != nil {
+		return x.FirstSize
+	}
+	return 0
+}
+
+func (x *GetConsistencyProofRequest) GetSecondSize() uint64 {
+	if x != nil {
+		return x.SecondSize
+	}
+	return 0
+}
+
+// Returns the consistency proof between two trees
+type GetConsistencyProofResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The size of the first tree
+	FirstSize uint64 `protobuf:"varint,1,opt,name=first_size,json=firstSize,proto3" json:"first_size,omitempty"`
+	// The size of the second tree
+	SecondSize uint64 `protobuf:"varint,2,opt,name=second_size,json=secondSize,proto3" json:"second_size,omitempty"`
+	// The consistency proof
+	Path          [][]byte `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsistencyProofResponse) Reset() {
+	*x = GetConsistencyProofResponse{}
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsistencyProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsistencyProofResponse) ProtoMessage() {}
+
+func (x *GetConsistencyProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsistencyProofResponse.ProtoReflect.Descriptor instead.
+func (*GetConsistencyProofResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetConsistencyProofResponse) GetFirstSize() uint64 {
+	if x != nil {
+		return x.FirstSize
+	}
+	return 0
+}
+
+func (x *GetConsistencyProofResponse) GetSecondSize() uint64 {
+	if x != nil {
+		return x.SecondSize
+	}
+	return 0
+}
+
+func (x *GetConsistencyProofResponse) GetPath() [][]byte {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+// Request to get the keys signing the tree heads
+type GetLogJwksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLogJwksRequest) Reset() {
+	*x = GetLogJwksRequest{}
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLogJwksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogJwksRequest) ProtoMessage() {}
+
+func (x *GetLogJwksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogJwksRequest.ProtoReflect.Descriptor instead.
+func (*GetLogJwksRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescGZIP(), []int{8}
+}
+
+// Returns the keys signing the tree heads
+type GetLogJwksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The Json Web Key Set (JWKS) of the node
+	Jwks          *v1alpha1.Jwks `protobuf:"bytes,1,opt,name=jwks,proto3" json:"jwks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLogJwksResponse) Reset() {
+	*x = GetLogJwksResponse{}
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLogJwksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogJwksResponse) ProtoMessage() {}
+
+func (x *GetLogJwksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogJwksResponse.ProtoReflect.Descriptor instead.
+func (*GetLogJwksResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetLogJwksResponse) GetJwks() *v1alpha1.Jwks {
+	if x != nil {
+		return x.Jwks
+	}
+	return nil
+}
+
+var File_agntcy_identity_node_v1alpha1_log_service_proto protoreflect.FileDescriptor
+
+const file_agntcy_identity_node_v1alpha1_log_service_proto_rawDesc = "" +
+	"\n" +
+	"/agntcy/identity/node/v1alpha1/log_service.proto\x12\x1dagntcy.identity.node.v1alpha1\x1a'agntcy/identity/core/v1alpha1/jwk.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x86\x01\n" +
+	"\x0eSignedTreeHead\x12\x1b\n" +
+	"\ttree_size\x18\x01 \x01(\x04R\btreeSize\x12\x1b\n" +
+	"\troot_hash\x18\x02 \x01(\fR\brootHash\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\tR\tsignature\"y\n" +
+	"\aLogLeaf\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x04R\x05index\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x16\n" +
+	"\x06digest\x18\x04 \x01(\tR\x06digest\x12\x12\n" +
+	"\x04hash\x18\x05 \x01(\fR\x04hash\"\x1a\n" +
+	"\x18GetSignedTreeHeadRequest\"t\n" +
+	"\x19GetSignedTreeHeadResponse\x12W\n" +
+	"\x10signed_tree_head\x18\x01 \x01(\v2-.agntcy.identity.node.v1alpha1.SignedTreeHeadR\x0esignedTreeHead\"T\n" +
+	"\x18GetInclusionProofRequest\x12\x1b\n" +
+	"\tleaf_hash\x18\x01 \x01(\fR\bleafHash\x12\x1b\n" +
+	"\ttree_size\x18\x02 \x01(\x04R\btreeSize\"\xcf\x01\n" +
+	"\x19GetInclusionProofResponse\x12:\n" +
+	"\x04leaf\x18\x01 \x01(\v2&.agntcy.identity.node.v1alpha1.LogLeafR\x04leaf\x12\x1d\n" +
+	"\n" +
+	"audit_path\x18\x02 \x03(\fR\tauditPath\x12W\n" +
+	"\x10signed_tree_head\x18\x03 \x01(\v2-.agntcy.identity.node.v1alpha1.SignedTreeHeadR\x0esignedTreeHead\"\\\n" +
+	"\x1aGetConsistencyProofRequest\x12\x1d\n" +
+	"\n" +
+	"first_size\x18\x01 \x01(\x04R\tfirstSize\x12\x1f\n" +
+	"\vsecond_size\x18\x02 \x01(\x04R\n" +
+	"secondSize\"q\n" +
+	"\x1bGetConsistencyProofResponse\x12\x1d\n" +
+	"\n" +
+	"first_size\x18\x01 \x01(\x04R\tfirstSize\x12\x1f\n" +
+	"\vsecond_size\x18\x02 \x01(\x04R\n" +
+	"secondSize\x12\x12\n" +
+	"\x04path\x18\x03 \x03(\fR\x04path\"\x13\n" +
+	"\x11GetLogJwksRequest\"M\n" +
+	"\x12GetLogJwksResponse\x127\n" +
+	"\x04jwks\x18\x01 \x01(\v2#.agntcy.identity.core.v1alpha1.JwksR\x04jwks2\xe0\b\n" +
+	"\n" +
+	"LogService\x12\x81\x02\n" +
+	"\x11GetSignedTreeHead\x127.agntcy.identity.node.v1alpha1.GetSignedTreeHeadRequest\x1a8.agntcy.identity.node.v1alpha1.GetSignedTreeHeadResponse\"y\x92A]\x12HReturns the current tree head of the transparency log signed by the node*\x11GetSignedTreeHead\x82\xd3\xe4\x93\x02\x13\x12\x11/v1alpha1/log/sth\x12\x86\x02\n" +
+	"\x11GetInclusionProof\x127.agntcy.identity.node.v1alpha1.GetInclusionProofRequest\x1a8.agntcy.identity.node.v1alpha1.GetInclusionProofResponse\"~\x92AV\x12AReturns the proof that a leaf is included in the transparency log*\x11GetInclusionProof\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1alpha1/log/proof/inclusion\x12\xa2\x02\n" +
+	"\x13GetConsistencyProof\x129.agntcy.identity.node.v1alpha1.GetConsistencyProofRequest\x1a:.agntcy.identity.node.v1alpha1.GetConsistencyProofResponse\"\x93\x01\x92Ai\x12RReturns the proof that a tree of the transparency log is a prefix of a larger tree*\x13GetConsistencyProof\x82\xd3\xe4\x93\x02!\x12\x1f/v1alpha1/log/proof/consistency\x12\x8e\x02\n" +
+	"\aGetJwks\x120.agntcy.identity.node.v1alpha1.GetLogJwksRequest\x1a1.agntcy.identity.node.v1alpha1.GetLogJwksResponse\"\x9d\x01\x92Ao\x12aReturns the public keys used by the node to sign the tree heads in Json Web Key Set (JWKS) format*\n" +
+	"GetLogJwks\x82\xd3\xe4\x93\x02%\x12#/v1alpha1/log/.well-known/jwks.json\x1a\x0f\x92A\f\n" +
+	"\n" +
+	"LogServiceBZZXgithub.com/agntcy/identity/api/server/agntcy/identity/node/v1alpha1;identity_node_sdk_gob\x06proto3"
+
+var (
+	file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescOnce sync.Once
+	file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescData []byte
+)
+
+func file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescGZIP() []byte {
+	file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescOnce.Do(func() {
+		file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agntcy_identity_node_v1alpha1_log_service_proto_rawDesc), len(file_agntcy_identity_node_v1alpha1_log_service_proto_rawDesc)))
+	})
+	return file_agntcy_identity_node_v1alpha1_log_service_proto_rawDescData
+}
+
+var file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_agntcy_identity_node_v1alpha1_log_service_proto_goTypes = []any{
+	(*SignedTreeHead)(nil),              // 0: agntcy.identity.node.v1alpha1.SignedTreeHead
+	(*LogLeaf)(nil),                     // 1: agntcy.identity.node.v1alpha1.LogLeaf
+	(*GetSignedTreeHeadRequest)(nil),    // 2: agntcy.identity.node.v1alpha1.GetSignedTreeHeadRequest
+	(*GetSignedTreeHeadResponse)(nil),   // 3: agntcy.identity.node.v1alpha1.GetSignedTreeHeadResponse
+	(*GetInclusionProofRequest)(nil),    // 4: agntcy.identity.node.v1alpha1.GetInclusionProofRequest
+	(*GetInclusionProofResponse)(nil),   // 5: agntcy.identity.node.v1alpha1.GetInclusionProofResponse
+	(*GetConsistencyProofRequest)(nil),  // 6: agntcy.identity.node.v1alpha1.GetConsistencyProofRequest
+	(*GetConsistencyProofResponse)(nil), // 7: agntcy.identity.node.v1alpha1.GetConsistencyProofResponse
+	(*GetLogJwksRequest)(nil),           // 8: agntcy.identity.node.v1alpha1.GetLogJwksRequest
+	(*GetLogJwksResponse)(nil),          // 9: agntcy.identity.node.v1alpha1.GetLogJwksResponse
+	(*v1alpha1.Jwks)(nil),               // 10: agntcy.identity.core.v1alpha1.Jwks
+}
+var file_agntcy_identity_node_v1alpha1_log_service_proto_depIdxs = []int32{
+	0,  // 0: agntcy.identity.node.v1alpha1.GetSignedTreeHeadResponse.signed_tree_head:type_name -> agntcy.identity.node.v1alpha1.SignedTreeHead
+	1,  // 1: agntcy.identity.node.v1alpha1.GetInclusionProofResponse.leaf:type_name -> agntcy.identity.node.v1alpha1.LogLeaf
+	0,  // 2: agntcy.identity.node.v1alpha1.GetInclusionProofResponse.signed_tree_head:type_name -> agntcy.identity.node.v1alpha1.SignedTreeHead
+	10, // 3: agntcy.identity.node.v1alpha1.GetLogJwksResponse.jwks:type_name -> agntcy.identity.core.v1alpha1.Jwks
+	2,  // 4: agntcy.identity.node.v1alpha1.LogService.GetSignedTreeHead:input_type -> agntcy.identity.node.v1alpha1.GetSignedTreeHeadRequest
+	4,  // 5: agntcy.identity.node.v1alpha1.LogService.GetInclusionProof:input_type -> agntcy.identity.node.v1alpha1.GetInclusionProofRequest
+	6,  // 6: agntcy.identity.node.v1alpha1.LogService.GetConsistencyProof:input_type -> agntcy.identity.node.v1alpha1.GetConsistencyProofRequest
+	8,  // 7: agntcy.identity.node.v1alpha1.LogService.GetJwks:input_type -> agntcy.identity.node.v1alpha1.GetLogJwksRequest
+	3,  // 8: agntcy.identity.node.v1alpha1.LogService.GetSignedTreeHead:output_type -> agntcy.identity.node.v1alpha1.GetSignedTreeHeadResponse
+	5,  // 9: agntcy.identity.node.v1alpha1.LogService.GetInclusionProof:output_type -> agntcy.identity.node.v1alpha1.GetInclusionProofResponse
+	7,  // 10: agntcy.identity.node.v1alpha1.LogService.GetConsistencyProof:output_type -> agntcy.identity.node.v1alpha1.GetConsistencyProofResponse
+	9,  // 11: agntcy.identity.node.v1alpha1.LogService.GetJwks:output_type -> agntcy.identity.node.v1alpha1.GetLogJwksResponse
+	8,  // [8:12] is the sub-list for method output_type
+	4,  // [4:8] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_agntcy_identity_node_v1alpha1_log_service_proto_init() }
+func file_agntcy_identity_node_v1alpha1_log_service_proto_init() {
+	if File_agntcy_identity_node_v1alpha1_log_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_identity_node_v1alpha1_log_service_proto_rawDesc), len(file_agntcy_identity_node_v1alpha1_log_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_agntcy_identity_node_v1alpha1_log_service_proto_goTypes,
+		DependencyIndexes: file_agntcy_identity_node_v1alpha1_log_service_proto_depIdxs,
+		MessageInfos:      file_agntcy_identity_node_v1alpha1_log_service_proto_msgTypes,
+	}.Build()
+	File_agntcy_identity_node_v1alpha1_log_service_proto = out.File
+	file_agntcy_identity_node_v1alpha1_log_service_proto_goTypes = nil
+	file_agntcy_identity_node_v1alpha1_log_service_proto_depIdxs = nil
+}
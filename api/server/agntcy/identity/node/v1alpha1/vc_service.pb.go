@@ -28,6 +28,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The change of a Verifiable Credential
+type VcEventType int32
+
+const (
+	// Unspecified event type.
+	VcEventType_VC_EVENT_TYPE_UNSPECIFIED VcEventType = 0
+	// The Verifiable Credential has been published
+	VcEventType_VC_EVENT_TYPE_PUBLISHED VcEventType = 1
+	// The Verifiable Credential has been revoked
+	VcEventType_VC_EVENT_TYPE_REVOKED VcEventType = 2
+	// The Verifiable Credential has been suspended
+	VcEventType_VC_EVENT_TYPE_SUSPENDED VcEventType = 3
+	// The Verifiable Credential has been reinstated
+	VcEventType_VC_EVENT_TYPE_REINSTATED VcEventType = 4
+)
+
+// Enum value maps for VcEventType.
+var (
+	VcEventType_name = map[int32]string{
+		0: "VC_EVENT_TYPE_UNSPECIFIED",
+		1: "VC_EVENT_TYPE_PUBLISHED",
+		2: "VC_EVENT_TYPE_REVOKED",
+		3: "VC_EVENT_TYPE_SUSPENDED",
+		4: "VC_EVENT_TYPE_REINSTATED",
+	}
+	VcEventType_value = map[string]int32{
+		"VC_EVENT_TYPE_UNSPECIFIED": 0,
+		"VC_EVENT_TYPE_PUBLISHED":   1,
+		"VC_EVENT_TYPE_REVOKED":     2,
+		"VC_EVENT_TYPE_SUSPENDED":   3,
+		"VC_EVENT_TYPE_REINSTATED":  4,
+	}
+)
+
+func (x VcEventType) Enum() *VcEventType {
+	p := new(VcEventType)
+	*p = x
+	return p
+}
+
+func (x VcEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VcEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_enumTypes[0].Descriptor()
+}
+
+func (VcEventType) Type() protoreflect.EnumType {
+	return &file_agntcy_identity_node_v1alpha1_vc_service_proto_enumTypes[0]
+}
+
+func (x VcEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VcEventType.Descriptor instead.
+func (VcEventType) EnumDescriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{0}
+}
+
 // Request to publish an issued Verifiable Credential
 type PublishRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -89,7 +150,12 @@ func (x *PublishRequest) GetProof() *v1alpha1.Proof {
 type VerifyRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The Verifiable Credential to verify
-	Vc            *v1alpha1.EnvelopedCredential `protobuf:"bytes,1,opt,name=vc,proto3" json:"vc,omitempty"`
+	Vc *v1alpha1.EnvelopedCredential `protobuf:"bytes,1,opt,name=vc,proto3" json:"vc,omitempty"`
+	// The nonce provided by the verifier to the holder,
+	// required for the credentials bound to a holder key
+	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// The optional intended audience of the credentials bound to a holder key
+	Audience      string `protobuf:"bytes,3,opt,name=audience,proto3" json:"audience,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,22 +197,116 @@ func (x *VerifyRequest) GetVc() *v1alpha1.EnvelopedCredential {
 	return nil
 }
 
+func (x *VerifyRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *VerifyRequest) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+// Request to verify a Verifiable Presentation
+type VerifyPresentationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The Verifiable Presentation to verify, secured with JOSE
+	Vp *v1alpha1.EnvelopedCredential `protobuf:"bytes,1,opt,name=vp,proto3" json:"vp,omitempty"`
+	// The nonce provided by the verifier to the holder
+	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// The optional intended audience of the presentation
+	Audience      string `protobuf:"bytes,3,opt,name=audience,proto3" json:"audience,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPresentationRequest) Reset() {
+	*x = VerifyPresentationRequest{}
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPresentationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPresentationRequest) ProtoMessage() {}
+
+func (x *VerifyPresentationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPresentationRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPresentationRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VerifyPresentationRequest) GetVp() *v1alpha1.EnvelopedCredential {
+	if x != nil {
+		return x.Vp
+	}
+	return nil
+}
+
+func (x *VerifyPresentationRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *VerifyPresentationRequest) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
 // Request to search for VCs based on the specified criteria
+// All the criteria are optional and combined with a logical AND
 type SearchRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID is the identifier.
+	// The resolver metadata ID the VCs are attached to
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Schema is the schema of the credential contained in the VC
-	Schema *v1alpha1.CredentialSchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
-	// The credential content representation in JSON-LD format
-	Content       string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// The common name of the Issuer of the VCs
+	Issuer string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// The type of the credential content (AgentBadge, MCPServerBadge)
+	ContentType v1alpha1.CredentialContentType `protobuf:"varint,5,opt,name=content_type,json=contentType,proto3,enum=agntcy.identity.core.v1alpha1.CredentialContentType" json:"content_type,omitempty"`
+	// Only VCs issued at or after this date (RFC3339)
+	IssuedAfter string `protobuf:"bytes,6,opt,name=issued_after,json=issuedAfter,proto3" json:"issued_after,omitempty"`
+	// Only VCs issued before this date (RFC3339)
+	IssuedBefore string `protobuf:"bytes,7,opt,name=issued_before,json=issuedBefore,proto3" json:"issued_before,omitempty"`
+	// Only VCs expiring at or after this date (RFC3339).
+	// VCs without an expiration date are included.
+	ExpiresAfter string `protobuf:"bytes,8,opt,name=expires_after,json=expiresAfter,proto3" json:"expires_after,omitempty"`
+	// Only VCs expiring before this date (RFC3339)
+	ExpiresBefore string `protobuf:"bytes,9,opt,name=expires_before,json=expiresBefore,proto3" json:"expires_before,omitempty"`
+	// Filter on the revocation state of the VCs
+	Revoked *bool `protobuf:"varint,10,opt,name=revoked,proto3,oneof" json:"revoked,omitempty"`
+	// The maximum number of VCs to return (default 20, max 100)
+	PageSize int32 `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The page token returned by a previous search to retrieve the next page
+	PageToken     string `protobuf:"bytes,12,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchRequest) Reset() {
 	*x = SearchRequest{}
-	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[2]
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -158,7 +318,7 @@ func (x *SearchRequest) String() string {
 func (*SearchRequest) ProtoMessage() {}
 
 func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[2]
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -171,7 +331,7 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{2}
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SearchRequest) GetId() string {
@@ -181,16 +341,65 @@ func (x *SearchRequest) GetId() string {
 	return ""
 }
 
-func (x *SearchRequest) GetSchema() *v1alpha1.CredentialSchema {
+func (x *SearchRequest) GetIssuer() string {
 	if x != nil {
-		return x.Schema
+		return x.Issuer
 	}
-	return nil
+	return ""
+}
+
+func (x *SearchRequest) GetContentType() v1alpha1.CredentialContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return v1alpha1.CredentialContentType(0)
+}
+
+func (x *SearchRequest) GetIssuedAfter() string {
+	if x != nil {
+		return x.IssuedAfter
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetIssuedBefore() string {
+	if x != nil {
+		return x.IssuedBefore
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetExpiresAfter() string {
+	if x != nil {
+		return x.ExpiresAfter
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetExpiresBefore() string {
+	if x != nil {
+		return x.ExpiresBefore
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetRevoked() bool {
+	if x != nil && x.Revoked != nil {
+		return *x.Revoked
+	}
+	return false
+}
+
+func (x *SearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *SearchRequest) GetContent() string {
+func (x *SearchRequest) GetPageToken() string {
 	if x != nil {
-		return x.Content
+		return x.PageToken
 	}
 	return ""
 }
@@ -199,14 +408,16 @@ func (x *SearchRequest) GetContent() string {
 type SearchResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The list of VCs that match the search criteria
-	Vcs           []*v1alpha1.EnvelopedCredential `protobuf:"bytes,1,rep,name=vcs,proto3" json:"vcs,omitempty"`
+	Vcs []*v1alpha1.EnvelopedCredential `protobuf:"bytes,1,rep,name=vcs,proto3" json:"vcs,omitempty"`
+	// The token to retrieve the next page, empty if there are no more results
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchResponse) Reset() {
 	*x = SearchResponse{}
-	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[3]
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -218,7 +429,7 @@ func (x *SearchResponse) String() string {
 func (*SearchResponse) ProtoMessage() {}
 
 func (x *SearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[3]
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -231,7 +442,7 @@ func (x *SearchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
 func (*SearchResponse) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{3}
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SearchResponse) GetVcs() []*v1alpha1.EnvelopedCredential {
@@ -241,6 +452,13 @@ func (x *SearchResponse) GetVcs() []*v1alpha1.EnvelopedCredential {
 	return nil
 }
 
+func (x *SearchResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // Requests the well-known VCs for an Id
 type GetVcWellKnownRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -252,7 +470,7 @@ type GetVcWellKnownRequest struct {
 
 func (x *GetVcWellKnownRequest) Reset() {
 	*x = GetVcWellKnownRequest{}
-	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[4]
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -264,7 +482,7 @@ func (x *GetVcWellKnownRequest) String() string {
 func (*GetVcWellKnownRequest) ProtoMessage() {}
 
 func (x *GetVcWellKnownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[4]
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -277,7 +495,7 @@ func (x *GetVcWellKnownRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVcWellKnownRequest.ProtoReflect.Descriptor instead.
 func (*GetVcWellKnownRequest) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{4}
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetVcWellKnownRequest) GetId() string {
@@ -298,7 +516,7 @@ type GetVcWellKnownResponse struct {
 
 func (x *GetVcWellKnownResponse) Reset() {
 	*x = GetVcWellKnownResponse{}
-	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[5]
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -310,7 +528,7 @@ func (x *GetVcWellKnownResponse) String() string {
 func (*GetVcWellKnownResponse) ProtoMessage() {}
 
 func (x *GetVcWellKnownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[5]
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -323,7 +541,7 @@ func (x *GetVcWellKnownResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVcWellKnownResponse.ProtoReflect.Descriptor instead.
 func (*GetVcWellKnownResponse) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{5}
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetVcWellKnownResponse) GetVcs() []*v1alpha1.EnvelopedCredential {
@@ -348,7 +566,7 @@ type RevokeRequest struct {
 
 func (x *RevokeRequest) Reset() {
 	*x = RevokeRequest{}
-	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[6]
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -360,7 +578,7 @@ func (x *RevokeRequest) String() string {
 func (*RevokeRequest) ProtoMessage() {}
 
 func (x *RevokeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[6]
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -373,7 +591,7 @@ func (x *RevokeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeRequest.ProtoReflect.Descriptor instead.
 func (*RevokeRequest) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{6}
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RevokeRequest) GetVc() *v1alpha1.EnvelopedCredential {
@@ -390,36 +608,568 @@ func (x *RevokeRequest) GetProof() *v1alpha1.Proof {
 	return nil
 }
 
+// Request to suspend a published Verifiable Credential
+type SuspendRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The Verifiable Credential to suspend, with a suspension status
+	Vc *v1alpha1.EnvelopedCredential `protobuf:"bytes,1,opt,name=vc,proto3" json:"vc,omitempty"`
+	// Required Proof of ownership of the Issuer's ResolverMetadata
+	// This should be provided when the Issuer is provided by an external IdP
+	// Example: a signed JWT
+	Proof         *v1alpha1.Proof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendRequest) Reset() {
+	*x = SuspendRequest{}
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendRequest) ProtoMessage() {}
+
+func (x *SuspendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendRequest.ProtoReflect.Descriptor instead.
+func (*SuspendRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SuspendRequest) GetVc() *v1alpha1.EnvelopedCredential {
+	if x != nil {
+		return x.Vc
+	}
+	return nil
+}
+
+func (x *SuspendRequest) GetProof() *v1alpha1.Proof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+// Request to reinstate a suspended Verifiable Credential
+type ReinstateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The Verifiable Credential to reinstate, without the suspension status
+	Vc *v1alpha1.EnvelopedCredential `protobuf:"bytes,1,opt,name=vc,proto3" json:"vc,omitempty"`
+	// Required Proof of ownership of the Issuer's ResolverMetadata
+	// This should be provided when the Issuer is provided by an external IdP
+	// Example: a signed JWT
+	Proof         *v1alpha1.Proof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinstateRequest) Reset() {
+	*x = ReinstateRequest{}
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinstateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinstateRequest) ProtoMessage() {}
+
+func (x *ReinstateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinstateRequest.ProtoReflect.Descriptor instead.
+func (*ReinstateRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReinstateRequest) GetVc() *v1alpha1.EnvelopedCredential {
+	if x != nil {
+		return x.Vc
+	}
+	return nil
+}
+
+func (x *ReinstateRequest) GetProof() *v1alpha1.Proof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+// Request the Bitstring Status List credential of an Issuer
+type GetStatusListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The common name of the Issuer
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// The purpose of the status list (revocation, suspension)
+	Purpose       string `protobuf:"bytes,2,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusListRequest) Reset() {
+	*x = GetStatusListRequest{}
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusListRequest) ProtoMessage() {}
+
+func (x *GetStatusListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusListRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusListRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetStatusListRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *GetStatusListRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+// Request the public keys used to verify the Status List credentials
+type GetStatusListWellKnownRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusListWellKnownRequest) Reset() {
+	*x = GetStatusListWellKnownRequest{}
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusListWellKnownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusListWellKnownRequest) ProtoMessage() {}
+
+func (x *GetStatusListWellKnownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusListWellKnownRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusListWellKnownRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{11}
+}
+
+// Returns the public keys used to verify the Status List credentials
+type GetStatusListWellKnownResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The JWKS of the Node
+	Jwks          *v1alpha1.Jwks `protobuf:"bytes,1,opt,name=jwks,proto3" json:"jwks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusListWellKnownResponse) Reset() {
+	*x = GetStatusListWellKnownResponse{}
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusListWellKnownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusListWellKnownResponse) ProtoMessage() {}
+
+func (x *GetStatusListWellKnownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusListWellKnownResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusListWellKnownResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetStatusListWellKnownResponse) GetJwks() *v1alpha1.Jwks {
+	if x != nil {
+		return x.Jwks
+	}
+	return nil
+}
+
+// A change of a Verifiable Credential
+type VcEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the event
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The change of the Verifiable Credential
+	Type VcEventType `protobuf:"varint,2,opt,name=type,proto3,enum=agntcy.identity.node.v1alpha1.VcEventType" json:"type,omitempty"`
+	// The ID of the Verifiable Credential
+	VcId string `protobuf:"bytes,3,opt,name=vc_id,json=vcId,proto3" json:"vc_id,omitempty"`
+	// The common name of the Issuer of the Verifiable Credential
+	Issuer string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// The resolver metadata ID the Verifiable Credential is attached to
+	ResolverMetadataId string `protobuf:"bytes,5,opt,name=resolver_metadata_id,json=resolverMetadataId,proto3" json:"resolver_metadata_id,omitempty"`
+	// The time of the change in milliseconds since the epoch
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The Verifiable Credential as submitted with the change
+	Vc *v1alpha1.EnvelopedCredential `protobuf:"bytes,7,opt,name=vc,proto3" json:"vc,omitempty"`
+	// The position of the event in the events of the Node,
+	// pass it as after_sequence to resume watching after the event
+	Sequence      uint64 `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VcEvent) Reset() {
+	*x = VcEvent{}
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VcEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VcEvent) ProtoMessage() {}
+
+func (x *VcEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VcEvent.ProtoReflect.Descriptor instead.
+func (*VcEvent) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VcEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VcEvent) GetType() VcEventType {
+	if x != nil {
+		return x.Type
+	}
+	return VcEventType_VC_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *VcEvent) GetVcId() string {
+	if x != nil {
+		return x.VcId
+	}
+	return ""
+}
+
+func (x *VcEvent) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *VcEvent) GetResolverMetadataId() string {
+	if x != nil {
+		return x.ResolverMetadataId
+	}
+	return ""
+}
+
+func (x *VcEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *VcEvent) GetVc() *v1alpha1.EnvelopedCredential {
+	if x != nil {
+		return x.Vc
+	}
+	return nil
+}
+
+func (x *VcEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+// Request to watch the changes of the Verifiable Credentials
+// The filters are optional and combined with a logical AND
+type WatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The common name of the Issuer of the Verifiable Credentials
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// The resolver metadata ID the Verifiable Credentials are attached to
+	ResolverMetadataId string `protobuf:"bytes,2,opt,name=resolver_metadata_id,json=resolverMetadataId,proto3" json:"resolver_metadata_id,omitempty"`
+	// Resume watching after the event with this sequence, the events still
+	// retained by the Node are sent first. Only the new events are sent when unset
+	AfterSequence *uint64 `protobuf:"varint,3,opt,name=after_sequence,json=afterSequence,proto3,oneof" json:"after_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *WatchRequest) GetResolverMetadataId() string {
+	if x != nil {
+		return x.ResolverMetadataId
+	}
+	return ""
+}
+
+func (x *WatchRequest) GetAfterSequence() uint64 {
+	if x != nil && x.AfterSequence != nil {
+		return *x.AfterSequence
+	}
+	return 0
+}
+
+// Returns a change of a Verifiable Credential
+type WatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The event describing the change
+	Event         *VcEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchResponse) Reset() {
+	*x = WatchResponse{}
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchResponse) ProtoMessage() {}
+
+func (x *WatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchResponse.ProtoReflect.Descriptor instead.
+func (*WatchResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchResponse) GetEvent() *VcEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
 var File_agntcy_identity_node_v1alpha1_vc_service_proto protoreflect.FileDescriptor
 
 const file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDesc = "" +
 	"\n" +
-	".agntcy/identity/node/v1alpha1/vc_service.proto\x12\x1dagntcy.identity.node.v1alpha1\x1a&agntcy/identity/core/v1alpha1/vc.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9f\x01\n" +
+	".agntcy/identity/node/v1alpha1/vc_service.proto\x12\x1dagntcy.identity.node.v1alpha1\x1a'agntcy/identity/core/v1alpha1/jwk.proto\x1a&agntcy/identity/core/v1alpha1/vc.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x9f\x01\n" +
 	"\x0ePublishRequest\x12B\n" +
 	"\x02vc\x18\x01 \x01(\v22.agntcy.identity.core.v1alpha1.EnvelopedCredentialR\x02vc\x12?\n" +
 	"\x05proof\x18\x02 \x01(\v2$.agntcy.identity.core.v1alpha1.ProofH\x00R\x05proof\x88\x01\x01B\b\n" +
-	"\x06_proof\"S\n" +
+	"\x06_proof\"\x85\x01\n" +
 	"\rVerifyRequest\x12B\n" +
-	"\x02vc\x18\x01 \x01(\v22.agntcy.identity.core.v1alpha1.EnvelopedCredentialR\x02vc\"\x82\x01\n" +
+	"\x02vc\x18\x01 \x01(\v22.agntcy.identity.core.v1alpha1.EnvelopedCredentialR\x02vc\x12\x14\n" +
+	"\x05nonce\x18\x02 \x01(\tR\x05nonce\x12\x1a\n" +
+	"\baudience\x18\x03 \x01(\tR\baudience\"\x91\x01\n" +
+	"\x19VerifyPresentationRequest\x12B\n" +
+	"\x02vp\x18\x01 \x01(\v22.agntcy.identity.core.v1alpha1.EnvelopedCredentialR\x02vp\x12\x14\n" +
+	"\x05nonce\x18\x02 \x01(\tR\x05nonce\x12\x1a\n" +
+	"\baudience\x18\x03 \x01(\tR\baudience\"\x97\x03\n" +
 	"\rSearchRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
-	"\x06schema\x18\x02 \x01(\v2/.agntcy.identity.core.v1alpha1.CredentialSchemaR\x06schema\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\"V\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06issuer\x18\x04 \x01(\tR\x06issuer\x12W\n" +
+	"\fcontent_type\x18\x05 \x01(\x0e24.agntcy.identity.core.v1alpha1.CredentialContentTypeR\vcontentType\x12!\n" +
+	"\fissued_after\x18\x06 \x01(\tR\vissuedAfter\x12#\n" +
+	"\rissued_before\x18\a \x01(\tR\fissuedBefore\x12#\n" +
+	"\rexpires_after\x18\b \x01(\tR\fexpiresAfter\x12%\n" +
+	"\x0eexpires_before\x18\t \x01(\tR\rexpiresBefore\x12\x1d\n" +
+	"\arevoked\x18\n" +
+	" \x01(\bH\x00R\arevoked\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\v \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\f \x01(\tR\tpageTokenB\n" +
+	"\n" +
+	"\b_revokedJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04\"~\n" +
 	"\x0eSearchResponse\x12D\n" +
-	"\x03vcs\x18\x01 \x03(\v22.agntcy.identity.core.v1alpha1.EnvelopedCredentialR\x03vcs\"'\n" +
+	"\x03vcs\x18\x01 \x03(\v22.agntcy.identity.core.v1alpha1.EnvelopedCredentialR\x03vcs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"'\n" +
 	"\x15GetVcWellKnownRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"^\n" +
 	"\x16GetVcWellKnownResponse\x12D\n" +
 	"\x03vcs\x18\x01 \x03(\v22.agntcy.identity.core.v1alpha1.EnvelopedCredentialR\x03vcs\"\x8f\x01\n" +
 	"\rRevokeRequest\x12B\n" +
 	"\x02vc\x18\x01 \x01(\v22.agntcy.identity.core.v1alpha1.EnvelopedCredentialR\x02vc\x12:\n" +
-	"\x05proof\x18\x02 \x01(\v2$.agntcy.identity.core.v1alpha1.ProofR\x05proof2\xdd\b\n" +
+	"\x05proof\x18\x02 \x01(\v2$.agntcy.identity.core.v1alpha1.ProofR\x05proof\"\x90\x01\n" +
+	"\x0eSuspendRequest\x12B\n" +
+	"\x02vc\x18\x01 \x01(\v22.agntcy.identity.core.v1alpha1.EnvelopedCredentialR\x02vc\x12:\n" +
+	"\x05proof\x18\x02 \x01(\v2$.agntcy.identity.core.v1alpha1.ProofR\x05proof\"\x92\x01\n" +
+	"\x10ReinstateRequest\x12B\n" +
+	"\x02vc\x18\x01 \x01(\v22.agntcy.identity.core.v1alpha1.EnvelopedCredentialR\x02vc\x12:\n" +
+	"\x05proof\x18\x02 \x01(\v2$.agntcy.identity.core.v1alpha1.ProofR\x05proof\"H\n" +
+	"\x14GetStatusListRequest\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x18\n" +
+	"\apurpose\x18\x02 \x01(\tR\apurpose\"\x1f\n" +
+	"\x1dGetStatusListWellKnownRequest\"Y\n" +
+	"\x1eGetStatusListWellKnownResponse\x127\n" +
+	"\x04jwks\x18\x01 \x01(\v2#.agntcy.identity.core.v1alpha1.JwksR\x04jwks\"\xb6\x02\n" +
+	"\aVcEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12>\n" +
+	"\x04type\x18\x02 \x01(\x0e2*.agntcy.identity.node.v1alpha1.VcEventTypeR\x04type\x12\x13\n" +
+	"\x05vc_id\x18\x03 \x01(\tR\x04vcId\x12\x16\n" +
+	"\x06issuer\x18\x04 \x01(\tR\x06issuer\x120\n" +
+	"\x14resolver_metadata_id\x18\x05 \x01(\tR\x12resolverMetadataId\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\x12B\n" +
+	"\x02vc\x18\a \x01(\v22.agntcy.identity.core.v1alpha1.EnvelopedCredentialR\x02vc\x12\x1a\n" +
+	"\bsequence\x18\b \x01(\x04R\bsequence\"\x97\x01\n" +
+	"\fWatchRequest\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x120\n" +
+	"\x14resolver_metadata_id\x18\x02 \x01(\tR\x12resolverMetadataId\x12*\n" +
+	"\x0eafter_sequence\x18\x03 \x01(\x04H\x00R\rafterSequence\x88\x01\x01B\x11\n" +
+	"\x0f_after_sequence\"M\n" +
+	"\rWatchResponse\x12<\n" +
+	"\x05event\x18\x01 \x01(\v2&.agntcy.identity.node.v1alpha1.VcEventR\x05event*\x9f\x01\n" +
+	"\vVcEventType\x12\x1d\n" +
+	"\x19VC_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17VC_EVENT_TYPE_PUBLISHED\x10\x01\x12\x19\n" +
+	"\x15VC_EVENT_TYPE_REVOKED\x10\x02\x12\x1b\n" +
+	"\x17VC_EVENT_TYPE_SUSPENDED\x10\x03\x12\x1c\n" +
+	"\x18VC_EVENT_TYPE_REINSTATED\x10\x042\xc6\x14\n" +
 	"\tVcService\x12\xb2\x01\n" +
 	"\aPublish\x12-.agntcy.identity.node.v1alpha1.PublishRequest\x1a\x16.google.protobuf.Empty\"`\x92A>\x12\x1fPublish a Verifiable Credential*\x1bPublishVerifiableCredential\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1alpha1/vc/publish\x12\xc8\x01\n" +
-	"\x06Verify\x12,.agntcy.identity.node.v1alpha1.VerifyRequest\x1a1.agntcy.identity.core.v1alpha1.VerificationResult\"]\x92A<\x12\x1eVerify a Verifiable Credential*\x1aVerifyVerifiableCredential\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1alpha1/vc/verify\x12\x83\x02\n" +
+	"\x06Verify\x12,.agntcy.identity.node.v1alpha1.VerifyRequest\x1a1.agntcy.identity.core.v1alpha1.VerificationResult\"]\x92A<\x12\x1eVerify a Verifiable Credential*\x1aVerifyVerifiableCredential\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1alpha1/vc/verify\x12\xa9\x02\n" +
+	"\x12VerifyPresentation\x128.agntcy.identity.node.v1alpha1.VerifyPresentationRequest\x1a=.agntcy.identity.core.v1alpha1.PresentationVerificationResult\"\x99\x01\x92Ak\x12KVerify a Verifiable Presentation and the Verifiable Credentials it contains*\x1cVerifyVerifiablePresentation\x82\xd3\xe4\x93\x02%:\x01*\" /v1alpha1/vc/presentation/verify\x12\x83\x02\n" +
 	"\fGetWellKnown\x124.agntcy.identity.node.v1alpha1.GetVcWellKnownRequest\x1a5.agntcy.identity.node.v1alpha1.GetVcWellKnownResponse\"\x85\x01\x92AT\x12BReturns the well-known Verifiable Credentials for the specified Id*\x0eGetVcWellKnown\x82\xd3\xe4\x93\x02(\x12&/v1alpha1/vc/{id}/.well-known/vcs.json\x12\xe9\x01\n" +
 	"\x06Search\x12,.agntcy.identity.node.v1alpha1.SearchRequest\x1a-.agntcy.identity.node.v1alpha1.SearchResponse\"\x81\x01\x92A`\x12ASearch for Verifiable Credentials based on the specified criteria*\x1bSearchVerifiableCredentials\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1alpha1/vc/search\x12\xcd\x01\n" +
-	"\x06Revoke\x12,.agntcy.identity.node.v1alpha1.RevokeRequest\x1a\x16.google.protobuf.Empty\"}\x92A\\\x12>Revoke a Verifiable Credential. THIS ACTION IS NOT REVERSIBLE.*\x1aRevokeVerifiableCredential\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1alpha1/vc/revoke\x1a\x0e\x92A\v\n" +
+	"\x06Revoke\x12,.agntcy.identity.node.v1alpha1.RevokeRequest\x1a\x16.google.protobuf.Empty\"}\x92A\\\x12>Revoke a Verifiable Credential. THIS ACTION IS NOT REVERSIBLE.*\x1aRevokeVerifiableCredential\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1alpha1/vc/revoke\x12\xdc\x01\n" +
+	"\aSuspend\x12-.agntcy.identity.node.v1alpha1.SuspendRequest\x1a\x16.google.protobuf.Empty\"\x89\x01\x92Ag\x12HSuspend a Verifiable Credential. The credential can be reinstated later.*\x1bSuspendVerifiableCredential\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1alpha1/vc/suspend\x12\xc6\x01\n" +
+	"\tReinstate\x12/.agntcy.identity.node.v1alpha1.ReinstateRequest\x1a\x16.google.protobuf.Empty\"p\x92AL\x12+Reinstate a suspended Verifiable Credential*\x1dReinstateVerifiableCredential\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1alpha1/vc/reinstate\x12\xfd\x01\n" +
+	"\rGetStatusList\x123.agntcy.identity.node.v1alpha1.GetStatusListRequest\x1a2.agntcy.identity.core.v1alpha1.EnvelopedCredential\"\x82\x01\x92AQ\x12@Returns the signed Bitstring Status List credential of an Issuer*\rGetStatusList\x82\xd3\xe4\x93\x02(\x12&/v1alpha1/vc/status/{issuer}/{purpose}\x12\xa1\x02\n" +
+	"\x16GetStatusListWellKnown\x12<.agntcy.identity.node.v1alpha1.GetStatusListWellKnownRequest\x1a=.agntcy.identity.node.v1alpha1.GetStatusListWellKnownResponse\"\x89\x01\x92A\\\x12BReturns the public keys used to verify the Status List credentials*\x16GetStatusListWellKnown\x82\xd3\xe4\x93\x02$\x12\"/v1alpha1/vc/.well-known/jwks.json\x12\xee\x01\n" +
+	"\x05Watch\x12+.agntcy.identity.node.v1alpha1.WatchRequest\x1a,.agntcy.identity.node.v1alpha1.WatchResponse\"\x87\x01\x92Aj\x12LStreams the publication and the status changes of the Verifiable Credentials*\x1aWatchVerifiableCredentials\x82\xd3\xe4\x93\x02\x14\x12\x12/v1alpha1/vc/watch0\x01\x1a\x0e\x92A\v\n" +
 	"\tVcServiceBZZXgithub.com/agntcy/identity/api/server/agntcy/identity/node/v1alpha1;identity_node_sdk_gob\x06proto3"
 
 var (
@@ -434,45 +1184,79 @@ func file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescGZIP() []byte {
 	return file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDescData
 }
 
-var file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_agntcy_identity_node_v1alpha1_vc_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_agntcy_identity_node_v1alpha1_vc_service_proto_goTypes = []any{
-	(*PublishRequest)(nil),               // 0: agntcy.identity.node.v1alpha1.PublishRequest
-	(*VerifyRequest)(nil),                // 1: agntcy.identity.node.v1alpha1.VerifyRequest
-	(*SearchRequest)(nil),                // 2: agntcy.identity.node.v1alpha1.SearchRequest
-	(*SearchResponse)(nil),               // 3: agntcy.identity.node.v1alpha1.SearchResponse
-	(*GetVcWellKnownRequest)(nil),        // 4: agntcy.identity.node.v1alpha1.GetVcWellKnownRequest
-	(*GetVcWellKnownResponse)(nil),       // 5: agntcy.identity.node.v1alpha1.GetVcWellKnownResponse
-	(*RevokeRequest)(nil),                // 6: agntcy.identity.node.v1alpha1.RevokeRequest
-	(*v1alpha1.EnvelopedCredential)(nil), // 7: agntcy.identity.core.v1alpha1.EnvelopedCredential
-	(*v1alpha1.Proof)(nil),               // 8: agntcy.identity.core.v1alpha1.Proof
-	(*v1alpha1.CredentialSchema)(nil),    // 9: agntcy.identity.core.v1alpha1.CredentialSchema
-	(*emptypb.Empty)(nil),                // 10: google.protobuf.Empty
-	(*v1alpha1.VerificationResult)(nil),  // 11: agntcy.identity.core.v1alpha1.VerificationResult
+	(VcEventType)(0),                                // 0: agntcy.identity.node.v1alpha1.VcEventType
+	(*PublishRequest)(nil),                          // 1: agntcy.identity.node.v1alpha1.PublishRequest
+	(*VerifyRequest)(nil),                           // 2: agntcy.identity.node.v1alpha1.VerifyRequest
+	(*VerifyPresentationRequest)(nil),               // 3: agntcy.identity.node.v1alpha1.VerifyPresentationRequest
+	(*SearchRequest)(nil),                           // 4: agntcy.identity.node.v1alpha1.SearchRequest
+	(*SearchResponse)(nil),                          // 5: agntcy.identity.node.v1alpha1.SearchResponse
+	(*GetVcWellKnownRequest)(nil),                   // 6: agntcy.identity.node.v1alpha1.GetVcWellKnownRequest
+	(*GetVcWellKnownResponse)(nil),                  // 7: agntcy.identity.node.v1alpha1.GetVcWellKnownResponse
+	(*RevokeRequest)(nil),                           // 8: agntcy.identity.node.v1alpha1.RevokeRequest
+	(*SuspendRequest)(nil),                          // 9: agntcy.identity.node.v1alpha1.SuspendRequest
+	(*ReinstateRequest)(nil),                        // 10: agntcy.identity.node.v1alpha1.ReinstateRequest
+	(*GetStatusListRequest)(nil),                    // 11: agntcy.identity.node.v1alpha1.GetStatusListRequest
+	(*GetStatusListWellKnownRequest)(nil),           // 12: agntcy.identity.node.v1alpha1.GetStatusListWellKnownRequest
+	(*GetStatusListWellKnownResponse)(nil),          // 13: agntcy.identity.node.v1alpha1.GetStatusListWellKnownResponse
+	(*VcEvent)(nil),                                 // 14: agntcy.identity.node.v1alpha1.VcEvent
+	(*WatchRequest)(nil),                            // 15: agntcy.identity.node.v1alpha1.WatchRequest
+	(*WatchResponse)(nil),                           // 16: agntcy.identity.node.v1alpha1.WatchResponse
+	(*v1alpha1.EnvelopedCredential)(nil),            // 17: agntcy.identity.core.v1alpha1.EnvelopedCredential
+	(*v1alpha1.Proof)(nil),                          // 18: agntcy.identity.core.v1alpha1.Proof
+	(v1alpha1.CredentialContentType)(0),             // 19: agntcy.identity.core.v1alpha1.CredentialContentType
+	(*v1alpha1.Jwks)(nil),                           // 20: agntcy.identity.core.v1alpha1.Jwks
+	(*emptypb.Empty)(nil),                           // 21: google.protobuf.Empty
+	(*v1alpha1.VerificationResult)(nil),             // 22: agntcy.identity.core.v1alpha1.VerificationResult
+	(*v1alpha1.PresentationVerificationResult)(nil), // 23: agntcy.identity.core.v1alpha1.PresentationVerificationResult
 }
 var file_agntcy_identity_node_v1alpha1_vc_service_proto_depIdxs = []int32{
-	7,  // 0: agntcy.identity.node.v1alpha1.PublishRequest.vc:type_name -> agntcy.identity.core.v1alpha1.EnvelopedCredential
-	8,  // 1: agntcy.identity.node.v1alpha1.PublishRequest.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
-	7,  // 2: agntcy.identity.node.v1alpha1.VerifyRequest.vc:type_name -> agntcy.identity.core.v1alpha1.EnvelopedCredential
-	9,  // 3: agntcy.identity.node.v1alpha1.SearchRequest.schema:type_name -> agntcy.identity.core.v1alpha1.CredentialSchema
-	7,  // 4: agntcy.identity.node.v1alpha1.SearchResponse.vcs:type_name -> agntcy.identity.core.v1alpha1.EnvelopedCredential
-	7,  // 5: agntcy.identity.node.v1alpha1.GetVcWellKnownResponse.vcs:type_name -> agntcy.identity.core.v1alpha1.EnvelopedCredential
-	7,  // 6: agntcy.identity.node.v1alpha1.RevokeRequest.vc:type_name -> agntcy.identity.core.v1alpha1.EnvelopedCredential
-	8,  // 7: agntcy.identity.node.v1alpha1.RevokeRequest.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
-	0,  // 8: agntcy.identity.node.v1alpha1.VcService.Publish:input_type -> agntcy.identity.node.v1alpha1.PublishRequest
-	1,  // 9: agntcy.identity.node.v1alpha1.VcService.Verify:input_type -> agntcy.identity.node.v1alpha1.VerifyRequest
-	4,  // 10: agntcy.identity.node.v1alpha1.VcService.GetWellKnown:input_type -> agntcy.identity.node.v1alpha1.GetVcWellKnownRequest
-	2,  // 11: agntcy.identity.node.v1alpha1.VcService.Search:input_type -> agntcy.identity.node.v1alpha1.SearchRequest
-	6,  // 12: agntcy.identity.node.v1alpha1.VcService.Revoke:input_type -> agntcy.identity.node.v1alpha1.RevokeRequest
-	10, // 13: agntcy.identity.node.v1alpha1.VcService.Publish:output_type -> google.protobuf.Empty
-	11, // 14: agntcy.identity.node.v1alpha1.VcService.Verify:output_type -> agntcy.identity.core.v1alpha1.VerificationResult
-	5,  // 15: agntcy.identity.node.v1alpha1.VcService.GetWellKnown:output_type -> agntcy.identity.node.v1alpha1.GetVcWellKnownResponse
-	3,  // 16: agntcy.identity.node.v1alpha1.VcService.Search:output_type -> agntcy.identity.node.v1alpha1.SearchResponse
-	10, // 17: agntcy.identity.node.v1alpha1.VcService.Revoke:output_type -> google.protobuf.Empty
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	17, // 0: agntcy.identity.node.v1alpha1.PublishRequest.vc:type_name -> agntcy.identity.core.v1alpha1.EnvelopedCredential
+	18, // 1: agntcy.identity.node.v1alpha1.PublishRequest.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
+	17, // 2: agntcy.identity.node.v1alpha1.VerifyRequest.vc:type_name -> agntcy.identity.core.v1alpha1.EnvelopedCredential
+	17, // 3: agntcy.identity.node.v1alpha1.VerifyPresentationRequest.vp:type_name -> agntcy.identity.core.v1alpha1.EnvelopedCredential
+	19, // 4: agntcy.identity.node.v1alpha1.SearchRequest.content_type:type_name -> agntcy.identity.core.v1alpha1.CredentialContentType
+	17, // 5: agntcy.identity.node.v1alpha1.SearchResponse.vcs:type_name -> agntcy.identity.core.v1alpha1.EnvelopedCredential
+	17, // 6: agntcy.identity.node.v1alpha1.GetVcWellKnownResponse.vcs:type_name -> agntcy.identity.core.v1alpha1.EnvelopedCredential
+	17, // 7: agntcy.identity.node.v1alpha1.RevokeRequest.vc:type_name -> agntcy.identity.core.v1alpha1.EnvelopedCredential
+	18, // 8: agntcy.identity.node.v1alpha1.RevokeRequest.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
+	17, // 9: agntcy.identity.node.v1alpha1.SuspendRequest.vc:type_name -> agntcy.identity.core.v1alpha1.EnvelopedCredential
+	18, // 10: agntcy.identity.node.v1alpha1.SuspendRequest.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
+	17, // 11: agntcy.identity.node.v1alpha1.ReinstateRequest.vc:type_name -> agntcy.identity.core.v1alpha1.EnvelopedCredential
+	18, // 12: agntcy.identity.node.v1alpha1.ReinstateRequest.proof:type_name -> agntcy.identity.core.v1alpha1.Proof
+	20, // 13: agntcy.identity.node.v1alpha1.GetStatusListWellKnownResponse.jwks:type_name -> agntcy.identity.core.v1alpha1.Jwks
+	0,  // 14: agntcy.identity.node.v1alpha1.VcEvent.type:type_name -> agntcy.identity.node.v1alpha1.VcEventType
+	17, // 15: agntcy.identity.node.v1alpha1.VcEvent.vc:type_name -> agntcy.identity.core.v1alpha1.EnvelopedCredential
+	14, // 16: agntcy.identity.node.v1alpha1.WatchResponse.event:type_name -> agntcy.identity.node.v1alpha1.VcEvent
+	1,  // 17: agntcy.identity.node.v1alpha1.VcService.Publish:input_type -> agntcy.identity.node.v1alpha1.PublishRequest
+	2,  // 18: agntcy.identity.node.v1alpha1.VcService.Verify:input_type -> agntcy.identity.node.v1alpha1.VerifyRequest
+	3,  // 19: agntcy.identity.node.v1alpha1.VcService.VerifyPresentation:input_type -> agntcy.identity.node.v1alpha1.VerifyPresentationRequest
+	6,  // 20: agntcy.identity.node.v1alpha1.VcService.GetWellKnown:input_type -> agntcy.identity.node.v1alpha1.GetVcWellKnownRequest
+	4,  // 21: agntcy.identity.node.v1alpha1.VcService.Search:input_type -> agntcy.identity.node.v1alpha1.SearchRequest
+	8,  // 22: agntcy.identity.node.v1alpha1.VcService.Revoke:input_type -> agntcy.identity.node.v1alpha1.RevokeRequest
+	9,  // 23: agntcy.identity.node.v1alpha1.VcService.Suspend:input_type -> agntcy.identity.node.v1alpha1.SuspendRequest
+	10, // 24: agntcy.identity.node.v1alpha1.VcService.Reinstate:input_type -> agntcy.identity.node.v1alpha1.ReinstateRequest
+	11, // 25: agntcy.identity.node.v1alpha1.VcService.GetStatusList:input_type -> agntcy.identity.node.v1alpha1.GetStatusListRequest
+	12, // 26: agntcy.identity.node.v1alpha1.VcService.GetStatusListWellKnown:input_type -> agntcy.identity.node.v1alpha1.GetStatusListWellKnownRequest
+	15, // 27: agntcy.identity.node.v1alpha1.VcService.Watch:input_type -> agntcy.identity.node.v1alpha1.WatchRequest
+	21, // 28: agntcy.identity.node.v1alpha1.VcService.Publish:output_type -> google.protobuf.Empty
+	22, // 29: agntcy.identity.node.v1alpha1.VcService.Verify:output_type -> agntcy.identity.core.v1alpha1.VerificationResult
+	23, // 30: agntcy.identity.node.v1alpha1.VcService.VerifyPresentation:output_type -> agntcy.identity.core.v1alpha1.PresentationVerificationResult
+	7,  // 31: agntcy.identity.node.v1alpha1.VcService.GetWellKnown:output_type -> agntcy.identity.node.v1alpha1.GetVcWellKnownResponse
+	5,  // 32: agntcy.identity.node.v1alpha1.VcService.Search:output_type -> agntcy.identity.node.v1alpha1.SearchResponse
+	21, // 33: agntcy.identity.node.v1alpha1.VcService.Revoke:output_type -> google.protobuf.Empty
+	21, // 34: agntcy.identity.node.v1alpha1.VcService.Suspend:output_type -> google.protobuf.Empty
+	21, // 35: agntcy.identity.node.v1alpha1.VcService.Reinstate:output_type -> google.protobuf.Empty
+	17, // 36: agntcy.identity.node.v1alpha1.VcService.GetStatusList:output_type -> agntcy.identity.core.v1alpha1.EnvelopedCredential
+	13, // 37: agntcy.identity.node.v1alpha1.VcService.GetStatusListWellKnown:output_type -> agntcy.identity.node.v1alpha1.GetStatusListWellKnownResponse
+	16, // 38: agntcy.identity.node.v1alpha1.VcService.Watch:output_type -> agntcy.identity.node.v1alpha1.WatchResponse
+	28, // [28:39] is the sub-list for method output_type
+	17, // [17:28] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_agntcy_identity_node_v1alpha1_vc_service_proto_init() }
@@ -481,18 +1265,21 @@ func file_agntcy_identity_node_v1alpha1_vc_service_proto_init() {
 		return
 	}
 	file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[0].OneofWrappers = []any{}
+	file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[3].OneofWrappers = []any{}
+	file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes[14].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDesc), len(file_agntcy_identity_node_v1alpha1_vc_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      1,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_agntcy_identity_node_v1alpha1_vc_service_proto_goTypes,
 		DependencyIndexes: file_agntcy_identity_node_v1alpha1_vc_service_proto_depIdxs,
+		EnumInfos:         file_agntcy_identity_node_v1alpha1_vc_service_proto_enumTypes,
 		MessageInfos:      file_agntcy_identity_node_v1alpha1_vc_service_proto_msgTypes,
 	}.Build()
 	File_agntcy_identity_node_v1alpha1_vc_service_proto = out.File
@@ -24,11 +24,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VcService_Publish_FullMethodName      = "/agntcy.identity.node.v1alpha1.VcService/Publish"
-	VcService_Verify_FullMethodName       = "/agntcy.identity.node.v1alpha1.VcService/Verify"
-	VcService_GetWellKnown_FullMethodName = "/agntcy.identity.node.v1alpha1.VcService/GetWellKnown"
-	VcService_Search_FullMethodName       = "/agntcy.identity.node.v1alpha1.VcService/Search"
-	VcService_Revoke_FullMethodName       = "/agntcy.identity.node.v1alpha1.VcService/Revoke"
+	VcService_Publish_FullMethodName                = "/agntcy.identity.node.v1alpha1.VcService/Publish"
+	VcService_Verify_FullMethodName                 = "/agntcy.identity.node.v1alpha1.VcService/Verify"
+	VcService_VerifyPresentation_FullMethodName     = "/agntcy.identity.node.v1alpha1.VcService/VerifyPresentation"
+	VcService_GetWellKnown_FullMethodName           = "/agntcy.identity.node.v1alpha1.VcService/GetWellKnown"
+	VcService_Search_FullMethodName                 = "/agntcy.identity.node.v1alpha1.VcService/Search"
+	VcService_Revoke_FullMethodName                 = "/agntcy.identity.node.v1alpha1.VcService/Revoke"
+	VcService_Suspend_FullMethodName                = "/agntcy.identity.node.v1alpha1.VcService/Suspend"
+	VcService_Reinstate_FullMethodName              = "/agntcy.identity.node.v1alpha1.VcService/Reinstate"
+	VcService_GetStatusList_FullMethodName          = "/agntcy.identity.node.v1alpha1.VcService/GetStatusList"
+	VcService_GetStatusListWellKnown_FullMethodName = "/agntcy.identity.node.v1alpha1.VcService/GetStatusListWellKnown"
+	VcService_Watch_FullMethodName                  = "/agntcy.identity.node.v1alpha1.VcService/Watch"
 )
 
 // VcServiceClient is the client API for VcService service.
@@ -41,12 +47,24 @@ type VcServiceClient interface {
 	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Verify an existing Verifiable Credential
 	Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*v1alpha1.VerificationResult, error)
+	// Verify a Verifiable Presentation and the Verifiable Credentials it contains
+	VerifyPresentation(ctx context.Context, in *VerifyPresentationRequest, opts ...grpc.CallOption) (*v1alpha1.PresentationVerificationResult, error)
 	// Returns the well-known Verifiable Credentials for the specified Id
 	GetWellKnown(ctx context.Context, in *GetVcWellKnownRequest, opts ...grpc.CallOption) (*GetVcWellKnownResponse, error)
 	// Search for Verifiable Credentials based on the specified criteria
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 	// Revoke a Verifiable Credential. THIS ACTION IS NOT REVERSIBLE.
 	Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Suspend a Verifiable Credential. The credential can be reinstated later.
+	Suspend(ctx context.Context, in *SuspendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Reinstate a suspended Verifiable Credential
+	Reinstate(ctx context.Context, in *ReinstateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the signed Bitstring Status List credential of an Issuer
+	GetStatusList(ctx context.Context, in *GetStatusListRequest, opts ...grpc.CallOption) (*v1alpha1.EnvelopedCredential, error)
+	// Returns the public keys used to verify the Status List credentials
+	GetStatusListWellKnown(ctx context.Context, in *GetStatusListWellKnownRequest, opts ...grpc.CallOption) (*GetStatusListWellKnownResponse, error)
+	// Streams the publication and the status changes of the Verifiable Credentials
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchResponse], error)
 }
 
 type vcServiceClient struct {
@@ -77,6 +95,16 @@ func (c *vcServiceClient) Verify(ctx context.Context, in *VerifyRequest, opts ..
 	return out, nil
 }
 
+func (c *vcServiceClient) VerifyPresentation(ctx context.Context, in *VerifyPresentationRequest, opts ...grpc.CallOption) (*v1alpha1.PresentationVerificationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1alpha1.PresentationVerificationResult)
+	err := c.cc.Invoke(ctx, VcService_VerifyPresentation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vcServiceClient) GetWellKnown(ctx context.Context, in *GetVcWellKnownRequest, opts ...grpc.CallOption) (*GetVcWellKnownResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetVcWellKnownResponse)
@@ -107,6 +135,65 @@ func (c *vcServiceClient) Revoke(ctx context.Context, in *RevokeRequest, opts ..
 	return out, nil
 }
 
+func (c *vcServiceClient) Suspend(ctx context.Context, in *SuspendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, VcService_Suspend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcServiceClient) Reinstate(ctx context.Context, in *ReinstateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, VcService_Reinstate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcServiceClient) GetStatusList(ctx context.Context, in *GetStatusListRequest, opts ...grpc.CallOption) (*v1alpha1.EnvelopedCredential, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1alpha1.EnvelopedCredential)
+	err := c.cc.Invoke(ctx, VcService_GetStatusList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcServiceClient) GetStatusListWellKnown(ctx context.Context, in *GetStatusListWellKnownRequest, opts ...grpc.CallOption) (*GetStatusListWellKnownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatusListWellKnownResponse)
+	err := c.cc.Invoke(ctx, VcService_GetStatusListWellKnown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vcServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VcService_ServiceDesc.Streams[0], VcService_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRequest, WatchResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VcService_WatchClient = grpc.ServerStreamingClient[WatchResponse]
+
 // VcServiceServer is the server API for VcService service.
 // All implementations should embed UnimplementedVcServiceServer
 // for forward compatibility.
@@ -117,12 +204,24 @@ type VcServiceServer interface {
 	Publish(context.Context, *PublishRequest) (*emptypb.Empty, error)
 	// Verify an existing Verifiable Credential
 	Verify(context.Context, *VerifyRequest) (*v1alpha1.VerificationResult, error)
+	// Verify a Verifiable Presentation and the Verifiable Credentials it contains
+	VerifyPresentation(context.Context, *VerifyPresentationRequest) (*v1alpha1.PresentationVerificationResult, error)
 	// Returns the well-known Verifiable Credentials for the specified Id
 	GetWellKnown(context.Context, *GetVcWellKnownRequest) (*GetVcWellKnownResponse, error)
 	// Search for Verifiable Credentials based on the specified criteria
 	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 	// Revoke a Verifiable Credential. THIS ACTION IS NOT REVERSIBLE.
 	Revoke(context.Context, *RevokeRequest) (*emptypb.Empty, error)
+	// Suspend a Verifiable Credential. The credential can be reinstated later.
+	Suspend(context.Context, *SuspendRequest) (*emptypb.Empty, error)
+	// Reinstate a suspended Verifiable Credential
+	Reinstate(context.Context, *ReinstateRequest) (*emptypb.Empty, error)
+	// Returns the signed Bitstring Status List credential of an Issuer
+	GetStatusList(context.Context, *GetStatusListRequest) (*v1alpha1.EnvelopedCredential, error)
+	// Returns the public keys used to verify the Status List credentials
+	GetStatusListWellKnown(context.Context, *GetStatusListWellKnownRequest) (*GetStatusListWellKnownResponse, error)
+	// Streams the publication and the status changes of the Verifiable Credentials
+	Watch(*WatchRequest, grpc.ServerStreamingServer[WatchResponse]) error
 }
 
 // UnimplementedVcServiceServer should be embedded to have
@@ -138,6 +237,9 @@ func (UnimplementedVcServiceServer) Publish(context.Context, *PublishRequest) (*
 func (UnimplementedVcServiceServer) Verify(context.Context, *VerifyRequest) (*v1alpha1.VerificationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Verify not implemented")
 }
+func (UnimplementedVcServiceServer) VerifyPresentation(context.Context, *VerifyPresentationRequest) (*v1alpha1.PresentationVerificationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPresentation not implemented")
+}
 func (UnimplementedVcServiceServer) GetWellKnown(context.Context, *GetVcWellKnownRequest) (*GetVcWellKnownResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWellKnown not implemented")
 }
@@ -147,6 +249,21 @@ func (UnimplementedVcServiceServer) Search(context.Context, *SearchRequest) (*Se
 func (UnimplementedVcServiceServer) Revoke(context.Context, *RevokeRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
+func (UnimplementedVcServiceServer) Suspend(context.Context, *SuspendRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suspend not implemented")
+}
+func (UnimplementedVcServiceServer) Reinstate(context.Context, *ReinstateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reinstate not implemented")
+}
+func (UnimplementedVcServiceServer) GetStatusList(context.Context, *GetStatusListRequest) (*v1alpha1.EnvelopedCredential, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusList not implemented")
+}
+func (UnimplementedVcServiceServer) GetStatusListWellKnown(context.Context, *GetStatusListWellKnownRequest) (*GetStatusListWellKnownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusListWellKnown not implemented")
+}
+func (UnimplementedVcServiceServer) Watch(*WatchRequest, grpc.ServerStreamingServer[WatchResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedVcServiceServer) testEmbeddedByValue() {}
 
 // UnsafeVcServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -203,6 +320,24 @@ func _VcService_Verify_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VcService_VerifyPresentation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPresentationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcServiceServer).VerifyPresentation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VcService_VerifyPresentation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcServiceServer).VerifyPresentation(ctx, req.(*VerifyPresentationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VcService_GetWellKnown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetVcWellKnownRequest)
 	if err := dec(in); err != nil {
@@ -257,6 +392,89 @@ func _VcService_Revoke_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VcService_Suspend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcServiceServer).Suspend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VcService_Suspend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcServiceServer).Suspend(ctx, req.(*SuspendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VcService_Reinstate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReinstateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcServiceServer).Reinstate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VcService_Reinstate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcServiceServer).Reinstate(ctx, req.(*ReinstateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VcService_GetStatusList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcServiceServer).GetStatusList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VcService_GetStatusList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcServiceServer).GetStatusList(ctx, req.(*GetStatusListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VcService_GetStatusListWellKnown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusListWellKnownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VcServiceServer).GetStatusListWellKnown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VcService_GetStatusListWellKnown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VcServiceServer).GetStatusListWellKnown(ctx, req.(*GetStatusListWellKnownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VcService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VcServiceServer).Watch(m, &grpc.GenericServerStream[WatchRequest, WatchResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VcService_WatchServer = grpc.ServerStreamingServer[WatchResponse]
+
 // VcService_ServiceDesc is the grpc.ServiceDesc for VcService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -272,6 +490,10 @@ var VcService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Verify",
 			Handler:    _VcService_Verify_Handler,
 		},
+		{
+			MethodName: "VerifyPresentation",
+			Handler:    _VcService_VerifyPresentation_Handler,
+		},
 		{
 			MethodName: "GetWellKnown",
 			Handler:    _VcService_GetWellKnown_Handler,
@@ -284,7 +506,29 @@ var VcService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _VcService_Revoke_Handler,
 		},
+		{
+			MethodName: "Suspend",
+			Handler:    _VcService_Suspend_Handler,
+		},
+		{
+			MethodName: "Reinstate",
+			Handler:    _VcService_Reinstate_Handler,
+		},
+		{
+			MethodName: "GetStatusList",
+			Handler:    _VcService_GetStatusList_Handler,
+		},
+		{
+			MethodName: "GetStatusListWellKnown",
+			Handler:    _VcService_GetStatusListWellKnown_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _VcService_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "agntcy/identity/node/v1alpha1/vc_service.proto",
 }
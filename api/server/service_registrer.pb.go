@@ -22,6 +22,8 @@ type GrpcServiceRegister struct {
 
 	IssuerServiceServer v1alpha11.IssuerServiceServer
 
+	LogServiceServer v1alpha11.LogServiceServer
+
 	VcServiceServer v1alpha11.VcServiceServer
 }
 
@@ -39,6 +41,10 @@ func (r GrpcServiceRegister) RegisterGrpcHandlers(grpcServer *grpc.Server) {
 		v1alpha11.RegisterIssuerServiceServer(grpcServer, r.IssuerServiceServer)
 	}
 
+	if r.LogServiceServer != nil {
+		v1alpha11.RegisterLogServiceServer(grpcServer, r.LogServiceServer)
+	}
+
 	if r.VcServiceServer != nil {
 		v1alpha11.RegisterVcServiceServer(grpcServer, r.VcServiceServer)
 	}
@@ -61,6 +67,13 @@ func (r GrpcServiceRegister) RegisterHttpHandlers(ctx context.Context, mux *runt
 		}
 	}
 
+	if r.LogServiceServer != nil {
+		err := v1alpha11.RegisterLogServiceHandler(ctx, mux, conn)
+		if err != nil {
+			return err
+		}
+	}
+
 	if r.VcServiceServer != nil {
 		err := v1alpha11.RegisterVcServiceHandler(ctx, mux, conn)
 		if err != nil {
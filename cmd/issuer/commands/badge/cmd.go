@@ -30,9 +30,11 @@ The badge command is used to issue and publish badges for your Agent and MCP Ser
 	}
 
 	cmd.AddCommand(NewCmdIssue(cache, badgeService, vaultSrv, a2aClient, mcpClient))
-	cmd.AddCommand(NewCmdPublish(cache, badgeService, issuerService))
+	cmd.AddCommand(NewCmdPublish(cache, badgeService, issuerService, vaultSrv))
+	cmd.AddCommand(NewCmdRevoke(cache, badgeService, vaultSrv))
 	cmd.AddCommand(NewCmdList(cache, badgeService))
 	cmd.AddCommand(NewCmdShow(cache, badgeService))
+	cmd.AddCommand(NewCmdDisclose(cache, badgeService))
 	cmd.AddCommand(NewCmdLoad(cache, badgeService))
 	cmd.AddCommand(NewCmdForget(cache, badgeService))
 
@@ -0,0 +1,168 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package badge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	clicache "github.com/agntcy/identity/cmd/issuer/cache"
+	"github.com/agntcy/identity/internal/core/vc/sdjwt"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	badgesrv "github.com/agntcy/identity/internal/issuer/badge"
+	"github.com/agntcy/identity/internal/pkg/cmdutil"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/spf13/cobra"
+)
+
+type DiscloseFlags struct {
+	BadgeID   string
+	Claims    []string
+	HolderKey string
+	Audience  string
+	Nonce     string
+}
+
+type DiscloseCommand struct {
+	cache        *clicache.Cache
+	badgeService badgesrv.BadgeService
+}
+
+func NewCmdDisclose(
+	cache *clicache.Cache,
+	badgeService badgesrv.BadgeService,
+) *cobra.Command {
+	flags := NewDiscloseFlags()
+
+	cmd := &cobra.Command{
+		Use:   "disclose",
+		Short: "Present an SD-JWT badge disclosing only the chosen badge claims",
+		Run: func(cmd *cobra.Command, args []string) {
+			c := DiscloseCommand{
+				cache:        cache,
+				badgeService: badgeService,
+			}
+
+			err := c.Run(cmd.Context(), flags)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	flags.AddFlags(cmd)
+
+	return cmd
+}
+
+func NewDiscloseFlags() *DiscloseFlags {
+	return &DiscloseFlags{}
+}
+
+func (f *DiscloseFlags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.BadgeID, "badge-id", "b", "", "The ID of the badge to present")
+	cmd.Flags().StringSliceVarP(
+		&f.Claims,
+		"claims",
+		"c",
+		nil,
+		"The top-level badge claims to disclose (e.g. name,url)",
+	)
+	cmd.Flags().StringVar(
+		&f.HolderKey,
+		"holder-key",
+		"",
+		"Path to the private JWK of the holder used to sign the Key Binding JWT",
+	)
+	cmd.Flags().StringVar(&f.Audience, "audience", "", "The intended verifier of the presentation")
+	cmd.Flags().StringVar(&f.Nonce, "nonce", "", "The nonce provided by the verifier")
+}
+
+func (cmd *DiscloseCommand) Run(ctx context.Context, flags *DiscloseFlags) error {
+	err := cmd.cache.ValidateForBadge()
+	if err != nil {
+		return fmt.Errorf("error validating local configuration: %w", err)
+	}
+
+	// if the badge id is not set, prompt the user for it interactively
+	// if there is a badge id in the cache, use it as the default when prompting
+	if cmd.cache.BadgeId != "" {
+		err = cmdutil.ScanWithDefaultIfNotSet(
+			"Badge ID to present",
+			cmd.cache.BadgeId,
+			&flags.BadgeID,
+		)
+	} else {
+		err = cmdutil.ScanRequiredIfNotSet("Badge ID to present", &flags.BadgeID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading badge ID: %w", err)
+	}
+
+	badge, err := cmd.badgeService.GetBadge(
+		cmd.cache.VaultId,
+		cmd.cache.KeyID,
+		cmd.cache.IssuerId,
+		cmd.cache.MetadataId,
+		flags.BadgeID,
+	)
+	if err != nil {
+		return fmt.Errorf("error getting badge: %w", err)
+	}
+
+	if badge.EnvelopedCredential == nil ||
+		badge.EnvelopedCredential.EnvelopeType != vctypes.CREDENTIAL_ENVELOPE_TYPE_SD_JWT {
+		return errors.New("only SD-JWT badges support selective disclosure")
+	}
+
+	var holderKey *jwk.Jwk
+
+	if flags.HolderKey != "" {
+		holderKey, err = readJwk(flags.HolderKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	presentation, err := sdjwt.Present(
+		badge.EnvelopedCredential,
+		flags.Claims,
+		holderKey,
+		flags.Audience,
+		flags.Nonce,
+	)
+	if err != nil {
+		return fmt.Errorf("error presenting badge: %w", err)
+	}
+
+	presentationJSON, err := json.MarshalIndent(presentation, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling presentation to JSON: %w", err)
+	}
+
+	fmt.Fprintf(os.Stdout, "%s\n", string(presentationJSON))
+
+	return nil
+}
+
+func readJwk(path string) (*jwk.Jwk, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the holder key: %w", err)
+	}
+
+	var key jwk.Jwk
+
+	err = json.Unmarshal(data, &key)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the holder key: %w", err)
+	}
+
+	return &key, nil
+}
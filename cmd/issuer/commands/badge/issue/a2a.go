@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	clicache "github.com/agntcy/identity/cmd/issuer/cache"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
@@ -20,6 +21,9 @@ import (
 
 type IssueA2AFlags struct {
 	A2AWellKnown string
+	ExpiresIn    time.Duration
+	Envelope     string
+	HolderKey    string
 }
 
 type IssueA2ACommand struct {
@@ -73,6 +77,9 @@ func (f *IssueA2AFlags) AddFlags(cmd *cobra.Command) {
 		"",
 		"The well-known URL of the A2A agent you want to sign in the badge",
 	)
+	addExpiresInFlag(cmd, &f.ExpiresIn)
+	addEnvelopeFlag(cmd, &f.Envelope)
+	addHolderKeyFlag(cmd, &f.HolderKey)
 }
 
 func (cmd *IssueA2ACommand) Run(ctx context.Context, flags *IssueA2AFlags) error {
@@ -81,6 +88,16 @@ func (cmd *IssueA2ACommand) Run(ctx context.Context, flags *IssueA2AFlags) error
 		return fmt.Errorf("error validating local configuration: %w", err)
 	}
 
+	envelopeType, err := parseEnvelopeType(flags.Envelope)
+	if err != nil {
+		return err
+	}
+
+	holderKey, err := loadHolderKey(flags.HolderKey)
+	if err != nil {
+		return err
+	}
+
 	// if the mcp server url is not set, prompt the user for it interactively
 	err = cmdutil.ScanRequiredIfNotSet(
 		"Well-known URL of the A2A agent you want to sign in the badge",
@@ -120,6 +137,9 @@ func (cmd *IssueA2ACommand) Run(ctx context.Context, flags *IssueA2AFlags) error
 			Content: claims.ToMap(),
 		},
 		prvKey,
+		flags.ExpiresIn,
+		envelopeType,
+		holderKey,
 	)
 	if err != nil {
 		return fmt.Errorf("error issuing badge: %w", err)
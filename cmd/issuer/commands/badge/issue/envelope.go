@@ -0,0 +1,89 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package issue
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envelopeJose     = "jose"
+	envelopeEmbedded = "embedded"
+	envelopeCose     = "cose"
+	envelopeSdJwt    = "sd-jwt"
+)
+
+func addEnvelopeFlag(cmd *cobra.Command, envelope *string) {
+	cmd.Flags().StringVar(
+		envelope,
+		"envelope",
+		envelopeJose,
+		fmt.Sprintf(
+			"The envelope securing the badge: %s (JWT), %s (selectively disclosable JWT), %s (COSE_Sign1) "+
+				"or %s (Data Integrity proof, requires an EC or Ed25519 key)",
+			envelopeJose,
+			envelopeSdJwt,
+			envelopeCose,
+			envelopeEmbedded,
+		),
+	)
+}
+
+func parseEnvelopeType(envelope string) (vctypes.CredentialEnvelopeType, error) {
+	switch envelope {
+	case envelopeJose:
+		return vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE, nil
+	case envelopeEmbedded:
+		return vctypes.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF, nil
+	case envelopeCose:
+		return vctypes.CREDENTIAL_ENVELOPE_TYPE_COSE, nil
+	case envelopeSdJwt:
+		return vctypes.CREDENTIAL_ENVELOPE_TYPE_SD_JWT, nil
+	default:
+		return vctypes.CREDENTIAL_ENVELOPE_TYPE_UNSPECIFIED, fmt.Errorf(
+			"invalid envelope %q, expected %s, %s, %s or %s",
+			envelope,
+			envelopeJose,
+			envelopeSdJwt,
+			envelopeCose,
+			envelopeEmbedded,
+		)
+	}
+}
+
+func addHolderKeyFlag(cmd *cobra.Command, holderKey *string) {
+	cmd.Flags().StringVar(
+		holderKey,
+		"holder-key",
+		"",
+		"Path to the public JWK of the holder, SD-JWT presentations must then be signed with this key",
+	)
+}
+
+// loadHolderKey reads the holder public JWK, it returns nil if the path is not set
+func loadHolderKey(path string) (*jwk.Jwk, error) {
+	if path == "" {
+		return nil, nil //nolint:nilnil // the holder key is optional
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the holder key: %w", err)
+	}
+
+	var key jwk.Jwk
+
+	err = json.Unmarshal(data, &key)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the holder key: %w", err)
+	}
+
+	return key.PublicKey(), nil
+}
@@ -0,0 +1,22 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package issue
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// Badges are short-lived by default, non-expiring badges cannot be issued
+const defaultBadgeExpiresIn = 30 * 24 * time.Hour
+
+func addExpiresInFlag(cmd *cobra.Command, expiresIn *time.Duration) {
+	cmd.Flags().DurationVar(
+		expiresIn,
+		"expires-in",
+		defaultBadgeExpiresIn,
+		"The duration after which the badge expires (e.g. 720h)",
+	)
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	clicache "github.com/agntcy/identity/cmd/issuer/cache"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
@@ -21,6 +22,9 @@ import (
 type IssueMcpFlags struct {
 	McpServerUrl  string
 	McpServerName string
+	ExpiresIn     time.Duration
+	Envelope      string
+	HolderKey     string
 }
 
 type IssueMcpCommand struct {
@@ -69,6 +73,9 @@ func NewIssueMcpFlags() *IssueMcpFlags {
 func (f *IssueMcpFlags) AddFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&f.McpServerUrl, "url", "u", "", "The URL of the MCP server")
 	cmd.Flags().StringVarP(&f.McpServerName, "name", "n", "", "The name of the MCP server")
+	addExpiresInFlag(cmd, &f.ExpiresIn)
+	addEnvelopeFlag(cmd, &f.Envelope)
+	addHolderKeyFlag(cmd, &f.HolderKey)
 }
 
 func (cmd *IssueMcpCommand) Run(ctx context.Context, flags *IssueMcpFlags) error {
@@ -77,6 +84,16 @@ func (cmd *IssueMcpCommand) Run(ctx context.Context, flags *IssueMcpFlags) error
 		return fmt.Errorf("error validating local configuration: %w", err)
 	}
 
+	envelopeType, err := parseEnvelopeType(flags.Envelope)
+	if err != nil {
+		return err
+	}
+
+	holderKey, err := loadHolderKey(flags.HolderKey)
+	if err != nil {
+		return err
+	}
+
 	// if the mcp server url is not set, prompt the user for it interactively
 	err = cmdutil.ScanRequiredIfNotSet(
 		"URL of the MCP server you want to sign in the badge",
@@ -131,6 +148,9 @@ func (cmd *IssueMcpCommand) Run(ctx context.Context, flags *IssueMcpFlags) error
 			Content: claims.ToMap(),
 		},
 		prvKey,
+		flags.ExpiresIn,
+		envelopeType,
+		holderKey,
 	)
 	if err != nil {
 		return fmt.Errorf("error issuing badge: %w", err)
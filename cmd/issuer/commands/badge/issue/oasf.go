@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	cliCache "github.com/agntcy/identity/cmd/issuer/cache"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
@@ -17,7 +18,10 @@ import (
 )
 
 type IssueOasfFlags struct {
-	OasfPath string
+	OasfPath  string
+	ExpiresIn time.Duration
+	Envelope  string
+	HolderKey string
 }
 
 type IssueOasfCommand struct {
@@ -68,6 +72,9 @@ func (f *IssueOasfFlags) AddFlags(cmd *cobra.Command) {
 		"",
 		"The file path to the OASF you want to sign in the badge",
 	)
+	addExpiresInFlag(cmd, &f.ExpiresIn)
+	addEnvelopeFlag(cmd, &f.Envelope)
+	addHolderKeyFlag(cmd, &f.HolderKey)
 }
 
 func (cmd *IssueOasfCommand) Run(ctx context.Context, flags *IssueOasfFlags) error {
@@ -76,6 +83,16 @@ func (cmd *IssueOasfCommand) Run(ctx context.Context, flags *IssueOasfFlags) err
 		return fmt.Errorf("error validating local configuration: %w", err)
 	}
 
+	envelopeType, err := parseEnvelopeType(flags.Envelope)
+	if err != nil {
+		return err
+	}
+
+	holderKey, err := loadHolderKey(flags.HolderKey)
+	if err != nil {
+		return err
+	}
+
 	// if the file path is not set, prompt the user for it interactively
 	err = cmdutil.ScanRequiredIfNotSet(
 		"Full file path to the OASF you want to sign in the badge",
@@ -110,6 +127,9 @@ func (cmd *IssueOasfCommand) Run(ctx context.Context, flags *IssueOasfFlags) err
 			Content: claims.ToMap(),
 		},
 		prvKey,
+		flags.ExpiresIn,
+		envelopeType,
+		holderKey,
 	)
 	if err != nil {
 		return fmt.Errorf("error issuing badge: %w", err)
@@ -63,6 +63,12 @@ func (cmd *ListCommand) Run(ctx context.Context) error {
 	fmt.Fprintf(os.Stdout, "%s\n", "Existing badge ids:")
 
 	for _, badge := range badges {
+		if badge.Revoked {
+			fmt.Fprintf(os.Stdout, "- %s (revoked)\n", badge.Id)
+
+			continue
+		}
+
 		fmt.Fprintf(os.Stdout, "- %s\n", badge.Id)
 	}
 
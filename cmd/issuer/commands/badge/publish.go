@@ -11,6 +11,7 @@ import (
 	clicache "github.com/agntcy/identity/cmd/issuer/cache"
 	badgesrv "github.com/agntcy/identity/internal/issuer/badge"
 	issuersrv "github.com/agntcy/identity/internal/issuer/issuer"
+	"github.com/agntcy/identity/internal/issuer/vault"
 	"github.com/agntcy/identity/internal/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -24,12 +25,14 @@ type PublishCommand struct {
 	cache         *clicache.Cache
 	badgeService  badgesrv.BadgeService
 	issuerService issuersrv.IssuerService
+	vaultSrv      vault.VaultService
 }
 
 func NewCmdPublish(
 	cache *clicache.Cache,
 	badgeService badgesrv.BadgeService,
 	issuerService issuersrv.IssuerService,
+	vaultSrv vault.VaultService,
 ) *cobra.Command {
 	flags := NewPublishFlags()
 
@@ -41,6 +44,7 @@ func NewCmdPublish(
 				cache:         cache,
 				badgeService:  badgeService,
 				issuerService: issuerService,
+				vaultSrv:      vaultSrv,
 			}
 
 			err := c.Run(cmd.Context(), flags)
@@ -100,6 +104,12 @@ func (cmd *PublishCommand) Run(ctx context.Context, flags *PublishFlags) error {
 		return fmt.Errorf("error getting badge: %w", err)
 	}
 
+	// The key signs the badge again when its status list indexes are in use
+	prvKey, err := cmd.vaultSrv.RetrievePrivKey(ctx, cmd.cache.VaultId, cmd.cache.KeyID)
+	if err != nil {
+		return fmt.Errorf("error retrieving private key: %w", err)
+	}
+
 	_, err = cmd.badgeService.PublishBadge(
 		ctx,
 		cmd.cache.VaultId,
@@ -107,6 +117,7 @@ func (cmd *PublishCommand) Run(ctx context.Context, flags *PublishFlags) error {
 		cmd.cache.IssuerId,
 		cmd.cache.MetadataId,
 		badge,
+		prvKey,
 		&flags.IdentityNodeURL,
 	)
 	if err != nil {
@@ -0,0 +1,125 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package badge
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	clicache "github.com/agntcy/identity/cmd/issuer/cache"
+	badgesrv "github.com/agntcy/identity/internal/issuer/badge"
+	"github.com/agntcy/identity/internal/issuer/vault"
+	"github.com/agntcy/identity/internal/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+type RevokeFlags struct {
+	IdentityNodeURL string
+	BadgeID         string
+}
+
+type RevokeCommand struct {
+	cache        *clicache.Cache
+	badgeService badgesrv.BadgeService
+	vaultSrv     vault.VaultService
+}
+
+func NewCmdRevoke(
+	cache *clicache.Cache,
+	badgeService badgesrv.BadgeService,
+	vaultSrv vault.VaultService,
+) *cobra.Command {
+	flags := NewRevokeFlags()
+
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke the chosen published badge",
+		Run: func(cmd *cobra.Command, args []string) {
+			c := RevokeCommand{
+				cache:        cache,
+				badgeService: badgeService,
+				vaultSrv:     vaultSrv,
+			}
+
+			err := c.Run(cmd.Context(), flags)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	flags.AddFlags(cmd)
+
+	return cmd
+}
+
+func NewRevokeFlags() *RevokeFlags {
+	return &RevokeFlags{}
+}
+
+func (f *RevokeFlags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().
+		StringVarP(&f.IdentityNodeURL, "identity-node-address", "i", "",
+			"Use a different Identity node than the Issuer's default")
+	cmd.Flags().StringVarP(&f.BadgeID, "badge-id", "b", "", "The ID of the badge to revoke")
+}
+
+func (cmd *RevokeCommand) Run(ctx context.Context, flags *RevokeFlags) error {
+	err := cmd.cache.ValidateForBadge()
+	if err != nil {
+		return fmt.Errorf("error validating local configuration: %w", err)
+	}
+
+	// if the badge id is not set, prompt the user for it interactively
+	// if there is a badge id in the cache, use it as the default when prompting
+	if cmd.cache.BadgeId != "" {
+		err = cmdutil.ScanWithDefaultIfNotSet(
+			"Badge ID to revoke",
+			cmd.cache.BadgeId,
+			&flags.BadgeID,
+		)
+	} else {
+		err = cmdutil.ScanRequiredIfNotSet("Badge ID to revoke", &flags.BadgeID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading badge ID: %w", err)
+	}
+
+	badge, err := cmd.badgeService.GetBadge(
+		cmd.cache.VaultId,
+		cmd.cache.KeyID,
+		cmd.cache.IssuerId,
+		cmd.cache.MetadataId,
+		flags.BadgeID,
+	)
+	if err != nil {
+		return fmt.Errorf("error getting badge: %w", err)
+	}
+
+	prvKey, err := cmd.vaultSrv.RetrievePrivKey(ctx, cmd.cache.VaultId, cmd.cache.KeyID)
+	if err != nil {
+		return fmt.Errorf("error retrieving private key: %w", err)
+	}
+
+	_, err = cmd.badgeService.RevokeBadge(
+		ctx,
+		cmd.cache.VaultId,
+		cmd.cache.KeyID,
+		cmd.cache.IssuerId,
+		cmd.cache.MetadataId,
+		badge,
+		prvKey,
+		&flags.IdentityNodeURL,
+	)
+	if err != nil {
+		return fmt.Errorf("error revoking badge: %w", err)
+	}
+
+	fmt.Fprintf(os.Stdout, "Revoked the badge %s\n", flags.BadgeID)
+
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/agntcy/identity/internal/issuer/vault"
 	"github.com/agntcy/identity/internal/pkg/cmdutil"
 	"github.com/agntcy/identity/internal/pkg/httputil"
+	"github.com/agntcy/identity/pkg/oidc"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 )
@@ -29,6 +30,10 @@ type RegisterFlags struct {
 	ClientID        string
 	ClientSecret    string
 	IssuerURL       string
+	AuthMethod      string
+	ClientKeyID     string
+	ClientCertFile  string
+	ClientKeyFile   string
 	CommonName      string // Self provided common name (e.g., url, email, etc.)
 	Organization    string
 	SubOrganization string
@@ -83,6 +88,13 @@ func (f *RegisterFlags) AddFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&f.ClientID, "idp-client-id", "c", "", "IdP client ID")
 	cmd.Flags().StringVarP(&f.ClientSecret, "idp-client-secret", "s", "", "IdP client secret")
 	cmd.Flags().StringVarP(&f.IssuerURL, "idp-issuer-url", "u", "", "IdP issuer URL")
+	cmd.Flags().StringVar(&f.AuthMethod, "idp-auth-method", string(oidc.ClientAuthMethodSecret),
+		"IdP client authentication: client_secret, private_key_jwt or tls_client_auth")
+	cmd.Flags().StringVar(&f.ClientKeyID, "idp-client-key-id", "",
+		"ID of the vault key dedicated to signing the private_key_jwt assertions")
+	cmd.Flags().StringVar(&f.ClientCertFile, "idp-client-cert", "", "IdP client certificate file for tls_client_auth")
+	cmd.Flags().StringVar(&f.ClientKeyFile, "idp-client-cert-key", "",
+		"IdP client certificate key file for tls_client_auth")
 	cmd.Flags().StringVarP(&f.Organization, "organization", "o", "", "Organization name")
 	cmd.Flags().StringVarP(&f.SubOrganization, "sub-organization", "b", "", "Sub-organization name")
 }
@@ -106,9 +118,13 @@ func (cmd *RegisterCommand) Run(ctx context.Context, flags *RegisterFlags) error
 	// If the common name is not set, use the IdP configuration
 	if flags.CommonName == "" {
 		idpConfig = &idptypes.IdpConfig{
-			ClientId:     flags.ClientID,
-			ClientSecret: flags.ClientSecret,
-			IssuerUrl:    flags.IssuerURL,
+			ClientId:       flags.ClientID,
+			ClientSecret:   flags.ClientSecret,
+			IssuerUrl:      flags.IssuerURL,
+			AuthMethod:     flags.AuthMethod,
+			ClientKeyId:    flags.ClientKeyID,
+			ClientCertFile: flags.ClientCertFile,
+			ClientKeyFile:  flags.ClientKeyFile,
 		}
 
 		// extract the root url from the issuer URL as the common name
@@ -197,7 +213,7 @@ func (cmd *RegisterCommand) validateFlags(flags *RegisterFlags) error {
 		}
 	}
 
-	// if self provided common name is not set, ask for IdP client ID, secret, and issuer URL
+	// if self provided common name is not set, ask for IdP client ID, credentials, and issuer URL
 	if flags.CommonName == "" {
 		err := cmd.scanIdpFlags(flags)
 		if err != nil {
@@ -225,7 +241,8 @@ func (cmd *RegisterCommand) validateFlags(flags *RegisterFlags) error {
 }
 
 func (cmd *RegisterCommand) noIdpFlagsSet(flags *RegisterFlags) bool {
-	return flags.ClientID == "" && flags.ClientSecret == "" && flags.IssuerURL == ""
+	return flags.ClientID == "" && flags.ClientSecret == "" && flags.IssuerURL == "" &&
+		flags.ClientKeyID == "" && flags.ClientCertFile == "" && flags.ClientKeyFile == ""
 }
 
 func (cmd *RegisterCommand) scanIdpFlags(flags *RegisterFlags) error {
@@ -235,10 +252,16 @@ func (cmd *RegisterCommand) scanIdpFlags(flags *RegisterFlags) error {
 		return fmt.Errorf("error reading IdP client ID: %w", err)
 	}
 
-	// if the client secret is not set, prompt the user for it interactively
-	err = cmdutil.ScanRequiredIfNotSet("IdP client secret", &flags.ClientSecret)
+	// prompt the user for the credentials of the authentication method
+	err = cmdutil.ScanIdpCredentials(cmdutil.IdpCredentials{
+		AuthMethod:     flags.AuthMethod,
+		ClientSecret:   &flags.ClientSecret,
+		ClientKeyID:    &flags.ClientKeyID,
+		ClientCertFile: &flags.ClientCertFile,
+		ClientKeyFile:  &flags.ClientKeyFile,
+	})
 	if err != nil {
-		return fmt.Errorf("error reading IdP client secret: %w", err)
+		return err
 	}
 
 	// if the issuer URL is not set, prompt the user for it interactively
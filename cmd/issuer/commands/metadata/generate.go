@@ -15,13 +15,18 @@ import (
 	mdsrv "github.com/agntcy/identity/internal/issuer/metadata"
 	issuerTypes "github.com/agntcy/identity/internal/issuer/types"
 	"github.com/agntcy/identity/internal/pkg/cmdutil"
+	"github.com/agntcy/identity/pkg/oidc"
 )
 
 type GenerateFlags struct {
-	IdentityNodeURL string
-	IdpClientID     string
-	IdpClientSecret string
-	IdpIssuerURL    string
+	IdentityNodeURL   string
+	IdpClientID       string
+	IdpClientSecret   string
+	IdpIssuerURL      string
+	IdpAuthMethod     string
+	IdpClientKeyID    string
+	IdpClientCertFile string
+	IdpClientKeyFile  string
 }
 
 type GenerateCommand struct {
@@ -71,6 +76,13 @@ func (f *GenerateFlags) AddFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&f.IdpClientID, "idp-client-id", "c", "", "IDP Client ID")
 	cmd.Flags().StringVarP(&f.IdpClientSecret, "idp-client-secret", "s", "", "IDP Client Secret")
 	cmd.Flags().StringVarP(&f.IdpIssuerURL, "idp-issuer-url", "u", "", "IDP Issuer URL")
+	cmd.Flags().StringVar(&f.IdpAuthMethod, "idp-auth-method", string(oidc.ClientAuthMethodSecret),
+		"IDP client authentication: client_secret, private_key_jwt or tls_client_auth")
+	cmd.Flags().StringVar(&f.IdpClientKeyID, "idp-client-key-id", "",
+		"ID of the vault key dedicated to signing the private_key_jwt assertions")
+	cmd.Flags().StringVar(&f.IdpClientCertFile, "idp-client-cert", "", "IDP client certificate file for tls_client_auth")
+	cmd.Flags().StringVar(&f.IdpClientKeyFile, "idp-client-cert-key", "",
+		"IDP client certificate key file for tls_client_auth")
 }
 
 func (cmd *GenerateCommand) Run(ctx context.Context, flags *GenerateFlags) error {
@@ -99,10 +111,16 @@ func (cmd *GenerateCommand) Run(ctx context.Context, flags *GenerateFlags) error
 			return fmt.Errorf("error reading IDP Client ID: %w", err)
 		}
 
-		// if the idp client secret is not set, prompt the user for it interactively
-		err = cmdutil.ScanRequiredIfNotSet("IDP Client Secret", &flags.IdpClientSecret)
+		// prompt the user for the credentials of the authentication method
+		err = cmdutil.ScanIdpCredentials(cmdutil.IdpCredentials{
+			AuthMethod:     flags.IdpAuthMethod,
+			ClientSecret:   &flags.IdpClientSecret,
+			ClientKeyID:    &flags.IdpClientKeyID,
+			ClientCertFile: &flags.IdpClientCertFile,
+			ClientKeyFile:  &flags.IdpClientKeyFile,
+		})
 		if err != nil {
-			return fmt.Errorf("error reading IDP Client Secret: %w", err)
+			return err
 		}
 
 		// if the idp issuer url is not set, prompt the user for it interactively
@@ -112,9 +130,13 @@ func (cmd *GenerateCommand) Run(ctx context.Context, flags *GenerateFlags) error
 		}
 
 		idpConfig = &issuerTypes.IdpConfig{
-			ClientId:     flags.IdpClientID,
-			ClientSecret: flags.IdpClientSecret,
-			IssuerUrl:    flags.IdpIssuerURL,
+			ClientId:       flags.IdpClientID,
+			ClientSecret:   flags.IdpClientSecret,
+			IssuerUrl:      flags.IdpIssuerURL,
+			AuthMethod:     flags.IdpAuthMethod,
+			ClientKeyId:    flags.IdpClientKeyID,
+			ClientCertFile: flags.IdpClientCertFile,
+			ClientKeyFile:  flags.IdpClientKeyFile,
 		}
 	}
 
@@ -0,0 +1,149 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package present
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	clicache "github.com/agntcy/identity/cmd/issuer/cache"
+	"github.com/agntcy/identity/internal/core/vc/presentation"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	badgesrv "github.com/agntcy/identity/internal/issuer/badge"
+	vaultsrv "github.com/agntcy/identity/internal/issuer/vault"
+	"github.com/agntcy/identity/internal/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+type PresentFlags struct {
+	BadgeIDs []string
+	Nonce    string
+	Audience string
+}
+
+type PresentCommand struct {
+	cache        *clicache.Cache
+	badgeService badgesrv.BadgeService
+	vaultSrv     vaultsrv.VaultService
+}
+
+func NewCmd(
+	cache *clicache.Cache,
+	badgeService badgesrv.BadgeService,
+	vaultSrv vaultsrv.VaultService,
+) *cobra.Command {
+	flags := NewPresentFlags()
+
+	cmd := &cobra.Command{
+		Use:   "present",
+		Short: "Wrap one or more local badges in a Verifiable Presentation signed by the holder key",
+		Run: func(cmd *cobra.Command, args []string) {
+			c := PresentCommand{
+				cache:        cache,
+				badgeService: badgeService,
+				vaultSrv:     vaultSrv,
+			}
+
+			err := c.Run(cmd.Context(), flags)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	flags.AddFlags(cmd)
+
+	return cmd
+}
+
+func NewPresentFlags() *PresentFlags {
+	return &PresentFlags{}
+}
+
+func (f *PresentFlags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVarP(
+		&f.BadgeIDs,
+		"badge-ids",
+		"b",
+		nil,
+		"The IDs of the badges to present (defaults to the badge in the local configuration)",
+	)
+	cmd.Flags().StringVarP(&f.Nonce, "nonce", "n", "", "The nonce provided by the verifier")
+	cmd.Flags().StringVarP(&f.Audience, "audience", "a", "", "The intended verifier of the presentation")
+}
+
+func (cmd *PresentCommand) Run(ctx context.Context, flags *PresentFlags) error {
+	err := cmd.cache.ValidateForBadge()
+	if err != nil {
+		return fmt.Errorf("error validating local configuration: %w", err)
+	}
+
+	if len(flags.BadgeIDs) == 0 {
+		if cmd.cache.BadgeId == "" {
+			return errors.New("no badge to present, please set the badge IDs")
+		}
+
+		flags.BadgeIDs = []string{cmd.cache.BadgeId}
+	}
+
+	// if the nonce is not set, prompt the user for it interactively
+	err = cmdutil.ScanRequiredIfNotSet("Nonce provided by the verifier", &flags.Nonce)
+	if err != nil {
+		return fmt.Errorf("error reading nonce: %w", err)
+	}
+
+	credentials := make([]*vctypes.EnvelopedCredential, 0, len(flags.BadgeIDs))
+
+	for _, badgeID := range flags.BadgeIDs {
+		badge, err := cmd.badgeService.GetBadge(
+			cmd.cache.VaultId,
+			cmd.cache.KeyID,
+			cmd.cache.IssuerId,
+			cmd.cache.MetadataId,
+			badgeID,
+		)
+		if err != nil {
+			return fmt.Errorf("error getting badge %s: %w", badgeID, err)
+		}
+
+		if badge.EnvelopedCredential == nil {
+			return fmt.Errorf("the badge %s has no enveloped credential", badgeID)
+		}
+
+		credentials = append(credentials, badge.EnvelopedCredential)
+	}
+
+	// The badges are issued for the metadata ID, the holder signs
+	// the presentation with the key of the metadata
+	prvKey, err := cmd.vaultSrv.RetrievePrivKey(ctx, cmd.cache.VaultId, cmd.cache.KeyID)
+	if err != nil {
+		return fmt.Errorf("error retrieving private key: %w", err)
+	}
+
+	vp, err := presentation.Create(
+		cmd.cache.MetadataId,
+		credentials,
+		prvKey,
+		&presentation.Challenge{
+			Nonce:    flags.Nonce,
+			Audience: flags.Audience,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error creating presentation: %w", err)
+	}
+
+	vpJSON, err := json.MarshalIndent(vp, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling presentation to JSON: %w", err)
+	}
+
+	fmt.Fprintf(os.Stdout, "%s\n", string(vpJSON))
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	clicache "github.com/agntcy/identity/cmd/issuer/cache"
 	"github.com/agntcy/identity/cmd/issuer/commands/vault/connect"
 	"github.com/agntcy/identity/cmd/issuer/commands/vault/key"
+	issuersrv "github.com/agntcy/identity/internal/issuer/issuer"
 	vaultsrv "github.com/agntcy/identity/internal/issuer/vault"
 	"github.com/spf13/cobra"
 )
@@ -14,6 +15,7 @@ import (
 func NewCmd(
 	cache *clicache.Cache,
 	vaultService vaultsrv.VaultService,
+	issuerService issuersrv.IssuerService,
 ) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "vault",
@@ -28,7 +30,7 @@ The vault command is used to configure and manage your vaults.
 	cmd.AddCommand(NewCmdShow(vaultService))
 	cmd.AddCommand(NewCmdForget(vaultService))
 	cmd.AddCommand(NewCmdLoad(vaultService))
-	cmd.AddCommand(key.NewCmd(cache, vaultService))
+	cmd.AddCommand(key.NewCmd(cache, vaultService, issuerService))
 
 	return cmd
 }
@@ -5,6 +5,7 @@ package key
 
 import (
 	clicache "github.com/agntcy/identity/cmd/issuer/cache"
+	issuersrv "github.com/agntcy/identity/internal/issuer/issuer"
 	vaultsrv "github.com/agntcy/identity/internal/issuer/vault"
 	"github.com/spf13/cobra"
 )
@@ -12,6 +13,7 @@ import (
 func NewCmd(
 	cache *clicache.Cache,
 	vaultService vaultsrv.VaultService,
+	issuerService issuersrv.IssuerService,
 ) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "key",
@@ -25,6 +27,7 @@ The keys command is used to generate and manage cryptographic keys for in your v
 	cmd.AddCommand(NewCmdList(cache, vaultService))
 	cmd.AddCommand(NewCmdShow(cache, vaultService))
 	cmd.AddCommand(NewCmdLoad(cache, vaultService))
+	cmd.AddCommand(NewCmdRotate(cache, vaultService, issuerService))
 
 	return cmd
 }
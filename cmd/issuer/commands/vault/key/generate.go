@@ -15,6 +15,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const defaultKeyAlgorithm = "RS256"
+
+type GenerateFlags struct {
+	Algorithm string
+}
+
 type GenerateCommand struct {
 	cache        *clicache.Cache
 	vaultService vaultsrv.VaultService
@@ -24,7 +30,9 @@ func NewCmdGenerate(
 	cache *clicache.Cache,
 	vaultService vaultsrv.VaultService,
 ) *cobra.Command {
-	return &cobra.Command{
+	flags := NewGenerateFlags()
+
+	cmd := &cobra.Command{
 		Use:   "generate",
 		Short: "Generate a new cryptographic key for the vault",
 		Run: func(cmd *cobra.Command, args []string) {
@@ -33,16 +41,34 @@ func NewCmdGenerate(
 				vaultService: vaultService,
 			}
 
-			err := c.Run(cmd.Context())
+			err := c.Run(cmd.Context(), flags)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "%v\n", err)
 				os.Exit(1)
 			}
 		},
 	}
+
+	flags.AddFlags(cmd)
+
+	return cmd
+}
+
+func NewGenerateFlags() *GenerateFlags {
+	return &GenerateFlags{}
+}
+
+func (f *GenerateFlags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(
+		&f.Algorithm,
+		"alg",
+		"a",
+		defaultKeyAlgorithm,
+		"The signing algorithm of the key (RS256, RS384, RS512, ES256, ES384, ES512, EdDSA, ML-DSA-44, ML-DSA-65, ML-DSA-87)",
+	)
 }
 
-func (cmd *GenerateCommand) Run(ctx context.Context) error {
+func (cmd *GenerateCommand) Run(ctx context.Context, flags *GenerateFlags) error {
 	err := cmd.cache.ValidateForKey()
 	if err != nil {
 		return fmt.Errorf("error validating local configuration: %w", err)
@@ -60,7 +86,7 @@ func (cmd *GenerateCommand) Run(ctx context.Context) error {
 
 	keyId := uuid.NewString()
 
-	priv, err := joseutil.GenerateJWK("RS256", "sig", keyId)
+	priv, err := joseutil.GenerateJWK(flags.Algorithm, "sig", keyId)
 	if err != nil {
 		return fmt.Errorf("error generating JWK: %w", err)
 	}
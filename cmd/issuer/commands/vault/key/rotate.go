@@ -0,0 +1,191 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package key
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	clicache "github.com/agntcy/identity/cmd/issuer/cache"
+	issuersrv "github.com/agntcy/identity/internal/issuer/issuer"
+	issuertypes "github.com/agntcy/identity/internal/issuer/issuer/types"
+	vaultsrv "github.com/agntcy/identity/internal/issuer/vault"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+type RotateFlags struct {
+	Algorithm         string
+	RetireImmediately bool
+}
+
+type RotateCommand struct {
+	cache         *clicache.Cache
+	vaultService  vaultsrv.VaultService
+	issuerService issuersrv.IssuerService
+}
+
+func NewCmdRotate(
+	cache *clicache.Cache,
+	vaultService vaultsrv.VaultService,
+	issuerService issuersrv.IssuerService,
+) *cobra.Command {
+	flags := NewRotateFlags()
+
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Replace the current key with a new key for all the issuers using it",
+		Run: func(cmd *cobra.Command, args []string) {
+			c := RotateCommand{
+				cache:         cache,
+				vaultService:  vaultService,
+				issuerService: issuerService,
+			}
+
+			err := c.Run(cmd.Context(), flags)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	flags.AddFlags(cmd)
+
+	return cmd
+}
+
+func NewRotateFlags() *RotateFlags {
+	return &RotateFlags{}
+}
+
+func (f *RotateFlags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(
+		&f.Algorithm,
+		"alg",
+		"a",
+		"",
+		"The signing algorithm of the new key (defaults to the algorithm of the current key)",
+	)
+	cmd.Flags().BoolVar(
+		&f.RetireImmediately,
+		"retire-immediately",
+		false,
+		"Retire the current key immediately, for instance when it is compromised",
+	)
+}
+
+func (cmd *RotateCommand) Run(ctx context.Context, flags *RotateFlags) error {
+	err := cmd.cache.ValidateForKey()
+	if err != nil {
+		return fmt.Errorf("error validating local configuration: %w", err)
+	}
+
+	issuers, err := cmd.issuerService.GetAllIssuers(cmd.cache.VaultId, cmd.cache.KeyID)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error listing issuers: %w", err)
+	}
+
+	if len(issuers) == 0 {
+		return errors.New("no issuer uses the current key, generate a new key instead")
+	}
+
+	currentKey, err := cmd.vaultService.RetrievePrivKey(ctx, cmd.cache.VaultId, cmd.cache.KeyID)
+	if err != nil {
+		return fmt.Errorf("error retrieving the current key: %w", err)
+	}
+
+	if flags.Algorithm == "" {
+		flags.Algorithm = currentKey.ALG
+	}
+
+	vault, err := cmd.vaultService.GetVault(cmd.cache.VaultId)
+	if err != nil {
+		return fmt.Errorf("error getting vault: %w", err)
+	}
+
+	service, err := newKeyService(vault)
+	if err != nil {
+		return fmt.Errorf("error creating key service: %w", err)
+	}
+
+	newKeyId := uuid.NewString()
+
+	priv, err := joseutil.GenerateJWK(flags.Algorithm, "sig", newKeyId)
+	if err != nil {
+		return fmt.Errorf("error generating JWK: %w", err)
+	}
+
+	err = service.SaveKey(ctx, priv.KID, priv)
+	if err != nil {
+		return fmt.Errorf("error saving key: %w", err)
+	}
+
+	// The requests to the Identity nodes are signed with the current key
+	for idx, issuer := range issuers {
+		err = cmd.issuerService.RotateKey(
+			ctx,
+			cmd.cache.VaultId,
+			cmd.cache.KeyID,
+			issuer,
+			priv.PublicKey(),
+			flags.RetireImmediately,
+		)
+		if err != nil {
+			return cmd.stopRotation(issuers[:idx], issuer.ID, newKeyId, err)
+		}
+
+		fmt.Fprintf(os.Stdout, "Rotated the key of the issuer %s\n", issuer.ID)
+	}
+
+	err = cmd.issuerService.MoveIssuers(cmd.cache.VaultId, cmd.cache.KeyID, newKeyId)
+	if err != nil {
+		return fmt.Errorf("error moving the issuers to the new key: %w", err)
+	}
+
+	fmt.Fprintf(os.Stdout, "Successfully rotated to the key with ID: %s\n", newKeyId)
+
+	cmd.cache.KeyID = newKeyId
+
+	err = clicache.SaveCache(cmd.cache)
+	if err != nil {
+		return fmt.Errorf("error saving local configuration: %w", err)
+	}
+
+	return nil
+}
+
+// stopRotation stops a rotation failing for an issuer. The issuers already rotated
+// are moved to the new key since the Identity nodes expect their requests to be
+// signed by it, the failed and remaining issuers keep the current key
+// and can be rotated by running the command again.
+func (cmd *RotateCommand) stopRotation(
+	rotated []*issuertypes.Issuer,
+	failedIssuerId, newKeyId string,
+	err error,
+) error {
+	err = fmt.Errorf(
+		"error rotating the key of the issuer %s to the new key %s: %w",
+		failedIssuerId,
+		newKeyId,
+		err,
+	)
+
+	for _, issuer := range rotated {
+		moveErr := cmd.issuerService.MoveIssuer(cmd.cache.VaultId, cmd.cache.KeyID, newKeyId, issuer.ID)
+		if moveErr != nil {
+			return errors.Join(
+				err,
+				fmt.Errorf("error moving the rotated issuer %s to the new key: %w", issuer.ID, moveErr),
+			)
+		}
+
+		fmt.Fprintf(os.Stdout, "Moved the rotated issuer %s to the key with ID: %s\n", issuer.ID, newKeyId)
+	}
+
+	return err
+}
@@ -23,6 +23,7 @@ const (
 type VerifyFlags struct {
 	IdentityNodeURL string
 	BadgeFilePath   string
+	CheckInclusion  bool
 }
 
 type VerifyCommand struct {
@@ -61,6 +62,12 @@ func (f *VerifyFlags) AddFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&f.BadgeFilePath, "file", "f", "", "Path to the badge file")
 	cmd.Flags().
 		StringVarP(&f.IdentityNodeURL, "identity-node-address", "i", "", "Identity node address")
+	cmd.Flags().BoolVar(
+		&f.CheckInclusion,
+		"check-inclusion",
+		false,
+		"Verify that the badge is recorded in the transparency log of the node",
+	)
 }
 
 func (cmd *VerifyCommand) Run(ctx context.Context, flags *VerifyFlags) error {
@@ -92,7 +99,12 @@ func (cmd *VerifyCommand) Run(ctx context.Context, flags *VerifyFlags) error {
 			continue
 		}
 
-		verifiedVC, err := cmd.verifyService.VerifyCredential(ctx, envelopedCredential, flags.IdentityNodeURL)
+		verifiedVC, err := cmd.verifyService.VerifyCredential(
+			ctx,
+			envelopedCredential,
+			flags.IdentityNodeURL,
+			flags.CheckInclusion,
+		)
 		if err != nil {
 			return err
 		}
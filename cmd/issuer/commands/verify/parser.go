@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"iter"
 	"os"
+	"slices"
 
 	v1alphaclient "github.com/agntcy/identity/api/client/models"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
@@ -16,6 +17,13 @@ import (
 
 var errUnsupportedFileFormat = errors.New("unsupported badge file format")
 
+var supportedEnvelopeTypes = []vctypes.CredentialEnvelopeType{
+	vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE,
+	vctypes.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF,
+	vctypes.CREDENTIAL_ENVELOPE_TYPE_COSE,
+	vctypes.CREDENTIAL_ENVELOPE_TYPE_SD_JWT,
+}
+
 var fileParsers = []func(data []byte) ([]*vctypes.EnvelopedCredential, error){
 	parseAsVcWellKnownResponse,
 	parseAsVcList,
@@ -60,7 +68,7 @@ func readBadgesFromFile(path string) (iter.Seq2[*vctypes.EnvelopedCredential, er
 
 	return func(yield func(*vctypes.EnvelopedCredential, error) bool) {
 		for _, vc := range vcs {
-			if vc.EnvelopeType != vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE {
+			if !slices.Contains(supportedEnvelopeTypes, vc.EnvelopeType) {
 				if !yield(nil, fmt.Errorf("skipping unsupported envelope type: %s", vc.EnvelopeType)) {
 					return
 				}
@@ -12,6 +12,7 @@ import (
 	configcmd "github.com/agntcy/identity/cmd/issuer/commands/configuration"
 	issuercmd "github.com/agntcy/identity/cmd/issuer/commands/issuer"
 	mdcmd "github.com/agntcy/identity/cmd/issuer/commands/metadata"
+	presentcmd "github.com/agntcy/identity/cmd/issuer/commands/present"
 	vaultcmd "github.com/agntcy/identity/cmd/issuer/commands/vault"
 	verifycmd "github.com/agntcy/identity/cmd/issuer/commands/verify"
 	versioncmd "github.com/agntcy/identity/cmd/issuer/commands/version"
@@ -33,6 +34,19 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// The environment variables configuring the connection to the Identity Nodes
+const (
+	// The API key sent to the Identity Nodes requiring one
+	nodeAuthEnv = "IDENTITY_NODE_API_KEY"
+
+	// The CA certificates verifying the Identity Nodes, the system CAs are used when empty
+	nodeCaFileEnv = "IDENTITY_NODE_CA_FILE"
+
+	// The client certificate and key presented to the Identity Nodes requiring one
+	nodeCertFileEnv = "IDENTITY_NODE_CLIENT_CERT_FILE"
+	nodeKeyFileEnv  = "IDENTITY_NODE_CLIENT_KEY_FILE"
+)
+
 func main() {
 	// rootCmd represents the base command when called without any subcommands
 	//nolint:lll // Allow long lines for CLI
@@ -65,7 +79,14 @@ The Identity CLI tool is a command line interface for generating, publishing and
 	// Initialize clients
 	a2aClient := a2a.NewDiscoveryClient()
 	mcpClient := mcp.NewDiscoveryClient()
-	nodeClientPrv := nodeapi.NewNodeClientProvider()
+	nodeClientPrv := nodeapi.NewNodeClientProvider(
+		nodeapi.WithApiKey(os.Getenv(nodeAuthEnv)),
+		nodeapi.WithTLS(
+			os.Getenv(nodeCaFileEnv),
+			os.Getenv(nodeCertFileEnv),
+			os.Getenv(nodeKeyFileEnv),
+		),
+	)
 
 	oidcAuth := oidc.NewAuthenticator()
 
@@ -95,7 +116,7 @@ The Identity CLI tool is a command line interface for generating, publishing and
 	)
 	verifyService := verify.NewVerifyService(nodeClientPrv)
 
-	rootCmd.AddCommand(vaultcmd.NewCmd(cache, vaultService))
+	rootCmd.AddCommand(vaultcmd.NewCmd(cache, vaultService, issuerService))
 	rootCmd.AddCommand(issuercmd.NewCmd(cache, issuerService, vaultService))
 	rootCmd.AddCommand(mdcmd.NewCmd(cache, metadataService, issuerService))
 	rootCmd.AddCommand(badgecmd.NewCmd(
@@ -106,6 +127,7 @@ The Identity CLI tool is a command line interface for generating, publishing and
 		a2aClient,
 		mcpClient,
 	))
+	rootCmd.AddCommand(presentcmd.NewCmd(cache, badgeService, vaultService))
 	rootCmd.AddCommand(verifycmd.NewCmd(verifyService))
 	rootCmd.AddCommand(configcmd.NewCmd(
 		cache,
@@ -13,11 +13,13 @@ type Configuration struct {
 	ServerGrpcHost                                          string        `split_words:"true" default:":4001"`
 	GoEnv                                                   string        `split_words:"true" default:"production"`
 	LogLevel                                                string        `split_words:"true" default:"InfoLevel"`
-	DbHost                                                  string        `split_words:"true"                                 required:"true"`
-	DbPort                                                  string        `split_words:"true"                                 required:"true"`
+	DbDriver                                                string        `split_words:"true" default:"postgres"`
+	DbFile                                                  string        `split_words:"true"`
+	DbHost                                                  string        `split_words:"true"`
+	DbPort                                                  string        `split_words:"true"`
 	DbName                                                  string        `split_words:"true" default:"identity"`
-	DbUsername                                              string        `split_words:"true"                                 required:"true"`
-	DbPassword                                              string        `split_words:"true"                                 required:"true"`
+	DbUsername                                              string        `split_words:"true"`
+	DbPassword                                              string        `split_words:"true"`
 	DbUseSsl                                                bool          `split_words:"true" default:"false"`
 	ServerGrpcKeepAliveEnvorcementPolicyMinTime             int           `split_words:"true" default:"300"`
 	ServerGrpcKeepAliveEnvorcementPolicyPermitWithoutStream bool          `split_words:"true" default:"false"`
@@ -32,4 +34,37 @@ type Configuration struct {
 	HttpServerReadTimeout                                   int           `split_words:"true" default:"100"`
 	HttpServerReadHeaderTimeout                             int           `split_words:"true" default:"100"`
 	DefaultCallTimeout                                      time.Duration `split_words:"true" default:"10000ms"`
+	ApiUrl                                                  string        `split_words:"true" default:"http://localhost:4000"`
+	StatusListSigningKey                                    string        `split_words:"true"`
+	StatusListTtl                                           time.Duration `split_words:"true" default:"5m"`
+	IssuerKeyRetirementPeriod                               time.Duration `split_words:"true" default:"720h"`
+	CredentialMaxValidity                                   time.Duration `split_words:"true" default:"8760h"`
+	TransparencyLogSigningKey                               string        `split_words:"true"`
+	TransparencyLogCachedLeaves                             int           `split_words:"true" default:"1048576"`
+	ServerMaxMessageSize                                    int           `split_words:"true" default:"4194304"`
+	ServerMaxFieldSize                                      int           `split_words:"true" default:"1048576"`
+	ServerMaxListSize                                       int           `split_words:"true" default:"1000"`
+	RateLimitIp                                             float64       `split_words:"true" default:"20"`
+	RateLimitIpBurst                                        int           `split_words:"true" default:"40"`
+	RateLimitIssuer                                         float64       `split_words:"true" default:"5"`
+	RateLimitIssuerBurst                                    int           `split_words:"true" default:"10"`
+	TrustedProxies                                          []string      `split_words:"true"`
+	AuthMode                                                string        `split_words:"true" default:"none"`
+	ApiKeys                                                 []string      `split_words:"true"`
+	TlsCertFile                                             string        `split_words:"true"`
+	TlsKeyFile                                              string        `split_words:"true"`
+	TlsCaFile                                               string        `split_words:"true"`
+	TlsClientAuth                                           string        `split_words:"true" default:"none"`
+	OidcProvidersFile                                       string        `split_words:"true"`
+	TrustPolicyFile                                         string        `split_words:"true"`
+	ProofAudience                                           []string      `split_words:"true"`
+	ProofProviderAudiences                                  []string      `split_words:"true"`
+	ProofMaxAge                                             time.Duration `split_words:"true" default:"5m"`
+	ProofReplayProtection                                   bool          `split_words:"true" default:"true"`
+	EventRetention                                          time.Duration `split_words:"true" default:"168h"`
+	WebhookUrls                                             []string      `split_words:"true"`
+	WebhookSecret                                           string        `split_words:"true"`
+	WebhookSigningKey                                       string        `split_words:"true"`
+	WebhookMaxAttempts                                      int           `split_words:"true" default:"10"`
+	WebhookTimeout                                          time.Duration `split_words:"true" default:"10s"`
 }
@@ -5,43 +5,54 @@ package main
 
 import (
 	"context"
-	"math"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
 	"time"
 
 	identityapi "github.com/agntcy/identity/api/server"
-	idpg "github.com/agntcy/identity/internal/core/id/postgres"
-	issuerpg "github.com/agntcy/identity/internal/core/issuer/postgres"
 	"github.com/agntcy/identity/internal/core/issuer/verification"
-	vcpg "github.com/agntcy/identity/internal/core/vc/postgres"
 	issuergrpc "github.com/agntcy/identity/internal/issuer/grpc"
 	"github.com/agntcy/identity/internal/node"
+	"github.com/agntcy/identity/internal/node/didresolver"
 	nodegrpc "github.com/agntcy/identity/internal/node/grpc"
+	"github.com/agntcy/identity/internal/node/interceptor"
 	"github.com/agntcy/identity/internal/pkg/grpcutil"
+	"github.com/agntcy/identity/internal/pkg/metrics"
 	"github.com/agntcy/identity/pkg/cmd"
-	"github.com/agntcy/identity/pkg/db"
 	"github.com/agntcy/identity/pkg/grpcserver"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/jwk"
 	"github.com/agntcy/identity/pkg/log"
 	"github.com/agntcy/identity/pkg/oidc"
+	"github.com/agntcy/identity/pkg/tlsutil"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
 )
 
-// ------------------------ GLOBAL -------------------- //
+const (
+	// The environment of the Node allowing the shortcuts of development
+	developmentEnv = "development"
 
-var maxMsgSize = math.MaxInt64
+	// The path of the Prometheus metrics endpoint
+	metricsPath = "/metrics"
 
-// ------------------------ GLOBAL -------------------- //
+	// The path of the stream of the credential events served by the gateway
+	watchPath = "/v1alpha1/vc/watch"
+)
 
-//nolint:funlen // Ignore linting for main function
+//nolint:funlen,cyclop,maintidx // Ignore linting for main function
 func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -54,8 +65,21 @@ func main() {
 	log.Init(config.GoEnv)
 	log.SetLogLevel(config.LogLevel)
 
+	// Run the migrations of the database instead of serving
+	if len(os.Args) > 1 && os.Args[1] == migrateCommand {
+		runMigrate(config, os.Args[2:])
+
+		return
+	}
+
 	log.Info("Starting in env:", config.GoEnv)
 
+	// Load the keys signing the status lists and the tree heads of the transparency log
+	statusListSigningKey, transparencyLogSigningKey, err := loadSigningKeys(config)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	// Create a gRPC server object
 	//nolint:lll // Ignore linting for long lines
 	var kaep = keepalive.EnforcementPolicy{
@@ -77,50 +101,76 @@ func main() {
 		) * time.Second, // Wait X second for the ping ack before assuming the connection is dead
 	}
 
-	// Create a database context
-	dbContext := db.NewContext(
-		config.DbHost,
-		config.DbPort,
-		config.DbName,
-		config.DbUsername,
-		config.DbPassword,
-		config.DbUseSsl,
-	)
+	// Load the identity providers accepted in the proofs and the trust policy of the issuers
+	providerRegistry, trustSource, err := loadIssuerTrust(config)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	// Connect to the database
-	err = dbContext.Connect()
+	// Load the audiences of the proofs of the identity providers
+	err = checkProofAudience(config)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	// Migrate the database
-	err = dbContext.AutoMigrate(
-		&issuerpg.Issuer{},
-		&idpg.ResolverMetadata{},
-		&idpg.Service{},
-		&idpg.VerificationMethod{},
-		&vcpg.VerifiableCredential{},
-		&vcpg.CredentialSchema{},
-		&vcpg.CredentialStatus{},
-	)
+	proofProviderAudiences, err := parseProviderAudiences(config.ProofProviderAudiences, providerRegistry)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create the interceptors protecting the API
+	interceptorConfig, err := newInterceptorConfig(config)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	rateLimiter := interceptor.NewRateLimiter(interceptorConfig, time.Now)
+
+	// Load the TLS certificates, reloaded when their files change
+	tlsReloader, err := newTLSReloader(config)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	serverCreds, gatewayCreds := transportCredentials(tlsReloader)
+
+	// Create the registry of the Prometheus metrics
+	metricsRegistry, err := newMetricsRegistry()
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	// Disconnect the database client when done
+	// Create the signer of the webhook deliveries
+	webhookSigner, err := newWebhookSigner(config)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create the repositories of the storage backend
+	repos, err := newRepositories(config)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Release the storage backend when done
 	defer func() {
-		if err = dbContext.Disconnect(); err != nil {
+		if err = repos.close(); err != nil {
 			log.Fatal(err)
 		}
 	}()
 
+	// Collect the metrics of the storage backend
+	metricsRegistry.MustRegister(repos.collectors...)
+
 	// Create a GRPC server
 	grpcsrv, err := grpcserver.New(
 		config.ServerGrpcHost,
-		grpc.ChainUnaryInterceptor(),
+		grpc.Creds(serverCreds),
+		grpc.ChainUnaryInterceptor(interceptor.NewChain(interceptorConfig, rateLimiter)...),
+		grpc.ChainStreamInterceptor(interceptor.NewStreamChain(interceptorConfig, rateLimiter)...),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-		grpc.MaxRecvMsgSize(maxMsgSize),
-		grpc.MaxSendMsgSize(maxMsgSize),
+		grpc.MaxRecvMsgSize(config.ServerMaxMessageSize),
+		grpc.MaxSendMsgSize(config.ServerMaxMessageSize),
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 	)
@@ -133,32 +183,66 @@ func main() {
 	}()
 
 	// Create OIDC parser
-	oidcParser := oidc.NewParser()
-
-	// Create repositories
-	issuerRepository := issuerpg.NewRepository(dbContext)
-	idRepository := idpg.NewIdRepository(dbContext)
-	vcRepository := vcpg.NewRepository(dbContext)
+	oidcParser := oidc.NewParser(providerRegistry)
 
 	// Create internal services
-	verificationService := verification.NewService(oidcParser, issuerRepository)
-	nodeIssuerService := node.NewIssuerService(issuerRepository, verificationService)
-	idGenerator := node.NewIDGenerator(verificationService)
+	nodeTransparencyLogService := node.NewTransparencyLogService(
+		repos.transparencyLog,
+		transparencyLogSigningKey,
+		config.TransparencyLogCachedLeaves,
+	)
+	verificationService := verification.NewService(
+		oidcParser,
+		repos.issuer,
+		trustSource,
+		append(
+			newProofOptions(ctx, config, proofProviderAudiences, repos.replay),
+			verification.WithRateLimiter(rateLimiter),
+		)...,
+	)
+	nodeIssuerService := node.NewIssuerService(
+		repos.issuer,
+		repos.id,
+		verificationService,
+		config.IssuerKeyRetirementPeriod,
+		nodeTransparencyLogService,
+		repos.transactor,
+	)
+	idGenerator := node.NewIDGenerator(verificationService, providerRegistry)
 	nodeIdService := node.NewIdService(
-		idRepository,
-		issuerRepository,
+		repos.id,
+		repos.issuer,
 		idGenerator,
+		nodeTransparencyLogService,
+		repos.transactor,
 	)
+
+	// The credential events are streamed to the watchers and sent to the webhooks
+	eventBroker := newEventBroker(ctx, config, repos.events, repos.webhook)
+	eventListeners := newEventListeners(ctx, config, repos.webhook, webhookSigner, eventBroker)
+
 	nodeVcService := node.NewVerifiableCredentialService(
-		idRepository,
+		repos.id,
 		verificationService,
-		vcRepository,
+		repos.vc,
+		repos.statusList,
+		nodeTransparencyLogService,
+		repos.transactor,
+		config.CredentialMaxValidity,
+		eventListeners...,
+	)
+	nodeStatusListService := node.NewStatusListService(
+		repos.statusList,
+		statusListSigningKey,
+		config.ApiUrl,
+		config.StatusListTtl,
 	)
 
 	register := identityapi.GrpcServiceRegister{
 		IdServiceServer:     nodegrpc.NewIdService(nodeIdService),
 		IssuerServiceServer: nodegrpc.NewIssuerService(nodeIssuerService),
-		VcServiceServer:     nodegrpc.NewVcService(nodeVcService),
+		VcServiceServer:     nodegrpc.NewVcService(nodeVcService, nodeStatusListService, eventBroker),
+		LogServiceServer:    nodegrpc.NewLogService(nodeTransparencyLogService),
 		LocalServiceServer:  issuergrpc.NewLocalService(),
 	}
 
@@ -189,29 +273,253 @@ func main() {
 
 	conn, err := grpc.NewClient(
 		"0.0.0.0"+config.ServerGrpcHost,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(gatewayCreds),
 		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 		grpc.WithKeepaliveParams(kacp),
 		grpc.WithDefaultCallOptions(
-			grpc.MaxCallRecvMsgSize(maxMsgSize),
-			grpc.MaxCallSendMsgSize(maxMsgSize),
+			grpc.MaxCallRecvMsgSize(config.ServerMaxMessageSize),
+			grpc.MaxCallSendMsgSize(config.ServerMaxMessageSize),
 		),
 	)
 	if err != nil {
 		log.Error("Failed to dial server:", err)
 	}
 
+	gwmux := newGatewayMux(ctx, &register, conn, interceptorConfig)
+
+	// The DID resolution and the metrics endpoints are served next to the gRPC-Gateway,
+	// protected by the rules of the gRPC server
+	mux := http.NewServeMux()
+	mux.Handle(didresolver.IdentifiersPath, interceptor.HTTPRateLimit(
+		didresolver.NewHandler(nodeIdService),
+		interceptorConfig,
+		rateLimiter,
+	))
+	mux.Handle(metricsPath, interceptor.HTTPRateLimit(
+		interceptor.HTTPAuthenticate(
+			promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{}),
+			interceptorConfig,
+		),
+		interceptorConfig,
+		rateLimiter,
+	))
+	mux.Handle(watchPath, interceptor.GatewayHandler(
+		withoutDeadlines(gwmux),
+		int64(config.ServerMaxMessageSize),
+	))
+	mux.Handle("/", interceptor.GatewayHandler(gwmux, int64(config.ServerMaxMessageSize)))
+
+	gwServer := newGatewayServer(config, mux, tlsReloader)
+
+	defer func() {
+		_ = tlsReloader.Close()
+
+		closeTrustSource(trustSource)
+	}()
+
+	defer func() {
+		_ = gwServer.Shutdown(ctx)
+	}()
+
+	go func() {
+		log.Info("Serving gRPC-Gateway on:", config.ServerHttpHost)
+
+		if err := listenAndServe(gwServer); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	interrupChannel := make(chan os.Signal, 1)
+	signal.Notify(interrupChannel, os.Interrupt)
+	<-interrupChannel
+
+	log.Info("Exiting the node")
+
+	cancel()
+}
+
+func loadSigningKeys(config *Configuration) (*jwk.Jwk, *jwk.Jwk, error) {
+	statusListSigningKey, err := loadSigningKey(
+		config,
+		"status list",
+		"STATUS_LIST_SIGNING_KEY",
+		config.StatusListSigningKey,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transparencyLogSigningKey, err := loadSigningKey(
+		config,
+		"transparency log",
+		"TRANSPARENCY_LOG_SIGNING_KEY",
+		config.TransparencyLogSigningKey,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return statusListSigningKey, transparencyLogSigningKey, nil
+}
+
+// loadSigningKey parses the signing key set in the variable. The signatures of
+// an ephemeral key stop verifying after a restart and on the other replicas,
+// so the Node only generates one in development.
+func loadSigningKey(config *Configuration, usage, variable, raw string) (*jwk.Jwk, error) {
+	if raw != "" {
+		return parseSigningKey(raw)
+	}
+
+	if config.GoEnv != developmentEnv {
+		return nil, fmt.Errorf("no %s signing key configured, set %s", usage, variable)
+	}
+
+	log.Warn("No ", usage, " signing key configured, generating an ephemeral key for development")
+
+	return joseutil.GenerateJWK("RS256", "sig", "")
+}
+
+// parseSigningKey parses and validates a private JWK
+func parseSigningKey(raw string) (*jwk.Jwk, error) {
+	var key jwk.Jwk
+
+	err := json.Unmarshal([]byte(raw), &key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = joseutil.ValidatePrivKey(&key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// loadProviderRegistry loads the identity providers of the configuration,
+// the default providers are used when no file is configured
+func loadProviderRegistry(config *Configuration) (*oidc.Registry, error) {
+	if config.OidcProvidersFile == "" {
+		return oidc.NewDefaultRegistry(), nil
+	}
+
+	log.Info("Loading the identity providers from ", config.OidcProvidersFile)
+
+	return oidc.LoadRegistry(config.OidcProvidersFile)
+}
+
+func newInterceptorConfig(config *Configuration) (*interceptor.Config, error) {
+	authMode, err := interceptor.ParseAuthMode(config.AuthMode)
+	if err != nil {
+		return nil, err
+	}
+
+	if authMode == interceptor.AuthModeApiKey && len(config.ApiKeys) == 0 {
+		return nil, errors.New("the api-key authentication mode requires API_KEYS")
+	}
+
+	// The token lets the gRPC server trust the client information
+	// forwarded by the gateway
+	gatewayToken, err := interceptor.NewGatewayToken()
+	if err != nil {
+		return nil, err
+	}
+
+	trustedProxies, err := interceptor.ParseTrustedProxies(config.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
+	return &interceptor.Config{
+		IPRateLimit:     config.RateLimitIp,
+		IPBurst:         config.RateLimitIpBurst,
+		IssuerRateLimit: config.RateLimitIssuer,
+		IssuerBurst:     config.RateLimitIssuerBurst,
+		AuthMode:        authMode,
+		ApiKeys:         config.ApiKeys,
+		MaxFieldSize:    config.ServerMaxFieldSize,
+		MaxListSize:     config.ServerMaxListSize,
+		GatewayToken:    gatewayToken,
+		TrustedProxies:  trustedProxies,
+	}, nil
+}
+
+// newMetricsRegistry returns the registry of the metrics of the node
+// and of the Go runtime
+func newMetricsRegistry() (*prometheus.Registry, error) {
+	registry := prometheus.NewRegistry()
+
+	err := metrics.Register(registry)
+	if err != nil {
+		return nil, err
+	}
+
+	err = registry.Register(collectors.NewGoCollector())
+	if err != nil {
+		return nil, err
+	}
+
+	err = registry.Register(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
+
+// newGatewayServer returns the HTTP server of the gateway
+func newGatewayServer(
+	config *Configuration,
+	handler http.Handler,
+	tlsReloader *tlsutil.Reloader,
+) *http.Server {
+	return &http.Server{
+		Addr:              config.ServerHttpHost,
+		Handler:           newCorsHandler(config, handler),
+		WriteTimeout:      time.Duration(config.HttpServerWriteTimeout) * time.Second,
+		IdleTimeout:       time.Duration(config.HttpServerIdleTimeout) * time.Second,
+		ReadTimeout:       time.Duration(config.HttpServerReadTimeout) * time.Second,
+		ReadHeaderTimeout: time.Duration(config.HttpServerReadHeaderTimeout) * time.Second,
+		TLSConfig:         serverTLSConfig(tlsReloader),
+	}
+}
+
+// newGatewayMux returns the gRPC-Gateway proxying the HTTP requests
+// to the gRPC server through the connection
+func newGatewayMux(
+	ctx context.Context,
+	register *identityapi.GrpcServiceRegister,
+	conn *grpc.ClientConn,
+	interceptorConfig *interceptor.Config,
+) *runtime.ServeMux {
 	gwOpts := []runtime.ServeMuxOption{
 		runtime.WithHealthzEndpoint(grpc_health_v1.NewHealthClient(conn)),
 		runtime.WithIncomingHeaderMatcher(grpcutil.CustomMatcher),
+		runtime.WithMetadata(interceptor.GatewayMetadata(interceptorConfig)),
 	}
 	gwmux := runtime.NewServeMux(gwOpts...)
 
-	err = register.RegisterHttpHandlers(ctx, gwmux, conn)
+	err := register.RegisterHttpHandlers(ctx, gwmux, conn)
 	if err != nil {
 		log.Error(err)
 	}
 
+	return gwmux
+}
+
+// withoutDeadlines lifts the read and write timeouts of the server
+// for the long-lived streaming responses
+func withoutDeadlines(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rc := http.NewResponseController(w)
+		_ = rc.SetReadDeadline(time.Time{})
+		_ = rc.SetWriteDeadline(time.Time{})
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func newCorsHandler(config *Configuration, handler http.Handler) http.Handler {
 	// Setup cors for dev
 	options := cors.Options{
 		AllowedOrigins: []string{"*"},
@@ -222,6 +530,7 @@ func main() {
 			"Origin",
 			"Accept",
 			"Authorization",
+			interceptor.ApiKeyHeader,
 		},
 		AllowCredentials: true,
 
@@ -230,37 +539,9 @@ func main() {
 	}
 
 	// Check current env
-	if config.GoEnv != "development" {
+	if config.GoEnv != developmentEnv {
 		options.Debug = false
 	}
-	c := cors.New(options)
 
-	gwServer := &http.Server{
-		Addr:              config.ServerHttpHost,
-		Handler:           c.Handler(gwmux),
-		WriteTimeout:      time.Duration(config.HttpServerWriteTimeout) * time.Second,
-		IdleTimeout:       time.Duration(config.HttpServerIdleTimeout) * time.Second,
-		ReadTimeout:       time.Duration(config.HttpServerReadTimeout) * time.Second,
-		ReadHeaderTimeout: time.Duration(config.HttpServerReadHeaderTimeout) * time.Second,
-	}
-
-	defer func() {
-		_ = gwServer.Shutdown(ctx)
-	}()
-
-	go func() {
-		log.Info("Serving gRPC-Gateway on:", config.ServerHttpHost)
-
-		if err := gwServer.ListenAndServe(); err != nil {
-			log.Fatal(err)
-		}
-	}()
-
-	interrupChannel := make(chan os.Signal, 1)
-	signal.Notify(interrupChannel, os.Interrupt)
-	<-interrupChannel
-
-	log.Info("Exiting the node")
-
-	cancel()
+	return cors.New(options).Handler(handler)
 }
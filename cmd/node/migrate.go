@@ -0,0 +1,168 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/agntcy/identity/pkg/db/migrate"
+	"github.com/spf13/cobra"
+)
+
+const migrateCommand = "migrate"
+
+// runMigrate runs the migrate command with the arguments
+// and exits when the command fails
+func runMigrate(config *Configuration, args []string) {
+	migrateCmd := newMigrateCmd(config)
+	migrateCmd.SetArgs(args)
+
+	if err := migrateCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// newMigrateCmd returns the command managing the versioned migrations
+// of the postgres database
+func newMigrateCmd(config *Configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   migrateCommand,
+		Short: "Manage the migrations of the node database",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if config.DbDriver != dbDriverPostgres {
+				return fmt.Errorf("the migrations only apply to the %s driver", dbDriverPostgres)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "up",
+			Short: "Apply all the pending migrations",
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return withMigrator(config, func(migrator *migrate.Migrator) error {
+					applied, err := migrator.Up(cmd.Context())
+					if err != nil {
+						return err
+					}
+
+					fmt.Fprintf(
+						os.Stdout,
+						"Applied %d migration(s), the database is at version %d\n",
+						len(applied),
+						migrator.LatestVersion(),
+					)
+
+					return nil
+				})
+			},
+		},
+		newMigrateDownCmd(config),
+		&cobra.Command{
+			Use:   "baseline",
+			Short: "Record the initial schema of a database created before the migrations as applied",
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return withMigrator(config, func(migrator *migrate.Migrator) error {
+					migration, err := migrator.Baseline(cmd.Context())
+					if err != nil {
+						return err
+					}
+
+					fmt.Fprintf(
+						os.Stdout,
+						"Recorded the migration %d_%s, run migrate up to apply the next ones\n",
+						migration.Version,
+						migration.Name,
+					)
+
+					return nil
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "version",
+			Short: "Print the version of the database schema",
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return withMigrator(config, func(migrator *migrate.Migrator) error {
+					version, err := migrator.Version(cmd.Context())
+					if err != nil {
+						return err
+					}
+
+					fmt.Fprintf(
+						os.Stdout,
+						"Database version: %d\nExpected version: %d\n",
+						version,
+						migrator.LatestVersion(),
+					)
+
+					return nil
+				})
+			},
+		},
+	)
+
+	return cmd
+}
+
+func newMigrateDownCmd(config *Configuration) *cobra.Command {
+	var steps int
+
+	cmd := &cobra.Command{
+		Use:   "down",
+		Short: "Roll back the last applied migrations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if steps < 1 {
+				return errors.New("the number of steps must be positive")
+			}
+
+			return withMigrator(config, func(migrator *migrate.Migrator) error {
+				rolledBack, err := migrator.Down(cmd.Context(), steps)
+				if err != nil {
+					return err
+				}
+
+				version, err := migrator.Version(cmd.Context())
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(
+					os.Stdout,
+					"Rolled back %d migration(s), the database is at version %d\n",
+					len(rolledBack),
+					version,
+				)
+
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().IntVarP(&steps, "steps", "n", 1, "Number of migrations to roll back")
+
+	return cmd
+}
+
+func withMigrator(config *Configuration, fn func(migrator *migrate.Migrator) error) error {
+	dbContext, err := connectPostgres(config)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = dbContext.Disconnect()
+	}()
+
+	migrator, err := newMigrator(dbContext)
+	if err != nil {
+		return err
+	}
+
+	return fn(migrator)
+}
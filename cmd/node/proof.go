@@ -0,0 +1,120 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/issuer/replay"
+	"github.com/agntcy/identity/internal/core/issuer/verification"
+	"github.com/agntcy/identity/pkg/log"
+	"github.com/agntcy/identity/pkg/oidc"
+)
+
+// The interval between the purges of the expired proof IDs
+const replayPurgeInterval = 10 * time.Minute
+
+// newProofOptions returns the freshness requirements of the proofs sent to the Node.
+// The proofs must be issued for one of the PROOF_AUDIENCE, the API URL by default,
+// or for the PROOF_PROVIDER_AUDIENCES of their identity provider.
+func newProofOptions(
+	ctx context.Context,
+	config *Configuration,
+	providerAudiences map[oidc.ProviderName][]string,
+	cache replay.Cache,
+) []verification.Option {
+	audiences := config.ProofAudience
+	if len(audiences) == 0 {
+		audiences = []string{config.ApiUrl}
+	}
+
+	opts := []verification.Option{
+		verification.WithAudience(audiences...),
+		verification.WithMaxAge(config.ProofMaxAge),
+	}
+
+	for provider, accepted := range providerAudiences {
+		opts = append(opts, verification.WithProviderAudience(provider, accepted...))
+	}
+
+	if config.ProofReplayProtection {
+		opts = append(opts, verification.WithReplayCache(cache))
+
+		go purgeReplayCache(ctx, cache)
+	} else {
+		log.Warn("The replay protection of the proofs is disabled")
+	}
+
+	return opts
+}
+
+// checkProofAudience makes sure the proofs are checked against the public URL of the Node.
+// The Issuer CLI issues the proofs for the address of the Node, so the default
+// local API_URL only matches the proofs of the local clients, in development.
+func checkProofAudience(config *Configuration) error {
+	if len(config.ProofAudience) > 0 || config.GoEnv == developmentEnv {
+		return nil
+	}
+
+	apiURL, err := url.Parse(config.ApiUrl)
+	if err != nil {
+		return fmt.Errorf("invalid API_URL: %w", err)
+	}
+
+	host := apiURL.Hostname()
+	if host == "localhost" || net.ParseIP(host).IsLoopback() {
+		return errors.New("no public audience configured for the proofs, set API_URL or PROOF_AUDIENCE")
+	}
+
+	return nil
+}
+
+// parseProviderAudiences parses the provider=audience items of PROOF_PROVIDER_AUDIENCES,
+// a provider repeated in several items accepts each of their audiences
+func parseProviderAudiences(
+	items []string,
+	registry *oidc.Registry,
+) (map[oidc.ProviderName][]string, error) {
+	audiences := make(map[oidc.ProviderName][]string)
+
+	for _, item := range items {
+		name, audience, ok := strings.Cut(item, "=")
+		if !ok || audience == "" {
+			return nil, fmt.Errorf("invalid item in PROOF_PROVIDER_AUDIENCES: %q", item)
+		}
+
+		provider := oidc.ProviderName(name)
+		if _, ok := registry.Get(provider); !ok && provider != oidc.SelfProviderName {
+			return nil, fmt.Errorf("unknown identity provider in PROOF_PROVIDER_AUDIENCES: %s", name)
+		}
+
+		audiences[provider] = append(audiences[provider], audience)
+	}
+
+	return audiences, nil
+}
+
+// purgeReplayCache removes the expired proof IDs until the context is cancelled
+func purgeReplayCache(ctx context.Context, cache replay.Cache) {
+	ticker := time.NewTicker(replayPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := cache.Purge(ctx, time.Now())
+			if err != nil {
+				log.Error("Unable to purge the expired proofs: ", err)
+			}
+		}
+	}
+}
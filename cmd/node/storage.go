@@ -0,0 +1,202 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	idcore "github.com/agntcy/identity/internal/core/id"
+	idmemory "github.com/agntcy/identity/internal/core/id/memory"
+	idpg "github.com/agntcy/identity/internal/core/id/postgres"
+	issuercore "github.com/agntcy/identity/internal/core/issuer"
+	issuermemory "github.com/agntcy/identity/internal/core/issuer/memory"
+	issuerpg "github.com/agntcy/identity/internal/core/issuer/postgres"
+	"github.com/agntcy/identity/internal/core/issuer/replay"
+	replaymemory "github.com/agntcy/identity/internal/core/issuer/replay/memory"
+	replaypg "github.com/agntcy/identity/internal/core/issuer/replay/postgres"
+	"github.com/agntcy/identity/internal/core/translog"
+	translogmemory "github.com/agntcy/identity/internal/core/translog/memory"
+	translogpg "github.com/agntcy/identity/internal/core/translog/postgres"
+	vccore "github.com/agntcy/identity/internal/core/vc"
+	"github.com/agntcy/identity/internal/core/vc/events"
+	eventsmemory "github.com/agntcy/identity/internal/core/vc/events/memory"
+	eventspg "github.com/agntcy/identity/internal/core/vc/events/postgres"
+	vcmemory "github.com/agntcy/identity/internal/core/vc/memory"
+	vcpg "github.com/agntcy/identity/internal/core/vc/postgres"
+	"github.com/agntcy/identity/internal/core/vc/statuslist"
+	statuslistmemory "github.com/agntcy/identity/internal/core/vc/statuslist/memory"
+	statuslistpg "github.com/agntcy/identity/internal/core/vc/statuslist/postgres"
+	"github.com/agntcy/identity/internal/core/webhook"
+	webhookmemory "github.com/agntcy/identity/internal/core/webhook/memory"
+	webhookpg "github.com/agntcy/identity/internal/core/webhook/postgres"
+	"github.com/agntcy/identity/internal/node/migrations"
+	"github.com/agntcy/identity/pkg/db"
+	"github.com/agntcy/identity/pkg/db/memory"
+	"github.com/agntcy/identity/pkg/db/migrate"
+	"github.com/agntcy/identity/pkg/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// The storage backends of the Node
+const (
+	dbDriverPostgres = "postgres"
+	dbDriverMemory   = "memory"
+)
+
+// repositories holds the repositories of the storage backend
+type repositories struct {
+	issuer          issuercore.Repository
+	id              idcore.IdRepository
+	vc              vccore.Repository
+	statusList      statuslist.Repository
+	transparencyLog translog.Repository
+	webhook         webhook.Repository
+	events          events.Repository
+	replay          replay.Cache
+
+	// Run the updates of several repositories atomically
+	transactor db.Transactor
+
+	// The metrics of the storage backend
+	collectors []prometheus.Collector
+
+	// Release the resources of the storage backend
+	close func() error
+}
+
+// newRepositories creates the repositories of the configured storage backend
+func newRepositories(config *Configuration) (*repositories, error) {
+	switch config.DbDriver {
+	case dbDriverPostgres:
+		return newPostgresRepositories(config)
+	case dbDriverMemory:
+		return newMemoryRepositories(config)
+	default:
+		return nil, fmt.Errorf("unsupported database driver: %s", config.DbDriver)
+	}
+}
+
+func newPostgresRepositories(config *Configuration) (*repositories, error) {
+	dbContext, err := connectPostgres(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refuse to serve when the schema is not the one expected by this version
+	migrator, err := newMigrator(dbContext)
+	if err != nil {
+		return nil, err
+	}
+
+	err = migrator.Check(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("%w, run `node migrate up` to upgrade the database", err)
+	}
+
+	sqlDB, err := dbContext.Client().DB()
+	if err != nil {
+		return nil, err
+	}
+
+	return &repositories{
+		issuer:          issuerpg.NewRepository(dbContext),
+		id:              idpg.NewIdRepository(dbContext),
+		vc:              vcpg.NewRepository(dbContext),
+		statusList:      statuslistpg.NewRepository(dbContext),
+		transparencyLog: translogpg.NewRepository(dbContext),
+		webhook:         webhookpg.NewRepository(dbContext),
+		events:          eventspg.NewRepository(dbContext),
+		replay:          replaypg.NewCache(dbContext),
+		transactor:      db.NewTransactor(dbContext),
+		collectors:      []prometheus.Collector{collectors.NewDBStatsCollector(sqlDB, config.DbName)},
+		close:           dbContext.Disconnect,
+	}, nil
+}
+
+// connectPostgres connects to the postgres database of the configuration
+func connectPostgres(config *Configuration) (db.Context, error) {
+	if config.DbHost == "" || config.DbPort == "" || config.DbUsername == "" {
+		return nil, errors.New("the postgres driver requires DB_HOST, DB_PORT and DB_USERNAME")
+	}
+
+	// Create a database context
+	dbContext := db.NewContext(
+		config.DbHost,
+		config.DbPort,
+		config.DbName,
+		config.DbUsername,
+		config.DbPassword,
+		config.DbUseSsl,
+	)
+
+	// Connect to the database
+	err := dbContext.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	return dbContext, nil
+}
+
+func newMigrator(dbContext db.Context) (*migrate.Migrator, error) {
+	scripts, err := migrations.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.NewMigrator(dbContext, scripts), nil
+}
+
+func newMemoryRepositories(config *Configuration) (*repositories, error) {
+	if config.DbFile == "" {
+		log.Warn("No database file configured, the data is lost when the node stops")
+	}
+
+	store, err := memory.NewStore(config.DbFile)
+	if err != nil {
+		return nil, err
+	}
+
+	repos := &repositories{
+		transactor: store,
+		close:      func() error { return nil },
+	}
+
+	if repos.issuer, err = issuermemory.NewRepository(store); err != nil {
+		return nil, err
+	}
+
+	if repos.id, err = idmemory.NewIdRepository(store); err != nil {
+		return nil, err
+	}
+
+	if repos.vc, err = vcmemory.NewRepository(store); err != nil {
+		return nil, err
+	}
+
+	if repos.statusList, err = statuslistmemory.NewRepository(store); err != nil {
+		return nil, err
+	}
+
+	if repos.transparencyLog, err = translogmemory.NewRepository(store); err != nil {
+		return nil, err
+	}
+
+	if repos.webhook, err = webhookmemory.NewRepository(store); err != nil {
+		return nil, err
+	}
+
+	if repos.events, err = eventsmemory.NewRepository(store); err != nil {
+		return nil, err
+	}
+
+	if repos.replay, err = replaymemory.NewCache(store); err != nil {
+		return nil, err
+	}
+
+	return repos, nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	"github.com/agntcy/identity/pkg/log"
+	"github.com/agntcy/identity/pkg/tlsutil"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// newTLSReloader loads the TLS files of the configuration,
+// it returns nil when the node serves in plaintext
+func newTLSReloader(config *Configuration) (*tlsutil.Reloader, error) {
+	if config.TlsCertFile == "" && config.TlsKeyFile == "" {
+		log.Warn("No TLS certificate configured, serving in plaintext")
+
+		return nil, nil //nolint:nilnil // No reloader when TLS is disabled
+	}
+
+	clientAuth, err := tlsutil.ParseClientAuth(config.TlsClientAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	return tlsutil.NewReloader(&tlsutil.Config{
+		CertFile:   config.TlsCertFile,
+		KeyFile:    config.TlsKeyFile,
+		CAFile:     config.TlsCaFile,
+		ClientAuth: clientAuth,
+	})
+}
+
+// transportCredentials returns the credentials of the gRPC server
+// and of the gateway connecting to it
+func transportCredentials(
+	reloader *tlsutil.Reloader,
+) (credentials.TransportCredentials, credentials.TransportCredentials) {
+	if reloader == nil {
+		return insecure.NewCredentials(), insecure.NewCredentials()
+	}
+
+	return credentials.NewTLS(reloader.ServerTLSConfig()),
+		credentials.NewTLS(reloader.LoopbackTLSConfig())
+}
+
+// serverTLSConfig returns the TLS configuration of the HTTP server,
+// nil when the node serves in plaintext
+func serverTLSConfig(reloader *tlsutil.Reloader) *tls.Config {
+	if reloader == nil {
+		return nil
+	}
+
+	return reloader.ServerTLSConfig()
+}
+
+// listenAndServe serves HTTPS when the server has a TLS configuration
+func listenAndServe(server *http.Server) error {
+	if server.TLSConfig != nil {
+		return server.ListenAndServeTLS("", "")
+	}
+
+	return server.ListenAndServe()
+}
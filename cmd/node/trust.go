@@ -0,0 +1,49 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"io"
+
+	"github.com/agntcy/identity/internal/core/issuer/trust"
+	"github.com/agntcy/identity/pkg/log"
+	"github.com/agntcy/identity/pkg/oidc"
+)
+
+// loadIssuerTrust loads the identity providers accepted in the proofs
+// and the trust policy restricting the issuers
+func loadIssuerTrust(config *Configuration) (*oidc.Registry, trust.Source, error) {
+	registry, err := loadProviderRegistry(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	source, err := newTrustSource(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return registry, source, nil
+}
+
+// newTrustSource loads the trust policy of the issuers, reloaded when its file changes.
+// The default policy trusting every issuer is used when no file is configured.
+func newTrustSource(config *Configuration) (trust.Source, error) {
+	if config.TrustPolicyFile == "" {
+		log.Warn("No trust policy configured, every issuer can register")
+
+		return trust.NewStaticSource(trust.DefaultPolicy()), nil
+	}
+
+	log.Info("Loading the trust policy from ", config.TrustPolicyFile)
+
+	return trust.NewFileSource(config.TrustPolicyFile)
+}
+
+// closeTrustSource stops watching the file of the trust policy
+func closeTrustSource(source trust.Source) {
+	if closer, ok := source.(io.Closer); ok {
+		_ = closer.Close()
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/vc/events"
+	"github.com/agntcy/identity/internal/core/webhook"
+	"github.com/agntcy/identity/internal/node"
+	"github.com/agntcy/identity/pkg/log"
+)
+
+// newWebhookSigner returns the signer of the webhook deliveries,
+// nil when no webhook endpoint is configured
+func newWebhookSigner(config *Configuration) (webhook.Signer, error) {
+	if len(config.WebhookUrls) == 0 {
+		return nil, nil //nolint:nilnil // No signer when the webhooks are disabled
+	}
+
+	for _, endpoint := range config.WebhookUrls {
+		u, err := url.Parse(endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid webhook URL: %s", endpoint)
+		}
+	}
+
+	switch {
+	case config.WebhookSecret != "" && config.WebhookSigningKey != "":
+		return nil, errors.New("WEBHOOK_SECRET and WEBHOOK_SIGNING_KEY are mutually exclusive")
+	case config.WebhookSecret != "":
+		return webhook.NewHMACSigner([]byte(config.WebhookSecret)), nil
+	case config.WebhookSigningKey != "":
+		key, err := parseSigningKey(config.WebhookSigningKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook signing key: %w", err)
+		}
+
+		return webhook.NewJWSSigner(key), nil
+	default:
+		return nil, errors.New("the webhooks require WEBHOOK_SECRET or WEBHOOK_SIGNING_KEY")
+	}
+}
+
+// The interval between the purges of the events older than the retention
+const eventPurgeInterval = 10 * time.Minute
+
+// newEventBroker returns the broker streaming the stored events to the watchers,
+// the events and the failed webhook deliveries older than the retention are purged
+// until the context is cancelled
+func newEventBroker(
+	ctx context.Context,
+	config *Configuration,
+	repository events.Repository,
+	webhookRepository webhook.Repository,
+) *events.Broker {
+	go purgeEvents(ctx, repository, webhookRepository, config.EventRetention)
+
+	return events.NewBroker(repository, events.DefaultMaxSubscribers, events.DefaultPollInterval)
+}
+
+// purgeEvents removes the events and the failed webhook deliveries older than
+// the retention until the context is cancelled
+func purgeEvents(
+	ctx context.Context,
+	repository events.Repository,
+	webhookRepository webhook.Repository,
+	retention time.Duration,
+) {
+	ticker := time.NewTicker(eventPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			before := time.Now().Add(-retention)
+
+			err := repository.Purge(ctx, before)
+			if err != nil {
+				log.Error("Unable to purge the expired events: ", err)
+			}
+
+			err = webhookRepository.PurgeFailed(ctx, before)
+			if err != nil {
+				log.Error("Unable to purge the failed webhook deliveries: ", err)
+			}
+		}
+	}
+}
+
+// newEventListeners returns the listeners of the credential events.
+// The webhook dispatcher, when configured, runs until the context is cancelled.
+func newEventListeners(
+	ctx context.Context,
+	config *Configuration,
+	repository webhook.Repository,
+	signer webhook.Signer,
+	broker *events.Broker,
+) []events.Listener {
+	if signer == nil {
+		return []events.Listener{broker}
+	}
+
+	dispatcher := node.NewWebhookDispatcher(
+		repository,
+		signer,
+		config.WebhookUrls,
+		config.WebhookTimeout,
+		max(config.WebhookMaxAttempts, 1),
+	)
+
+	go dispatcher.Run(ctx)
+
+	return []events.Listener{broker, dispatcher}
+}
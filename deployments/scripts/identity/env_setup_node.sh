@@ -13,7 +13,8 @@ if [ ! -f "$NODE_ENV" ]; then
 
   echo "Creating .env file with defaults"
   touch "$NODE_ENV" && \
-  echo "DB_HOST=identity-postgres" > "$NODE_ENV" && \
+  echo "GO_ENV=development" > "$NODE_ENV" && \
+  echo "DB_HOST=identity-postgres" >> "$NODE_ENV" && \
   echo "DB_PORT=5432" >> "$NODE_ENV" && \
   echo "DB_USERNAME=postgres" >> "$NODE_ENV" && \
   echo "DB_PASSWORD=postgres" >> "$NODE_ENV" && \
@@ -6,5 +6,32 @@
 DOCKER_FILE=./deployments/docker/identity/Dockerfile.test
 TEST_COMMAND='go test -cover -v ./...'
 
+# The tests of the Postgres repositories run against a disposable database
+TEST_NETWORK=identity-test
+TEST_DB_CONTAINER=identity-test-postgres
+TEST_DB_PASSWORD=postgres
+TEST_DB_NAME=identity_test
+
+cleanup() {
+  docker rm -f "$TEST_DB_CONTAINER" >/dev/null 2>&1
+  docker network rm "$TEST_NETWORK" >/dev/null 2>&1
+}
+trap cleanup EXIT
+
+docker network create "$TEST_NETWORK" >/dev/null
+docker run -d --name "$TEST_DB_CONTAINER" --network "$TEST_NETWORK" \
+  -e POSTGRES_PASSWORD="$TEST_DB_PASSWORD" \
+  -e POSTGRES_DB="$TEST_DB_NAME" \
+  postgres:16-alpine >/dev/null
+
+# The init scripts of the image run on a server without TCP, wait for the final server
+until docker exec "$TEST_DB_CONTAINER" pg_isready -h 127.0.0.1 -U postgres -d "$TEST_DB_NAME" >/dev/null 2>&1; do
+  sleep 1
+done
+
 echo RUNNING TESTS
-docker run "$(docker build --no-cache -f ${DOCKER_FILE} -q .)" $TEST_COMMAND
+docker run --network "$TEST_NETWORK" \
+  -e TEST_DB_HOST="$TEST_DB_CONTAINER" \
+  -e TEST_DB_PASSWORD="$TEST_DB_PASSWORD" \
+  -e TEST_DB_NAME="$TEST_DB_NAME" \
+  "$(docker build --no-cache -f ${DOCKER_FILE} -q .)" $TEST_COMMAND
@@ -6,5 +6,6 @@ package errors
 import "errors"
 
 var (
-	ErrResourceNotFound = errors.New("resource not found")
+	ErrResourceNotFound      = errors.New("resource not found")
+	ErrResourceAlreadyExists = errors.New("resource already exists")
 )
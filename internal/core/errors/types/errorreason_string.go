@@ -22,11 +22,26 @@ func _() {
 	_ = x[ERROR_REASON_UNKNOWN_IDP-11]
 	_ = x[ERROR_REASON_ID_ALREADY_REGISTERED-12]
 	_ = x[ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED-13]
+	_ = x[ERROR_REASON_INVALID_SEARCH_CRITERIA-14]
+	_ = x[ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED-15]
+	_ = x[ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED-16]
+	_ = x[ERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALID-17]
+	_ = x[ERROR_REASON_INVALID_VERIFIABLE_PRESENTATION-18]
+	_ = x[ERROR_REASON_INVALID_PRESENTATION_CHALLENGE-19]
+	_ = x[ERROR_REASON_ID_DEACTIVATED-20]
+	_ = x[ERROR_REASON_INVALID_RESOLVER_METADATA-21]
+	_ = x[ERROR_REASON_TRANSPARENCY_LOG_ENTRY_NOT_FOUND-22]
+	_ = x[ERROR_REASON_INVALID_TREE_SIZE-23]
+	_ = x[ERROR_REASON_RATE_LIMIT_EXCEEDED-24]
+	_ = x[ERROR_REASON_UNAUTHENTICATED-25]
+	_ = x[ERROR_REASON_PAYLOAD_TOO_LARGE-26]
+	_ = x[ERROR_REASON_UNTRUSTED_ISSUER-27]
+	_ = x[ERROR_REASON_STATUS_LIST_INDEX_IN_USE-28]
 }
 
-const _ErrorReason_name = "ERROR_REASON_UNSPECIFIEDERROR_REASON_INTERNALERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_TYPEERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_VALUE_FORMATERROR_REASON_INVALID_ISSUERERROR_REASON_ISSUER_NOT_REGISTEREDERROR_REASON_INVALID_VERIFIABLE_CREDENTIALERROR_REASON_IDP_REQUIREDERROR_REASON_INVALID_PROOFERROR_REASON_UNSUPPORTED_PROOFERROR_REASON_RESOLVER_METADATA_NOT_FOUNDERROR_REASON_UNKNOWN_IDPERROR_REASON_ID_ALREADY_REGISTEREDERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED"
+const _ErrorReason_name = "ERROR_REASON_UNSPECIFIEDERROR_REASON_INTERNALERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_TYPEERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_VALUE_FORMATERROR_REASON_INVALID_ISSUERERROR_REASON_ISSUER_NOT_REGISTEREDERROR_REASON_INVALID_VERIFIABLE_CREDENTIALERROR_REASON_IDP_REQUIREDERROR_REASON_INVALID_PROOFERROR_REASON_UNSUPPORTED_PROOFERROR_REASON_RESOLVER_METADATA_NOT_FOUNDERROR_REASON_UNKNOWN_IDPERROR_REASON_ID_ALREADY_REGISTEREDERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKEDERROR_REASON_INVALID_SEARCH_CRITERIAERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDEDERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIREDERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALIDERROR_REASON_INVALID_VERIFIABLE_PRESENTATIONERROR_REASON_INVALID_PRESENTATION_CHALLENGEERROR_REASON_ID_DEACTIVATEDERROR_REASON_INVALID_RESOLVER_METADATAERROR_REASON_TRANSPARENCY_LOG_ENTRY_NOT_FOUNDERROR_REASON_INVALID_TREE_SIZEERROR_REASON_RATE_LIMIT_EXCEEDEDERROR_REASON_UNAUTHENTICATEDERROR_REASON_PAYLOAD_TOO_LARGEERROR_REASON_UNTRUSTED_ISSUERERROR_REASON_STATUS_LIST_INDEX_IN_USE"
 
-var _ErrorReason_index = [...]uint16{0, 24, 45, 90, 143, 170, 204, 246, 271, 297, 327, 367, 391, 425, 467}
+var _ErrorReason_index = [...]uint16{0, 24, 45, 90, 143, 170, 204, 246, 271, 297, 327, 367, 391, 425, 467, 503, 547, 589, 637, 681, 724, 751, 789, 834, 864, 896, 924, 954, 983, 1020}
 
 func (i ErrorReason) String() string {
 	if i < 0 || i >= ErrorReason(len(_ErrorReason_index)-1) {
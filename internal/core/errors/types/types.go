@@ -61,6 +61,51 @@ const (
 
 	// The Verifiable Credential is revoked
 	ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED
+
+	// The search criteria contains one or more invalid fields
+	ERROR_REASON_INVALID_SEARCH_CRITERIA
+
+	// The Verifiable Credential is suspended
+	ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED
+
+	// The Verifiable Credential is expired
+	ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED
+
+	// The Verifiable Credential is not yet valid
+	ERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALID
+
+	// The Verifiable Presentation is invalid or its proof cannot be verified
+	ERROR_REASON_INVALID_VERIFIABLE_PRESENTATION
+
+	// The nonce or the audience of the Verifiable Presentation does not match the challenge
+	ERROR_REASON_INVALID_PRESENTATION_CHALLENGE
+
+	// The ID has been deactivated by its controller
+	ERROR_REASON_ID_DEACTIVATED
+
+	// The Resolver Metadata contains one or more invalid verification methods or services
+	ERROR_REASON_INVALID_RESOLVER_METADATA
+
+	// The entry is not in the transparency log
+	ERROR_REASON_TRANSPARENCY_LOG_ENTRY_NOT_FOUND
+
+	// The tree size is invalid or larger than the transparency log
+	ERROR_REASON_INVALID_TREE_SIZE
+
+	// The client sent too many requests
+	ERROR_REASON_RATE_LIMIT_EXCEEDED
+
+	// The client is not authenticated to call the RPC
+	ERROR_REASON_UNAUTHENTICATED
+
+	// The request payload exceeds the configured limits
+	ERROR_REASON_PAYLOAD_TOO_LARGE
+
+	// The issuer or its identity provider is not trusted by the trust policy of the Node
+	ERROR_REASON_UNTRUSTED_ISSUER
+
+	// The status list index of the credential is already allocated to another credential
+	ERROR_REASON_STATUS_LIST_INDEX_IN_USE
 )
 
 // Describes the cause of the error with structured details.
@@ -0,0 +1,82 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package did
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// The DID method of the IDs registered on an Identity node
+// The method-specific identifier is the ID, for instance did:agntcy:OKTA-<sub>
+const (
+	Scheme = "did"
+	Method = "agntcy"
+	Prefix = Scheme + ":" + Method + ":"
+
+	// A DID is made of the scheme, the method and the method-specific identifier
+	didParts = 3
+)
+
+var (
+	ErrInvalidDID         = errors.New("invalid DID")
+	ErrMethodNotSupported = errors.New("DID method not supported")
+)
+
+// FromID returns the DID of an ID.
+// The characters not allowed in a method-specific identifier are percent-encoded.
+func FromID(id string) string {
+	var builder strings.Builder
+
+	builder.WriteString(Prefix)
+
+	for _, b := range []byte(id) {
+		if isIDChar(b) {
+			builder.WriteByte(b)
+		} else {
+			fmt.Fprintf(&builder, "%%%02X", b)
+		}
+	}
+
+	return builder.String()
+}
+
+// ToID returns the ID identified by a DID using the agntcy method
+func ToID(did string) (string, error) {
+	parts := strings.SplitN(did, ":", didParts)
+	if len(parts) != didParts || parts[0] != Scheme || parts[1] == "" || parts[2] == "" {
+		return "", fmt.Errorf("%w: %s", ErrInvalidDID, did)
+	}
+
+	if parts[1] != Method {
+		return "", fmt.Errorf("%w: %s", ErrMethodNotSupported, parts[1])
+	}
+
+	id, err := url.PathUnescape(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidDID, err)
+	}
+
+	return id, nil
+}
+
+// FromVerificationMethodID returns the DID URL of a verification method.
+// The verification method IDs of an ID use the ID as a prefix followed by a fragment.
+func FromVerificationMethodID(id, vmID string) string {
+	if fragment, ok := strings.CutPrefix(vmID, id+"#"); ok {
+		return FromID(id) + "#" + fragment
+	}
+
+	return FromID(id) + "#" + url.PathEscape(vmID)
+}
+
+// isIDChar returns true if the byte is an idchar as defined in the DID syntax
+func isIDChar(b byte) bool {
+	return (b >= 'a' && b <= 'z') ||
+		(b >= 'A' && b <= 'Z') ||
+		(b >= '0' && b <= '9') ||
+		b == '.' || b == '-' || b == '_'
+}
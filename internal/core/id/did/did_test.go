@@ -0,0 +1,83 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package did_test
+
+import (
+	"testing"
+
+	"github.com/agntcy/identity/internal/core/id/did"
+	idtypes "github.com/agntcy/identity/internal/core/id/types"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromID_Should_Be_Reversible(t *testing.T) {
+	t.Parallel()
+
+	ids := []string{
+		"AGNTCY-3f0c2a1e-8d4b-4c53-9a51-2f1e0c7f6b2d",
+		"OKTA-00u1abcdEFGH",
+		"IDP-user@example.com",
+		"DUO-some subject/with:separators",
+	}
+
+	for _, id := range ids {
+		identifier := did.FromID(id)
+
+		assert.Regexp(t, `^did:agntcy:[A-Za-z0-9._%-]+$`, identifier)
+
+		decoded, err := did.ToID(identifier)
+
+		assert.NoError(t, err)
+		assert.Equal(t, id, decoded)
+	}
+}
+
+func TestToID_Should_Reject_Invalid_DIDs(t *testing.T) {
+	t.Parallel()
+
+	_, err := did.ToID("did:web:example.com")
+	assert.ErrorIs(t, err, did.ErrMethodNotSupported)
+
+	for _, identifier := range []string{"", "AGNTCY-test", "did:agntcy:", "did:agntcy:%zz", "uri:agntcy:test"} {
+		_, err = did.ToID(identifier)
+		assert.ErrorIs(t, err, did.ErrInvalidDID)
+	}
+}
+
+func TestNewDocument_Should_Map_The_Resolver_Metadata(t *testing.T) {
+	t.Parallel()
+
+	key, err := joseutil.GenerateJWK("ES256", "sig", "key-1")
+	assert.NoError(t, err)
+
+	md := &idtypes.ResolverMetadata{
+		ID: "AGNTCY-test",
+		VerificationMethod: []*idtypes.VerificationMethod{
+			{ID: "AGNTCY-test#key-1", PublicKeyJwk: key},
+		},
+		AssertionMethod: []string{"AGNTCY-test#key-1"},
+		Service: []*idtypes.Service{
+			{ServiceEndpoint: []string{"https://example.com"}},
+		},
+	}
+
+	doc := did.NewDocument(md)
+
+	assert.Equal(t, "did:agntcy:AGNTCY-test", doc.ID)
+	assert.Len(t, doc.VerificationMethod, 1)
+	assert.Equal(t, "did:agntcy:AGNTCY-test#key-1", doc.VerificationMethod[0].ID)
+	assert.Equal(t, doc.ID, doc.VerificationMethod[0].Controller)
+	assert.Empty(t, doc.VerificationMethod[0].PublicKeyJwk.D)
+	assert.Equal(t, []string{"did:agntcy:AGNTCY-test#key-1"}, doc.AssertionMethod)
+	assert.Equal(t, "did:agntcy:AGNTCY-test#service-1", doc.Service[0].ID)
+
+	md.Deactivated = true
+
+	doc = did.NewDocument(md)
+
+	assert.Equal(t, "did:agntcy:AGNTCY-test", doc.ID)
+	assert.Empty(t, doc.VerificationMethod)
+	assert.Empty(t, doc.Service)
+}
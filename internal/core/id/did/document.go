@@ -0,0 +1,121 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package did
+
+import (
+	"fmt"
+
+	idtypes "github.com/agntcy/identity/internal/core/id/types"
+	"github.com/agntcy/identity/pkg/jwk"
+)
+
+const (
+	ContextDIDv1          = "https://www.w3.org/ns/did/v1"
+	ContextJWS2020        = "https://w3id.org/security/suites/jws-2020/v1"
+	ContextDIDResolution  = "https://w3id.org/did-resolution/v1"
+	VerificationMethodJWK = "JsonWebKey2020"
+	ServiceTypeIdentity   = "IdentityService"
+)
+
+// Document is a DID Document as defined in the [W3C DID Core] specification
+//
+// [W3C DID Core]: https://www.w3.org/TR/did-core/
+type Document struct {
+	// The JSON-LD context, only present in the application/did+ld+json representation
+	Context []string `json:"@context,omitempty"`
+
+	// The DID subject of the document
+	ID string `json:"id"`
+
+	// The public keys used to verify the proofs created by the DID subject
+	VerificationMethod []*VerificationMethod `json:"verificationMethod,omitempty"`
+
+	// The verification methods used to issue verifiable credentials
+	AssertionMethod []string `json:"assertionMethod,omitempty"`
+
+	// The ways of communicating with the DID subject
+	Service []*Service `json:"service,omitempty"`
+}
+
+// VerificationMethod is a public key of a DID Document
+type VerificationMethod struct {
+	ID           string   `json:"id"`
+	Type         string   `json:"type"`
+	Controller   string   `json:"controller"`
+	PublicKeyJwk *jwk.Jwk `json:"publicKeyJwk,omitempty"`
+}
+
+// Service is a service endpoint of a DID Document
+type Service struct {
+	ID              string   `json:"id"`
+	Type            string   `json:"type"`
+	ServiceEndpoint []string `json:"serviceEndpoint"`
+}
+
+// ResolutionResult is the result of the resolution of a DID as defined
+// in the [W3C DID Resolution] specification
+//
+// [W3C DID Resolution]: https://w3c.github.io/did-resolution/
+type ResolutionResult struct {
+	Context               string              `json:"@context"`
+	DIDDocument           *Document           `json:"didDocument"`
+	DIDResolutionMetadata *ResolutionMetadata `json:"didResolutionMetadata"`
+	DIDDocumentMetadata   *DocumentMetadata   `json:"didDocumentMetadata"`
+}
+
+// ResolutionMetadata contains the metadata of the resolution process
+type ResolutionMetadata struct {
+	// The media type of the returned DID Document
+	ContentType string `json:"contentType,omitempty"`
+
+	// The error code when the resolution failed, for instance notFound
+	Error string `json:"error,omitempty"`
+}
+
+// DocumentMetadata contains the metadata about the DID Document
+type DocumentMetadata struct {
+	// Deactivated is true when the DID has been deactivated
+	Deactivated bool `json:"deactivated,omitempty"`
+}
+
+// NewDocument creates the DID Document of a resolver metadata.
+// The document of a deactivated ID only contains its DID.
+func NewDocument(md *idtypes.ResolverMetadata) *Document {
+	did := FromID(md.ID)
+	doc := &Document{
+		Context: []string{ContextDIDv1, ContextJWS2020},
+		ID:      did,
+	}
+
+	if md.Deactivated {
+		return doc
+	}
+
+	for _, vm := range md.VerificationMethod {
+		if vm.PublicKeyJwk == nil {
+			continue
+		}
+
+		doc.VerificationMethod = append(doc.VerificationMethod, &VerificationMethod{
+			ID:           FromVerificationMethodID(md.ID, vm.ID),
+			Type:         VerificationMethodJWK,
+			Controller:   did,
+			PublicKeyJwk: vm.PublicKeyJwk.PublicKey(),
+		})
+	}
+
+	for _, am := range md.AssertionMethod {
+		doc.AssertionMethod = append(doc.AssertionMethod, FromVerificationMethodID(md.ID, am))
+	}
+
+	for idx, service := range md.Service {
+		doc.Service = append(doc.Service, &Service{
+			ID:              fmt.Sprintf("%s#service-%d", did, idx+1),
+			Type:            ServiceTypeIdentity,
+			ServiceEndpoint: service.ServiceEndpoint,
+		})
+	}
+
+	return doc
+}
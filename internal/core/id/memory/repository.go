@@ -0,0 +1,150 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	errcore "github.com/agntcy/identity/internal/core/errors"
+	idcore "github.com/agntcy/identity/internal/core/id"
+	idtypes "github.com/agntcy/identity/internal/core/id/types"
+	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db/memory"
+)
+
+const resolverMetadataTable = "resolver_metadata"
+
+type idMemoryRepository struct {
+	metadata *memory.Table[*idtypes.ResolverMetadata]
+}
+
+// NewIdRepository creates a new instance of the IdRepository
+// storing the resolver metadata in the in-memory store
+func NewIdRepository(store *memory.Store) (idcore.IdRepository, error) {
+	metadata, err := memory.NewTable[*idtypes.ResolverMetadata](store, resolverMetadataTable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &idMemoryRepository{
+		metadata: metadata,
+	}, nil
+}
+
+func (r *idMemoryRepository) CreateID(
+	ctx context.Context,
+	metadata *idtypes.ResolverMetadata,
+	issuer *issuertypes.Issuer,
+) (*idtypes.ResolverMetadata, error) {
+	stored, err := memory.Clone(metadata)
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error creating the resolver metadata")
+	}
+
+	stored.Controller = issuer.CommonName
+
+	err = r.metadata.Update(ctx, func(rows map[string]*idtypes.ResolverMetadata) error {
+		if _, ok := rows[metadata.ID]; ok {
+			return errcore.ErrResourceAlreadyExists
+		}
+
+		rows[metadata.ID] = stored
+
+		return nil
+	})
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error creating the resolver metadata")
+	}
+
+	return metadata, nil
+}
+
+func (r *idMemoryRepository) ResolveID(
+	ctx context.Context,
+	id string,
+) (*idtypes.ResolverMetadata, error) {
+	var metadata *idtypes.ResolverMetadata
+
+	err := r.metadata.View(ctx, func(rows map[string]*idtypes.ResolverMetadata) error {
+		stored, ok := rows[id]
+		if !ok {
+			return errcore.ErrResourceNotFound
+		}
+
+		var err error
+		metadata, err = memory.Clone(stored)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+func (r *idMemoryRepository) GetByController(
+	ctx context.Context,
+	controller string,
+) ([]*idtypes.ResolverMetadata, error) {
+	mds := make([]*idtypes.ResolverMetadata, 0)
+
+	err := r.metadata.View(ctx, func(rows map[string]*idtypes.ResolverMetadata) error {
+		for _, stored := range rows {
+			if stored.Controller != controller {
+				continue
+			}
+
+			md, err := memory.Clone(stored)
+			if err != nil {
+				return err
+			}
+
+			mds = append(mds, md)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error fetching the resolver metadata")
+	}
+
+	slices.SortFunc(mds, func(a, b *idtypes.ResolverMetadata) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return mds, nil
+}
+
+// UpdateID stores the verification methods, the services, the assertion methods
+// and the deactivation state of the resolver metadata, the controller is unchanged
+func (r *idMemoryRepository) UpdateID(
+	ctx context.Context,
+	metadata *idtypes.ResolverMetadata,
+) (*idtypes.ResolverMetadata, error) {
+	updated, err := memory.Clone(metadata)
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error updating the resolver metadata")
+	}
+
+	err = r.metadata.Update(ctx, func(rows map[string]*idtypes.ResolverMetadata) error {
+		stored, ok := rows[metadata.ID]
+		if !ok {
+			return errcore.ErrResourceNotFound
+		}
+
+		updated.Controller = stored.Controller
+		rows[metadata.ID] = updated
+
+		return nil
+	})
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error updating the resolver metadata")
+	}
+
+	return metadata, nil
+}
@@ -4,6 +4,8 @@
 package postgres
 
 import (
+	"time"
+
 	"github.com/agntcy/identity/internal/core/id/types"
 	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
 	vc "github.com/agntcy/identity/internal/core/vc/postgres"
@@ -20,6 +22,8 @@ type ResolverMetadata struct {
 	VC                 []*vc.VerifiableCredential `gorm:"foreignKey:ResolverMetadataID"`
 	AssertionMethod    pq.StringArray             `gorm:"type:text[]"`
 	Controller         string
+	Deactivated        bool
+	DeactivatedAt      *time.Time
 }
 
 func (md *ResolverMetadata) ToCoreType() *types.ResolverMetadata {
@@ -39,12 +43,15 @@ func (md *ResolverMetadata) ToCoreType() *types.ResolverMetadata {
 		),
 		AssertionMethod: md.AssertionMethod,
 		Controller:      md.Controller,
+		Deactivated:     md.Deactivated,
+		DeactivatedAt:   md.DeactivatedAt,
 	}
 }
 
 type VerificationMethod struct {
 	ID                 string   `gorm:"primaryKey"`
 	PublicKeyJwk       *jwk.Jwk `gorm:"embedded;embeddedPrefix:public_key_jwk_"`
+	RetiredAt          *time.Time
 	ResolverMetadataID string
 }
 
@@ -52,6 +59,7 @@ func (vm *VerificationMethod) ToCoreType() *types.VerificationMethod {
 	return &types.VerificationMethod{
 		ID:           vm.ID,
 		PublicKeyJwk: vm.PublicKeyJwk,
+		RetiredAt:    vm.RetiredAt,
 	}
 }
 
@@ -87,6 +95,7 @@ func newVerificationMethodModel(src *types.VerificationMethod) *VerificationMeth
 	return &VerificationMethod{
 		ID:           src.ID,
 		PublicKeyJwk: src.PublicKeyJwk,
+		RetiredAt:    src.RetiredAt,
 	}
 }
 
@@ -13,6 +13,7 @@ import (
 	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
 	"github.com/agntcy/identity/internal/pkg/errutil"
 	"github.com/agntcy/identity/pkg/db"
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -34,7 +35,7 @@ func (r *idPostgresRepository) CreateID(
 ) (*idtypes.ResolverMetadata, error) {
 	model := newResolverMetadataModel(metadata, issuer)
 
-	result := r.dbContext.Client().Create(model)
+	result := db.Client(ctx, r.dbContext).Create(model)
 	if result.Error != nil {
 		return nil, errutil.Err(
 			result.Error, "there was an error creating the resolver metadata",
@@ -50,7 +51,7 @@ func (r *idPostgresRepository) ResolveID(
 ) (*idtypes.ResolverMetadata, error) {
 	var metadata ResolverMetadata
 
-	result := r.dbContext.Client().
+	result := db.Client(ctx, r.dbContext).
 		Model(&ResolverMetadata{}).
 		Preload(clause.Associations).
 		First(&metadata, "id = ?", id)
@@ -66,3 +67,112 @@ func (r *idPostgresRepository) ResolveID(
 
 	return metadata.ToCoreType(), nil
 }
+
+func (r *idPostgresRepository) GetByController(
+	ctx context.Context,
+	controller string,
+) ([]*idtypes.ResolverMetadata, error) {
+	var stored []*ResolverMetadata
+
+	result := db.Client(ctx, r.dbContext).
+		Preload(clause.Associations).
+		Where("controller = ?", controller).
+		Find(&stored)
+	if result.Error != nil {
+		return nil, errutil.Err(
+			result.Error, "there was an error fetching the resolver metadata",
+		)
+	}
+
+	mds := make([]*idtypes.ResolverMetadata, 0, len(stored))
+	for _, md := range stored {
+		mds = append(mds, md.ToCoreType())
+	}
+
+	return mds, nil
+}
+
+// UpdateID stores the verification methods, the services, the assertion methods
+// and the deactivation state of the resolver metadata, the controller is unchanged
+func (r *idPostgresRepository) UpdateID(
+	ctx context.Context,
+	metadata *idtypes.ResolverMetadata,
+) (*idtypes.ResolverMetadata, error) {
+	err := db.Client(ctx, r.dbContext).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&ResolverMetadata{ID: metadata.ID}).
+			Updates(map[string]any{
+				"assertion_method": pq.StringArray(metadata.AssertionMethod),
+				"deactivated":      metadata.Deactivated,
+				"deactivated_at":   metadata.DeactivatedAt,
+			}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = updateVerificationMethods(tx, metadata)
+		if err != nil {
+			return err
+		}
+
+		return updateServices(tx, metadata)
+	})
+	if err != nil {
+		return nil, errutil.Err(
+			err, "there was an error updating the resolver metadata",
+		)
+	}
+
+	return metadata, nil
+}
+
+// updateVerificationMethods removes the verification methods that are no longer
+// part of the resolver metadata and upserts the remaining ones
+func updateVerificationMethods(tx *gorm.DB, metadata *idtypes.ResolverMetadata) error {
+	ids := make([]string, 0, len(metadata.VerificationMethod))
+	for _, vm := range metadata.VerificationMethod {
+		ids = append(ids, vm.ID)
+	}
+
+	query := tx.Where("resolver_metadata_id = ?", metadata.ID)
+	if len(ids) > 0 {
+		query = query.Where("id NOT IN ?", ids)
+	}
+
+	err := query.Delete(&VerificationMethod{}).Error
+	if err != nil {
+		return err
+	}
+
+	for _, vm := range metadata.VerificationMethod {
+		model := newVerificationMethodModel(vm)
+		model.ResolverMetadataID = metadata.ID
+
+		err = tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(model).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateServices replaces the services of the resolver metadata
+func updateServices(tx *gorm.DB, metadata *idtypes.ResolverMetadata) error {
+	err := tx.Where("resolver_metadata_id = ?", metadata.ID).Delete(&Service{}).Error
+	if err != nil {
+		return err
+	}
+
+	for _, service := range metadata.Service {
+		model := newServiceModel(service)
+		model.ResolverMetadataID = metadata.ID
+
+		err = tx.Create(model).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
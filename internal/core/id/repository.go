@@ -17,4 +17,9 @@ type IdRepository interface {
 		issuer *issuertypes.Issuer,
 	) (*types.ResolverMetadata, error)
 	ResolveID(ctx context.Context, id string) (*types.ResolverMetadata, error)
+	GetByController(ctx context.Context, controller string) ([]*types.ResolverMetadata, error)
+	UpdateID(
+		ctx context.Context,
+		metadata *types.ResolverMetadata,
+	) (*types.ResolverMetadata, error)
 }
@@ -41,3 +41,36 @@ func (r *FakeIdRepository) ResolveID(
 
 	return nil, errcore.ErrResourceNotFound
 }
+
+func (r *FakeIdRepository) GetByController(
+	ctx context.Context,
+	controller string,
+) ([]*idtypes.ResolverMetadata, error) {
+	mds := make([]*idtypes.ResolverMetadata, 0)
+
+	for _, md := range r.store {
+		if md.Controller == controller {
+			mds = append(mds, md)
+		}
+	}
+
+	return mds, nil
+}
+
+func (r *FakeIdRepository) UpdateID(
+	ctx context.Context,
+	metadata *idtypes.ResolverMetadata,
+) (*idtypes.ResolverMetadata, error) {
+	md, ok := r.store[metadata.ID]
+	if !ok {
+		return nil, errcore.ErrResourceNotFound
+	}
+
+	md.VerificationMethod = metadata.VerificationMethod
+	md.AssertionMethod = metadata.AssertionMethod
+	md.Service = metadata.Service
+	md.Deactivated = metadata.Deactivated
+	md.DeactivatedAt = metadata.DeactivatedAt
+
+	return md, nil
+}
@@ -4,6 +4,9 @@
 package types
 
 import (
+	"slices"
+	"time"
+
 	"github.com/agntcy/identity/pkg/jwk"
 )
 
@@ -16,6 +19,15 @@ type VerificationMethod struct {
 
 	// The public key used for the verification method.
 	PublicKeyJwk *jwk.Jwk `json:"publicKeyJwk,omitempty" protobuf:"bytes,2,opt,name=public_key_jwk"`
+
+	// The date and time after which the key of a rotated verification
+	// method can no longer be used.
+	RetiredAt *time.Time `json:"retiredAt,omitempty" protobuf:"bytes,3,opt,name=retired_at"`
+}
+
+// IsActive returns true if the verification method is not retired at the given time
+func (vm *VerificationMethod) IsActive(now time.Time) bool {
+	return vm.RetiredAt == nil || vm.RetiredAt.After(now)
 }
 
 // Service is used in ResolverMetadata to express ways of communicating with
@@ -51,13 +63,59 @@ type ResolverMetadata struct {
 
 	// A controller is an entity that is authorized to make changes to a Resolver Metadata.
 	Controller string
+
+	// Deactivated is true when the ID has been permanently deactivated by its controller.
+	// A deactivated ID can no longer be updated and the VCs bound to it fail verification.
+	Deactivated bool `json:"deactivated,omitempty" protobuf:"varint,6,opt,name=deactivated"`
+
+	// The date and time when the ID has been deactivated.
+	DeactivatedAt *time.Time `json:"deactivatedAt,omitempty"`
+}
+
+// Tombstone returns the resolver metadata published for a deactivated ID,
+// without any verification method or service
+func (r *ResolverMetadata) Tombstone() *ResolverMetadata {
+	return &ResolverMetadata{
+		ID:            r.ID,
+		Controller:    r.Controller,
+		Deactivated:   true,
+		DeactivatedAt: r.DeactivatedAt,
+	}
+}
+
+// ActiveMetadata returns a copy of the resolver metadata
+// without the verification methods retired at the given time
+func (r *ResolverMetadata) ActiveMetadata(now time.Time) *ResolverMetadata {
+	active := *r
+	active.VerificationMethod = make([]*VerificationMethod, 0, len(r.VerificationMethod))
+	active.AssertionMethod = make([]string, 0, len(r.AssertionMethod))
+
+	retired := make([]string, 0)
+
+	for _, vm := range r.VerificationMethod {
+		if vm.IsActive(now) {
+			active.VerificationMethod = append(active.VerificationMethod, vm)
+		} else {
+			retired = append(retired, vm.ID)
+		}
+	}
+
+	for _, id := range r.AssertionMethod {
+		if !slices.Contains(retired, id) {
+			active.AssertionMethod = append(active.AssertionMethod, id)
+		}
+	}
+
+	return &active
 }
 
+// GetJwks returns the public keys of the active verification methods
 func (r *ResolverMetadata) GetJwks() *jwk.Jwks {
 	jwks := jwk.Jwks{}
+	now := time.Now()
 
 	for _, vm := range r.VerificationMethod {
-		if vm.PublicKeyJwk != nil {
+		if vm.PublicKeyJwk != nil && vm.IsActive(now) {
 			jwks.Keys = append(jwks.Keys, vm.PublicKeyJwk)
 		}
 	}
@@ -0,0 +1,114 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+
+	errcore "github.com/agntcy/identity/internal/core/errors"
+	issuercore "github.com/agntcy/identity/internal/core/issuer"
+	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db/memory"
+)
+
+const issuersTable = "issuers"
+
+type repository struct {
+	issuers *memory.Table[*issuertypes.Issuer]
+}
+
+// NewRepository creates a new instance of the IssuerRepository
+// storing the issuers in the in-memory store
+func NewRepository(store *memory.Store) (issuercore.Repository, error) {
+	issuers, err := memory.NewTable[*issuertypes.Issuer](store, issuersTable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository{
+		issuers: issuers,
+	}, nil
+}
+
+// CreateIssuer creates a new Issuer
+func (r *repository) CreateIssuer(
+	ctx context.Context,
+	issuer *issuertypes.Issuer,
+) (*issuertypes.Issuer, error) {
+	stored, err := memory.Clone(issuer)
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error creating the issuer")
+	}
+
+	err = r.issuers.Update(ctx, func(rows map[string]*issuertypes.Issuer) error {
+		if _, ok := rows[issuer.CommonName]; ok {
+			return errcore.ErrResourceAlreadyExists
+		}
+
+		rows[issuer.CommonName] = stored
+
+		return nil
+	})
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error creating the issuer")
+	}
+
+	return issuer, nil
+}
+
+func (r *repository) GetIssuer(
+	ctx context.Context,
+	commonName string,
+) (*issuertypes.Issuer, error) {
+	var issuer *issuertypes.Issuer
+
+	err := r.issuers.View(ctx, func(rows map[string]*issuertypes.Issuer) error {
+		stored, ok := rows[commonName]
+		if !ok {
+			return errcore.ErrResourceNotFound
+		}
+
+		var err error
+		issuer, err = memory.Clone(stored)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return issuer, nil
+}
+
+// GetIssuerForUpdate fetches the Issuer. The transactions of the
+// in-memory store are exclusive, so the Issuer cannot change before
+// the end of the transaction of the context
+func (r *repository) GetIssuerForUpdate(
+	ctx context.Context,
+	commonName string,
+) (*issuertypes.Issuer, error) {
+	return r.GetIssuer(ctx, commonName)
+}
+
+// UpdateIssuer updates the Issuer and replaces its retired keys
+func (r *repository) UpdateIssuer(
+	ctx context.Context,
+	issuer *issuertypes.Issuer,
+) (*issuertypes.Issuer, error) {
+	stored, err := memory.Clone(issuer)
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error updating the issuer")
+	}
+
+	err = r.issuers.Update(ctx, func(rows map[string]*issuertypes.Issuer) error {
+		rows[issuer.CommonName] = stored
+		return nil
+	})
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error updating the issuer")
+	}
+
+	return issuer, nil
+}
@@ -4,8 +4,11 @@
 package postgres
 
 import (
+	"time"
+
 	id "github.com/agntcy/identity/internal/core/id/postgres"
 	"github.com/agntcy/identity/internal/core/issuer/types"
+	"github.com/agntcy/identity/internal/pkg/convertutil"
 	"github.com/agntcy/identity/pkg/jwk"
 )
 
@@ -16,9 +19,24 @@ type Issuer struct {
 	SubOrganization  string   `gorm:"not null;type:varchar(256);"`
 	PublicKey        *jwk.Jwk `gorm:"embedded;embeddedPrefix:public_key_"`
 	AuthType         types.IssuerAuthType
+	RetiredKeys      []*RetiredKey          `gorm:"foreignKey:IssuerCommonName"`
 	ResolverMetadata []*id.ResolverMetadata `gorm:"foreignKey:Controller"`
 }
 
+type RetiredKey struct {
+	ID               uint     `gorm:"primaryKey"`
+	PublicKey        *jwk.Jwk `gorm:"embedded;embeddedPrefix:public_key_"`
+	RetiredAt        time.Time
+	IssuerCommonName string `gorm:"index"`
+}
+
+func (k *RetiredKey) ToCoreType() *types.RetiredKey {
+	return &types.RetiredKey{
+		PublicKey: k.PublicKey,
+		RetiredAt: k.RetiredAt,
+	}
+}
+
 func (i *Issuer) ToCoreType() *types.Issuer {
 	return &types.Issuer{
 		CommonName:      i.CommonName,
@@ -27,6 +45,9 @@ func (i *Issuer) ToCoreType() *types.Issuer {
 		Organization:    i.Organization,
 		SubOrganization: i.SubOrganization,
 		PublicKey:       i.PublicKey,
+		RetiredKeys: convertutil.ConvertSlice(i.RetiredKeys, func(k *RetiredKey) *types.RetiredKey {
+			return k.ToCoreType()
+		}),
 	}
 }
 
@@ -38,5 +59,16 @@ func newIssuerModel(src *types.Issuer) *Issuer {
 		Organization:    src.Organization,
 		SubOrganization: src.SubOrganization,
 		PublicKey:       src.PublicKey,
+		RetiredKeys: convertutil.ConvertSlice(src.RetiredKeys, func(k *types.RetiredKey) *RetiredKey {
+			return newRetiredKeyModel(k, src.CommonName)
+		}),
+	}
+}
+
+func newRetiredKeyModel(src *types.RetiredKey, commonName string) *RetiredKey {
+	return &RetiredKey{
+		PublicKey:        src.PublicKey,
+		RetiredAt:        src.RetiredAt,
+		IssuerCommonName: commonName,
 	}
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/agntcy/identity/internal/pkg/errutil"
 	"github.com/agntcy/identity/pkg/db"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type repository struct {
@@ -34,7 +35,7 @@ func (r *repository) CreateIssuer(
 	model := newIssuerModel(issuer)
 
 	// Create the issuer
-	inserted := r.dbContext.Client().Create(model)
+	inserted := db.Client(ctx, r.dbContext).Create(model)
 	if inserted.Error != nil {
 		return nil, errutil.Err(
 			inserted.Error, "there was an error creating the issuer",
@@ -47,10 +48,29 @@ func (r *repository) CreateIssuer(
 func (r *repository) GetIssuer(
 	ctx context.Context,
 	commonName string,
+) (*issuertypes.Issuer, error) {
+	return r.getIssuer(db.Client(ctx, r.dbContext), commonName)
+}
+
+// GetIssuerForUpdate fetches the Issuer and locks its row
+// until the end of the transaction of the context
+func (r *repository) GetIssuerForUpdate(
+	ctx context.Context,
+	commonName string,
+) (*issuertypes.Issuer, error) {
+	return r.getIssuer(
+		db.Client(ctx, r.dbContext).Clauses(clause.Locking{Strength: "UPDATE"}),
+		commonName,
+	)
+}
+
+func (r *repository) getIssuer(
+	client *gorm.DB,
+	commonName string,
 ) (*issuertypes.Issuer, error) {
 	var issuer Issuer
 
-	result := r.dbContext.Client().First(&issuer, map[string]interface{}{
+	result := client.Preload("RetiredKeys").First(&issuer, map[string]interface{}{
 		"common_name": commonName,
 	})
 	if result.Error != nil {
@@ -65,3 +85,36 @@ func (r *repository) GetIssuer(
 
 	return issuer.ToCoreType(), nil
 }
+
+// UpdateIssuer updates the Issuer and replaces its retired keys
+func (r *repository) UpdateIssuer(
+	ctx context.Context,
+	issuer *issuertypes.Issuer,
+) (*issuertypes.Issuer, error) {
+	model := newIssuerModel(issuer)
+
+	err := db.Client(ctx, r.dbContext).Transaction(func(tx *gorm.DB) error {
+		err := tx.Omit(clause.Associations).Save(model).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("issuer_common_name = ?", issuer.CommonName).Delete(&RetiredKey{}).Error
+		if err != nil {
+			return err
+		}
+
+		if len(model.RetiredKeys) == 0 {
+			return nil
+		}
+
+		return tx.Create(model.RetiredKeys).Error
+	})
+	if err != nil {
+		return nil, errutil.Err(
+			err, "there was an error updating the issuer",
+		)
+	}
+
+	return issuer, nil
+}
@@ -0,0 +1,20 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package replay remembers the proofs accepted by the Node
+// so a captured proof cannot be used twice.
+package replay
+
+import (
+	"context"
+	"time"
+)
+
+type Cache interface {
+	// Record stores the ID of a proof until its expiration.
+	// It returns false when the ID is already stored and not expired at now.
+	Record(ctx context.Context, id string, now, expiresAt time.Time) (bool, error)
+
+	// Purge removes the IDs expired at now
+	Purge(ctx context.Context, now time.Time) error
+}
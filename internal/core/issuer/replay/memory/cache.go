@@ -0,0 +1,73 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/issuer/replay"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db/memory"
+)
+
+const proofIDsTable = "proof_ids"
+
+type replayMemoryCache struct {
+	ids *memory.Table[time.Time]
+}
+
+// NewCache creates a new instance of the replay Cache
+// storing the IDs in the in-memory store
+func NewCache(store *memory.Store) (replay.Cache, error) {
+	ids, err := memory.NewTable[time.Time](store, proofIDsTable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replayMemoryCache{
+		ids: ids,
+	}, nil
+}
+
+func (c *replayMemoryCache) Record(
+	ctx context.Context,
+	id string,
+	now, expiresAt time.Time,
+) (bool, error) {
+	recorded := false
+
+	err := c.ids.Update(ctx, func(rows map[string]time.Time) error {
+		if stored, ok := rows[id]; ok && stored.After(now) {
+			return nil
+		}
+
+		rows[id] = expiresAt
+		recorded = true
+
+		return nil
+	})
+	if err != nil {
+		return false, errutil.Err(err, "there was an error recording the proof")
+	}
+
+	return recorded, nil
+}
+
+func (c *replayMemoryCache) Purge(ctx context.Context, now time.Time) error {
+	err := c.ids.Update(ctx, func(rows map[string]time.Time) error {
+		for id, expiresAt := range rows {
+			if !expiresAt.After(now) {
+				delete(rows, id)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errutil.Err(err, "there was an error purging the expired proofs")
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package memory_test
+
+import (
+	"testing"
+	"time"
+
+	replaymemory "github.com/agntcy/identity/internal/core/issuer/replay/memory"
+	"github.com/agntcy/identity/pkg/db/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache_Should_Record_An_ID_Until_It_Expires(t *testing.T) {
+	t.Parallel()
+
+	store, err := memory.NewStore("")
+	assert.NoError(t, err)
+
+	sut, err := replaymemory.NewCache(store)
+	assert.NoError(t, err)
+
+	now := time.Now()
+
+	recorded, err := sut.Record(t.Context(), "ID", now, now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, recorded)
+
+	recorded, err = sut.Record(t.Context(), "ID", now.Add(time.Second), now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.False(t, recorded)
+
+	// An expired ID can be recorded again
+	recorded, err = sut.Record(t.Context(), "ID", now.Add(time.Minute), now.Add(2*time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, recorded)
+
+	assert.NoError(t, sut.Purge(t.Context(), now.Add(2*time.Minute)))
+
+	recorded, err = sut.Record(t.Context(), "ID", now, now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, recorded)
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/issuer/replay"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db"
+	"gorm.io/gorm/clause"
+)
+
+type replayPostgresCache struct {
+	dbContext db.Context
+}
+
+// NewCache creates a new instance of the replay Cache shared by the replicas of the Node
+func NewCache(dbContext db.Context) replay.Cache {
+	return &replayPostgresCache{
+		dbContext: dbContext,
+	}
+}
+
+// Record inserts the ID or replaces it when expired in a single statement,
+// so concurrent Nodes cannot both record the same ID
+func (c *replayPostgresCache) Record(
+	ctx context.Context,
+	id string,
+	now, expiresAt time.Time,
+) (bool, error) {
+	result := db.Client(ctx, c.dbContext).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"expires_at"}),
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Lte{Column: clause.Column{Table: "proof_ids", Name: "expires_at"}, Value: now},
+			}},
+		}).
+		Create(&ProofID{ID: id, ExpiresAt: expiresAt})
+	if result.Error != nil {
+		return false, errutil.Err(result.Error, "there was an error recording the proof")
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (c *replayPostgresCache) Purge(ctx context.Context, now time.Time) error {
+	err := db.Client(ctx, c.dbContext).
+		Where("expires_at <= ?", now).
+		Delete(&ProofID{}).Error
+	if err != nil {
+		return errutil.Err(err, "there was an error purging the expired proofs")
+	}
+
+	return nil
+}
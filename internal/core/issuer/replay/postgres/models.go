@@ -0,0 +1,17 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"time"
+)
+
+type ProofID struct {
+	ID        string    `gorm:"primaryKey"`
+	ExpiresAt time.Time `gorm:"index"`
+}
+
+func (ProofID) TableName() string {
+	return "proof_ids"
+}
@@ -16,4 +16,12 @@ type Repository interface {
 		issuer *types.Issuer,
 	) (*types.Issuer, error)
 	GetIssuer(ctx context.Context, commonName string) (*types.Issuer, error)
+
+	// GetIssuerForUpdate fetches the Issuer and locks it until the end
+	// of the transaction of the context
+	GetIssuerForUpdate(ctx context.Context, commonName string) (*types.Issuer, error)
+	UpdateIssuer(
+		ctx context.Context,
+		issuer *types.Issuer,
+	) (*types.Issuer, error)
 }
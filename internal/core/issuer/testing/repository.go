@@ -39,3 +39,23 @@ func (r *FakeIssuerRepository) GetIssuer(
 
 	return nil, errors.ErrResourceNotFound
 }
+
+func (r *FakeIssuerRepository) GetIssuerForUpdate(
+	ctx context.Context,
+	commonName string,
+) (*issuertypes.Issuer, error) {
+	return r.GetIssuer(ctx, commonName)
+}
+
+func (r *FakeIssuerRepository) UpdateIssuer(
+	ctx context.Context,
+	issuer *issuertypes.Issuer,
+) (*issuertypes.Issuer, error) {
+	if _, ok := r.store[issuer.CommonName]; !ok {
+		return nil, errors.ErrResourceNotFound
+	}
+
+	r.store[issuer.CommonName] = issuer
+
+	return issuer, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package trust defines the trust policy of the Node, which restricts
+// the identity providers and the common names of the issuers.
+package trust
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/oidc"
+)
+
+// Policy restricts the issuers trusted by the Node
+type Policy struct {
+	// The issuer URLs of the identity providers allowed to register issuers,
+	// any identity provider is allowed when empty
+	AllowedIssuers []string `json:"allowedIssuers,omitempty"`
+
+	// The issuer URLs of the identity providers never trusted,
+	// they take precedence over the allowed issuers
+	DeniedIssuers []string `json:"deniedIssuers,omitempty"`
+
+	// Whether the self-issued issuers may register
+	AllowSelfIssued bool `json:"allowSelfIssued"`
+
+	// The patterns of the common names allowed for each provider of the registry,
+	// including self for the self-issued issuers. A pattern is a domain name,
+	// *.example.com matches the subdomains of example.com.
+	// Any common name is allowed for the providers without patterns.
+	CommonNames map[oidc.ProviderName][]string `json:"commonNames,omitempty"`
+}
+
+// DefaultPolicy trusts every identity provider and the self-issued issuers
+func DefaultPolicy() *Policy {
+	return &Policy{
+		AllowSelfIssued: true,
+	}
+}
+
+// LoadPolicy reads the policy from the JSON file at the path
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy Policy
+
+	err = json.Unmarshal(data, &policy)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the trust policy of %s: %w", path, err)
+	}
+
+	err = policy.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Validate checks the issuer URLs and the common name patterns of the policy
+func (p *Policy) Validate() error {
+	for _, issuer := range slices.Concat(p.AllowedIssuers, p.DeniedIssuers) {
+		u, err := url.Parse(issuer)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid issuer URL in the trust policy: %q", issuer)
+		}
+	}
+
+	for provider, patterns := range p.CommonNames {
+		for _, pattern := range patterns {
+			domain := strings.TrimPrefix(pattern, "*.")
+			if domain == "" || strings.Contains(domain, "*") {
+				return fmt.Errorf("invalid common name pattern of the provider %s: %q", provider, pattern)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckRegistration verifies that an issuer with the common name
+// may register with a proof of the provider and the issuer URL
+func (p *Policy) CheckRegistration(
+	provider oidc.ProviderName,
+	issuer string,
+	commonName string,
+) error {
+	if provider == oidc.SelfProviderName {
+		if !p.AllowSelfIssued {
+			return untrustedError("the self-issued issuers are not allowed to register")
+		}
+	} else {
+		err := p.CheckIssuer(issuer)
+		if err != nil {
+			return err
+		}
+	}
+
+	patterns, ok := p.CommonNames[provider]
+	if !ok || len(patterns) == 0 {
+		return nil
+	}
+
+	for _, pattern := range patterns {
+		if matchesCommonName(pattern, commonName) {
+			return nil
+		}
+	}
+
+	return untrustedError(
+		fmt.Sprintf("the common name %s is not allowed for the provider %s", commonName, provider),
+	)
+}
+
+// CheckIssuer verifies that the proofs of the identity provider
+// with the issuer URL are trusted
+func (p *Policy) CheckIssuer(issuer string) error {
+	if containsIssuer(p.DeniedIssuers, issuer) {
+		return untrustedError(fmt.Sprintf("the identity provider %s is denied", issuer))
+	}
+
+	if len(p.AllowedIssuers) > 0 && !containsIssuer(p.AllowedIssuers, issuer) {
+		return untrustedError(fmt.Sprintf("the identity provider %s is not allowed", issuer))
+	}
+
+	return nil
+}
+
+func containsIssuer(issuers []string, issuer string) bool {
+	issuer = strings.TrimSuffix(issuer, "/")
+
+	return slices.ContainsFunc(issuers, func(i string) bool {
+		return strings.TrimSuffix(i, "/") == issuer
+	})
+}
+
+// matchesCommonName reports whether the common name is the domain of the pattern
+// or, for a *. pattern, one of its subdomains
+func matchesCommonName(pattern, commonName string) bool {
+	pattern = strings.ToLower(pattern)
+	commonName = strings.ToLower(commonName)
+
+	if domain, ok := strings.CutPrefix(pattern, "*."); ok {
+		return strings.HasSuffix(commonName, "."+domain)
+	}
+
+	return commonName == pattern
+}
+
+func untrustedError(message string) error {
+	return errutil.ErrInfo(errtypes.ERROR_REASON_UNTRUSTED_ISSUER, message, nil)
+}
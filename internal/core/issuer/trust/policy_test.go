@@ -0,0 +1,126 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package trust_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	errtesting "github.com/agntcy/identity/internal/core/errors/testing"
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/core/issuer/trust"
+	"github.com/agntcy/identity/pkg/oidc"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	corpIssuer   = "https://corp.okta.com"
+	tenantIssuer = "https://tenant.okta.com"
+)
+
+func TestDefaultPolicy_Should_Trust_Every_Issuer(t *testing.T) {
+	t.Parallel()
+
+	sut := trust.DefaultPolicy()
+
+	assert.NoError(t, sut.CheckRegistration(oidc.OktaProviderName, tenantIssuer, "tenant.okta.com"))
+	assert.NoError(t, sut.CheckRegistration(oidc.SelfProviderName, "", "agent.example.com"))
+	assert.NoError(t, sut.CheckIssuer(tenantIssuer))
+}
+
+func TestPolicy_Should_Restrict_The_Issuers(t *testing.T) {
+	t.Parallel()
+
+	sut := &trust.Policy{
+		AllowedIssuers: []string{corpIssuer + "/", tenantIssuer},
+		DeniedIssuers:  []string{tenantIssuer},
+	}
+
+	assert.NoError(t, sut.CheckIssuer(corpIssuer))
+
+	err := sut.CheckIssuer(tenantIssuer + "/")
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_UNTRUSTED_ISSUER)
+
+	err = sut.CheckIssuer("https://other.okta.com")
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_UNTRUSTED_ISSUER)
+
+	err = sut.CheckRegistration(oidc.SelfProviderName, "", "agent.example.com")
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_UNTRUSTED_ISSUER)
+}
+
+func TestPolicy_Should_Restrict_The_Common_Names_Of_A_Provider(t *testing.T) {
+	t.Parallel()
+
+	sut := &trust.Policy{
+		AllowSelfIssued: true,
+		CommonNames: map[oidc.ProviderName][]string{
+			oidc.SelfProviderName: {"*.agents.example.com", "example.com"},
+		},
+	}
+
+	assert.NoError(t, sut.CheckRegistration(oidc.SelfProviderName, "", "Billing.Agents.Example.com"))
+	assert.NoError(t, sut.CheckRegistration(oidc.SelfProviderName, "", "example.com"))
+	assert.NoError(t, sut.CheckRegistration(oidc.OktaProviderName, tenantIssuer, "tenant.okta.com"))
+
+	for _, commonName := range []string{"agents.example.com", "evilagents.example.com", "example.com.evil"} {
+		err := sut.CheckRegistration(oidc.SelfProviderName, "", commonName)
+		errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_UNTRUSTED_ISSUER)
+	}
+}
+
+func TestPolicy_Should_Reject_Invalid_Policies(t *testing.T) {
+	t.Parallel()
+
+	invalid := []*trust.Policy{
+		{AllowedIssuers: []string{"corp.okta.com"}},
+		{DeniedIssuers: []string{"https://"}},
+		{CommonNames: map[oidc.ProviderName][]string{oidc.OktaProviderName: {"*"}}},
+		{CommonNames: map[oidc.ProviderName][]string{oidc.OktaProviderName: {"a.*.example.com"}}},
+	}
+
+	for _, policy := range invalid {
+		assert.Error(t, policy.Validate())
+	}
+}
+
+func TestFileSource_Should_Reload_The_Policy_On_File_Change(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "trust.json")
+	writePolicy(t, path, `{"allowSelfIssued": false}`)
+
+	sut, err := trust.NewFileSource(path)
+	assert.NoError(t, err)
+
+	defer func() {
+		_ = sut.Close()
+	}()
+
+	assert.False(t, sut.Policy().AllowSelfIssued)
+
+	writePolicy(t, path, `{"allowSelfIssued": true}`)
+
+	assert.Eventually(t, func() bool {
+		return sut.Policy().AllowSelfIssued
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestNewFileSource_Should_Reject_An_Invalid_Policy(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "trust.json")
+	writePolicy(t, path, `{"deniedIssuers": ["okta"]}`)
+
+	_, err := trust.NewFileSource(path)
+	assert.Error(t, err)
+}
+
+func writePolicy(t *testing.T, path, policy string) {
+	t.Helper()
+
+	err := os.WriteFile(path, []byte(policy), 0o600)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package trust
+
+import (
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/agntcy/identity/pkg/log"
+	"github.com/fsnotify/fsnotify"
+)
+
+// Source provides the current trust policy of the Node
+type Source interface {
+	Policy() *Policy
+}
+
+type staticSource struct {
+	policy *Policy
+}
+
+// NewStaticSource creates a source always providing the policy
+func NewStaticSource(policy *Policy) Source {
+	return &staticSource{policy}
+}
+
+func (s *staticSource) Policy() *Policy {
+	return s.policy
+}
+
+// FileSource provides the policy of a JSON file,
+// the policy is reloaded when the file changes
+type FileSource struct {
+	path    string
+	watcher *fsnotify.Watcher
+	policy  atomic.Pointer[Policy]
+}
+
+// NewFileSource loads the policy of the file and watches it for changes
+func NewFileSource(path string) (*FileSource, error) {
+	policy, err := LoadPolicy(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &FileSource{
+		path: path,
+	}
+	s.policy.Store(policy)
+
+	s.watcher, err = fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	// Watch the directory since the file is often replaced
+	// rather than written, for instance when mounted from a Kubernetes config map
+	err = s.watcher.Add(filepath.Dir(path))
+	if err != nil {
+		_ = s.watcher.Close()
+		return nil, err
+	}
+
+	go s.watch()
+
+	return s, nil
+}
+
+// Policy returns the latest valid policy of the file
+func (s *FileSource) Policy() *Policy {
+	return s.policy.Load()
+}
+
+// Close stops watching the file
+func (s *FileSource) Close() error {
+	if s == nil {
+		return nil
+	}
+
+	return s.watcher.Close()
+}
+
+func (s *FileSource) watch() {
+	for {
+		select {
+		case event, ok := <-s.watcher.Events:
+			if !ok {
+				return
+			}
+
+			if filepath.Clean(event.Name) != filepath.Clean(s.path) &&
+				filepath.Base(event.Name) != "..data" {
+				continue
+			}
+
+			policy, err := LoadPolicy(s.path)
+			if err != nil {
+				// Keep enforcing the previous policy, the change may be partial
+				log.Warn("Unable to reload the trust policy: ", err)
+				continue
+			}
+
+			s.policy.Store(policy)
+
+			log.Info("Reloaded the trust policy after a change of ", event.Name)
+		case err, ok := <-s.watcher.Errors:
+			if !ok {
+				return
+			}
+
+			log.Warn("Unable to watch the trust policy: ", err)
+		}
+	}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	"fmt"
 	"net/mail"
+	"time"
 
 	"github.com/agntcy/identity/pkg/jwk"
 )
@@ -55,6 +56,53 @@ type Issuer struct {
 	// It determines whether the issuer uses an external Identity Provider (IDP)
 	// or a self-issued key for authentication.
 	AuthType IssuerAuthType `json:"authType,omitempty" protobuf:"varint,7,opt,name=auth_type"`
+
+	// The previous public keys of the issuer replaced by a key rotation
+	RetiredKeys []*RetiredKey `json:"retiredKeys,omitempty"`
+}
+
+// A public key of the Issuer replaced by a key rotation
+type RetiredKey struct {
+	// The public key in JWK format
+	PublicKey *jwk.Jwk `json:"publicKey,omitempty"`
+
+	// The date and time after which the key can no longer be used
+	// to verify the claims of the issuer
+	RetiredAt time.Time `json:"retiredAt"`
+}
+
+// ActiveKeys returns the current public key of the issuer followed by
+// the previous keys that are not yet retired at the given time
+func (i *Issuer) ActiveKeys(now time.Time) []*jwk.Jwk {
+	keys := make([]*jwk.Jwk, 0, len(i.RetiredKeys)+1)
+
+	if i.PublicKey != nil {
+		keys = append(keys, i.PublicKey)
+	}
+
+	for _, key := range i.RetiredKeys {
+		if key.PublicKey != nil && key.RetiredAt.After(now) {
+			keys = append(keys, key.PublicKey)
+		}
+	}
+
+	return keys
+}
+
+// HasKeyID returns true if the current key or a previous key
+// of the issuer has the given key ID
+func (i *Issuer) HasKeyID(kid string) bool {
+	if i.PublicKey != nil && i.PublicKey.KID == kid {
+		return true
+	}
+
+	for _, key := range i.RetiredKeys {
+		if key.PublicKey != nil && key.PublicKey.KID == kid {
+			return true
+		}
+	}
+
+	return false
 }
 
 // ValidateCommonName validates the common name of the issuer
@@ -0,0 +1,150 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package verification
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/core/issuer/replay"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/oidc"
+)
+
+// The clock difference tolerated between the Node and the issuers of the proofs
+const clockSkew = 5 * time.Second
+
+type Option func(v *service)
+
+// WithAudience requires the proofs to contain one of the identifiers of the Node in their aud claim
+func WithAudience(audiences ...string) Option {
+	return func(v *service) {
+		v.audiences = audiences
+	}
+}
+
+// WithProviderAudience requires the proofs of the provider to contain one of the audiences
+// instead of the identifiers of the Node. Some identity providers, such as Okta or Entra ID,
+// ignore the audience requested by the issuers and use the one of their authorization server.
+func WithProviderAudience(provider oidc.ProviderName, audiences ...string) Option {
+	return func(v *service) {
+		if v.providerAudiences == nil {
+			v.providerAudiences = make(map[oidc.ProviderName][]string)
+		}
+
+		v.providerAudiences[provider] = audiences
+	}
+}
+
+// WithMaxAge rejects the proofs issued, according to their iat claim, longer than maxAge ago
+func WithMaxAge(maxAge time.Duration) Option {
+	return func(v *service) {
+		v.maxAge = maxAge
+	}
+}
+
+// WithReplayCache requires the proofs to contain a jti claim
+// and rejects the proofs whose jti has already been used
+func WithReplayCache(cache replay.Cache) Option {
+	return func(v *service) {
+		v.replayCache = cache
+	}
+}
+
+// checkFreshness verifies that the proof is issued for the Node, recently and is used once.
+// It must be called last, once the proof is verified, so a rejected proof is not recorded.
+func (v *service) checkFreshness(
+	ctx context.Context,
+	provider oidc.ProviderName,
+	claims *oidc.Claims,
+) error {
+	now := time.Now()
+
+	audiences := v.audiencesOf(provider)
+	if len(audiences) > 0 && !containsAudience(claims.Audience, audiences) {
+		return invalidProofError(
+			fmt.Sprintf("the proof is not issued for the audience %s", strings.Join(audiences, ", ")),
+		)
+	}
+
+	if v.maxAge > 0 {
+		if claims.IssuedAt.IsZero() {
+			return invalidProofError("the proof has no 'iat' claim")
+		}
+
+		if now.Sub(claims.IssuedAt) > v.maxAge+clockSkew {
+			return invalidProofError(fmt.Sprintf("the proof is older than %s", v.maxAge))
+		}
+	}
+
+	if v.replayCache == nil {
+		return nil
+	}
+
+	if claims.JwtID == "" {
+		return invalidProofError("the proof has no 'jti' claim")
+	}
+
+	expiresAt := v.proofExpiration(claims)
+	if expiresAt.IsZero() {
+		return invalidProofError("the proof has no 'exp' claim")
+	}
+
+	// The jti is only unique for an issuer of proofs
+	recorded, err := v.replayCache.Record(ctx, claims.Issuer+"#"+claims.JwtID, now, expiresAt)
+	if err != nil {
+		return errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
+	}
+
+	if !recorded {
+		return invalidProofError("the proof has already been used")
+	}
+
+	return nil
+}
+
+// audiencesOf returns the audiences one of which the proofs of the provider must contain
+func (v *service) audiencesOf(provider oidc.ProviderName) []string {
+	if audiences, ok := v.providerAudiences[provider]; ok {
+		return audiences
+	}
+
+	return v.audiences
+}
+
+// containsAudience reports whether the aud claim contains one of the audiences
+func containsAudience(claimed, audiences []string) bool {
+	return slices.ContainsFunc(claimed, func(audience string) bool {
+		return slices.ContainsFunc(audiences, func(expected string) bool {
+			return strings.TrimSuffix(audience, "/") == strings.TrimSuffix(expected, "/")
+		})
+	})
+}
+
+// proofExpiration returns the time after which the proof is no longer accepted,
+// its jti can be forgotten from then on
+func (v *service) proofExpiration(claims *oidc.Claims) time.Time {
+	var expiresAt time.Time
+
+	if !claims.Expiration.IsZero() {
+		expiresAt = claims.Expiration.Add(clockSkew)
+	}
+
+	if v.maxAge > 0 {
+		maxAgeExpiration := claims.IssuedAt.Add(v.maxAge + clockSkew)
+		if expiresAt.IsZero() || maxAgeExpiration.Before(expiresAt) {
+			expiresAt = maxAgeExpiration
+		}
+	}
+
+	return expiresAt
+}
+
+func invalidProofError(message string) error {
+	return errutil.ErrInfo(errtypes.ERROR_REASON_INVALID_PROOF, message, nil)
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package verification_test
+
+import (
+	"testing"
+	"time"
+
+	errtesting "github.com/agntcy/identity/internal/core/errors/testing"
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	replaymemory "github.com/agntcy/identity/internal/core/issuer/replay/memory"
+	issuertesting "github.com/agntcy/identity/internal/core/issuer/testing"
+	"github.com/agntcy/identity/internal/core/issuer/trust"
+	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
+	"github.com/agntcy/identity/internal/core/issuer/verification"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/pkg/db/memory"
+	"github.com/agntcy/identity/pkg/oidc"
+	oidctesting "github.com/agntcy/identity/pkg/oidc/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	nodeAudience     = "https://node.example.com"
+	issuerCommonName = "issuer.example.com"
+	proofID          = "proof-id"
+)
+
+var jwtProof = &vctypes.Proof{Type: "JWT"}
+
+// newFreshnessSut creates a verification service accepting the claims
+// of an IdP issuer registered on a Node requiring fresh proofs
+func newFreshnessSut(
+	t *testing.T,
+	claims *oidc.Claims,
+	opts ...verification.Option,
+) verification.Service {
+	t.Helper()
+
+	store, err := memory.NewStore("")
+	assert.NoError(t, err)
+
+	cache, err := replaymemory.NewCache(store)
+	assert.NoError(t, err)
+
+	repo := issuertesting.NewFakeIssuerRepository()
+	_, _ = repo.CreateIssuer(t.Context(), &issuertypes.Issuer{
+		CommonName: issuerCommonName,
+		AuthType:   issuertypes.ISSUER_AUTH_TYPE_IDP,
+	})
+
+	claims.Issuer = "https://" + issuerCommonName
+	claims.Subject = "subject"
+
+	return verification.NewService(
+		oidctesting.NewFakeParser(&oidc.ParsedJWT{
+			Provider:   oidc.IdpProviderName,
+			Claims:     claims,
+			CommonName: issuerCommonName,
+		}, nil),
+		repo,
+		trust.NewStaticSource(trust.DefaultPolicy()),
+		append([]verification.Option{
+			verification.WithAudience(nodeAudience),
+			verification.WithMaxAge(time.Minute),
+			verification.WithReplayCache(cache),
+		}, opts...)...,
+	)
+}
+
+func TestVerifyExistingIssuer_Should_Accept_A_Fresh_Proof_Once(t *testing.T) {
+	t.Parallel()
+
+	sut := newFreshnessSut(t, &oidc.Claims{
+		Audience:   []string{"other", nodeAudience + "/"},
+		IssuedAt:   time.Now(),
+		Expiration: time.Now().Add(time.Hour),
+		JwtID:      proofID,
+	})
+
+	_, err := sut.VerifyExistingIssuer(t.Context(), jwtProof)
+	assert.NoError(t, err)
+
+	_, err = sut.VerifyExistingIssuer(t.Context(), jwtProof)
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_PROOF)
+	assert.ErrorContains(t, err, "already been used")
+}
+
+func TestVerifyExistingIssuer_Should_Reject_Stale_Or_Unbound_Proofs(t *testing.T) {
+	t.Parallel()
+
+	invalid := map[string]*oidc.Claims{
+		"not issued for the audience": {
+			Audience: []string{"https://other-node.example.com"},
+			IssuedAt: time.Now(),
+			JwtID:    proofID,
+		},
+		"older than": {
+			Audience: []string{nodeAudience},
+			IssuedAt: time.Now().Add(-time.Hour),
+			JwtID:    proofID,
+		},
+		"no 'iat' claim": {
+			Audience: []string{nodeAudience},
+			JwtID:    proofID,
+		},
+		"no 'jti' claim": {
+			Audience: []string{nodeAudience},
+			IssuedAt: time.Now(),
+		},
+	}
+
+	for message, claims := range invalid {
+		sut := newFreshnessSut(t, claims)
+
+		_, err := sut.VerifyExistingIssuer(t.Context(), jwtProof)
+
+		errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_PROOF)
+		assert.ErrorContains(t, err, message)
+	}
+}
+
+func TestVerifyExistingIssuer_Should_Accept_The_Audiences_Of_The_Provider(t *testing.T) {
+	t.Parallel()
+
+	const authServerAudience = "api://default"
+
+	newClaims := func(audience string) *oidc.Claims {
+		return &oidc.Claims{
+			Audience: []string{audience},
+			IssuedAt: time.Now(),
+			JwtID:    proofID,
+		}
+	}
+
+	// Any of the identifiers of the Node
+	sut := newFreshnessSut(
+		t,
+		newClaims("https://node.internal"),
+		verification.WithAudience(nodeAudience, "https://node.internal"),
+	)
+
+	_, err := sut.VerifyExistingIssuer(t.Context(), jwtProof)
+	assert.NoError(t, err)
+
+	// The audience of the authorization server of the provider
+	// replaces the identifiers of the Node for its proofs
+	for audience, valid := range map[string]bool{authServerAudience: true, nodeAudience: false} {
+		sut = newFreshnessSut(
+			t,
+			newClaims(audience),
+			verification.WithProviderAudience(oidc.IdpProviderName, authServerAudience),
+		)
+
+		_, err = sut.VerifyExistingIssuer(t.Context(), jwtProof)
+		if valid {
+			assert.NoError(t, err, audience)
+		} else {
+			errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_PROOF)
+		}
+	}
+
+	// The other providers keep the identifiers of the Node
+	sut = newFreshnessSut(
+		t,
+		newClaims(authServerAudience),
+		verification.WithProviderAudience(oidc.OktaProviderName, authServerAudience),
+	)
+
+	_, err = sut.VerifyExistingIssuer(t.Context(), jwtProof)
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_PROOF)
+}
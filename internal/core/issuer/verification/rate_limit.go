@@ -0,0 +1,41 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package verification
+
+import (
+	"fmt"
+
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+)
+
+// RateLimiter limits the rate of the requests of the issuers
+type RateLimiter interface {
+	// AllowIssuer consumes a request of the issuer,
+	// false when the issuer exceeds its rate
+	AllowIssuer(commonName string) bool
+}
+
+// WithRateLimiter limits the rate of the requests of the issuers.
+// The issuers are only known once their proof is verified,
+// an issuer cannot be limited with the proofs of another issuer.
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(v *service) {
+		v.rateLimiter = limiter
+	}
+}
+
+// checkRateLimit consumes a request of the issuer of a verified proof,
+// before the proof is recorded as used
+func (v *service) checkRateLimit(commonName string) error {
+	if v.rateLimiter == nil || v.rateLimiter.AllowIssuer(commonName) {
+		return nil
+	}
+
+	return errutil.ErrInfo(
+		errtypes.ERROR_REASON_RATE_LIMIT_EXCEEDED,
+		fmt.Sprintf("too many requests from the issuer %s", commonName),
+		nil,
+	)
+}
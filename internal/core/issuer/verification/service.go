@@ -7,13 +7,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	errcore "github.com/agntcy/identity/internal/core/errors"
 	errtypes "github.com/agntcy/identity/internal/core/errors/types"
 	issuercore "github.com/agntcy/identity/internal/core/issuer"
+	"github.com/agntcy/identity/internal/core/issuer/replay"
+	"github.com/agntcy/identity/internal/core/issuer/trust"
 	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
 	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/jwk"
 	"github.com/agntcy/identity/pkg/log"
 	"github.com/agntcy/identity/pkg/oidc"
 )
@@ -37,19 +41,45 @@ type Service interface {
 		ctx context.Context,
 		proof *vctypes.Proof,
 	) (*Result, error)
+	VerifyKeyOwnership(
+		ctx context.Context,
+		issuer *issuertypes.Issuer,
+		newKey *jwk.Jwk,
+		proof *vctypes.Proof,
+	) (*Result, error)
 }
 
 type service struct {
-	oidcParser oidc.Parser
-	repository issuercore.Repository
+	oidcParser        oidc.Parser
+	repository        issuercore.Repository
+	trustSource       trust.Source
+	audiences         []string
+	providerAudiences map[oidc.ProviderName][]string
+	maxAge            time.Duration
+	replayCache       replay.Cache
+	rateLimiter       RateLimiter
 }
 
-// NewVerificationService creates a new instance of the VerificationService
-func NewService(oidcParser oidc.Parser, repository issuercore.Repository) Service {
-	return &service{
-		oidcParser,
-		repository,
+// NewVerificationService creates a new instance of the VerificationService,
+// the proofs are checked against the current policy of the trust source
+// and the freshness requirements of the options
+func NewService(
+	oidcParser oidc.Parser,
+	repository issuercore.Repository,
+	trustSource trust.Source,
+	opts ...Option,
+) Service {
+	v := &service{
+		oidcParser:  oidcParser,
+		repository:  repository,
+		trustSource: trustSource,
 	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
 }
 
 // Verify verifies the issuer's common name against the proof
@@ -89,6 +119,17 @@ func (v *service) Verify(
 		)
 	}
 
+	// Only the issuers trusted by the Node can register,
+	// the policy is checked before any request to the issuer
+	err = v.trustSource.Policy().CheckRegistration(
+		parsedJWT.Provider,
+		parsedJWT.Claims.Issuer,
+		parsedJWT.CommonName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	if parsedJWT.Provider == oidc.SelfProviderName {
 		// We make sure we always use the Issuer's public key to verify the JWT
 		parsedJWT.Claims.SubJWK = string(issuer.PublicKey.ToJSON())
@@ -109,6 +150,21 @@ func (v *service) Verify(
 
 	log.Debug("Common name verified successfully")
 
+	err = checkGeneralPurpose(parsedJWT.Claims)
+	if err != nil {
+		return nil, err
+	}
+
+	err = v.checkRateLimit(issuer.CommonName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = v.checkFreshness(ctx, parsedJWT.Provider, parsedJWT.Claims)
+	if err != nil {
+		return nil, err
+	}
+
 	verified := parsedJWT.Provider != oidc.SelfProviderName
 
 	return &Result{
@@ -152,6 +208,17 @@ func (v *service) VerifyExistingIssuer(
 		)
 	}
 
+	// The registered issuers lose their access when the current policy
+	// would no longer let them register, before any request to the issuer
+	err = v.trustSource.Policy().CheckRegistration(
+		parsedJWT.Provider,
+		parsedJWT.Claims.Issuer,
+		parsedJWT.CommonName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	issuer, err := v.getIssuer(ctx, parsedJWT.CommonName)
 	if err != nil {
 		return nil, err
@@ -179,6 +246,21 @@ func (v *service) VerifyExistingIssuer(
 		)
 	}
 
+	err = checkGeneralPurpose(parsedJWT.Claims)
+	if err != nil {
+		return nil, err
+	}
+
+	err = v.checkRateLimit(issuer.CommonName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = v.checkFreshness(ctx, parsedJWT.Provider, parsedJWT.Claims)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Result{
 		Issuer:   issuer,
 		Verified: issuer.Verified,
@@ -187,6 +269,110 @@ func (v *service) VerifyExistingIssuer(
 	}, nil
 }
 
+// VerifyKeyOwnership verifies that the proof is a self-issued JWT
+// signed by the current key of the issuer authorizing the rotation to the new key
+func (v *service) VerifyKeyOwnership(
+	ctx context.Context,
+	issuer *issuertypes.Issuer,
+	newKey *jwk.Jwk,
+	proof *vctypes.Proof,
+) (*Result, error) {
+	if proof == nil {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_PROOF,
+			"proof is empty",
+			nil,
+		)
+	}
+
+	if !proof.IsJWT() {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_UNSUPPORTED_PROOF,
+			fmt.Sprintf("unsupported proof type: %s", proof.Type),
+			nil,
+		)
+	}
+
+	parsedJWT, err := v.oidcParser.ParseJwt(ctx, &proof.ProofValue)
+	if err != nil {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_PROOF,
+			err.Error(),
+			err,
+		)
+	}
+
+	// The issuers no longer trusted by the current policy cannot rotate their key
+	err = v.trustSource.Policy().CheckRegistration(
+		parsedJWT.Provider,
+		parsedJWT.Claims.Issuer,
+		parsedJWT.CommonName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if parsedJWT.Provider != oidc.SelfProviderName {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_PROOF,
+			"the proof must be self-issued with the current key of the issuer",
+			nil,
+		)
+	}
+
+	// We make sure we always use the Issuer's current public key to verify the JWT
+	parsedJWT.Claims.SubJWK = string(issuer.PublicKey.ToJSON())
+
+	err = v.oidcParser.VerifyJwt(ctx, parsedJWT)
+	if err != nil {
+		return nil, errutil.ErrInfo(errtypes.ERROR_REASON_INVALID_PROOF, err.Error(), err)
+	}
+
+	if parsedJWT.CommonName != issuer.CommonName {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_PROOF,
+			"common name does not match issuer",
+			nil,
+		)
+	}
+
+	// A proof sent for another operation must not authorize a rotation
+	if parsedJWT.Claims.Purpose != oidc.SelfIssuedTokenPurposeRotateKey {
+		return nil, invalidProofError("the proof is not issued for a key rotation")
+	}
+
+	thumbprint, err := newKey.Thumbprint()
+	if err != nil || parsedJWT.Claims.NewKeyThumbprint != thumbprint {
+		return nil, invalidProofError("the proof is not issued for the rotation to the new key")
+	}
+
+	err = v.checkRateLimit(issuer.CommonName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = v.checkFreshness(ctx, parsedJWT.Provider, parsedJWT.Claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Issuer:   issuer,
+		Verified: issuer.Verified,
+		Provider: parsedJWT.Provider,
+		Subject:  parsedJWT.Claims.Subject,
+	}, nil
+}
+
+// checkGeneralPurpose rejects the proofs restricted to another operation
+func checkGeneralPurpose(claims *oidc.Claims) error {
+	if claims.Purpose != "" {
+		return invalidProofError(fmt.Sprintf("the proof is restricted to the operation %s", claims.Purpose))
+	}
+
+	return nil
+}
+
 func (v *service) getIssuer(
 	ctx context.Context,
 	commonName string,
@@ -0,0 +1,258 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package verification_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	errtesting "github.com/agntcy/identity/internal/core/errors/testing"
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	issuertesting "github.com/agntcy/identity/internal/core/issuer/testing"
+	"github.com/agntcy/identity/internal/core/issuer/trust"
+	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
+	"github.com/agntcy/identity/internal/core/issuer/verification"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/pkg/httputil"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/agntcy/identity/pkg/oidc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyKeyOwnership_Should_Require_A_Proof_For_The_New_Key(t *testing.T) {
+	t.Parallel()
+
+	key, newKey := generateKey(t), generateKey(t)
+	sut, issuer := newSelfIssuedSut(t, key)
+
+	rotationProof, err := oidc.SelfIssueKeyRotationJWT(issuerCommonName, "sub", nodeAudience, key, newKey)
+	assert.NoError(t, err)
+
+	// A proof sent with another request cannot rotate the key
+	generalProof, err := oidc.SelfIssueJWT(issuerCommonName, "sub", nodeAudience, key)
+	assert.NoError(t, err)
+
+	_, err = sut.VerifyKeyOwnership(t.Context(), issuer, newKey.PublicKey(), newJwtProof(generalProof))
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_PROOF)
+
+	// The proof cannot rotate the key to another key
+	_, err = sut.VerifyKeyOwnership(t.Context(), issuer, generateKey(t).PublicKey(), newJwtProof(rotationProof))
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_PROOF)
+
+	_, err = sut.VerifyKeyOwnership(t.Context(), issuer, newKey.PublicKey(), newJwtProof(rotationProof))
+	assert.NoError(t, err)
+}
+
+func TestVerifyExistingIssuer_Should_Reject_A_Key_Rotation_Proof(t *testing.T) {
+	t.Parallel()
+
+	key := generateKey(t)
+	sut, _ := newSelfIssuedSut(t, key)
+
+	rotationProof, err := oidc.SelfIssueKeyRotationJWT(
+		issuerCommonName,
+		"sub",
+		nodeAudience,
+		key,
+		generateKey(t),
+	)
+	assert.NoError(t, err)
+
+	_, err = sut.VerifyExistingIssuer(t.Context(), newJwtProof(rotationProof))
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_PROOF)
+
+	generalProof, err := oidc.SelfIssueJWT(issuerCommonName, "sub", nodeAudience, key)
+	assert.NoError(t, err)
+
+	_, err = sut.VerifyExistingIssuer(t.Context(), newJwtProof(generalProof))
+	assert.NoError(t, err)
+}
+
+func TestVerifyExistingIssuer_Should_Limit_The_Rate_Of_The_Issuer(t *testing.T) {
+	t.Parallel()
+
+	key := generateKey(t)
+	limiter := &fakeRateLimiter{remaining: 1}
+	sut, _ := newSelfIssuedSut(t, key, verification.WithRateLimiter(limiter))
+
+	proof, err := oidc.SelfIssueJWT(issuerCommonName, "sub", nodeAudience, key)
+	assert.NoError(t, err)
+
+	_, err = sut.VerifyExistingIssuer(t.Context(), newJwtProof(proof))
+	assert.NoError(t, err)
+
+	// The limit applies to the issuer of the verified proof
+	_, err = sut.VerifyExistingIssuer(t.Context(), newJwtProof(proof))
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_RATE_LIMIT_EXCEEDED)
+	assert.Equal(t, []string{issuerCommonName, issuerCommonName}, limiter.issuers)
+
+	// The proofs of unknown issuers do not consume the rate of the issuer
+	otherProof, err := oidc.SelfIssueJWT(issuerCommonName, "sub", nodeAudience, generateKey(t))
+	assert.NoError(t, err)
+
+	_, err = sut.VerifyExistingIssuer(t.Context(), newJwtProof(otherProof))
+	assert.Error(t, err)
+	assert.Len(t, limiter.issuers, 2)
+}
+
+func TestVerify_Should_Check_The_Trust_Policy_Before_Requesting_The_Issuer(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	registry, err := oidc.NewRegistry([]*oidc.Provider{
+		{Name: oidc.IdpProviderName, IssuerPatterns: []string{"http://*"}, IDScheme: oidc.IdpIDScheme},
+	})
+	assert.NoError(t, err)
+
+	issuer := &issuertypes.Issuer{
+		CommonName: httputil.Hostname(server.URL),
+		AuthType:   issuertypes.ISSUER_AUTH_TYPE_IDP,
+	}
+
+	repo := issuertesting.NewFakeIssuerRepository()
+	_, err = repo.CreateIssuer(t.Context(), issuer)
+	assert.NoError(t, err)
+
+	sut := verification.NewService(
+		oidc.NewParser(registry),
+		repo,
+		trust.NewStaticSource(&trust.Policy{DeniedIssuers: []string{server.URL}}),
+	)
+
+	payload, err := json.Marshal(map[string]any{
+		"iss": server.URL,
+		"sub": "sub",
+		"exp": time.Now().Add(time.Minute).Unix(),
+	})
+	assert.NoError(t, err)
+
+	token, err := joseutil.Sign(generateKey(t), payload)
+	assert.NoError(t, err)
+
+	_, err = sut.Verify(t.Context(), issuer, newJwtProof(string(token)))
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_UNTRUSTED_ISSUER)
+
+	_, err = sut.VerifyExistingIssuer(t.Context(), newJwtProof(string(token)))
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_UNTRUSTED_ISSUER)
+
+	// The metadata of a denied issuer is never requested
+	assert.Zero(t, requests.Load())
+}
+
+func TestVerifyExistingIssuer_Should_Apply_The_Registration_Policy(t *testing.T) {
+	t.Parallel()
+
+	key := generateKey(t)
+
+	proof, err := oidc.SelfIssueJWT(issuerCommonName, "sub", nodeAudience, key)
+	assert.NoError(t, err)
+
+	policies := []*trust.Policy{
+		{AllowSelfIssued: false},
+		{
+			AllowSelfIssued: true,
+			CommonNames: map[oidc.ProviderName][]string{
+				oidc.SelfProviderName: {"*.other.example.com"},
+			},
+		},
+	}
+
+	for _, policy := range policies {
+		sut, _ := newSelfIssuedSutWithPolicy(t, key, policy)
+
+		_, err = sut.VerifyExistingIssuer(t.Context(), newJwtProof(proof))
+		errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_UNTRUSTED_ISSUER)
+	}
+}
+
+func TestVerifyKeyOwnership_Should_Apply_The_Registration_Policy(t *testing.T) {
+	t.Parallel()
+
+	key, newKey := generateKey(t), generateKey(t)
+
+	proof, err := oidc.SelfIssueKeyRotationJWT(issuerCommonName, "sub", nodeAudience, key, newKey)
+	assert.NoError(t, err)
+
+	sut, issuer := newSelfIssuedSutWithPolicy(t, key, &trust.Policy{AllowSelfIssued: false})
+
+	_, err = sut.VerifyKeyOwnership(t.Context(), issuer, newKey.PublicKey(), newJwtProof(proof))
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_UNTRUSTED_ISSUER)
+}
+
+type fakeRateLimiter struct {
+	remaining int
+	issuers   []string
+}
+
+func (l *fakeRateLimiter) AllowIssuer(commonName string) bool {
+	l.issuers = append(l.issuers, commonName)
+	l.remaining--
+
+	return l.remaining >= 0
+}
+
+// newSelfIssuedSut creates a verification service parsing the proofs
+// of a self-issued issuer registered with the key
+func newSelfIssuedSut(
+	t *testing.T,
+	key *jwk.Jwk,
+	opts ...verification.Option,
+) (verification.Service, *issuertypes.Issuer) {
+	t.Helper()
+
+	return newSelfIssuedSutWithPolicy(t, key, trust.DefaultPolicy(), opts...)
+}
+
+// newSelfIssuedSutWithPolicy creates a verification service parsing the proofs
+// of a self-issued issuer registered with the key under the trust policy
+func newSelfIssuedSutWithPolicy(
+	t *testing.T,
+	key *jwk.Jwk,
+	policy *trust.Policy,
+	opts ...verification.Option,
+) (verification.Service, *issuertypes.Issuer) {
+	t.Helper()
+
+	issuer := &issuertypes.Issuer{
+		CommonName: issuerCommonName,
+		PublicKey:  key.PublicKey(),
+		AuthType:   issuertypes.ISSUER_AUTH_TYPE_SELF,
+	}
+
+	repo := issuertesting.NewFakeIssuerRepository()
+	_, err := repo.CreateIssuer(t.Context(), issuer)
+	assert.NoError(t, err)
+
+	return verification.NewService(
+		oidc.NewParser(oidc.NewDefaultRegistry()),
+		repo,
+		trust.NewStaticSource(policy),
+		append([]verification.Option{verification.WithAudience(nodeAudience)}, opts...)...,
+	), issuer
+}
+
+func generateKey(t *testing.T) *jwk.Jwk {
+	t.Helper()
+
+	key, err := joseutil.GenerateJWK("ES256", "sig", "key")
+	assert.NoError(t, err)
+
+	return key
+}
+
+func newJwtProof(token string) *vctypes.Proof {
+	return &vctypes.Proof{Type: "JWT", ProofValue: token}
+}
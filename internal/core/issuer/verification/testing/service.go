@@ -9,6 +9,8 @@ import (
 	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
 	"github.com/agntcy/identity/internal/core/issuer/verification"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/agntcy/identity/pkg/oidc"
 )
 
 const (
@@ -40,6 +42,19 @@ func (f *FakeVerifiedVerificationServiceStub) VerifyExistingIssuer(
 	panic("unimplemented")
 }
 
+func (f *FakeVerifiedVerificationServiceStub) VerifyKeyOwnership(
+	ctx context.Context,
+	issuer *issuertypes.Issuer,
+	newKey *jwk.Jwk,
+	proof *vctypes.Proof,
+) (*verification.Result, error) {
+	return &verification.Result{
+		Issuer:   issuer,
+		Verified: issuer.Verified,
+		Provider: oidc.SelfProviderName,
+	}, nil
+}
+
 type FakeUnverifiedVerificationServiceStub struct{}
 
 func NewFakeUnverifiedVerificationServiceStub() verification.Service {
@@ -63,3 +78,16 @@ func (f *FakeUnverifiedVerificationServiceStub) VerifyExistingIssuer(
 ) (*verification.Result, error) {
 	panic("unimplemented")
 }
+
+func (f *FakeUnverifiedVerificationServiceStub) VerifyKeyOwnership(
+	ctx context.Context,
+	issuer *issuertypes.Issuer,
+	newKey *jwk.Jwk,
+	proof *vctypes.Proof,
+) (*verification.Result, error) {
+	return &verification.Result{
+		Issuer:   issuer,
+		Verified: issuer.Verified,
+		Provider: oidc.SelfProviderName,
+	}, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"time"
+
+	errcore "github.com/agntcy/identity/internal/core/errors"
+	"github.com/agntcy/identity/internal/core/translog"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db/memory"
+)
+
+const transparencyLogLeavesTable = "transparency_log_leaves"
+
+type translogMemoryRepository struct {
+	leaves *memory.Table[*translog.Leaf]
+}
+
+// NewRepository creates a new instance of the transparency log Repository
+// storing the leaves in the in-memory store, indexed by their position
+func NewRepository(store *memory.Store) (translog.Repository, error) {
+	leaves, err := memory.NewTable[*translog.Leaf](store, transparencyLogLeavesTable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &translogMemoryRepository{
+		leaves: leaves,
+	}, nil
+}
+
+func (r *translogMemoryRepository) Append(
+	ctx context.Context,
+	entry *translog.Entry,
+) (*translog.Leaf, error) {
+	hash, err := entry.LeafHash()
+	if err != nil {
+		return nil, err
+	}
+
+	var leaf translog.Leaf
+
+	err = r.leaves.Update(ctx, func(rows map[string]*translog.Leaf) error {
+		leaf = translog.Leaf{
+			Index:     uint64(len(rows)),
+			Entry:     entry,
+			Hash:      hash,
+			CreatedAt: time.Now(),
+		}
+
+		stored := leaf
+		storedEntry := *entry
+		stored.Entry = &storedEntry
+		rows[indexKey(leaf.Index)] = &stored
+
+		return nil
+	})
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error appending to the transparency log")
+	}
+
+	return &leaf, nil
+}
+
+func (r *translogMemoryRepository) GetTreeSize(ctx context.Context) (uint64, error) {
+	var size uint64
+
+	_ = r.leaves.View(ctx, func(rows map[string]*translog.Leaf) error {
+		size = uint64(len(rows))
+		return nil
+	})
+
+	return size, nil
+}
+
+func (r *translogMemoryRepository) GetLeafHashes(
+	ctx context.Context,
+	start, end uint64,
+) ([][]byte, error) {
+	hashes := make([][]byte, 0, end-start)
+
+	_ = r.leaves.View(ctx, func(rows map[string]*translog.Leaf) error {
+		for index := start; index < end; index++ {
+			leaf, ok := rows[indexKey(index)]
+			if !ok {
+				break
+			}
+
+			hashes = append(hashes, leaf.Hash)
+		}
+
+		return nil
+	})
+
+	return hashes, nil
+}
+
+func (r *translogMemoryRepository) GetLeafByHash(
+	ctx context.Context,
+	hash []byte,
+) (*translog.Leaf, error) {
+	var found *translog.Leaf
+
+	_ = r.leaves.View(ctx, func(rows map[string]*translog.Leaf) error {
+		for _, leaf := range rows {
+			if bytes.Equal(leaf.Hash, hash) && (found == nil || leaf.Index < found.Index) {
+				found = leaf
+			}
+		}
+
+		return nil
+	})
+
+	if found == nil {
+		return nil, errcore.ErrResourceNotFound
+	}
+
+	entry := *found.Entry
+	leaf := *found
+	leaf.Entry = &entry
+
+	return &leaf, nil
+}
+
+func indexKey(index uint64) string {
+	return strconv.FormatUint(index, 10)
+}
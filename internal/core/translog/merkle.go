@@ -0,0 +1,251 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package translog
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// The domain separation prefixes of the Merkle Tree Hash defined in [RFC 6962]
+//
+// [RFC 6962]: https://www.rfc-editor.org/rfc/rfc6962#section-2.1
+const (
+	leafHashPrefix = 0x00
+	nodeHashPrefix = 0x01
+)
+
+var (
+	ErrInvalidProof    = errors.New("invalid Merkle proof")
+	ErrInvalidTreeSize = errors.New("invalid tree size")
+)
+
+// LeafHash returns the hash of a leaf of the Merkle tree
+func LeafHash(data []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{leafHashPrefix})
+	h.Write(data)
+
+	return h.Sum(nil)
+}
+
+func nodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{nodeHashPrefix})
+	h.Write(left)
+	h.Write(right)
+
+	return h.Sum(nil)
+}
+
+// RootHash returns the Merkle Tree Hash of the leaf hashes
+func RootHash(leafHashes [][]byte) []byte {
+	switch len(leafHashes) {
+	case 0:
+		empty := sha256.Sum256(nil)
+		return empty[:]
+	case 1:
+		return leafHashes[0]
+	}
+
+	k := splitPoint(uint64(len(leafHashes)))
+
+	return nodeHash(RootHash(leafHashes[:k]), RootHash(leafHashes[k:]))
+}
+
+// AuditPath returns the audit path of the leaf at the index
+// in the tree made of the leaf hashes
+func AuditPath(leafHashes [][]byte, index uint64) ([][]byte, error) {
+	if index >= uint64(len(leafHashes)) {
+		return nil, fmt.Errorf(
+			"%w: the leaf %d is not in a tree of size %d",
+			ErrInvalidTreeSize,
+			index,
+			len(leafHashes),
+		)
+	}
+
+	return inclusionPath(leafHashes, index), nil
+}
+
+func inclusionPath(leafHashes [][]byte, index uint64) [][]byte {
+	if len(leafHashes) <= 1 {
+		return [][]byte{}
+	}
+
+	k := splitPoint(uint64(len(leafHashes)))
+
+	if index < k {
+		return append(inclusionPath(leafHashes[:k], index), RootHash(leafHashes[k:]))
+	}
+
+	return append(inclusionPath(leafHashes[k:], index-k), RootHash(leafHashes[:k]))
+}
+
+// ConsistencyPath returns the proof that the tree of the first size
+// is a prefix of the tree made of the leaf hashes
+func ConsistencyPath(leafHashes [][]byte, firstSize uint64) ([][]byte, error) {
+	if firstSize == 0 || firstSize > uint64(len(leafHashes)) {
+		return nil, fmt.Errorf(
+			"%w: no consistency proof between the sizes %d and %d",
+			ErrInvalidTreeSize,
+			firstSize,
+			len(leafHashes),
+		)
+	}
+
+	return consistencySubproof(leafHashes, firstSize, true), nil
+}
+
+func consistencySubproof(leafHashes [][]byte, m uint64, complete bool) [][]byte {
+	n := uint64(len(leafHashes))
+
+	if m == n {
+		if complete {
+			return [][]byte{}
+		}
+
+		return [][]byte{RootHash(leafHashes)}
+	}
+
+	k := splitPoint(n)
+
+	if m <= k {
+		return append(consistencySubproof(leafHashes[:k], m, complete), RootHash(leafHashes[k:]))
+	}
+
+	return append(consistencySubproof(leafHashes[k:], m-k, false), RootHash(leafHashes[:k]))
+}
+
+// VerifyInclusion verifies the audit path of a leaf against the root hash
+// of a tree, as defined in [RFC 9162]
+//
+// [RFC 9162]: https://www.rfc-editor.org/rfc/rfc9162#section-2.1.3.2
+func VerifyInclusion(leafHash []byte, index, treeSize uint64, proof [][]byte, rootHash []byte) error {
+	if index >= treeSize {
+		return fmt.Errorf("%w: the leaf %d is not in a tree of size %d", ErrInvalidTreeSize, index, treeSize)
+	}
+
+	fn, sn := index, treeSize-1
+	r := leafHash
+
+	for _, p := range proof {
+		if sn == 0 {
+			return ErrInvalidProof
+		}
+
+		if fn&1 == 1 || fn == sn {
+			r = nodeHash(p, r)
+
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			r = nodeHash(r, p)
+		}
+
+		fn >>= 1
+		sn >>= 1
+	}
+
+	if sn != 0 || !bytes.Equal(r, rootHash) {
+		return ErrInvalidProof
+	}
+
+	return nil
+}
+
+// VerifyConsistency verifies that the tree of the first size is a prefix
+// of the tree of the second size, as defined in [RFC 9162]
+//
+// [RFC 9162]: https://www.rfc-editor.org/rfc/rfc9162#section-2.1.4.2
+func VerifyConsistency(
+	firstSize, secondSize uint64,
+	firstRoot, secondRoot []byte,
+	proof [][]byte,
+) error {
+	if firstSize == 0 || firstSize > secondSize {
+		return fmt.Errorf(
+			"%w: no consistency proof between the sizes %d and %d",
+			ErrInvalidTreeSize,
+			firstSize,
+			secondSize,
+		)
+	}
+
+	if firstSize == secondSize {
+		if len(proof) != 0 || !bytes.Equal(firstRoot, secondRoot) {
+			return ErrInvalidProof
+		}
+
+		return nil
+	}
+
+	// The root of the first tree is part of the second tree
+	if bits.OnesCount64(firstSize) == 1 {
+		proof = append([][]byte{firstRoot}, proof...)
+	}
+
+	if len(proof) == 0 {
+		return ErrInvalidProof
+	}
+
+	fr, sr, err := consistencyRoots(firstSize-1, secondSize-1, proof)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(fr, firstRoot) || !bytes.Equal(sr, secondRoot) {
+		return ErrInvalidProof
+	}
+
+	return nil
+}
+
+// consistencyRoots computes the root hashes of the two trees from the
+// consistency proof, fn and sn are the indexes of the last leaves
+func consistencyRoots(fn, sn uint64, proof [][]byte) ([]byte, []byte, error) {
+	for fn&1 == 1 {
+		fn >>= 1
+		sn >>= 1
+	}
+
+	fr, sr := proof[0], proof[0]
+
+	for _, c := range proof[1:] {
+		if sn == 0 {
+			return nil, nil, ErrInvalidProof
+		}
+
+		if fn&1 == 1 || fn == sn {
+			fr = nodeHash(c, fr)
+			sr = nodeHash(c, sr)
+
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			sr = nodeHash(sr, c)
+		}
+
+		fn >>= 1
+		sn >>= 1
+	}
+
+	if sn != 0 {
+		return nil, nil, ErrInvalidProof
+	}
+
+	return fr, sr, nil
+}
+
+// splitPoint returns the largest power of two smaller than n
+func splitPoint(n uint64) uint64 {
+	return 1 << (bits.Len64(n-1) - 1)
+}
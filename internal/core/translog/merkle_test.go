@@ -0,0 +1,104 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package translog_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/agntcy/identity/internal/core/translog"
+	"github.com/stretchr/testify/assert"
+)
+
+const maxTestTreeSize = 33
+
+func newLeafHashes(size int) [][]byte {
+	hashes := make([][]byte, 0, size)
+
+	for idx := range size {
+		hashes = append(hashes, translog.LeafHash([]byte(fmt.Sprintf("leaf-%d", idx))))
+	}
+
+	return hashes
+}
+
+func TestRootHash_Should_Hash_The_Empty_Tree(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(
+		t,
+		"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		hex.EncodeToString(translog.RootHash(nil)),
+	)
+}
+
+func TestVerifyInclusion_Should_Verify_Every_Leaf(t *testing.T) {
+	t.Parallel()
+
+	for size := 1; size <= maxTestTreeSize; size++ {
+		leaves := newLeafHashes(size)
+		root := translog.RootHash(leaves)
+
+		for idx := range leaves {
+			path, err := translog.AuditPath(leaves, uint64(idx))
+			assert.NoError(t, err)
+
+			err = translog.VerifyInclusion(leaves[idx], uint64(idx), uint64(size), path, root)
+			assert.NoError(t, err, "leaf %d of tree %d", idx, size)
+
+			// The proof of a leaf cannot be used for another leaf
+			other := translog.LeafHash([]byte("other"))
+			err = translog.VerifyInclusion(other, uint64(idx), uint64(size), path, root)
+			assert.ErrorIs(t, err, translog.ErrInvalidProof)
+		}
+	}
+}
+
+func TestVerifyConsistency_Should_Verify_Every_Prefix(t *testing.T) {
+	t.Parallel()
+
+	for size := 1; size <= maxTestTreeSize; size++ {
+		leaves := newLeafHashes(size)
+		root := translog.RootHash(leaves)
+
+		for first := 1; first <= size; first++ {
+			firstRoot := translog.RootHash(leaves[:first])
+
+			path, err := translog.ConsistencyPath(leaves, uint64(first))
+			assert.NoError(t, err)
+
+			err = translog.VerifyConsistency(uint64(first), uint64(size), firstRoot, root, path)
+			assert.NoError(t, err, "tree %d to tree %d", first, size)
+
+			if first < size {
+				// A rewritten history cannot be proven consistent
+				rewritten := newLeafHashes(first)
+				rewritten[0] = translog.LeafHash([]byte("rewritten"))
+
+				err = translog.VerifyConsistency(
+					uint64(first),
+					uint64(size),
+					translog.RootHash(rewritten),
+					root,
+					path,
+				)
+				assert.ErrorIs(t, err, translog.ErrInvalidProof)
+			}
+		}
+	}
+}
+
+func TestAuditPath_Should_Reject_Leaves_Outside_The_Tree(t *testing.T) {
+	t.Parallel()
+
+	_, err := translog.AuditPath(newLeafHashes(3), 3)
+	assert.ErrorIs(t, err, translog.ErrInvalidTreeSize)
+
+	_, err = translog.ConsistencyPath(newLeafHashes(3), 0)
+	assert.ErrorIs(t, err, translog.ErrInvalidTreeSize)
+
+	_, err = translog.ConsistencyPath(newLeafHashes(3), 4)
+	assert.ErrorIs(t, err, translog.ErrInvalidTreeSize)
+}
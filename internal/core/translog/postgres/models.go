@@ -0,0 +1,49 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"time"
+
+	"github.com/agntcy/identity/internal/core/translog"
+)
+
+type TransparencyLogLeaf struct {
+	LeafIndex uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Type      translog.EntryType
+	Subject   string `gorm:"index"`
+	Digest    string
+	Hash      []byte `gorm:"index"`
+	CreatedAt time.Time
+}
+
+func (TransparencyLogLeaf) TableName() string {
+	return "transparency_log_leaves"
+}
+
+// The ID of the single row of the transparency_log_size table
+const transparencyLogSizeID = 1
+
+// TransparencyLogSize is the number of leaves of the log
+type TransparencyLogSize struct {
+	ID   int `gorm:"primaryKey;autoIncrement:false"`
+	Size uint64
+}
+
+func (TransparencyLogSize) TableName() string {
+	return "transparency_log_size"
+}
+
+func (l *TransparencyLogLeaf) ToCoreType() *translog.Leaf {
+	return &translog.Leaf{
+		Index: l.LeafIndex,
+		Entry: &translog.Entry{
+			Type:    l.Type,
+			Subject: l.Subject,
+			Digest:  l.Digest,
+		},
+		Hash:      l.Hash,
+		CreatedAt: l.CreatedAt,
+	}
+}
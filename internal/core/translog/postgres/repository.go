@@ -0,0 +1,119 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	errcore "github.com/agntcy/identity/internal/core/errors"
+	"github.com/agntcy/identity/internal/core/translog"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type translogPostgresRepository struct {
+	dbContext db.Context
+}
+
+func NewRepository(dbContext db.Context) translog.Repository {
+	return &translogPostgresRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r *translogPostgresRepository) Append(
+	ctx context.Context,
+	entry *translog.Entry,
+) (*translog.Leaf, error) {
+	hash, err := entry.LeafHash()
+	if err != nil {
+		return nil, err
+	}
+
+	model := &TransparencyLogLeaf{
+		Type:    entry.Type,
+		Subject: entry.Subject,
+		Digest:  entry.Digest,
+		Hash:    hash,
+	}
+
+	err = db.Client(ctx, r.dbContext).Transaction(func(tx *gorm.DB) error {
+		// The size is locked until the end of the transaction,
+		// so the leaves are appended one at a time and their indexes have no gap
+		var size TransparencyLogSize
+
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&size, transparencyLogSizeID).Error
+		if err != nil {
+			return err
+		}
+
+		model.LeafIndex = size.Size
+
+		err = tx.Create(model).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&size).Update("size", size.Size+1).Error
+	})
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error appending to the transparency log")
+	}
+
+	return model.ToCoreType(), nil
+}
+
+func (r *translogPostgresRepository) GetTreeSize(ctx context.Context) (uint64, error) {
+	var size TransparencyLogSize
+
+	err := db.Client(ctx, r.dbContext).First(&size, transparencyLogSizeID).Error
+	if err != nil {
+		return 0, errutil.Err(err, "there was an error fetching the size of the transparency log")
+	}
+
+	return size.Size, nil
+}
+
+func (r *translogPostgresRepository) GetLeafHashes(
+	ctx context.Context,
+	start, end uint64,
+) ([][]byte, error) {
+	var hashes [][]byte
+
+	err := db.Client(ctx, r.dbContext).
+		Model(&TransparencyLogLeaf{}).
+		Where("leaf_index >= ? AND leaf_index < ?", start, end).
+		Order("leaf_index").
+		Pluck("hash", &hashes).Error
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error fetching the transparency log leaves")
+	}
+
+	return hashes, nil
+}
+
+func (r *translogPostgresRepository) GetLeafByHash(
+	ctx context.Context,
+	hash []byte,
+) (*translog.Leaf, error) {
+	var leaf TransparencyLogLeaf
+
+	err := db.Client(ctx, r.dbContext).
+		Where("hash = ?", hash).
+		Order("leaf_index").
+		First(&leaf).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcore.ErrResourceNotFound
+		}
+
+		return nil, errutil.Err(err, "there was an error fetching the transparency log leaf")
+	}
+
+	return leaf.ToCoreType(), nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/agntcy/identity/internal/core/translog"
+	translogpg "github.com/agntcy/identity/internal/core/translog/postgres"
+	"github.com/agntcy/identity/internal/pkg/pgtesting"
+	"github.com/agntcy/identity/pkg/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppend_Should_Index_The_Leaves_Without_Gap(t *testing.T) {
+	t.Parallel()
+
+	dbContext := pgtesting.Connect(t)
+	ctx := t.Context()
+	transactor := db.NewTransactor(dbContext)
+	sut := translogpg.NewRepository(dbContext)
+
+	// A rolled back append releases its index
+	err := transactor.Transaction(ctx, func(ctx context.Context) error {
+		_, err := sut.Append(ctx, translog.NewEntry(translog.EntryTypeIdGenerate, uuid.NewString(), nil))
+		if err != nil {
+			return err
+		}
+
+		return errors.New("the change failed")
+	})
+	assert.Error(t, err)
+
+	const appends = 10
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		indexes []uint64
+	)
+
+	for range appends {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			leaf, err := sut.Append(ctx, translog.NewEntry(translog.EntryTypeIdGenerate, uuid.NewString(), nil))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			indexes = append(indexes, leaf.Index)
+		}()
+	}
+
+	wg.Wait()
+	slices.Sort(indexes)
+
+	// The concurrent appends of the other tests may be interleaved
+	assert.Len(t, slices.Compact(indexes), appends)
+
+	size, err := sut.GetTreeSize(ctx)
+	assert.NoError(t, err)
+	assert.Greater(t, size, indexes[len(indexes)-1])
+
+	hashes, err := sut.GetLeafHashes(ctx, 0, size)
+	assert.NoError(t, err)
+	assert.Len(t, hashes, int(size))
+}
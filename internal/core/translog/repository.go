@@ -0,0 +1,24 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package translog
+
+import (
+	"context"
+)
+
+// Repository stores the leaves of the append-only transparency log
+type Repository interface {
+	// Append the entry as the next leaf of the log
+	Append(ctx context.Context, entry *Entry) (*Leaf, error)
+
+	// Return the number of leaves of the log
+	GetTreeSize(ctx context.Context) (uint64, error)
+
+	// Return the hashes of the leaves of the log from the start index
+	// to the end index excluded, ordered by index
+	GetLeafHashes(ctx context.Context, start, end uint64) ([][]byte, error)
+
+	// Return the first leaf with the hash
+	GetLeafByHash(ctx context.Context, hash []byte) (*Leaf, error)
+}
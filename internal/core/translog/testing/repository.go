@@ -0,0 +1,87 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package testing
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	errcore "github.com/agntcy/identity/internal/core/errors"
+	"github.com/agntcy/identity/internal/core/translog"
+)
+
+type FakeTransparencyLogRepository struct {
+	mu     sync.Mutex
+	leaves []*translog.Leaf
+}
+
+func NewFakeTransparencyLogRepository() translog.Repository {
+	return &FakeTransparencyLogRepository{}
+}
+
+func (r *FakeTransparencyLogRepository) Append(
+	ctx context.Context,
+	entry *translog.Entry,
+) (*translog.Leaf, error) {
+	hash, err := entry.LeafHash()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	leaf := &translog.Leaf{
+		Index:     uint64(len(r.leaves)),
+		Entry:     entry,
+		Hash:      hash,
+		CreatedAt: time.Now(),
+	}
+	r.leaves = append(r.leaves, leaf)
+
+	return leaf, nil
+}
+
+func (r *FakeTransparencyLogRepository) GetTreeSize(ctx context.Context) (uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return uint64(len(r.leaves)), nil
+}
+
+func (r *FakeTransparencyLogRepository) GetLeafHashes(
+	ctx context.Context,
+	start, end uint64,
+) ([][]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	hashes := make([][]byte, 0, end-start)
+
+	for _, leaf := range r.leaves {
+		if leaf.Index >= start && leaf.Index < end {
+			hashes = append(hashes, leaf.Hash)
+		}
+	}
+
+	return hashes, nil
+}
+
+func (r *FakeTransparencyLogRepository) GetLeafByHash(
+	ctx context.Context,
+	hash []byte,
+) (*translog.Leaf, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, leaf := range r.leaves {
+		if bytes.Equal(leaf.Hash, hash) {
+			return leaf, nil
+		}
+	}
+
+	return nil, errcore.ErrResourceNotFound
+}
@@ -0,0 +1,186 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package translog
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/jwk"
+)
+
+// EntryType is the mutation of the Node recorded by an entry of the transparency log
+type EntryType string
+
+const (
+	EntryTypeIssuerRegister  EntryType = "issuer.register"
+	EntryTypeIssuerRotateKey EntryType = "issuer.rotate_key"
+	EntryTypeIdGenerate      EntryType = "id.generate"
+	EntryTypeIdUpdate        EntryType = "id.update"
+	EntryTypeIdDeactivate    EntryType = "id.deactivate"
+	EntryTypeVcPublish       EntryType = "vc.publish"
+	EntryTypeVcRevoke        EntryType = "vc.revoke"
+	EntryTypeVcSuspend       EntryType = "vc.suspend"
+	EntryTypeVcReinstate     EntryType = "vc.reinstate"
+)
+
+// Entry is the content of a leaf of the transparency log.
+// The content of the mutation is recorded by its digest so anyone holding
+// the content can compute the leaf hash and check its inclusion.
+type Entry struct {
+	// The mutation
+	Type EntryType `json:"type"`
+
+	// The resource affected by the mutation: the common name of an Issuer,
+	// an ID or the ID of a Verifiable Credential
+	Subject string `json:"subject"`
+
+	// The hex encoded SHA-256 digest of the content of the mutation
+	Digest string `json:"digest"`
+}
+
+// NewEntry creates an entry recording the content of a mutation
+func NewEntry(entryType EntryType, subject string, content []byte) *Entry {
+	digest := sha256.Sum256(content)
+
+	return &Entry{
+		Type:    entryType,
+		Subject: subject,
+		Digest:  hex.EncodeToString(digest[:]),
+	}
+}
+
+// LeafData returns the serialized entry stored as a leaf of the Merkle tree
+func (e *Entry) LeafData() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// LeafHash returns the hash of the leaf of the entry
+func (e *Entry) LeafHash() ([]byte, error) {
+	data, err := e.LeafData()
+	if err != nil {
+		return nil, err
+	}
+
+	return LeafHash(data), nil
+}
+
+// Leaf is an entry appended to the transparency log
+type Leaf struct {
+	// The position of the leaf in the log, starting at 0
+	Index uint64
+
+	// The recorded entry
+	Entry *Entry
+
+	// The hash of the leaf
+	Hash []byte
+
+	// The date and time when the entry has been appended
+	CreatedAt time.Time
+}
+
+// TreeHead is the state of the transparency log at a given size
+type TreeHead struct {
+	// The number of leaves of the tree
+	TreeSize uint64 `json:"treeSize"`
+
+	// The Merkle Tree Hash of the tree
+	RootHash []byte `json:"rootHash"`
+
+	// The time when the tree head has been signed in milliseconds since the epoch
+	Timestamp int64 `json:"timestamp"`
+}
+
+// SignedTreeHead is a tree head signed by the Node
+type SignedTreeHead struct {
+	TreeHead
+
+	// The tree head signed as a JWS in the compact serialization
+	Signature string
+}
+
+// NewSignedTreeHead signs the tree head with the key of the Node
+func NewSignedTreeHead(treeHead *TreeHead, signingKey *jwk.Jwk) (*SignedTreeHead, error) {
+	payload, err := json.Marshal(treeHead)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := joseutil.Sign(signingKey, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignedTreeHead{
+		TreeHead:  *treeHead,
+		Signature: string(signature),
+	}, nil
+}
+
+// VerifySignature verifies that the tree head is signed by one of the keys
+func (sth *SignedTreeHead) VerifySignature(keys *jwk.Jwks) error {
+	if keys == nil {
+		return errors.New("no key to verify the signed tree head")
+	}
+
+	for _, key := range keys.Keys {
+		payload, err := joseutil.Verify(key, []byte(sth.Signature))
+		if err != nil {
+			continue
+		}
+
+		var signed TreeHead
+
+		err = json.Unmarshal(payload, &signed)
+		if err != nil {
+			return err
+		}
+
+		if signed.TreeSize != sth.TreeSize ||
+			signed.Timestamp != sth.Timestamp ||
+			!bytes.Equal(signed.RootHash, sth.RootHash) {
+			return errors.New("the signed tree head does not match its signature")
+		}
+
+		return nil
+	}
+
+	return errors.New("the signature of the tree head cannot be verified")
+}
+
+// InclusionProof proves that a leaf is part of the tree of a signed tree head
+type InclusionProof struct {
+	Leaf           *Leaf
+	AuditPath      [][]byte
+	SignedTreeHead *SignedTreeHead
+}
+
+// Verify verifies the audit path of the leaf against the signed tree head
+func (p *InclusionProof) Verify() error {
+	if p.Leaf == nil || p.SignedTreeHead == nil {
+		return ErrInvalidProof
+	}
+
+	return VerifyInclusion(
+		p.Leaf.Hash,
+		p.Leaf.Index,
+		p.SignedTreeHead.TreeSize,
+		p.AuditPath,
+		p.SignedTreeHead.RootHash,
+	)
+}
+
+// ConsistencyProof proves that the tree of the first size is a prefix
+// of the tree of the second size
+type ConsistencyProof struct {
+	FirstSize  uint64
+	SecondSize uint64
+	Path       [][]byte
+}
@@ -0,0 +1,255 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cose secures Verifiable Credentials with COSE_Sign1 as defined in
+// https://www.w3.org/TR/vc-jose-cose/#securing-with-cose
+package cose
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/joseutil"
+	jwktype "github.com/agntcy/identity/pkg/jwk"
+	gocose "github.com/veraison/go-cose"
+)
+
+const (
+	// The proof type of the credentials secured with COSE
+	ProofType = "COSE"
+
+	// The media types of the secured and the unsecured credential
+	mediaTypeVcCose = "application/vc+cose"
+	mediaTypeVc     = "application/vc"
+)
+
+// Sign secures the credential with a COSE_Sign1 structure.
+// The algorithm is selected from the private key:
+// PS256/384/512 for RSA keys, ES256/384/512 for EC keys and EdDSA for Ed25519 keys.
+// The envelope value is the base64url encoded COSE_Sign1 structure.
+func Sign(
+	credential *vctypes.VerifiableCredential,
+	privateKey *jwktype.Jwk,
+) (*vctypes.EnvelopedCredential, error) {
+	key, err := joseutil.RawKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signingKey, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("the key is not a private key")
+	}
+
+	alg, err := algorithmFor(signingKey.Public(), privateKey.ALG)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := gocose.NewSigner(alg, signingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	unsecured := *credential
+	unsecured.Proof = nil
+
+	payload, err := json.Marshal(&unsecured)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := gocose.Headers{
+		Protected: gocose.ProtectedHeader{
+			gocose.HeaderLabelAlgorithm:   alg,
+			gocose.HeaderLabelType:        mediaTypeVcCose,
+			gocose.HeaderLabelContentType: mediaTypeVc,
+		},
+	}
+
+	if privateKey.KID != "" {
+		headers.Protected[gocose.HeaderLabelKeyID] = []byte(privateKey.KID)
+	}
+
+	signed, err := gocose.Sign1(rand.Reader, signer, headers, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vctypes.EnvelopedCredential{
+		EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_COSE,
+		Value:        base64.RawURLEncoding.EncodeToString(signed),
+	}, nil
+}
+
+// Verify verifies the COSE_Sign1 signature of the credential
+// using the key of the jwks referenced by the kid header
+func Verify(
+	jwks *jwktype.Jwks,
+	credential *vctypes.EnvelopedCredential,
+) error {
+	message, err := decode(credential)
+	if err != nil {
+		return invalidCredentialErr(err)
+	}
+
+	alg, err := message.Headers.Protected.Algorithm()
+	if err != nil {
+		return invalidCredentialErr(err)
+	}
+
+	kid, _ := message.Headers.Protected[gocose.HeaderLabelKeyID].([]byte)
+
+	for _, publicKey := range findKeys(jwks, string(kid)) {
+		// Skip the keys that cannot verify COSE signatures (ex: AKP keys)
+		key, err := joseutil.RawKey(publicKey)
+		if err != nil {
+			continue
+		}
+
+		verifier, err := gocose.NewVerifier(alg, key)
+		if err != nil {
+			continue
+		}
+
+		if message.Verify(nil, verifier) == nil {
+			return nil
+		}
+	}
+
+	return invalidCredentialErr(errors.New("could not verify the COSE_Sign1 signature with any of the keys"))
+}
+
+// Parse parses the COSE_Sign1 payload without verifying the signature
+func Parse(
+	credential *vctypes.EnvelopedCredential,
+) (*vctypes.VerifiableCredential, error) {
+	message, err := decode(credential)
+	if err != nil {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_VALUE_FORMAT,
+			err.Error(),
+			err,
+		)
+	}
+
+	var parsedVC vctypes.VerifiableCredential
+
+	err = json.Unmarshal(message.Payload, &parsedVC)
+	if err != nil {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_VALUE_FORMAT,
+			err.Error(),
+			err,
+		)
+	}
+
+	parsedVC.Proof = &vctypes.Proof{
+		Type:       ProofType,
+		ProofValue: credential.Value,
+	}
+
+	return &parsedVC, nil
+}
+
+func VerifyAndParse(
+	jwks *jwktype.Jwks,
+	credential *vctypes.EnvelopedCredential,
+) (*vctypes.VerifiableCredential, error) {
+	err := Verify(jwks, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(credential)
+}
+
+func decode(credential *vctypes.EnvelopedCredential) (*gocose.Sign1Message, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(credential.Value)
+	if err != nil {
+		return nil, fmt.Errorf("the COSE envelope is not base64url encoded: %w", err)
+	}
+
+	var message gocose.Sign1Message
+
+	err = message.UnmarshalCBOR(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if message.Payload == nil {
+		return nil, errors.New("detached COSE payloads are not supported")
+	}
+
+	return &message, nil
+}
+
+// algorithmFor returns the COSE algorithm matching the public key.
+// RSA keys use RSASSA-PSS since COSE does not support RSASSA-PKCS1-v1_5 signatures.
+func algorithmFor(publicKey crypto.PublicKey, jwkAlg string) (gocose.Algorithm, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		switch jwkAlg {
+		case "RS384", "PS384":
+			return gocose.AlgorithmPS384, nil
+		case "RS512", "PS512":
+			return gocose.AlgorithmPS512, nil
+		default:
+			return gocose.AlgorithmPS256, nil
+		}
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return gocose.AlgorithmES256, nil
+		case elliptic.P384():
+			return gocose.AlgorithmES384, nil
+		case elliptic.P521():
+			return gocose.AlgorithmES512, nil
+		default:
+			return gocose.AlgorithmReserved, fmt.Errorf("unsupported curve %s", key.Curve.Params().Name)
+		}
+	case ed25519.PublicKey:
+		return gocose.AlgorithmEdDSA, nil
+	default:
+		return gocose.AlgorithmReserved, fmt.Errorf("unsupported key type %T for COSE", publicKey)
+	}
+}
+
+// findKeys returns the key matching the kid,
+// or all the keys when the kid is not set
+func findKeys(jwks *jwktype.Jwks, kid string) []*jwktype.Jwk {
+	if jwks == nil {
+		return nil
+	}
+
+	if kid == "" {
+		return jwks.Keys
+	}
+
+	for _, key := range jwks.Keys {
+		if key != nil && key.KID == kid {
+			return []*jwktype.Jwk{key}
+		}
+	}
+
+	return nil
+}
+
+func invalidCredentialErr(err error) error {
+	return errutil.ErrInfo(
+		errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+		err.Error(),
+		err,
+	)
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package cose_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/agntcy/identity/internal/core/vc/cose"
+	vctesting "github.com/agntcy/identity/internal/core/vc/testing"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/pkg/joseutil"
+	jwktype "github.com/agntcy/identity/pkg/jwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign_And_Verify(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T) *jwktype.Jwk{
+		"RSA": func(t *testing.T) *jwktype.Jwk {
+			t.Helper()
+
+			key, err := joseutil.GenerateJWK("RS256", "sig", "key-1")
+			require.NoError(t, err)
+
+			return key
+		},
+		"P-256": func(t *testing.T) *jwktype.Jwk {
+			t.Helper()
+
+			key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+			return vctesting.ToJwk(t, key, "key-1")
+		},
+		"Ed25519": func(t *testing.T) *jwktype.Jwk {
+			t.Helper()
+
+			_, key, _ := ed25519.GenerateKey(rand.Reader)
+
+			return vctesting.ToJwk(t, key, "key-1")
+		},
+	}
+
+	for name, newKey := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			privateKey := newKey(t)
+
+			envelope, err := cose.Sign(vctesting.NewCredential(), privateKey)
+			require.NoError(t, err)
+			assert.Equal(t, vctypes.CREDENTIAL_ENVELOPE_TYPE_COSE, envelope.EnvelopeType)
+
+			parsed, err := cose.VerifyAndParse(privateKey.PublicKey().Jwks(), envelope)
+			require.NoError(t, err)
+			assert.Equal(t, "urn:uuid:1234", parsed.ID)
+			assert.Equal(t, cose.ProofType, parsed.Proof.Type)
+			assert.Equal(t, envelope.Value, parsed.Proof.ProofValue)
+		})
+	}
+}
+
+func TestVerify_Should_Reject_Tampered_Credential(t *testing.T) {
+	t.Parallel()
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	privateKey := vctesting.ToJwk(t, key, "key-1")
+
+	envelope, err := cose.Sign(vctesting.NewCredential(), privateKey)
+	require.NoError(t, err)
+
+	raw, err := base64.RawURLEncoding.DecodeString(envelope.Value)
+	require.NoError(t, err)
+
+	// Flip a bit of the signature
+	raw[len(raw)-1] ^= 0x01
+	envelope.Value = base64.RawURLEncoding.EncodeToString(raw)
+
+	err = cose.Verify(privateKey.PublicKey().Jwks(), envelope)
+	assert.Error(t, err)
+}
+
+func TestVerify_Should_Reject_Unknown_Key(t *testing.T) {
+	t.Parallel()
+
+	_, key, _ := ed25519.GenerateKey(rand.Reader)
+	privateKey := vctesting.ToJwk(t, key, "key-1")
+
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+	otherPrivateKey := vctesting.ToJwk(t, otherKey, "key-1")
+
+	envelope, err := cose.Sign(vctesting.NewCredential(), privateKey)
+	require.NoError(t, err)
+
+	err = cose.Verify(otherPrivateKey.PublicKey().Jwks(), envelope)
+	assert.Error(t, err)
+}
+
+func TestVerify_Should_Skip_The_Unsupported_Keys_Without_Kid(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := joseutil.GenerateJWK("ES256", "sig", "")
+	require.NoError(t, err)
+
+	privateKey.KID = ""
+
+	pqKey, err := joseutil.GenerateJWK("ML-DSA-44", "sig", "")
+	require.NoError(t, err)
+
+	envelope, err := cose.Sign(vctesting.NewCredential(), privateKey)
+	require.NoError(t, err)
+
+	jwks := &jwktype.Jwks{Keys: []*jwktype.Jwk{pqKey.PublicKey(), privateKey.PublicKey()}}
+
+	assert.NoError(t, cose.Verify(jwks, envelope))
+}
+
+func TestParse_Should_Reject_Invalid_Envelope(t *testing.T) {
+	t.Parallel()
+
+	_, err := cose.Parse(&vctypes.EnvelopedCredential{
+		EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_COSE,
+		Value:        "not a cose message",
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dataintegrity
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+)
+
+const (
+	// The ECDSA cryptosuite using the JSON Canonicalization Scheme
+	// https://www.w3.org/TR/vc-di-ecdsa/#ecdsa-jcs-2019
+	CryptosuiteEcdsaJcs2019 = "ecdsa-jcs-2019"
+
+	// The EdDSA cryptosuite using the JSON Canonicalization Scheme
+	// https://www.w3.org/TR/vc-di-eddsa/#eddsa-jcs-2022
+	CryptosuiteEddsaJcs2022 = "eddsa-jcs-2022"
+)
+
+// cryptosuiteFor returns the cryptosuite matching the type of the key
+func cryptosuiteFor(key any) (string, error) {
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		return CryptosuiteEcdsaJcs2019, validateCurve(k.Curve)
+	case *ecdsa.PublicKey:
+		return CryptosuiteEcdsaJcs2019, validateCurve(k.Curve)
+	case ed25519.PrivateKey, ed25519.PublicKey:
+		return CryptosuiteEddsaJcs2022, nil
+	default:
+		return "", fmt.Errorf("unsupported key type %T for Data Integrity proofs", key)
+	}
+}
+
+func validateCurve(curve elliptic.Curve) error {
+	if curve != elliptic.P256() && curve != elliptic.P384() {
+		return fmt.Errorf("unsupported curve %s for %s", curve.Params().Name, CryptosuiteEcdsaJcs2019)
+	}
+
+	return nil
+}
+
+// hashFor returns the hash function of the cryptosuite for the key.
+// ecdsa-jcs-2019 uses SHA-384 with P-384 keys and SHA-256 otherwise.
+func hashFor(key any) crypto.Hash {
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		return hashForCurve(k.Curve)
+	case *ecdsa.PublicKey:
+		return hashForCurve(k.Curve)
+	default:
+		return crypto.SHA256
+	}
+}
+
+func hashForCurve(curve elliptic.Curve) crypto.Hash {
+	if curve == elliptic.P384() {
+		return crypto.SHA384
+	}
+
+	return crypto.SHA256
+}
+
+// sign creates the signature of the hash data,
+// ECDSA signatures are serialized as r || s
+func sign(key any, hashData []byte) ([]byte, error) {
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		h := hashForCurve(k.Curve).New()
+		h.Write(hashData)
+
+		r, s, err := ecdsa.Sign(rand.Reader, k, h.Sum(nil))
+		if err != nil {
+			return nil, err
+		}
+
+		size := (k.Curve.Params().BitSize + 7) / 8 //nolint:mnd // bits to bytes
+
+		return append(r.FillBytes(make([]byte, size)), s.FillBytes(make([]byte, size))...), nil
+	case ed25519.PrivateKey:
+		return ed25519.Sign(k, hashData), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T for Data Integrity proofs", key)
+	}
+}
+
+func verify(key any, hashData, signature []byte) bool {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8 //nolint:mnd // bits to bytes
+		if len(signature) != 2*size {
+			return false
+		}
+
+		h := hashForCurve(k.Curve).New()
+		h.Write(hashData)
+
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+
+		return ecdsa.Verify(k, h.Sum(nil), r, s)
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, hashData, signature)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,355 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dataintegrity secures Verifiable Credentials with embedded
+// Data Integrity proofs as defined in https://www.w3.org/TR/vc-data-integrity/
+package dataintegrity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/joseutil"
+	jwktype "github.com/agntcy/identity/pkg/jwk"
+)
+
+const (
+	// The type of the embedded proofs
+	ProofType = "DataIntegrityProof"
+
+	// The proof purpose of the embedded proofs
+	ProofPurposeAssertionMethod = "assertionMethod"
+
+	proofProperty   = "proof"
+	contextProperty = "@context"
+)
+
+// The JSON key of the context in the credentials, replaced by @context in the secured documents
+var credentialContextProperty = jsonKey(reflect.TypeFor[vctypes.VerifiableCredential](), "Context")
+
+// The embedded proof of a secured document
+type proof struct {
+	Type               string `json:"type"`
+	Cryptosuite        string `json:"cryptosuite"`
+	Created            string `json:"created,omitempty"`
+	VerificationMethod string `json:"verificationMethod"`
+	ProofPurpose       string `json:"proofPurpose"`
+	Context            any    `json:"@context,omitempty"`
+	ProofValue         string `json:"proofValue,omitempty"`
+}
+
+// Sign secures the credential with an embedded Data Integrity proof.
+// The cryptosuite is selected from the private key:
+// ecdsa-jcs-2019 for P-256 and P-384 keys, eddsa-jcs-2022 for Ed25519 keys.
+func Sign(
+	credential *vctypes.VerifiableCredential,
+	privateKey *jwktype.Jwk,
+) (*vctypes.EnvelopedCredential, error) {
+	key, err := joseutil.RawKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptosuite, err := cryptosuiteFor(key)
+	if err != nil {
+		return nil, err
+	}
+
+	unsecured := *credential
+	unsecured.Proof = nil
+
+	document, err := toDocument(&unsecured)
+	if err != nil {
+		return nil, err
+	}
+
+	if context, ok := document[credentialContextProperty]; ok {
+		delete(document, credentialContextProperty)
+		document[contextProperty] = context
+	}
+
+	config := proof{
+		Type:               ProofType,
+		Cryptosuite:        cryptosuite,
+		Created:            time.Now().UTC().Format(time.RFC3339),
+		VerificationMethod: privateKey.KID,
+		ProofPurpose:       ProofPurposeAssertionMethod,
+		Context:            document[contextProperty],
+	}
+
+	hashData, err := createHashData(key, &config, document)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := sign(key, hashData)
+	if err != nil {
+		return nil, err
+	}
+
+	config.ProofValue = encodeMultibase(signature)
+	document[proofProperty] = config
+
+	secured, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vctypes.EnvelopedCredential{
+		EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF,
+		Value:        string(secured),
+	}, nil
+}
+
+// Verify verifies the embedded proof of the credential
+// using the key of the jwks referenced by the verification method
+func Verify(
+	jwks *jwktype.Jwks,
+	credential *vctypes.EnvelopedCredential,
+) error {
+	document, err := parseDocument(credential.Value)
+	if err != nil {
+		return invalidCredentialErr(err)
+	}
+
+	embedded, err := extractProof(document)
+	if err != nil {
+		return invalidCredentialErr(err)
+	}
+
+	publicKey := findKey(jwks, embedded.VerificationMethod)
+	if publicKey == nil {
+		return invalidCredentialErr(
+			fmt.Errorf("no key found for the verification method %s", embedded.VerificationMethod),
+		)
+	}
+
+	key, err := joseutil.RawKey(publicKey)
+	if err != nil {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INTERNAL,
+			"unable to parse the resolver metadata public key",
+			err,
+		)
+	}
+
+	cryptosuite, err := cryptosuiteFor(key)
+	if err != nil || cryptosuite != embedded.Cryptosuite {
+		return invalidCredentialErr(
+			fmt.Errorf("the cryptosuite %s does not match the verification method", embedded.Cryptosuite),
+		)
+	}
+
+	signature, err := decodeMultibase(embedded.ProofValue)
+	if err != nil {
+		return invalidCredentialErr(err)
+	}
+
+	embedded.ProofValue = ""
+
+	hashData, err := createHashData(key, embedded, document)
+	if err != nil {
+		return invalidCredentialErr(err)
+	}
+
+	if !verify(key, hashData, signature) {
+		return invalidCredentialErr(errors.New("the proof signature is invalid"))
+	}
+
+	return nil
+}
+
+// Parse parses the secured document without verifying the proof
+func Parse(
+	credential *vctypes.EnvelopedCredential,
+) (*vctypes.VerifiableCredential, error) {
+	var parsedVC vctypes.VerifiableCredential
+
+	err := json.Unmarshal([]byte(credential.Value), &parsedVC)
+	if err != nil {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_VALUE_FORMAT,
+			err.Error(),
+			err,
+		)
+	}
+
+	if parsedVC.Proof == nil || parsedVC.Proof.Type != ProofType {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_VALUE_FORMAT,
+			"the credential does not contain a Data Integrity proof",
+			nil,
+		)
+	}
+
+	if len(parsedVC.Context) == 0 {
+		var jsonLD struct {
+			Context []string `json:"@context"`
+		}
+
+		// The context is validated by the proof, a malformed context only leaves it empty
+		_ = json.Unmarshal([]byte(credential.Value), &jsonLD)
+		parsedVC.Context = jsonLD.Context
+	}
+
+	// Keep the whole secured document so it can be served as is
+	parsedVC.Proof = &vctypes.Proof{
+		Type:         ProofType,
+		ProofPurpose: parsedVC.Proof.ProofPurpose,
+		ProofValue:   credential.Value,
+	}
+
+	return &parsedVC, nil
+}
+
+func VerifyAndParse(
+	jwks *jwktype.Jwks,
+	credential *vctypes.EnvelopedCredential,
+) (*vctypes.VerifiableCredential, error) {
+	err := Verify(jwks, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(credential)
+}
+
+// createHashData hashes the canonical proof configuration
+// and the canonical unsecured document, then concatenates them
+func createHashData(key any, config *proof, document map[string]any) ([]byte, error) {
+	configDocument, err := toDocument(config)
+	if err != nil {
+		return nil, err
+	}
+
+	canonicalConfig, err := canonicalize(configDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	canonicalDocument, err := canonicalize(document)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := hashFor(key)
+
+	configHash := hash.New()
+	configHash.Write(canonicalConfig)
+
+	documentHash := hash.New()
+	documentHash.Write(canonicalDocument)
+
+	return documentHash.Sum(configHash.Sum(nil)), nil
+}
+
+// extractProof removes the proof from the document and validates it
+func extractProof(document map[string]any) (*proof, error) {
+	rawProof, ok := document[proofProperty]
+	if !ok {
+		return nil, errors.New("the credential does not contain a proof")
+	}
+
+	delete(document, proofProperty)
+
+	if _, ok := rawProof.(map[string]any); !ok {
+		return nil, errors.New("only a single embedded proof is supported")
+	}
+
+	data, err := json.Marshal(rawProof)
+	if err != nil {
+		return nil, err
+	}
+
+	var embedded proof
+
+	err = json.Unmarshal(data, &embedded)
+	if err != nil {
+		return nil, err
+	}
+
+	if embedded.Type != ProofType {
+		return nil, fmt.Errorf("unsupported proof type %s", embedded.Type)
+	}
+
+	if embedded.ProofPurpose != ProofPurposeAssertionMethod {
+		return nil, fmt.Errorf("unsupported proof purpose %s", embedded.ProofPurpose)
+	}
+
+	if embedded.Context != nil && !reflect.DeepEqual(embedded.Context, document[contextProperty]) {
+		return nil, errors.New("the proof context does not match the document context")
+	}
+
+	return &embedded, nil
+}
+
+func findKey(jwks *jwktype.Jwks, verificationMethod string) *jwktype.Jwk {
+	if jwks == nil || verificationMethod == "" {
+		return nil
+	}
+
+	for _, key := range jwks.Keys {
+		if key == nil || key.KID == "" {
+			continue
+		}
+
+		if key.KID == verificationMethod || strings.HasSuffix(verificationMethod, "#"+key.KID) {
+			return key
+		}
+	}
+
+	return nil
+}
+
+func toDocument(value any) (map[string]any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseDocument(string(data))
+}
+
+func parseDocument(value string) (map[string]any, error) {
+	decoded, err := decodeJSON([]byte(value))
+	if err != nil {
+		return nil, err
+	}
+
+	document, ok := decoded.(map[string]any)
+	if !ok {
+		return nil, errors.New("the secured document must be a JSON object")
+	}
+
+	return document, nil
+}
+
+// jsonKey returns the JSON key of the field of a struct type
+func jsonKey(structType reflect.Type, fieldName string) string {
+	field, ok := structType.FieldByName(fieldName)
+	if !ok {
+		return fieldName
+	}
+
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return fieldName
+	}
+
+	return name
+}
+
+func invalidCredentialErr(err error) error {
+	return errutil.ErrInfo(
+		errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+		err.Error(),
+		err,
+	)
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dataintegrity_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/agntcy/identity/internal/core/vc/dataintegrity"
+	vctesting "github.com/agntcy/identity/internal/core/vc/testing"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	jwktype "github.com/agntcy/identity/pkg/jwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKeyID = "did:web:example.com#key-1"
+
+func TestSign_And_Verify(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		key         func() any
+		cryptosuite string
+	}{
+		"P-256": {
+			key: func() any {
+				key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				return key
+			},
+			cryptosuite: dataintegrity.CryptosuiteEcdsaJcs2019,
+		},
+		"P-384": {
+			key: func() any {
+				key, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+				return key
+			},
+			cryptosuite: dataintegrity.CryptosuiteEcdsaJcs2019,
+		},
+		"Ed25519": {
+			key: func() any {
+				_, key, _ := ed25519.GenerateKey(rand.Reader)
+				return key
+			},
+			cryptosuite: dataintegrity.CryptosuiteEddsaJcs2022,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			privateKey, publicKeys := toJwk(t, tc.key())
+
+			envelope, err := dataintegrity.Sign(vctesting.NewCredential(), privateKey)
+			require.NoError(t, err)
+			assert.Equal(t, vctypes.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF, envelope.EnvelopeType)
+			assert.Contains(t, envelope.Value, tc.cryptosuite)
+
+			parsed, err := dataintegrity.VerifyAndParse(publicKeys, envelope)
+			require.NoError(t, err)
+			assert.Equal(t, "urn:uuid:1234", parsed.ID)
+			assert.Equal(t, dataintegrity.ProofType, parsed.Proof.Type)
+			assert.Equal(t, dataintegrity.ProofPurposeAssertionMethod, parsed.Proof.ProofPurpose)
+			assert.Equal(t, envelope.Value, parsed.Proof.ProofValue)
+		})
+	}
+}
+
+func TestSign_Should_Secure_The_JSON_LD_Context(t *testing.T) {
+	t.Parallel()
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	privateKey, publicKeys := toJwk(t, key)
+	credential := vctesting.NewCredential()
+
+	envelope, err := dataintegrity.Sign(credential, privateKey)
+	require.NoError(t, err)
+
+	var document map[string]any
+
+	require.NoError(t, json.Unmarshal([]byte(envelope.Value), &document))
+	assert.Equal(t, []any{credential.Context[0]}, document["@context"])
+	assert.NotContains(t, document, "context")
+
+	// The proof is bound to the context of the document
+	proof, ok := document["proof"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, document["@context"], proof["@context"])
+
+	parsed, err := dataintegrity.VerifyAndParse(publicKeys, envelope)
+	require.NoError(t, err)
+	assert.Equal(t, credential.Context, parsed.Context)
+}
+
+func TestVerify_Should_Reject_Tampered_Credential(t *testing.T) {
+	t.Parallel()
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	privateKey, publicKeys := toJwk(t, key)
+
+	envelope, err := dataintegrity.Sign(vctesting.NewCredential(), privateKey)
+	require.NoError(t, err)
+
+	envelope.Value = strings.Replace(envelope.Value, "did:web:example.com", "did:web:attacker.com", 1)
+
+	err = dataintegrity.Verify(publicKeys, envelope)
+	assert.Error(t, err)
+}
+
+func TestVerify_Should_Reject_Unknown_Verification_Method(t *testing.T) {
+	t.Parallel()
+
+	_, key, _ := ed25519.GenerateKey(rand.Reader)
+	privateKey, _ := toJwk(t, key)
+
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+	_, otherPublicKeys := toJwk(t, otherKey)
+	otherPublicKeys.Keys[0].KID = "other"
+
+	envelope, err := dataintegrity.Sign(vctesting.NewCredential(), privateKey)
+	require.NoError(t, err)
+
+	err = dataintegrity.Verify(otherPublicKeys, envelope)
+	assert.ErrorContains(t, err, "no key found")
+}
+
+func TestSign_Should_Reject_RSA_Keys(t *testing.T) {
+	t.Parallel()
+
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	privateKey, _ := toJwk(t, key)
+
+	_, err := dataintegrity.Sign(vctesting.NewCredential(), privateKey)
+	assert.ErrorContains(t, err, "unsupported key type")
+}
+
+func toJwk(t *testing.T, raw any) (*jwktype.Jwk, *jwktype.Jwks) {
+	t.Helper()
+
+	privateKey := vctesting.ToJwk(t, raw, testKeyID)
+
+	return privateKey, &jwktype.Jwks{Keys: []*jwktype.Jwk{privateKey.PublicKey()}}
+}
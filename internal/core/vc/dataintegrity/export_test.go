@@ -0,0 +1,12 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dataintegrity
+
+var (
+	DecodeJSON      = decodeJSON
+	Canonicalize    = canonicalize
+	FormatNumber    = formatNumber
+	EncodeMultibase = encodeMultibase
+	DecodeMultibase = decodeMultibase
+)
@@ -0,0 +1,226 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dataintegrity
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	// Numbers with a decimal exponent in this range are serialized
+	// without an exponent (ECMAScript Number::toString)
+	maxDecimalExponent = 21
+	minDecimalExponent = -6
+
+	// Control characters below this code point are escaped
+	firstPrintableCharacter = 0x20
+)
+
+// decodeJSON decodes a JSON document keeping the numbers as json.Number
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+
+	err := decoder.Decode(&value)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after the JSON document")
+	}
+
+	return value, nil
+}
+
+// canonicalize serializes a decoded JSON value using the
+// JSON Canonicalization Scheme defined in [RFC 8785]
+//
+// [RFC 8785]: https://www.rfc-editor.org/rfc/rfc8785
+func canonicalize(value any) ([]byte, error) {
+	var buf bytes.Buffer
+
+	err := writeCanonical(&buf, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeCanonical(buf *bytes.Buffer, value any) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case json.Number:
+		f, err := strconv.ParseFloat(v.String(), 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %s: %w", v, err)
+		}
+
+		number, err := formatNumber(f)
+		if err != nil {
+			return err
+		}
+
+		buf.WriteString(number)
+	case float64:
+		number, err := formatNumber(v)
+		if err != nil {
+			return err
+		}
+
+		buf.WriteString(number)
+	case string:
+		writeString(buf, v)
+	case []any:
+		buf.WriteByte('[')
+
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			err := writeCanonical(buf, item)
+			if err != nil {
+				return err
+			}
+		}
+
+		buf.WriteByte(']')
+	case map[string]any:
+		return writeObject(buf, v)
+	default:
+		return fmt.Errorf("unsupported JSON value of type %T", value)
+	}
+
+	return nil
+}
+
+func writeObject(buf *bytes.Buffer, object map[string]any) error {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+
+	// The properties are sorted by their UTF-16 code units
+	slices.SortFunc(keys, func(a, b string) int {
+		return slices.Compare(utf16.Encode([]rune(a)), utf16.Encode([]rune(b)))
+	})
+
+	buf.WriteByte('{')
+
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		writeString(buf, key)
+		buf.WriteByte(':')
+
+		err := writeCanonical(buf, object[key])
+		if err != nil {
+			return err
+		}
+	}
+
+	buf.WriteByte('}')
+
+	return nil
+}
+
+func writeString(buf *bytes.Buffer, value string) {
+	buf.WriteByte('"')
+
+	for _, r := range value {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < firstPrintableCharacter {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+
+	buf.WriteByte('"')
+}
+
+// formatNumber serializes a number like ECMAScript Number::toString
+func formatNumber(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", errors.New("NaN and Infinity are not valid JSON numbers")
+	}
+
+	if f == 0 {
+		return "0", nil
+	}
+
+	sign := ""
+	if f < 0 {
+		sign = "-"
+		f = -f
+	}
+
+	// The shortest representation that round trips, as d.ddddde±xx
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(f, 'e', -1, 64), "e")
+	digits := strings.Replace(mantissa, ".", "", 1)
+
+	exp, err := strconv.Atoi(exponent)
+	if err != nil {
+		return "", err
+	}
+
+	n := exp + 1
+	k := len(digits)
+
+	switch {
+	case k <= n && n <= maxDecimalExponent:
+		return sign + digits + strings.Repeat("0", n-k), nil
+	case 0 < n && n <= maxDecimalExponent:
+		return sign + digits[:n] + "." + digits[n:], nil
+	case minDecimalExponent < n && n <= 0:
+		return sign + "0." + strings.Repeat("0", -n) + digits, nil
+	}
+
+	expSign := "+"
+	if n-1 < 0 {
+		expSign = "-"
+	}
+
+	exponent = expSign + strconv.Itoa(int(math.Abs(float64(n-1))))
+
+	if k == 1 {
+		return sign + digits + "e" + exponent, nil
+	}
+
+	return sign + digits[:1] + "." + digits[1:] + "e" + exponent, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dataintegrity_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/agntcy/identity/internal/core/vc/dataintegrity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalize(t *testing.T) {
+	t.Parallel()
+
+	// Sample from RFC 8785, section 3.2.3
+	input := `{
+		"numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001],
+		"string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/",
+		"literals": [null, true, false]
+	}`
+	expected := `{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],` +
+		`"string":"` + "€" + `$\u000f\nA'B\"\\\\\"/"}`
+
+	value, err := dataintegrity.DecodeJSON([]byte(input))
+	require.NoError(t, err)
+
+	canonical, err := dataintegrity.Canonicalize(value)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(canonical))
+}
+
+func TestCanonicalize_Should_Sort_By_UTF16_Code_Units(t *testing.T) {
+	t.Parallel()
+
+	// Sample from RFC 8785, section 3.2.3
+	input := `{"€":"Euro Sign","\r":"Carriage Return","דּ":"Hebrew Letter Dalet With Dagesh",` +
+		`"1":"One","😀":"Emoji: Grinning Face","\u0080":"Control","ö":"Latin Small Letter O With Diaeresis"}`
+	expected := `{"\r":"Carriage Return","1":"One","` + "\u0080" + `":"Control",` +
+		`"` + "ö" + `":"Latin Small Letter O With Diaeresis","` + "€" + `":"Euro Sign",` +
+		`"` + "\U0001f600" + `":"Emoji: Grinning Face","` + "דּ" + `":"Hebrew Letter Dalet With Dagesh"}`
+
+	value, err := dataintegrity.DecodeJSON([]byte(input))
+	require.NoError(t, err)
+
+	canonical, err := dataintegrity.Canonicalize(value)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(canonical))
+}
+
+func TestFormatNumber(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[float64]string{
+		0:                      "0",
+		-0.5:                   "-0.5",
+		1e21:                   "1e+21",
+		1e20:                   "100000000000000000000",
+		1e-7:                   "1e-7",
+		0.000001:               "0.000001",
+		9007199254740991:       "9007199254740991",
+		math.MaxFloat64:        "1.7976931348623157e+308",
+		5e-324:                 "5e-324",
+		123456789012345680000:  "123456789012345680000",
+		-1.2345678901234568e22: "-1.2345678901234568e+22",
+	}
+
+	for input, expected := range testCases {
+		actual, err := dataintegrity.FormatNumber(input)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	}
+
+	_, err := dataintegrity.FormatNumber(math.NaN())
+	assert.Error(t, err)
+}
+
+func TestMultibase_Roundtrip(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{0, 0, 1, 2, 3, 255}
+
+	encoded := dataintegrity.EncodeMultibase(data)
+	assert.Equal(t, byte('z'), encoded[0])
+
+	decoded, err := dataintegrity.DecodeMultibase(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, data, decoded)
+
+	assert.Equal(t, "z2NEpo7TZRRrLZSi2U", dataintegrity.EncodeMultibase([]byte("Hello World!")))
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dataintegrity
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+const (
+	// The multibase prefix of the base58-btc encoding
+	base58btcPrefix = 'z'
+
+	base58btcAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+)
+
+var base58Radix = big.NewInt(int64(len(base58btcAlphabet)))
+
+// encodeMultibase encodes the data using the base58-btc multibase encoding
+func encodeMultibase(data []byte) string {
+	var encoded []byte
+
+	n := new(big.Int).SetBytes(data)
+	mod := new(big.Int)
+
+	for n.Sign() > 0 {
+		n.DivMod(n, base58Radix, mod)
+		encoded = append(encoded, base58btcAlphabet[mod.Int64()])
+	}
+
+	// Leading zero bytes are encoded as leading '1'
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+
+		encoded = append(encoded, base58btcAlphabet[0])
+	}
+
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+
+	return string(base58btcPrefix) + string(encoded)
+}
+
+// decodeMultibase decodes a base58-btc multibase value
+func decodeMultibase(value string) ([]byte, error) {
+	if value == "" || value[0] != base58btcPrefix {
+		return nil, errors.New("unsupported multibase encoding, expected base58-btc")
+	}
+
+	value = value[1:]
+	n := new(big.Int)
+
+	for _, c := range value {
+		digit := strings.IndexRune(base58btcAlphabet, c)
+		if digit < 0 {
+			return nil, errors.New("invalid base58-btc character")
+		}
+
+		n.Mul(n, base58Radix)
+		n.Add(n, big.NewInt(int64(digit)))
+	}
+
+	leadingZeros := len(value) - len(strings.TrimLeft(value, base58btcAlphabet[:1]))
+
+	return append(make([]byte, leadingZeros), n.Bytes()...), nil
+}
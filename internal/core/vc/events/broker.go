@@ -0,0 +1,161 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	// The maximum number of concurrent subscribers
+	DefaultMaxSubscribers = 1024
+
+	// The interval between two reads of the stored events by a subscriber
+	// waiting for new events, the events stored by the other Nodes
+	// sharing the storage are only seen on these reads
+	DefaultPollInterval = 2 * time.Second
+
+	// The maximum number of events read at once by a subscriber
+	subscriptionBatchSize = 100
+)
+
+var (
+	ErrTooManySubscribers = errors.New("too many subscribers")
+
+	// The subscriber resumes after an event that is no longer retained,
+	// the events following it may have been purged
+	ErrEventsExpired = errors.New("the events after the sequence are no longer retained")
+)
+
+// Broker stores the events and streams them to the subscribers matching them.
+// The subscribers read the stored events from a cursor, they can resume
+// after a disconnection and never miss an event as long as it is retained.
+type Broker struct {
+	repository     Repository
+	maxSubscribers int
+	pollInterval   time.Duration
+
+	mu          sync.Mutex
+	subscribers int
+
+	// Closed and replaced when an event is committed
+	// to wake the waiting subscribers
+	changed chan struct{}
+}
+
+func NewBroker(repository Repository, maxSubscribers int, pollInterval time.Duration) *Broker {
+	return &Broker{
+		repository:     repository,
+		maxSubscribers: maxSubscribers,
+		pollInterval:   pollInterval,
+		changed:        make(chan struct{}),
+	}
+}
+
+// Subscription reads the events matching its filter after its cursor
+type Subscription struct {
+	broker *Broker
+	filter Filter
+	cursor uint64
+	closed bool
+}
+
+// Subscribe starts reading the events matching the filter after the sequence,
+// after the last stored event when the sequence is nil.
+// Resuming after 0 reads all the retained events.
+func (b *Broker) Subscribe(ctx context.Context, filter Filter, after *uint64) (*Subscription, error) {
+	first, last, err := b.repository.GetSequenceRange(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := last
+
+	if after != nil {
+		if *after != 0 && *after < first {
+			return nil, ErrEventsExpired
+		}
+
+		cursor = *after
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.subscribers >= b.maxSubscribers {
+		return nil, ErrTooManySubscribers
+	}
+
+	b.subscribers++
+
+	return &Subscription{
+		broker: b,
+		filter: filter,
+		cursor: cursor,
+	}, nil
+}
+
+// Store appends the event to the stored events in the transaction of the change
+func (b *Broker) Store(ctx context.Context, event *Event) error {
+	return b.repository.Append(ctx, event)
+}
+
+// OnEvent wakes the subscribers once the event is committed
+func (b *Broker) OnEvent(_ context.Context, _ *Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
+
+func (b *Broker) waitChange() <-chan struct{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.changed
+}
+
+// Next returns the next events matching the filter of the subscription,
+// waiting for them until the context is cancelled
+func (s *Subscription) Next(ctx context.Context) ([]*Event, error) {
+	ticker := time.NewTicker(s.broker.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Wait for the events committed after the read
+		changed := s.broker.waitChange()
+
+		events, err := s.broker.repository.ListAfter(ctx, s.cursor, s.filter, subscriptionBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(events) > 0 {
+			s.cursor = events[len(events)-1].Sequence
+			return events, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-changed:
+		case <-ticker.C:
+		}
+	}
+}
+
+// Close releases the subscription
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	if !s.closed {
+		s.closed = true
+		s.broker.subscribers--
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package events_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/vc/events"
+	eventsmemory "github.com/agntcy/identity/internal/core/vc/events/memory"
+	"github.com/agntcy/identity/internal/pkg/ptrutil"
+	"github.com/agntcy/identity/pkg/db/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+const resolverMetadataID = "ID-1"
+
+func TestBroker_Should_Deliver_The_Matching_Events(t *testing.T) {
+	t.Parallel()
+
+	sut := newBroker(t, events.DefaultMaxSubscribers)
+	all, err := sut.Subscribe(t.Context(), events.Filter{}, nil)
+	assert.NoError(t, err)
+	byIssuer, err := sut.Subscribe(t.Context(), events.Filter{Issuer: "issuer-a"}, nil)
+	assert.NoError(t, err)
+	byID, err := sut.Subscribe(
+		t.Context(),
+		events.Filter{Issuer: "issuer-b", ResolverMetadataID: resolverMetadataID},
+		nil,
+	)
+	assert.NoError(t, err)
+
+	first := &events.Event{ID: "1", Issuer: "issuer-a", ResolverMetadataID: resolverMetadataID}
+	second := &events.Event{ID: "2", Issuer: "issuer-b", ResolverMetadataID: resolverMetadataID}
+
+	publish(t, sut, first)
+	publish(t, sut, second)
+
+	assert.Equal(t, []string{"1", "2"}, eventIDs(next(t, all)))
+	assert.Equal(t, []string{"1"}, eventIDs(next(t, byIssuer)))
+	assert.Equal(t, []string{"2"}, eventIDs(next(t, byID)))
+
+	// No other event is delivered
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = byIssuer.Next(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestBroker_Should_Wake_The_Waiting_Subscribers(t *testing.T) {
+	t.Parallel()
+
+	sut := newBroker(t, events.DefaultMaxSubscribers)
+	sub, err := sut.Subscribe(t.Context(), events.Filter{}, nil)
+	assert.NoError(t, err)
+
+	received := make(chan []*events.Event, 1)
+
+	go func() {
+		nextEvents, _ := sub.Next(t.Context())
+		received <- nextEvents
+	}()
+
+	publish(t, sut, &events.Event{ID: "1"})
+
+	select {
+	case nextEvents := <-received:
+		assert.Equal(t, []string{"1"}, eventIDs(nextEvents))
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "the subscriber was not woken")
+	}
+}
+
+func TestBroker_Should_Resume_After_The_Sequence(t *testing.T) {
+	t.Parallel()
+
+	sut := newBroker(t, events.DefaultMaxSubscribers)
+
+	publish(t, sut, &events.Event{ID: "1"})
+	publish(t, sut, &events.Event{ID: "2"})
+	publish(t, sut, &events.Event{ID: "3"})
+
+	all, err := sut.Subscribe(t.Context(), events.Filter{}, ptrutil.Ptr[uint64](0))
+	assert.NoError(t, err)
+
+	received := next(t, all)
+	assert.Equal(t, []string{"1", "2", "3"}, eventIDs(received))
+
+	// A subscriber resuming after an event receives the following events
+	resumed, err := sut.Subscribe(t.Context(), events.Filter{}, &received[0].Sequence)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", "3"}, eventIDs(next(t, resumed)))
+
+	// The new subscribers only receive the new events
+	sub, err := sut.Subscribe(t.Context(), events.Filter{}, nil)
+	assert.NoError(t, err)
+
+	publish(t, sut, &events.Event{ID: "4"})
+
+	assert.Equal(t, []string{"4"}, eventIDs(next(t, sub)))
+}
+
+func TestBroker_Should_Reject_Resuming_After_Purged_Events(t *testing.T) {
+	t.Parallel()
+
+	repository := newRepository(t)
+	sut := events.NewBroker(repository, events.DefaultMaxSubscribers, events.DefaultPollInterval)
+
+	old := time.Now().Add(-time.Hour)
+	publish(t, sut, &events.Event{ID: "1", Timestamp: old})
+	publish(t, sut, &events.Event{ID: "2", Timestamp: old})
+	publish(t, sut, &events.Event{ID: "3", Timestamp: old})
+
+	assert.NoError(t, repository.Purge(t.Context(), time.Now()))
+
+	_, err := sut.Subscribe(t.Context(), events.Filter{}, ptrutil.Ptr[uint64](1))
+	assert.ErrorIs(t, err, events.ErrEventsExpired)
+
+	// The last event is kept so the sequences keep growing
+	sub, err := sut.Subscribe(t.Context(), events.Filter{}, ptrutil.Ptr[uint64](0))
+	assert.NoError(t, err)
+
+	received := next(t, sub)
+	assert.Equal(t, []string{"3"}, eventIDs(received))
+	assert.Equal(t, uint64(3), received[0].Sequence)
+}
+
+func TestBroker_Should_Limit_The_Subscribers(t *testing.T) {
+	t.Parallel()
+
+	sut := newBroker(t, 1)
+	sub, err := sut.Subscribe(t.Context(), events.Filter{}, nil)
+	assert.NoError(t, err)
+
+	_, err = sut.Subscribe(t.Context(), events.Filter{}, nil)
+	assert.ErrorIs(t, err, events.ErrTooManySubscribers)
+
+	sub.Close()
+
+	// Closing a subscription twice releases it once
+	sub.Close()
+
+	_, err = sut.Subscribe(t.Context(), events.Filter{}, nil)
+	assert.NoError(t, err)
+
+	_, err = sut.Subscribe(t.Context(), events.Filter{}, nil)
+	assert.ErrorIs(t, err, events.ErrTooManySubscribers)
+}
+
+func newRepository(t *testing.T) events.Repository {
+	t.Helper()
+
+	store, err := memory.NewStore("")
+	assert.NoError(t, err)
+
+	repository, err := eventsmemory.NewRepository(store)
+	assert.NoError(t, err)
+
+	return repository
+}
+
+func newBroker(t *testing.T, maxSubscribers int) *events.Broker {
+	t.Helper()
+
+	return events.NewBroker(newRepository(t), maxSubscribers, events.DefaultPollInterval)
+}
+
+// publish stores the event and notifies the broker as a committed change
+func publish(t *testing.T, broker *events.Broker, event *events.Event) {
+	t.Helper()
+
+	assert.NoError(t, broker.Store(t.Context(), event))
+	broker.OnEvent(t.Context(), event)
+}
+
+func next(t *testing.T, sub *events.Subscription) []*events.Event {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	nextEvents, err := sub.Next(ctx)
+	assert.NoError(t, err)
+
+	return nextEvents
+}
+
+func eventIDs(list []*events.Event) []string {
+	ids := make([]string, 0, len(list))
+	for _, event := range list {
+		ids = append(ids, event.ID)
+	}
+
+	return ids
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package events defines the lifecycle events of the published Verifiable
+// Credentials and a broker streaming the stored events to the subscribers.
+package events
+
+import (
+	"context"
+	"time"
+
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/google/uuid"
+)
+
+// EventType is the change in the lifecycle of a Verifiable Credential
+type EventType string
+
+const (
+	EventTypePublished  EventType = "vc.published"
+	EventTypeRevoked    EventType = "vc.revoked"
+	EventTypeSuspended  EventType = "vc.suspended"
+	EventTypeReinstated EventType = "vc.reinstated"
+)
+
+// Event is emitted when a Verifiable Credential is published
+// or when its status changes
+type Event struct {
+	// The unique identifier of the event
+	ID string `json:"id"`
+
+	// The change in the lifecycle of the credential
+	Type EventType `json:"type"`
+
+	// The ID of the Verifiable Credential
+	VcID string `json:"vcId"`
+
+	// The common name of the Issuer of the credential
+	Issuer string `json:"issuer"`
+
+	// The resolver metadata ID the credential is attached to
+	ResolverMetadataID string `json:"resolverMetadataId"`
+
+	// The time the event occurred
+	Timestamp time.Time `json:"timestamp"`
+
+	// The enveloped credential as submitted to the Node
+	Credential *vctypes.EnvelopedCredential `json:"vc,omitempty"`
+
+	// The position of the event in the stored events, assigned when the event is stored
+	Sequence uint64 `json:"sequence"`
+}
+
+// NewEvent creates an event occurring now
+func NewEvent(
+	eventType EventType,
+	credential *vctypes.VerifiableCredential,
+	resolverMetadataID string,
+	envelope *vctypes.EnvelopedCredential,
+) *Event {
+	return &Event{
+		ID:                 uuid.NewString(),
+		Type:               eventType,
+		VcID:               credential.ID,
+		Issuer:             credential.Issuer,
+		ResolverMetadataID: resolverMetadataID,
+		Timestamp:          time.Now().UTC(),
+		Credential:         envelope,
+	}
+}
+
+// Filter selects the events delivered to a subscriber.
+// Empty fields match every event.
+type Filter struct {
+	// The common name of the Issuer of the credentials
+	Issuer string
+
+	// The resolver metadata ID the credentials are attached to
+	ResolverMetadataID string
+}
+
+// Matches reports whether the event passes the filter
+func (f Filter) Matches(event *Event) bool {
+	if f.Issuer != "" && f.Issuer != event.Issuer {
+		return false
+	}
+
+	if f.ResolverMetadataID != "" && f.ResolverMetadataID != event.ResolverMetadataID {
+		return false
+	}
+
+	return true
+}
+
+// Listener receives the events of the changes of the credentials.
+// Store is called in the transaction of the change, the change is rolled back
+// when an event cannot be stored. OnEvent is called once the change is committed,
+// it must not block since the listeners are called synchronously.
+//
+// The changes append to the transparency log before storing their events:
+// the Postgres repositories lock the size of the log, then the events table,
+// and every transaction must take these locks in this order to avoid deadlocks.
+type Listener interface {
+	Store(ctx context.Context, event *Event) error
+	OnEvent(ctx context.Context, event *Event)
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/vc/events"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db/memory"
+)
+
+const eventsTable = "credential_events"
+
+type eventsMemoryRepository struct {
+	events *memory.Table[*events.Event]
+}
+
+// NewRepository creates a new instance of the events Repository
+// storing the events in the in-memory store, indexed by their sequence
+func NewRepository(store *memory.Store) (events.Repository, error) {
+	table, err := memory.NewTable[*events.Event](store, eventsTable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventsMemoryRepository{
+		events: table,
+	}, nil
+}
+
+func (r *eventsMemoryRepository) Append(ctx context.Context, event *events.Event) error {
+	err := r.events.Update(ctx, func(rows map[string]*events.Event) error {
+		_, last := sequenceRange(rows)
+		event.Sequence = last + 1
+
+		stored := *event
+		rows[sequenceKey(stored.Sequence)] = &stored
+
+		return nil
+	})
+	if err != nil {
+		return errutil.Err(err, "there was an error storing the event")
+	}
+
+	return nil
+}
+
+func (r *eventsMemoryRepository) ListAfter(
+	ctx context.Context,
+	after uint64,
+	filter events.Filter,
+	limit int,
+) ([]*events.Event, error) {
+	result := make([]*events.Event, 0)
+
+	_ = r.events.View(ctx, func(rows map[string]*events.Event) error {
+		for _, event := range rows {
+			if event.Sequence > after && filter.Matches(event) {
+				stored := *event
+				result = append(result, &stored)
+			}
+		}
+
+		return nil
+	})
+
+	slices.SortFunc(result, func(a, b *events.Event) int {
+		return cmp.Compare(a.Sequence, b.Sequence)
+	})
+
+	return result[:min(limit, len(result))], nil
+}
+
+func (r *eventsMemoryRepository) GetSequenceRange(ctx context.Context) (uint64, uint64, error) {
+	var first, last uint64
+
+	_ = r.events.View(ctx, func(rows map[string]*events.Event) error {
+		first, last = sequenceRange(rows)
+		return nil
+	})
+
+	return first, last, nil
+}
+
+func (r *eventsMemoryRepository) Purge(ctx context.Context, before time.Time) error {
+	err := r.events.Update(ctx, func(rows map[string]*events.Event) error {
+		_, last := sequenceRange(rows)
+
+		for key, event := range rows {
+			if event.Timestamp.Before(before) && event.Sequence < last {
+				delete(rows, key)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errutil.Err(err, "there was an error purging the events")
+	}
+
+	return nil
+}
+
+func sequenceRange(rows map[string]*events.Event) (uint64, uint64) {
+	var first, last uint64
+
+	for _, event := range rows {
+		if first == 0 || event.Sequence < first {
+			first = event.Sequence
+		}
+
+		last = max(last, event.Sequence)
+	}
+
+	return first, last
+}
+
+func sequenceKey(sequence uint64) string {
+	return strconv.FormatUint(sequence, 10)
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/vc/events"
+)
+
+type CredentialEvent struct {
+	Sequence           uint64 `gorm:"primaryKey;autoIncrement:false"`
+	ID                 string `gorm:"uniqueIndex"`
+	Type               events.EventType
+	VcID               string
+	Issuer             string    `gorm:"index"`
+	ResolverMetadataID string    `gorm:"index"`
+	Timestamp          time.Time `gorm:"index"`
+
+	// The serialized event
+	Payload []byte
+}
+
+func (CredentialEvent) TableName() string {
+	return "credential_events"
+}
+
+func (e *CredentialEvent) ToCoreType() (*events.Event, error) {
+	var event events.Event
+
+	err := json.Unmarshal(e.Payload, &event)
+	if err != nil {
+		return nil, err
+	}
+
+	event.Sequence = e.Sequence
+
+	return &event, nil
+}
+
+func newCredentialEventModel(src *events.Event) (*CredentialEvent, error) {
+	payload, err := json.Marshal(src)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CredentialEvent{
+		Sequence:           src.Sequence,
+		ID:                 src.ID,
+		Type:               src.Type,
+		VcID:               src.VcID,
+		Issuer:             src.Issuer,
+		ResolverMetadataID: src.ResolverMetadataID,
+		Timestamp:          src.Timestamp,
+		Payload:            payload,
+	}, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/vc/events"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db"
+	"gorm.io/gorm"
+)
+
+type eventsPostgresRepository struct {
+	dbContext db.Context
+}
+
+func NewRepository(dbContext db.Context) events.Repository {
+	return &eventsPostgresRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r *eventsPostgresRepository) Append(ctx context.Context, event *events.Event) error {
+	err := db.Client(ctx, r.dbContext).Transaction(func(tx *gorm.DB) error {
+		// The events are appended one at a time until the transaction commits,
+		// a subscriber never reads an event after a later one
+		err := tx.Exec("LOCK TABLE credential_events IN EXCLUSIVE MODE").Error
+		if err != nil {
+			return err
+		}
+
+		var last uint64
+
+		err = tx.Model(&CredentialEvent{}).
+			Select("COALESCE(MAX(sequence), 0)").
+			Scan(&last).Error
+		if err != nil {
+			return err
+		}
+
+		event.Sequence = last + 1
+
+		model, err := newCredentialEventModel(event)
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(model).Error
+	})
+	if err != nil {
+		return errutil.Err(err, "there was an error storing the event")
+	}
+
+	return nil
+}
+
+func (r *eventsPostgresRepository) ListAfter(
+	ctx context.Context,
+	after uint64,
+	filter events.Filter,
+	limit int,
+) ([]*events.Event, error) {
+	query := db.Client(ctx, r.dbContext).Where("sequence > ?", after)
+
+	if filter.Issuer != "" {
+		query = query.Where("issuer = ?", filter.Issuer)
+	}
+
+	if filter.ResolverMetadataID != "" {
+		query = query.Where("resolver_metadata_id = ?", filter.ResolverMetadataID)
+	}
+
+	var models []*CredentialEvent
+
+	err := query.Order("sequence").Limit(limit).Find(&models).Error
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error fetching the events")
+	}
+
+	result := make([]*events.Event, 0, len(models))
+
+	for _, model := range models {
+		event, err := model.ToCoreType()
+		if err != nil {
+			return nil, errutil.Err(err, "there was an error reading the event")
+		}
+
+		result = append(result, event)
+	}
+
+	return result, nil
+}
+
+func (r *eventsPostgresRepository) GetSequenceRange(ctx context.Context) (uint64, uint64, error) {
+	var sequences struct {
+		First uint64
+		Last  uint64
+	}
+
+	err := db.Client(ctx, r.dbContext).
+		Model(&CredentialEvent{}).
+		Select("COALESCE(MIN(sequence), 0) AS first, COALESCE(MAX(sequence), 0) AS last").
+		Scan(&sequences).Error
+	if err != nil {
+		return 0, 0, errutil.Err(err, "there was an error fetching the sequences of the events")
+	}
+
+	return sequences.First, sequences.Last, nil
+}
+
+func (r *eventsPostgresRepository) Purge(ctx context.Context, before time.Time) error {
+	err := db.Client(ctx, r.dbContext).
+		Where("timestamp < ?", before).
+		Where("sequence < (SELECT MAX(sequence) FROM credential_events)").
+		Delete(&CredentialEvent{}).Error
+	if err != nil {
+		return errutil.Err(err, "there was an error purging the events")
+	}
+
+	return nil
+}
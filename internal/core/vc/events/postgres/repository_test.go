@@ -0,0 +1,75 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/vc/events"
+	eventspg "github.com/agntcy/identity/internal/core/vc/events/postgres"
+	"github.com/agntcy/identity/internal/pkg/pgtesting"
+	"github.com/agntcy/identity/pkg/db"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppend_Should_Store_The_Events_Of_Committed_Transactions(t *testing.T) {
+	t.Parallel()
+
+	dbContext := pgtesting.Connect(t)
+	ctx := t.Context()
+	transactor := db.NewTransactor(dbContext)
+	sut := eventspg.NewRepository(dbContext)
+	issuer := uuid.NewString() + ".example.com"
+
+	newEvent := func() *events.Event {
+		return &events.Event{
+			ID:        uuid.NewString(),
+			Type:      events.EventTypePublished,
+			Issuer:    issuer,
+			Timestamp: time.Now().UTC(),
+		}
+	}
+
+	committed := newEvent()
+	err := transactor.Transaction(ctx, func(ctx context.Context) error {
+		return sut.Append(ctx, committed)
+	})
+	assert.NoError(t, err)
+
+	rolledBack := newEvent()
+	err = transactor.Transaction(ctx, func(ctx context.Context) error {
+		err := sut.Append(ctx, rolledBack)
+		if err != nil {
+			return err
+		}
+
+		return errors.New("the change failed")
+	})
+	assert.Error(t, err)
+
+	last := newEvent()
+	assert.NoError(t, sut.Append(ctx, last))
+	assert.Greater(t, last.Sequence, committed.Sequence)
+
+	stored, err := sut.ListAfter(ctx, 0, events.Filter{Issuer: issuer}, 10)
+	assert.NoError(t, err)
+	assert.Len(t, stored, 2)
+	assert.Equal(t, committed.ID, stored[0].ID)
+	assert.Equal(t, committed.Sequence, stored[0].Sequence)
+	assert.Equal(t, last.ID, stored[1].ID)
+
+	stored, err = sut.ListAfter(ctx, committed.Sequence, events.Filter{Issuer: issuer}, 10)
+	assert.NoError(t, err)
+	assert.Len(t, stored, 1)
+	assert.Equal(t, last.ID, stored[0].ID)
+
+	first, lastSequence, err := sut.GetSequenceRange(ctx)
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, first, committed.Sequence)
+	assert.GreaterOrEqual(t, lastSequence, last.Sequence)
+}
@@ -0,0 +1,27 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	// Append stores the event and assigns its sequence, the sequences
+	// grow in the order the appending transactions are committed
+	Append(ctx context.Context, event *Event) error
+
+	// ListAfter returns up to limit events matching the filter
+	// with a sequence greater than after, in the order of their sequence
+	ListAfter(ctx context.Context, after uint64, filter Filter, limit int) ([]*Event, error)
+
+	// GetSequenceRange returns the sequences of the first and the last
+	// retained events, 0 when there is none
+	GetSequenceRange(ctx context.Context) (first, last uint64, err error)
+
+	// Purge removes the events that occurred before the time,
+	// the last event is kept so the sequences keep growing
+	Purge(ctx context.Context, before time.Time) error
+}
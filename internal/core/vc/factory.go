@@ -4,9 +4,11 @@
 package vc
 
 import (
+	"errors"
 	"time"
 
 	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
+	"github.com/agntcy/identity/internal/core/vc/statuslist"
 	"github.com/agntcy/identity/internal/core/vc/types"
 	"github.com/google/uuid"
 )
@@ -50,6 +52,29 @@ func WithCredentialContent(
 	}
 }
 
+// WithExpiration sets the validity period of the credential,
+// starting from its issuance date, more information can be found [here]
+//
+// [here]: https://www.w3.org/TR/vc-data-model-2.0/#validity-period
+func WithExpiration(expiresIn time.Duration) VerifiableCredentialOption {
+	return func(vc *types.VerifiableCredential) error {
+		if expiresIn <= 0 {
+			return errors.New("the expiration must be a positive duration")
+		}
+
+		issuanceDate, err := time.Parse(time.RFC3339, vc.IssuanceDate)
+		if err != nil {
+			return err
+		}
+
+		vc.ValidFrom = vc.IssuanceDate
+		vc.ValidUntil = issuanceDate.Add(expiresIn).Format(time.RFC3339)
+		vc.ExpirationDate = vc.ValidUntil
+
+		return nil
+	}
+}
+
 // Schemas can be used to include JSON Schemas within the Verifiable Credential created by [Create]
 // more information can be found [here]
 //
@@ -70,3 +95,27 @@ func WithCredentialSchema(schemas ...string) VerifiableCredentialOption {
 		return nil
 	}
 }
+
+// WithStatusList assigns the credential a random index in the Issuer's
+// Bitstring Status List located at statusListURL, more information can be found [here]
+//
+// [here]: https://www.w3.org/TR/vc-bitstring-status-list/
+func WithStatusList(
+	statusListURL string,
+	purpose types.CredentialStatusPurpose,
+) VerifiableCredentialOption {
+	return func(vc *types.VerifiableCredential) error {
+		index, err := statuslist.RandomIndex()
+		if err != nil {
+			return err
+		}
+
+		vc.Status = append(vc.Status, statuslist.NewStatusEntry(
+			statusListURL,
+			purpose,
+			index,
+		))
+
+		return nil
+	}
+}
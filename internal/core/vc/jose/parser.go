@@ -5,10 +5,13 @@ package jose
 
 import (
 	"encoding/json"
+	"errors"
+	"strings"
 
 	errtypes "github.com/agntcy/identity/internal/core/errors/types"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
 	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/joseutil"
 	jwktype "github.com/agntcy/identity/pkg/jwk"
 	"github.com/lestrrat-go/jwx/v3/jwk"
 	"github.com/lestrrat-go/jwx/v3/jws"
@@ -19,7 +22,23 @@ func Verify(
 	credential *vctypes.EnvelopedCredential,
 ) error {
 	// we assume the VC is not encrypted with JWE
-	keys := jwks.Raw()
+	message, err := jws.Parse([]byte(credential.Value))
+	if err != nil {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+			err.Error(),
+			err,
+		)
+	}
+
+	headers := message.Signatures()[0].ProtectedHeaders()
+	if alg, ok := headers.Algorithm(); ok && joseutil.IsMLDSA(alg.String()) {
+		kid, _ := headers.KeyID()
+
+		return verifyMLDSA(jwks, kid, credential)
+	}
+
+	keys := joseutil.SupportedKeys(jwks).Raw()
 	if keys == nil {
 		return errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unable to parse jwks", nil)
 	}
@@ -87,3 +106,34 @@ func VerifyAndParse(
 
 	return Parse(credential)
 }
+
+// verifyMLDSA verifies the credential signed with a post-quantum ML-DSA key,
+// the AKP keys are not supported by the jwx library
+func verifyMLDSA(
+	jwks *jwktype.Jwks,
+	kid string,
+	credential *vctypes.EnvelopedCredential,
+) error {
+	verifyErr := errors.New("no AKP key found to verify the signature")
+
+	for _, key := range jwks.Keys {
+		if key == nil || !strings.EqualFold(key.KTY, joseutil.KeyTypeAKP) {
+			continue
+		}
+
+		if kid != "" && key.KID != kid {
+			continue
+		}
+
+		_, verifyErr = joseutil.Verify(key, []byte(credential.Value))
+		if verifyErr == nil {
+			return nil
+		}
+	}
+
+	return errutil.ErrInfo(
+		errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+		verifyErr.Error(),
+		verifyErr,
+	)
+}
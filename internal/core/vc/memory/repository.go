@@ -0,0 +1,197 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	errcore "github.com/agntcy/identity/internal/core/errors"
+	vccore "github.com/agntcy/identity/internal/core/vc"
+	"github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db/memory"
+)
+
+const vcTable = "verifiable_credentials"
+
+// VerifiableCredential is the row of a credential in the in-memory store
+type VerifiableCredential struct {
+	ResolverMetadataID string
+	Credential         *types.VerifiableCredential
+}
+
+type vcMemoryRepository struct {
+	credentials *memory.Table[*VerifiableCredential]
+}
+
+// NewRepository creates a new instance of the VC Repository
+// storing the credentials in the in-memory store
+func NewRepository(store *memory.Store) (vccore.Repository, error) {
+	credentials, err := memory.NewTable[*VerifiableCredential](store, vcTable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vcMemoryRepository{
+		credentials: credentials,
+	}, nil
+}
+
+func (r *vcMemoryRepository) Create(
+	ctx context.Context,
+	credential *types.VerifiableCredential,
+	resolverMetadataID string,
+) (*types.VerifiableCredential, error) {
+	err := r.save(ctx, credential, resolverMetadataID, false)
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error creating the verifiable credential")
+	}
+
+	return credential, nil
+}
+
+func (r *vcMemoryRepository) Update(
+	ctx context.Context,
+	credential *types.VerifiableCredential,
+	resolverMetadataID string,
+) (*types.VerifiableCredential, error) {
+	err := r.save(ctx, credential, resolverMetadataID, true)
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error updating the verifiable credential")
+	}
+
+	return credential, nil
+}
+
+func (r *vcMemoryRepository) GetByResolverMetadata(
+	ctx context.Context,
+	resolverMetadataID string,
+) ([]*types.VerifiableCredential, error) {
+	vcs, err := r.find(ctx, func(row *VerifiableCredential) bool {
+		return row.ResolverMetadataID == resolverMetadataID
+	})
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error fetching the verifiable credentials")
+	}
+
+	return vcs, nil
+}
+
+func (r *vcMemoryRepository) GetByID(
+	ctx context.Context,
+	id string,
+) (*types.VerifiableCredential, error) {
+	var credential *types.VerifiableCredential
+
+	err := r.credentials.View(ctx, func(rows map[string]*VerifiableCredential) error {
+		row, ok := rows[id]
+		if !ok {
+			return errcore.ErrResourceNotFound
+		}
+
+		var err error
+		credential, err = memory.Clone(row.Credential)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return credential, nil
+}
+
+func (r *vcMemoryRepository) Search(
+	ctx context.Context,
+	criteria *vccore.SearchCriteria,
+	cursor *vccore.SearchCursor,
+	limit int,
+) ([]*types.VerifiableCredential, *vccore.SearchCursor, error) {
+	vcs, err := r.find(ctx, func(row *VerifiableCredential) bool {
+		if criteria != nil && !criteria.Matches(row.Credential) {
+			return false
+		}
+
+		return cursor == nil || cursor.Precedes(row.Credential)
+	})
+	if err != nil {
+		return nil, nil, errutil.Err(
+			err, "there was an error searching the verifiable credentials",
+		)
+	}
+
+	if len(vcs) <= limit {
+		return vcs, nil, nil
+	}
+
+	vcs = vcs[:limit]
+	last := vcs[len(vcs)-1]
+
+	return vcs, &vccore.SearchCursor{IssuanceDate: last.IssuanceDate, ID: last.ID}, nil
+}
+
+func (r *vcMemoryRepository) save(
+	ctx context.Context,
+	credential *types.VerifiableCredential,
+	resolverMetadataID string,
+	overwrite bool,
+) error {
+	stored, err := memory.Clone(credential)
+	if err != nil {
+		return err
+	}
+
+	return r.credentials.Update(ctx, func(rows map[string]*VerifiableCredential) error {
+		if _, ok := rows[credential.ID]; ok && !overwrite {
+			return errcore.ErrResourceAlreadyExists
+		}
+
+		rows[credential.ID] = &VerifiableCredential{
+			ResolverMetadataID: resolverMetadataID,
+			Credential:         stored,
+		}
+
+		return nil
+	})
+}
+
+// find returns the credentials matching the filter,
+// ordered by issuance date then ID, both descending
+func (r *vcMemoryRepository) find(
+	ctx context.Context,
+	filter func(row *VerifiableCredential) bool,
+) ([]*types.VerifiableCredential, error) {
+	vcs := make([]*types.VerifiableCredential, 0)
+
+	err := r.credentials.View(ctx, func(rows map[string]*VerifiableCredential) error {
+		for _, row := range rows {
+			if !filter(row) {
+				continue
+			}
+
+			credential, err := memory.Clone(row.Credential)
+			if err != nil {
+				return err
+			}
+
+			vcs = append(vcs, credential)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(vcs, func(a, b *types.VerifiableCredential) int {
+		return cmp.Or(
+			cmp.Compare(b.IssuanceDate, a.IssuanceDate),
+			cmp.Compare(b.ID, a.ID),
+		)
+	})
+
+	return vcs, nil
+}
@@ -4,81 +4,138 @@
 package vc
 
 import (
+	"time"
+
 	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/core/vc/cose"
+	"github.com/agntcy/identity/internal/core/vc/dataintegrity"
 	"github.com/agntcy/identity/internal/core/vc/jose"
+	"github.com/agntcy/identity/internal/core/vc/sdjwt"
 	"github.com/agntcy/identity/internal/core/vc/types"
 	"github.com/agntcy/identity/internal/pkg/errutil"
 	"github.com/agntcy/identity/pkg/jwk"
 	"github.com/agntcy/identity/pkg/log"
 )
 
-func ParseEnvelopedCredential(cred *types.EnvelopedCredential) (*types.VerifiableCredential, error) {
-	var parsed *types.VerifiableCredential
-
-	switch cred.EnvelopeType {
-	case types.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF:
-		return nil, credentialEnvelopeTypeNotImplErr()
-	case types.CREDENTIAL_ENVELOPE_TYPE_JOSE:
-		log.Debug("Parsing the JOSE Verifiable Credential")
+// The verification and parsing functions of a credential envelope,
+// verifyPresented verifies the envelopes that can be bound to a holder
+type envelope struct {
+	name            string
+	verify          func(jwks *jwk.Jwks, cred *types.EnvelopedCredential) error
+	verifyPresented func(jwks *jwk.Jwks, cred *types.EnvelopedCredential, nonce, audience string) error
+	parse           func(cred *types.EnvelopedCredential) (*types.VerifiableCredential, error)
+}
 
-		parsedVC, err := jose.Parse(cred)
-		if err != nil {
-			return nil, err
-		}
+var envelopes = map[types.CredentialEnvelopeType]envelope{
+	types.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF: {
+		name:   "Data Integrity",
+		verify: dataintegrity.Verify,
+		parse:  dataintegrity.Parse,
+	},
+	types.CREDENTIAL_ENVELOPE_TYPE_JOSE: {
+		name:   "JOSE",
+		verify: jose.Verify,
+		parse:  jose.Parse,
+	},
+	types.CREDENTIAL_ENVELOPE_TYPE_COSE: {
+		name:   "COSE",
+		verify: cose.Verify,
+		parse:  cose.Parse,
+	},
+	types.CREDENTIAL_ENVELOPE_TYPE_SD_JWT: {
+		name:            "SD-JWT",
+		verify:          sdjwt.Verify,
+		verifyPresented: sdjwt.VerifyPresentation,
+		parse:           sdjwt.Parse,
+	},
+}
 
-		parsed = parsedVC
-	default:
+func ParseEnvelopedCredential(cred *types.EnvelopedCredential) (*types.VerifiableCredential, error) {
+	env, ok := envelopes[cred.EnvelopeType]
+	if !ok {
 		return nil, invalidCredentialEnvelopeTypeErr()
 	}
 
-	return parsed, nil
+	log.Debug("Parsing the ", env.name, " Verifiable Credential")
+
+	return env.parse(cred)
 }
 
 func VerifyEnvelopedCredential(cred *types.EnvelopedCredential, jwks *jwk.Jwks, checkStatus bool) error {
-	var vc *types.VerifiableCredential
+	env, ok := envelopes[cred.EnvelopeType]
+	if !ok {
+		return invalidCredentialEnvelopeTypeErr()
+	}
 
-	switch cred.EnvelopeType {
-	case types.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF:
-		return credentialEnvelopeTypeNotImplErr()
-	case types.CREDENTIAL_ENVELOPE_TYPE_JOSE:
-		log.Debug("Verifying the JOSE Verifiable Credential")
+	log.Debug("Verifying the ", env.name, " Verifiable Credential")
 
-		err := jose.Verify(jwks, cred)
-		if err != nil {
-			return errutil.ErrInfo(
-				errtypes.ERROR_REASON_INVALID_PROOF,
-				"Unable to verify the integrity of the data provided.",
-				err,
-			)
-		}
+	return verifyEnvelope(&env, cred, checkStatus, func() error {
+		return env.verify(jwks, cred)
+	})
+}
 
-		vc, err = jose.Parse(cred)
-		if err != nil {
-			return errutil.ErrInfo(
-				errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
-				"Unable to parse the data provided.",
-				err,
-			)
-		}
-	default:
+// VerifyPresentedCredential verifies a credential presented to a verifier,
+// the credentials bound to a holder key must be presented by their holder
+// for the nonce and the audience of the verifier
+func VerifyPresentedCredential(
+	cred *types.EnvelopedCredential,
+	jwks *jwk.Jwks,
+	nonce string,
+	audience string,
+	checkStatus bool,
+) error {
+	env, ok := envelopes[cred.EnvelopeType]
+	if !ok {
 		return invalidCredentialEnvelopeTypeErr()
 	}
 
+	log.Debug("Verifying the presented ", env.name, " Verifiable Credential")
+
+	return verifyEnvelope(&env, cred, checkStatus, func() error {
+		if env.verifyPresented == nil {
+			return env.verify(jwks, cred)
+		}
+
+		return env.verifyPresented(jwks, cred, nonce, audience)
+	})
+}
+
+func verifyEnvelope(
+	env *envelope,
+	cred *types.EnvelopedCredential,
+	checkStatus bool,
+	verify func() error,
+) error {
+	err := verify()
+	if err != nil {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_PROOF,
+			"Unable to verify the integrity of the data provided.",
+			err,
+		)
+	}
+
+	vc, err := env.parse(cred)
+	if err != nil {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+			"Unable to parse the data provided.",
+			err,
+		)
+	}
+
 	if checkStatus {
+		err := vc.ValidateValidityPeriod(time.Now())
+		if err != nil {
+			return err
+		}
+
 		return vc.ValidateStatus()
 	}
 
 	return nil
 }
 
-func credentialEnvelopeTypeNotImplErr() error {
-	return errutil.ErrInfo(
-		errtypes.ERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_TYPE,
-		"credential envelope type not implemented yet",
-		nil,
-	)
-}
-
 func invalidCredentialEnvelopeTypeErr() error {
 	return errutil.ErrInfo(
 		errtypes.ERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_TYPE,
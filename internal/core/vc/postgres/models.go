@@ -22,6 +22,8 @@ type VerifiableCredential struct {
 	CredentialSubject  json.RawMessage
 	IssuanceDate       string
 	ExpirationDate     string
+	ValidFrom          string
+	ValidUntil         string
 	CredentialSchema   []*CredentialSchema `gorm:"foreignKey:VerifiableCredentialID"`
 	Status             []*CredentialStatus `gorm:"foreignKey:VerifiableCredentialID"`
 	Proof              *types.Proof        `gorm:"embedded;embeddedPrefix:proof_"`
@@ -44,6 +46,8 @@ func (vm *VerifiableCredential) ToCoreType() *types.VerifiableCredential {
 		ID:                vm.ID,
 		IssuanceDate:      vm.IssuanceDate,
 		ExpirationDate:    vm.ExpirationDate,
+		ValidFrom:         vm.ValidFrom,
+		ValidUntil:        vm.ValidUntil,
 		CredentialSchema: convertutil.ConvertSlice(
 			vm.CredentialSchema,
 			func(c *CredentialSchema) *types.CredentialSchema {
@@ -79,14 +83,18 @@ type CredentialStatus struct {
 	Type                   string
 	CreatedAt              time.Time
 	Purpose                types.CredentialStatusPurpose
+	StatusListIndex        string
+	StatusListCredential   string
 }
 
 func (c *CredentialStatus) ToCoreType() *types.CredentialStatus {
 	return &types.CredentialStatus{
-		ID:        c.ID,
-		Type:      c.Type,
-		CreatedAt: c.CreatedAt,
-		Purpose:   c.Purpose,
+		ID:                   c.ID,
+		Type:                 c.Type,
+		CreatedAt:            c.CreatedAt,
+		Purpose:              c.Purpose,
+		StatusListIndex:      c.StatusListIndex,
+		StatusListCredential: c.StatusListCredential,
 	}
 }
 
@@ -108,6 +116,8 @@ func newVerifiableCredentialModel(
 		CredentialSubject: sub,
 		IssuanceDate:      src.IssuanceDate,
 		ExpirationDate:    src.ExpirationDate,
+		ValidFrom:         src.ValidFrom,
+		ValidUntil:        src.ValidUntil,
 		CredentialSchema: convertutil.ConvertSlice(
 			src.CredentialSchema,
 			func(cs *types.CredentialSchema) *CredentialSchema {
@@ -139,6 +149,8 @@ func newCredentialStatusModel(src *types.CredentialStatus, vcID string) *Credent
 		Type:                   src.Type,
 		CreatedAt:              src.CreatedAt,
 		Purpose:                src.Purpose,
+		StatusListIndex:        src.StatusListIndex,
+		StatusListCredential:   src.StatusListCredential,
 		VerifiableCredentialID: vcID,
 	}
 }
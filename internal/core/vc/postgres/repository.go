@@ -6,6 +6,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	errcore "github.com/agntcy/identity/internal/core/errors"
 	vccore "github.com/agntcy/identity/internal/core/vc"
@@ -32,7 +33,7 @@ func (r *vcPostgresRepository) Create(
 ) (*types.VerifiableCredential, error) {
 	model := newVerifiableCredentialModel(credential, resolverMetadataID)
 
-	result := r.dbContext.Client().Create(model)
+	result := db.Client(ctx, r.dbContext).Create(model)
 	if result.Error != nil {
 		return nil, errutil.Err(
 			result.Error, "there was an error creating the verifiable credential",
@@ -49,7 +50,21 @@ func (r *vcPostgresRepository) Update(
 ) (*types.VerifiableCredential, error) {
 	model := newVerifiableCredentialModel(credential, resolverMetadataID)
 
-	err := r.dbContext.Client().Save(model).Error
+	// Save upserts the associations but never deletes the removed ones,
+	// they are replaced so a reinstated credential loses its suspension status
+	err := db.Client(ctx, r.dbContext).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("verifiable_credential_id = ?", model.ID).Delete(&CredentialStatus{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("verifiable_credential_id = ?", model.ID).Delete(&CredentialSchema{}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Save(model).Error
+	})
 	if err != nil {
 		return nil, errutil.Err(
 			err, "there was an error updating the verifiable credential",
@@ -65,7 +80,7 @@ func (r *vcPostgresRepository) GetByResolverMetadata(
 ) ([]*types.VerifiableCredential, error) {
 	var storedVCs []*VerifiableCredential
 
-	result := r.dbContext.Client().
+	result := db.Client(ctx, r.dbContext).
 		Preload("Status").
 		Where("resolver_metadata_id = ?", resolverMetadataID).
 		Order("issuance_date DESC").
@@ -90,7 +105,7 @@ func (r *vcPostgresRepository) GetByID(
 ) (*types.VerifiableCredential, error) {
 	var vc VerifiableCredential
 
-	err := r.dbContext.Client().Preload("Status").Where("id = ?", id).First(&vc).Error
+	err := db.Client(ctx, r.dbContext).Preload("Status").Where("id = ?", id).First(&vc).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errcore.ErrResourceNotFound
@@ -103,3 +118,114 @@ func (r *vcPostgresRepository) GetByID(
 
 	return vc.ToCoreType(), nil
 }
+
+func (r *vcPostgresRepository) Search(
+	ctx context.Context,
+	criteria *vccore.SearchCriteria,
+	cursor *vccore.SearchCursor,
+	limit int,
+) ([]*types.VerifiableCredential, *vccore.SearchCursor, error) {
+	query := db.Client(ctx, r.dbContext).Preload("Status")
+
+	if criteria != nil {
+		query = applySearchCriteria(query, criteria)
+	}
+
+	if cursor != nil {
+		query = query.Where(
+			"(issuance_date < ? OR (issuance_date = ? AND id < ?))",
+			cursor.IssuanceDate,
+			cursor.IssuanceDate,
+			cursor.ID,
+		)
+	}
+
+	var storedVCs []*VerifiableCredential
+
+	// Fetch one extra row to know if there is a next page
+	result := query.
+		Order("issuance_date DESC").
+		Order("id DESC").
+		Limit(limit + 1).
+		Find(&storedVCs)
+	if result.Error != nil {
+		return nil, nil, errutil.Err(
+			result.Error, "there was an error searching the verifiable credentials",
+		)
+	}
+
+	var next *vccore.SearchCursor
+
+	if len(storedVCs) > limit {
+		storedVCs = storedVCs[:limit]
+		last := storedVCs[len(storedVCs)-1]
+		next = &vccore.SearchCursor{
+			IssuanceDate: last.IssuanceDate,
+			ID:           last.ID,
+		}
+	}
+
+	vcs := make([]*types.VerifiableCredential, 0, len(storedVCs))
+	for _, vc := range storedVCs {
+		vcs = append(vcs, vc.ToCoreType())
+	}
+
+	return vcs, next, nil
+}
+
+func applySearchCriteria(query *gorm.DB, criteria *vccore.SearchCriteria) *gorm.DB {
+	if criteria.IssuerCommonName != "" {
+		query = query.Where("issuer = ?", criteria.IssuerCommonName)
+	}
+
+	if criteria.ContentType != types.CREDENTIAL_CONTENT_TYPE_UNSPECIFIED {
+		query = query.Where("? = ANY(type)", criteria.ContentType.String())
+	}
+
+	if criteria.ResolverMetadataID != "" {
+		query = query.Where("resolver_metadata_id = ?", criteria.ResolverMetadataID)
+	}
+
+	// Dates are stored as RFC3339 UTC strings which preserve the chronological order
+	if criteria.IssuedAfter != nil {
+		query = query.Where("issuance_date >= ?", formatDate(criteria.IssuedAfter))
+	}
+
+	if criteria.IssuedBefore != nil {
+		query = query.Where("issuance_date < ?", formatDate(criteria.IssuedBefore))
+	}
+
+	if criteria.ExpiresAfter != nil {
+		query = query.Where(
+			"(expiration_date = '' OR expiration_date >= ?)",
+			formatDate(criteria.ExpiresAfter),
+		)
+	}
+
+	if criteria.ExpiresBefore != nil {
+		query = query.Where(
+			"expiration_date <> '' AND expiration_date < ?",
+			formatDate(criteria.ExpiresBefore),
+		)
+	}
+
+	if criteria.Revoked != nil {
+		revoked := `EXISTS (
+			SELECT 1 FROM credential_statuses
+			WHERE credential_statuses.verifiable_credential_id = verifiable_credentials.id
+			AND credential_statuses.purpose = ?
+		)`
+
+		if *criteria.Revoked {
+			query = query.Where(revoked, types.CREDENTIAL_STATUS_PURPOSE_REVOCATION)
+		} else {
+			query = query.Not(revoked, types.CREDENTIAL_STATUS_PURPOSE_REVOCATION)
+		}
+	}
+
+	return query
+}
+
+func formatDate(t *time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"testing"
+
+	idpg "github.com/agntcy/identity/internal/core/id/postgres"
+	idtypes "github.com/agntcy/identity/internal/core/id/types"
+	issuerpg "github.com/agntcy/identity/internal/core/issuer/postgres"
+	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
+	vcpg "github.com/agntcy/identity/internal/core/vc/postgres"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/pkg/pgtesting"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdate_Should_Remove_The_Deleted_Statuses(t *testing.T) {
+	t.Parallel()
+
+	dbContext := pgtesting.Connect(t)
+	ctx := t.Context()
+
+	issuer := &issuertypes.Issuer{
+		CommonName:      uuid.NewString() + ".example.com",
+		Organization:    "Organization",
+		SubOrganization: "Sub Organization",
+	}
+
+	_, err := issuerpg.NewRepository(dbContext).CreateIssuer(ctx, issuer)
+	assert.NoError(t, err)
+
+	metadata := &idtypes.ResolverMetadata{ID: "AGNTCY-" + uuid.NewString(), Controller: issuer.CommonName}
+
+	_, err = idpg.NewIdRepository(dbContext).CreateID(ctx, metadata, issuer)
+	assert.NoError(t, err)
+
+	sut := vcpg.NewRepository(dbContext)
+
+	revocation := &vctypes.CredentialStatus{
+		ID:      "revocation",
+		Type:    "CredentialStatusEntry",
+		Purpose: vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
+	}
+	suspension := &vctypes.CredentialStatus{
+		ID:      "suspension",
+		Type:    "CredentialStatusEntry",
+		Purpose: vctypes.CREDENTIAL_STATUS_PURPOSE_SUSPENSION,
+	}
+	credential := &vctypes.VerifiableCredential{
+		ID:     uuid.NewString(),
+		Issuer: issuer.CommonName,
+		Status: []*vctypes.CredentialStatus{revocation},
+	}
+
+	_, err = sut.Create(ctx, credential, metadata.ID)
+	assert.NoError(t, err)
+
+	// Suspend then reinstate the credential
+	credential.Status = []*vctypes.CredentialStatus{revocation, suspension}
+
+	_, err = sut.Update(ctx, credential, metadata.ID)
+	assert.NoError(t, err)
+
+	credential.Status = []*vctypes.CredentialStatus{revocation}
+
+	_, err = sut.Update(ctx, credential, metadata.ID)
+	assert.NoError(t, err)
+
+	stored, err := sut.GetByID(ctx, credential.ID)
+	assert.NoError(t, err)
+	assert.Len(t, stored.Status, 1)
+	assert.Equal(t, "revocation", stored.Status[0].ID)
+}
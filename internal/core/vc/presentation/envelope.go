@@ -0,0 +1,93 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package presentation
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+)
+
+const (
+	envelopedVerifiableCredentialType = "EnvelopedVerifiableCredential"
+
+	dataURLPrefix = "data:"
+)
+
+// The media types of the enveloped credentials as defined [here]
+//
+// [here]: https://www.w3.org/TR/vc-jose-cose/#media-types
+var mediaTypes = map[vctypes.CredentialEnvelopeType]string{
+	vctypes.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF: "application/vc",
+	vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE:           "application/vc+jwt",
+	vctypes.CREDENTIAL_ENVELOPE_TYPE_COSE:           "application/vc+cose",
+	vctypes.CREDENTIAL_ENVELOPE_TYPE_SD_JWT:         "application/vc+sd-jwt",
+}
+
+// envelope wraps the credential in an EnvelopedVerifiableCredential
+// identified by a data URL, as defined [here]
+//
+// [here]: https://www.w3.org/TR/vc-data-model-2.0/#enveloped-verifiable-credentials
+func envelope(cred *vctypes.EnvelopedCredential) (*vctypes.VerifiableCredential, error) {
+	mediaType, ok := mediaTypes[cred.EnvelopeType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported credential envelope type: %s", cred.EnvelopeType)
+	}
+
+	if cred.Value == "" {
+		return nil, errors.New("the credential envelope value is empty")
+	}
+
+	// The embedded proof credentials are JSON documents, they are escaped
+	// to be a valid data URL, the other envelopes are already URL safe
+	value := cred.Value
+	if cred.EnvelopeType == vctypes.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF {
+		value = url.PathEscape(value)
+	}
+
+	return &vctypes.VerifiableCredential{
+		Context: []string{"https://www.w3.org/ns/credentials/v2"},
+		Type:    []string{envelopedVerifiableCredentialType},
+		ID:      dataURLPrefix + mediaType + "," + value,
+	}, nil
+}
+
+// unenvelope returns the credential identified by the data URL
+// of an EnvelopedVerifiableCredential
+func unenvelope(vc *vctypes.VerifiableCredential) (*vctypes.EnvelopedCredential, error) {
+	if !slices.Contains(vc.Type, envelopedVerifiableCredentialType) {
+		return nil, errors.New("the presentation only supports enveloped credentials")
+	}
+
+	mediaType, value, ok := strings.Cut(strings.TrimPrefix(vc.ID, dataURLPrefix), ",")
+	if !ok || !strings.HasPrefix(vc.ID, dataURLPrefix) {
+		return nil, errors.New("the enveloped credential ID must be a data URL")
+	}
+
+	for envelopeType, mt := range mediaTypes {
+		if mt != mediaType {
+			continue
+		}
+
+		if envelopeType == vctypes.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF {
+			unescaped, err := url.PathUnescape(value)
+			if err != nil {
+				return nil, err
+			}
+
+			value = unescaped
+		}
+
+		return &vctypes.EnvelopedCredential{
+			EnvelopeType: envelopeType,
+			Value:        value,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported enveloped credential media type: %s", mediaType)
+}
@@ -0,0 +1,280 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package presentation creates and verifies Verifiable Presentations
+// secured as a JWT (VP-JWT) as defined in https://www.w3.org/TR/vc-jose-cose/#with-jose
+// The presentation wraps the enveloped credentials of a holder and is bound
+// to the challenge (nonce and audience) provided by the verifier.
+package presentation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/joseutil"
+	jwktype "github.com/agntcy/identity/pkg/jwk"
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+)
+
+const (
+	// The proof type of the presentations secured with a JWT
+	ProofType = "JWT"
+
+	// The media type of the VP-JWT
+	mediaTypeVpJwt = "vp+jwt"
+
+	verifiablePresentationType = "VerifiablePresentation"
+
+	// How long a presentation is accepted after it was created
+	maxAge = 5 * time.Minute
+
+	// The tolerated clock difference between the holder and the verifier
+	clockSkew = time.Minute
+)
+
+// The challenge provided by the verifier the presentation is bound to
+type Challenge struct {
+	// The nonce provided by the verifier
+	Nonce string
+
+	// The intended verifier of the presentation
+	Audience string
+
+	// The time the presentation was created
+	IssuedAt time.Time
+}
+
+// The claims of the VP-JWT
+type claims struct {
+	*vctypes.VerifiablePresentation
+
+	Nonce    string `json:"nonce"`
+	Audience string `json:"aud,omitempty"`
+	IssuedAt int64  `json:"iat"`
+}
+
+// Create wraps the enveloped credentials in a Verifiable Presentation
+// of the holder, bound to the challenge and signed by the holder private key
+func Create(
+	holder string,
+	credentials []*vctypes.EnvelopedCredential,
+	privateKey *jwktype.Jwk,
+	challenge *Challenge,
+) (*vctypes.EnvelopedCredential, error) {
+	if len(credentials) == 0 {
+		return nil, errors.New("the presentation must contain at least one credential")
+	}
+
+	if challenge == nil || challenge.Nonce == "" {
+		return nil, errors.New("the presentation must be bound to a nonce")
+	}
+
+	vp := vctypes.VerifiablePresentation{
+		Context: []string{"https://www.w3.org/ns/credentials/v2"},
+		Type:    []string{verifiablePresentationType},
+		Holder:  holder,
+	}
+
+	for _, cred := range credentials {
+		enveloped, err := envelope(cred)
+		if err != nil {
+			return nil, err
+		}
+
+		vp.VerifiableCredential = append(vp.VerifiableCredential, *enveloped)
+	}
+
+	payload, err := json.Marshal(&claims{
+		VerifiablePresentation: &vp,
+		Nonce:                  challenge.Nonce,
+		Audience:               challenge.Audience,
+		IssuedAt:               time.Now().Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := sign(payload, privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vctypes.EnvelopedCredential{
+		EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE,
+		Value:        string(token),
+	}, nil
+}
+
+// Verify verifies the signature of the presentation with the holder keys
+func Verify(
+	jwks *jwktype.Jwks,
+	presentation *vctypes.EnvelopedCredential,
+) error {
+	keys := joseutil.SupportedKeys(jwks).Raw()
+	if keys == nil {
+		return errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unable to parse jwks", nil)
+	}
+
+	set, err := jwk.Parse(keys)
+	if err != nil {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INTERNAL,
+			"unable to parse the holder public key",
+			err,
+		)
+	}
+
+	_, err = jws.Verify(
+		[]byte(presentation.Value),
+		jws.WithKeySet(set, jws.WithInferAlgorithmFromKey(true)),
+	)
+	if err != nil {
+		return invalidPresentationErr(err)
+	}
+
+	return nil
+}
+
+// Parse parses the presentation and its challenge without verifying the signature
+func Parse(
+	presentation *vctypes.EnvelopedCredential,
+) (*vctypes.VerifiablePresentation, *Challenge, error) {
+	if presentation.EnvelopeType != vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE {
+		return nil, nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_TYPE,
+			"the presentation must be secured with JOSE",
+			nil,
+		)
+	}
+
+	message, err := jws.Parse([]byte(presentation.Value))
+	if err != nil {
+		return nil, nil, invalidPresentationErr(err)
+	}
+
+	if typ, _ := message.Signatures()[0].ProtectedHeaders().Type(); typ != mediaTypeVpJwt {
+		return nil, nil, invalidPresentationErr(errors.New("invalid presentation type"))
+	}
+
+	var parsed claims
+
+	err = json.Unmarshal(message.Payload(), &parsed)
+	if err != nil {
+		return nil, nil, invalidPresentationErr(err)
+	}
+
+	if parsed.VerifiablePresentation == nil {
+		return nil, nil, invalidPresentationErr(errors.New("the presentation is empty"))
+	}
+
+	vp := parsed.VerifiablePresentation
+	vp.Proof = &vctypes.Proof{
+		Type:       ProofType,
+		ProofValue: presentation.Value,
+	}
+
+	return vp, &Challenge{
+		Nonce:    parsed.Nonce,
+		Audience: parsed.Audience,
+		IssuedAt: time.Unix(parsed.IssuedAt, 0),
+	}, nil
+}
+
+// Credentials returns the enveloped credentials of the presentation
+func Credentials(vp *vctypes.VerifiablePresentation) ([]*vctypes.EnvelopedCredential, error) {
+	credentials := make([]*vctypes.EnvelopedCredential, 0, len(vp.VerifiableCredential))
+
+	for idx := range vp.VerifiableCredential {
+		cred, err := unenvelope(&vp.VerifiableCredential[idx])
+		if err != nil {
+			return nil, invalidPresentationErr(err)
+		}
+
+		credentials = append(credentials, cred)
+	}
+
+	return credentials, nil
+}
+
+// ValidateChallenge checks that the presentation was created recently and that
+// it is bound to the nonce and, when set, to the audience expected by the verifier
+func (c *Challenge) ValidateChallenge(now time.Time, nonce, audience string) error {
+	if c.IssuedAt.After(now.Add(clockSkew)) {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_PRESENTATION_CHALLENGE,
+			"the presentation is created in the future",
+			nil,
+		)
+	}
+
+	if c.IssuedAt.Before(now.Add(-maxAge)) {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_PRESENTATION_CHALLENGE,
+			"the presentation has expired",
+			nil,
+		)
+	}
+
+	if nonce == "" || c.Nonce != nonce {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_PRESENTATION_CHALLENGE,
+			"the nonce of the presentation does not match the challenge",
+			nil,
+		)
+	}
+
+	if audience != "" && c.Audience != audience {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_PRESENTATION_CHALLENGE,
+			"the audience of the presentation does not match the challenge",
+			nil,
+		)
+	}
+
+	return nil
+}
+
+func sign(payload []byte, privateKey *jwktype.Jwk) ([]byte, error) {
+	if privateKey == nil {
+		return nil, errors.New("private key is nil")
+	}
+
+	alg, ok := jwa.LookupSignatureAlgorithm(privateKey.ALG)
+	if !ok {
+		return nil, fmt.Errorf("unsupported algorithm: %s", privateKey.ALG)
+	}
+
+	rawKey, err := json.Marshal(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := jwk.ParseKey(rawKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWK: %w", err)
+	}
+
+	headers := jws.NewHeaders()
+
+	err = headers.Set(jws.TypeKey, mediaTypeVpJwt)
+	if err != nil {
+		return nil, err
+	}
+
+	return jws.Sign(payload, jws.WithKey(alg, key, jws.WithProtectedHeaders(headers)))
+}
+
+func invalidPresentationErr(err error) error {
+	return errutil.ErrInfo(
+		errtypes.ERROR_REASON_INVALID_VERIFIABLE_PRESENTATION,
+		err.Error(),
+		err,
+	)
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package presentation_test
+
+import (
+	"testing"
+	"time"
+
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/core/vc/presentation"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/pkg/joseutil"
+	jwktype "github.com/agntcy/identity/pkg/jwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testHolder   = "AGNTCY-1234"
+	testNonce    = "n-0S6_WzA2Mj"
+	testAudience = "https://verifier.example.com"
+	testEnvelope = "eyJhbGciOi.eyJpc3MiOi.c2lnbmF0dXJl"
+)
+
+func TestCreate_Should_Wrap_The_Credentials(t *testing.T) {
+	t.Parallel()
+
+	holderKey, err := joseutil.GenerateJWK("RS256", "sig", "holder")
+	require.NoError(t, err)
+
+	credentials := []*vctypes.EnvelopedCredential{
+		{EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE, Value: testEnvelope},
+		{EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF, Value: `{"id":"vc 1","proof":{}}`},
+	}
+
+	envelope, err := presentation.Create(
+		testHolder,
+		credentials,
+		holderKey,
+		&presentation.Challenge{Nonce: testNonce, Audience: testAudience},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE, envelope.EnvelopeType)
+
+	require.NoError(t, presentation.Verify(holderKey.PublicKey().Jwks(), envelope))
+
+	vp, challenge, err := presentation.Parse(envelope)
+	require.NoError(t, err)
+	assert.Equal(t, testHolder, vp.Holder)
+	assert.Equal(t, presentation.ProofType, vp.Proof.Type)
+	require.NoError(t, challenge.ValidateChallenge(time.Now(), testNonce, testAudience))
+
+	parsedCredentials, err := presentation.Credentials(vp)
+	require.NoError(t, err)
+	assert.Equal(t, credentials, parsedCredentials)
+}
+
+func TestVerify_Should_Reject_Another_Holder_Key(t *testing.T) {
+	t.Parallel()
+
+	holderKey, err := joseutil.GenerateJWK("RS256", "sig", "holder")
+	require.NoError(t, err)
+
+	otherKey, err := joseutil.GenerateJWK("RS256", "sig", "holder")
+	require.NoError(t, err)
+
+	envelope, err := presentation.Create(
+		testHolder,
+		[]*vctypes.EnvelopedCredential{
+			{EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE, Value: testEnvelope},
+		},
+		holderKey,
+		&presentation.Challenge{Nonce: testNonce},
+	)
+	require.NoError(t, err)
+
+	err = presentation.Verify(otherKey.PublicKey().Jwks(), envelope)
+	assert.True(t, errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INVALID_VERIFIABLE_PRESENTATION))
+}
+
+func TestVerify_Should_Accept_A_Key_Set_With_AKP_Keys(t *testing.T) {
+	t.Parallel()
+
+	holderKey, err := joseutil.GenerateJWK("ES256", "sig", "holder")
+	require.NoError(t, err)
+
+	pqKey, err := joseutil.GenerateJWK("ML-DSA-44", "sig", "pq")
+	require.NoError(t, err)
+
+	envelope, err := presentation.Create(
+		testHolder,
+		[]*vctypes.EnvelopedCredential{
+			{EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE, Value: testEnvelope},
+		},
+		holderKey,
+		&presentation.Challenge{Nonce: testNonce},
+	)
+	require.NoError(t, err)
+
+	jwks := &jwktype.Jwks{Keys: []*jwktype.Jwk{pqKey.PublicKey(), holderKey.PublicKey()}}
+
+	require.NoError(t, presentation.Verify(jwks, envelope))
+}
+
+func TestValidateChallenge_Should_Reject_A_Different_Challenge(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	challenge := presentation.Challenge{Nonce: testNonce, Audience: testAudience, IssuedAt: now}
+
+	for name, tc := range map[string]struct {
+		nonce    string
+		audience string
+	}{
+		"missing nonce":  {"", testAudience},
+		"other nonce":    {"other", testAudience},
+		"other audience": {testNonce, "https://other.example.com"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := challenge.ValidateChallenge(now, tc.nonce, tc.audience)
+			assert.True(t, errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INVALID_PRESENTATION_CHALLENGE))
+		})
+	}
+}
+
+func TestValidateChallenge_Should_Reject_An_Expired_Presentation(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	for name, tc := range map[string]struct {
+		issuedAt time.Time
+		valid    bool
+	}{
+		"recent":          {now.Add(-time.Minute), true},
+		"within the skew": {now.Add(30 * time.Second), true},
+		"expired":         {now.Add(-10 * time.Minute), false},
+		"in the future":   {now.Add(10 * time.Minute), false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			challenge := presentation.Challenge{Nonce: testNonce, IssuedAt: tc.issuedAt}
+
+			err := challenge.ValidateChallenge(now, testNonce, "")
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INVALID_PRESENTATION_CHALLENGE))
+			}
+		})
+	}
+}
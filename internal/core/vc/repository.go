@@ -5,10 +5,145 @@ package vc
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/agntcy/identity/internal/core/vc/types"
 )
 
+// SearchCriteria defines the filters used to search published Verifiable Credentials.
+// Empty fields are ignored.
+type SearchCriteria struct {
+	// The common name of the Issuer of the credentials
+	IssuerCommonName string
+
+	// The content type of the credentials (AgentBadge, MCPServerBadge)
+	ContentType types.CredentialContentType
+
+	// The resolver metadata ID the credentials are attached to
+	ResolverMetadataID string
+
+	// Only credentials issued at or after this time
+	IssuedAfter *time.Time
+
+	// Only credentials issued before this time
+	IssuedBefore *time.Time
+
+	// Only credentials expiring at or after this time, credentials without
+	// an expiration date are included
+	ExpiresAfter *time.Time
+
+	// Only credentials expiring before this time
+	ExpiresBefore *time.Time
+
+	// Filter on the revocation state of the credentials
+	Revoked *bool
+}
+
+// Matches returns true when the credential matches every filter of the criteria
+func (c *SearchCriteria) Matches(credential *types.VerifiableCredential) bool {
+	if c.IssuerCommonName != "" && credential.Issuer != c.IssuerCommonName {
+		return false
+	}
+
+	if c.ContentType != types.CREDENTIAL_CONTENT_TYPE_UNSPECIFIED &&
+		!slices.Contains(credential.Type, c.ContentType.String()) {
+		return false
+	}
+
+	if c.ResolverMetadataID != "" &&
+		credential.CredentialSubject["id"] != c.ResolverMetadataID {
+		return false
+	}
+
+	if !c.matchesDates(credential) {
+		return false
+	}
+
+	if c.Revoked != nil {
+		revoked := slices.ContainsFunc(credential.Status, func(s *types.CredentialStatus) bool {
+			return s.Purpose == types.CREDENTIAL_STATUS_PURPOSE_REVOCATION
+		})
+		if revoked != *c.Revoked {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Dates are compared as RFC3339 UTC strings which preserve the chronological order
+func (c *SearchCriteria) matchesDates(credential *types.VerifiableCredential) bool {
+	if c.IssuedAfter != nil && credential.IssuanceDate < formatDate(c.IssuedAfter) {
+		return false
+	}
+
+	if c.IssuedBefore != nil && credential.IssuanceDate >= formatDate(c.IssuedBefore) {
+		return false
+	}
+
+	if c.ExpiresAfter != nil && credential.ExpirationDate != "" &&
+		credential.ExpirationDate < formatDate(c.ExpiresAfter) {
+		return false
+	}
+
+	if c.ExpiresBefore != nil &&
+		(credential.ExpirationDate == "" ||
+			credential.ExpirationDate >= formatDate(c.ExpiresBefore)) {
+		return false
+	}
+
+	return true
+}
+
+func formatDate(t *time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// SearchCursor marks the position of the last credential returned by a search.
+// Search results are ordered by issuance date then ID, both descending.
+type SearchCursor struct {
+	IssuanceDate string
+	ID           string
+}
+
+const searchCursorSeparator = "|"
+
+// Precedes returns true when the cursor comes before the credential
+// in the order of the search results
+func (c *SearchCursor) Precedes(credential *types.VerifiableCredential) bool {
+	return credential.IssuanceDate < c.IssuanceDate ||
+		(credential.IssuanceDate == c.IssuanceDate && credential.ID < c.ID)
+}
+
+// Encode returns the opaque page token representation of the cursor
+func (c *SearchCursor) Encode() string {
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(c.IssuanceDate + searchCursorSeparator + c.ID),
+	)
+}
+
+// DecodeSearchCursor parses a page token produced by [SearchCursor.Encode]
+func DecodeSearchCursor(token string) (*SearchCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	issuanceDate, id, found := strings.Cut(string(raw), searchCursorSeparator)
+	if !found || id == "" {
+		return nil, errors.New("malformed page token")
+	}
+
+	return &SearchCursor{
+		IssuanceDate: issuanceDate,
+		ID:           id,
+	}, nil
+}
+
 type Repository interface {
 	Create(
 		ctx context.Context,
@@ -31,4 +166,14 @@ type Repository interface {
 		ctx context.Context,
 		id string,
 	) (*types.VerifiableCredential, error)
+
+	// Search returns at most limit credentials matching the criteria,
+	// starting after the cursor if provided. The returned cursor is nil
+	// when there are no more results.
+	Search(
+		ctx context.Context,
+		criteria *SearchCriteria,
+		cursor *SearchCursor,
+		limit int,
+	) ([]*types.VerifiableCredential, *SearchCursor, error)
 }
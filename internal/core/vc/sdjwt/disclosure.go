@@ -0,0 +1,202 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package sdjwt
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+const (
+	// The hash algorithm used for the disclosure digests
+	hashAlgorithm = "sha-256"
+
+	saltSize = 16
+
+	sdProperty    = "_sd"
+	sdAlgProperty = "_sd_alg"
+)
+
+// A disclosure of a selectively disclosable object property
+type disclosure struct {
+	// The base64url encoded disclosure as it appears in the SD-JWT
+	encoded string
+
+	name  string
+	value any
+}
+
+func newDisclosure(name string, value any) (*disclosure, error) {
+	salt := make([]byte, saltSize)
+
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal([]any{base64.RawURLEncoding.EncodeToString(salt), name, value})
+	if err != nil {
+		return nil, err
+	}
+
+	return &disclosure{
+		encoded: base64.RawURLEncoding.EncodeToString(raw),
+		name:    name,
+		value:   value,
+	}, nil
+}
+
+func parseDisclosure(encoded string) (*disclosure, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("the disclosure is not base64url encoded: %w", err)
+	}
+
+	var elements []any
+
+	err = json.Unmarshal(raw, &elements)
+	if err != nil {
+		return nil, fmt.Errorf("invalid disclosure: %w", err)
+	}
+
+	//nolint:mnd // salt, name and value
+	if len(elements) != 3 {
+		return nil, errors.New("only object property disclosures are supported")
+	}
+
+	name, ok := elements[1].(string)
+	if !ok || name == sdProperty || name == "..." {
+		return nil, errors.New("invalid disclosure claim name")
+	}
+
+	return &disclosure{
+		encoded: encoded,
+		name:    name,
+		value:   elements[2],
+	}, nil
+}
+
+func (d *disclosure) digest() string {
+	return digest(d.encoded)
+}
+
+func digest(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// makeDisclosable replaces every property of the object with a digest
+// in the _sd array and returns the corresponding disclosures
+func makeDisclosable(object map[string]any) (map[string]any, []*disclosure, error) {
+	disclosures := make([]*disclosure, 0, len(object))
+	digests := make([]string, 0, len(object))
+
+	for name, value := range object {
+		d, err := newDisclosure(name, value)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		disclosures = append(disclosures, d)
+		digests = append(digests, d.digest())
+	}
+
+	// The digests are sorted to hide the original order of the properties
+	slices.Sort(digests)
+
+	return map[string]any{sdProperty: digests}, disclosures, nil
+}
+
+// resolveDisclosures replaces the digests of the payload with the disclosed
+// properties. Every disclosure must be referenced exactly once.
+func resolveDisclosures(payload map[string]any, disclosures []*disclosure) error {
+	if alg, ok := payload[sdAlgProperty]; ok && alg != hashAlgorithm {
+		return fmt.Errorf("unsupported _sd_alg %v", alg)
+	}
+
+	delete(payload, sdAlgProperty)
+
+	byDigest := make(map[string]*disclosure, len(disclosures))
+
+	for _, d := range disclosures {
+		if _, ok := byDigest[d.digest()]; ok {
+			return errors.New("duplicate disclosure")
+		}
+
+		byDigest[d.digest()] = d
+	}
+
+	err := resolveObject(payload, byDigest)
+	if err != nil {
+		return err
+	}
+
+	if len(byDigest) > 0 {
+		return errors.New("the SD-JWT contains disclosures that are not referenced")
+	}
+
+	return nil
+}
+
+func resolveObject(object map[string]any, byDigest map[string]*disclosure) error {
+	if rawDigests, ok := object[sdProperty]; ok {
+		delete(object, sdProperty)
+
+		digests, ok := rawDigests.([]any)
+		if !ok {
+			return errors.New("the _sd claim must be an array")
+		}
+
+		for _, rawDigest := range digests {
+			digest, ok := rawDigest.(string)
+			if !ok {
+				return errors.New("the _sd claim must contain strings")
+			}
+
+			d, ok := byDigest[digest]
+			if !ok {
+				// Undisclosed property or decoy digest
+				continue
+			}
+
+			delete(byDigest, digest)
+
+			if _, exists := object[d.name]; exists {
+				return fmt.Errorf("the disclosed claim %s already exists", d.name)
+			}
+
+			object[d.name] = d.value
+		}
+	}
+
+	for _, value := range object {
+		err := resolveValue(value, byDigest)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resolveValue(value any, byDigest map[string]*disclosure) error {
+	switch v := value.(type) {
+	case map[string]any:
+		return resolveObject(v, byDigest)
+	case []any:
+		for _, item := range v {
+			err := resolveValue(item, byDigest)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
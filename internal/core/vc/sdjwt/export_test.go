@@ -0,0 +1,17 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package sdjwt
+
+import "time"
+
+// ValidateKeyBinding validates the claims of a Key Binding JWT issued at issuedAt
+func ValidateKeyBinding(issuedAt time.Time, nonce, audience, verifierNonce, verifierAudience string) error {
+	claims := keyBindingClaims{
+		IssuedAt: issuedAt.Unix(),
+		Audience: audience,
+		Nonce:    nonce,
+	}
+
+	return claims.validate(&challenge{nonce: verifierNonce, audience: verifierAudience}, time.Now())
+}
@@ -0,0 +1,263 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package sdjwt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	jwktype "github.com/agntcy/identity/pkg/jwk"
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+)
+
+const (
+	// The media type of the Key Binding JWT
+	mediaTypeKbJwt = "kb+jwt"
+
+	// How long a Key Binding JWT is accepted after it was issued
+	keyBindingMaxAge = 5 * time.Minute
+
+	// The tolerated clock difference between the holder and the verifier
+	keyBindingClockSkew = time.Minute
+)
+
+// The claims of the Key Binding JWT
+type keyBindingClaims struct {
+	IssuedAt int64  `json:"iat"`
+	Audience string `json:"aud,omitempty"`
+	Nonce    string `json:"nonce,omitempty"`
+	SdHash   string `json:"sd_hash"`
+}
+
+// The nonce and the audience of the verifier the SD-JWT is presented to
+type challenge struct {
+	nonce    string
+	audience string
+}
+
+// Present creates a presentation of the SD-JWT disclosing only the
+// badge properties listed in disclosed. When the holder key is set,
+// a Key Binding JWT is added for the audience and the nonce.
+func Present(
+	credential *vctypes.EnvelopedCredential,
+	disclosed []string,
+	holderKey *jwktype.Jwk,
+	audience string,
+	nonce string,
+) (*vctypes.EnvelopedCredential, error) {
+	token, err := split(credential.Value)
+	if err != nil {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_VALUE_FORMAT,
+			err.Error(),
+			err,
+		)
+	}
+
+	presentation := sdJwt{jwt: token.jwt}
+
+	for _, d := range token.disclosures {
+		if slices.Contains(disclosed, d.name) {
+			presentation.disclosures = append(presentation.disclosures, d)
+		}
+	}
+
+	if holderKey != nil {
+		claims := keyBindingClaims{
+			IssuedAt: time.Now().Unix(),
+			Audience: audience,
+			Nonce:    nonce,
+			SdHash:   digest(presentation.withoutKeyBinding()),
+		}
+
+		keyBindingJwt, err := sign(claims, holderKey, mediaTypeKbJwt)
+		if err != nil {
+			return nil, err
+		}
+
+		presentation.keyBindingJwt = string(keyBindingJwt)
+	}
+
+	return &vctypes.EnvelopedCredential{
+		EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_SD_JWT,
+		Value:        presentation.String(),
+	}, nil
+}
+
+// verifyKeyBinding verifies the Key Binding JWT with the confirmation key
+// of the issuer JWT and checks that it is bound to the presented disclosures
+// and, when the verifier is set, to its challenge
+func verifyKeyBinding(token *sdJwt, confirmation any, verifier *challenge) error {
+	holderKey, err := confirmationKey(confirmation)
+	if err != nil {
+		return err
+	}
+
+	if holderKey == nil {
+		return errors.New("the SD-JWT does not contain a confirmation key")
+	}
+
+	rawKey, err := json.Marshal(holderKey)
+	if err != nil {
+		return err
+	}
+
+	key, err := jwk.ParseKey(rawKey)
+	if err != nil {
+		return fmt.Errorf("invalid confirmation key: %w", err)
+	}
+
+	alg, err := signatureAlgorithm(holderKey)
+	if err != nil {
+		return err
+	}
+
+	message, err := jws.Parse([]byte(token.keyBindingJwt))
+	if err != nil {
+		return err
+	}
+
+	if typ, _ := message.Signatures()[0].ProtectedHeaders().Type(); typ != mediaTypeKbJwt {
+		return errors.New("invalid Key Binding JWT type")
+	}
+
+	rawClaims, err := jws.Verify([]byte(token.keyBindingJwt), jws.WithKey(alg, key))
+	if err != nil {
+		return fmt.Errorf("invalid Key Binding JWT signature: %w", err)
+	}
+
+	var claims keyBindingClaims
+
+	err = json.Unmarshal(rawClaims, &claims)
+	if err != nil {
+		return err
+	}
+
+	if claims.SdHash != digest(token.withoutKeyBinding()) {
+		return errors.New("the Key Binding JWT does not match the presented disclosures")
+	}
+
+	if verifier != nil {
+		return claims.validate(verifier, time.Now())
+	}
+
+	return nil
+}
+
+// validate checks that the Key Binding JWT is recent and bound to the challenge of the verifier
+func (c *keyBindingClaims) validate(verifier *challenge, now time.Time) error {
+	issuedAt := time.Unix(c.IssuedAt, 0)
+
+	if issuedAt.After(now.Add(keyBindingClockSkew)) {
+		return errors.New("the Key Binding JWT is issued in the future")
+	}
+
+	if issuedAt.Before(now.Add(-keyBindingMaxAge)) {
+		return errors.New("the Key Binding JWT has expired")
+	}
+
+	if verifier.nonce == "" || c.Nonce != verifier.nonce {
+		return errors.New("the nonce of the Key Binding JWT does not match the challenge")
+	}
+
+	if verifier.audience != "" && c.Audience != verifier.audience {
+		return errors.New("the audience of the Key Binding JWT does not match the challenge")
+	}
+
+	return nil
+}
+
+// confirmationKey returns the holder key of the confirmation (cnf) claim,
+// it returns nil if the claim has no key
+func confirmationKey(confirmation any) (*jwktype.Jwk, error) {
+	cnf, ok := confirmation.(map[string]any)
+	if !ok || cnf["jwk"] == nil {
+		return nil, nil //nolint:nilnil // the confirmation key is optional
+	}
+
+	rawKey, err := json.Marshal(cnf["jwk"])
+	if err != nil {
+		return nil, err
+	}
+
+	var holderKey jwktype.Jwk
+
+	err = json.Unmarshal(rawKey, &holderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &holderKey, nil
+}
+
+func sign(payload any, privateKey *jwktype.Jwk, typ string) ([]byte, error) {
+	if privateKey == nil {
+		return nil, errors.New("private key is nil")
+	}
+
+	rawKey, err := json.Marshal(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := jwk.ParseKey(rawKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWK: %w", err)
+	}
+
+	alg, err := signatureAlgorithm(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rawPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := jws.NewHeaders()
+
+	err = headers.Set(jws.TypeKey, typ)
+	if err != nil {
+		return nil, err
+	}
+
+	return jws.Sign(rawPayload, jws.WithKey(alg, key, jws.WithProtectedHeaders(headers)))
+}
+
+// signatureAlgorithm returns the algorithm of the key,
+// or the default algorithm of the key type when it is not set
+func signatureAlgorithm(key *jwktype.Jwk) (jwa.SignatureAlgorithm, error) {
+	if key.ALG != "" {
+		alg, ok := jwa.LookupSignatureAlgorithm(key.ALG)
+		if !ok {
+			return jwa.EmptySignatureAlgorithm(), fmt.Errorf("unsupported algorithm: %s", key.ALG)
+		}
+
+		return alg, nil
+	}
+
+	switch key.KTY + ":" + key.CRV {
+	case "RSA:":
+		return jwa.RS256(), nil
+	case "EC:P-256":
+		return jwa.ES256(), nil
+	case "EC:P-384":
+		return jwa.ES384(), nil
+	case "EC:P-521":
+		return jwa.ES512(), nil
+	case "OKP:Ed25519":
+		return jwa.EdDSA(), nil
+	default:
+		return jwa.EmptySignatureAlgorithm(), fmt.Errorf("unsupported key type: %s", key.KTY)
+	}
+}
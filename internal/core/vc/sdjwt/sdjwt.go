@@ -0,0 +1,384 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sdjwt secures Verifiable Credentials with SD-JWT as defined in
+// https://www.w3.org/TR/vc-jose-cose/#with-sd-jwt
+// The top-level properties of the badge are selectively disclosable,
+// holders present a subset of them with an optional Key Binding JWT.
+package sdjwt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/joseutil"
+	jwktype "github.com/agntcy/identity/pkg/jwk"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+)
+
+const (
+	// The proof type of the credentials secured with SD-JWT
+	ProofType = "SD-JWT"
+
+	// The media type of the SD-JWT
+	mediaTypeVcSdJwt = "vc+sd-jwt"
+
+	separator = "~"
+
+	badgeProperty             = "badge"
+	credentialSubjectProperty = "credentialSubject"
+	confirmationProperty      = "cnf"
+)
+
+// An SD-JWT split in its components
+type sdJwt struct {
+	jwt           string
+	disclosures   []*disclosure
+	keyBindingJwt string
+}
+
+// Issue secures the credential with an SD-JWT signed by the private key.
+// The top-level properties of the badge are selectively disclosable.
+// When the holder key is set, it is added as the confirmation key (cnf)
+// the holder must use to sign the Key Binding JWT of the presentations.
+func Issue(
+	credential *vctypes.VerifiableCredential,
+	privateKey *jwktype.Jwk,
+	holderKey *jwktype.Jwk,
+) (*vctypes.EnvelopedCredential, error) {
+	unsecured := *credential
+	unsecured.Proof = nil
+
+	payload, err := toMap(&unsecured)
+	if err != nil {
+		return nil, err
+	}
+
+	disclosures, err := makeBadgeDisclosable(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	payload[sdAlgProperty] = hashAlgorithm
+
+	if holderKey != nil {
+		payload[confirmationProperty] = map[string]any{"jwk": holderKey.PublicKey()}
+	}
+
+	token, err := sign(payload, privateKey, mediaTypeVcSdJwt)
+	if err != nil {
+		return nil, err
+	}
+
+	issued := sdJwt{
+		jwt:         string(token),
+		disclosures: disclosures,
+	}
+
+	return &vctypes.EnvelopedCredential{
+		EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_SD_JWT,
+		Value:        issued.String(),
+	}, nil
+}
+
+// Verify verifies the issuer signature, the disclosures and,
+// when present, the Key Binding JWT of the SD-JWT.
+// It verifies the SD-JWT as issued, use VerifyPresentation to verify
+// that the SD-JWT is presented by its holder.
+func Verify(
+	jwks *jwktype.Jwks,
+	credential *vctypes.EnvelopedCredential,
+) error {
+	return verify(jwks, credential, nil)
+}
+
+// VerifyPresentation verifies the SD-JWT presented to a verifier.
+// The SD-JWT bound to a holder key must have a recent Key Binding JWT
+// for the nonce and, when set, the audience of the verifier.
+func VerifyPresentation(
+	jwks *jwktype.Jwks,
+	credential *vctypes.EnvelopedCredential,
+	nonce string,
+	audience string,
+) error {
+	return verify(jwks, credential, &challenge{nonce: nonce, audience: audience})
+}
+
+func verify(
+	jwks *jwktype.Jwks,
+	credential *vctypes.EnvelopedCredential,
+	verifier *challenge,
+) error {
+	keys := joseutil.SupportedKeys(jwks).Raw()
+	if keys == nil {
+		return errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unable to parse jwks", nil)
+	}
+
+	set, err := jwk.Parse(keys)
+	if err != nil {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INTERNAL,
+			"unable to parse the resolver metadata public key",
+			err,
+		)
+	}
+
+	token, err := split(credential.Value)
+	if err != nil {
+		return invalidCredentialErr(err)
+	}
+
+	rawPayload, err := jws.Verify(
+		[]byte(token.jwt),
+		jws.WithKeySet(set, jws.WithInferAlgorithmFromKey(true)),
+	)
+	if err != nil {
+		return invalidCredentialErr(err)
+	}
+
+	var payload map[string]any
+
+	err = json.Unmarshal(rawPayload, &payload)
+	if err != nil {
+		return invalidCredentialErr(err)
+	}
+
+	if token.keyBindingJwt != "" {
+		err = verifyKeyBinding(token, payload[confirmationProperty], verifier)
+		if err != nil {
+			return invalidCredentialErr(err)
+		}
+	} else if verifier != nil && payload[confirmationProperty] != nil {
+		return invalidCredentialErr(
+			errors.New("the SD-JWT is bound to a holder key and must be presented with a Key Binding JWT"),
+		)
+	}
+
+	err = resolveDisclosures(payload, token.disclosures)
+	if err != nil {
+		return invalidCredentialErr(err)
+	}
+
+	return nil
+}
+
+// Parse parses the SD-JWT and resolves the disclosed properties
+// without verifying the signatures
+func Parse(
+	credential *vctypes.EnvelopedCredential,
+) (*vctypes.VerifiableCredential, error) {
+	parsedVC, err := parse(credential)
+	if err != nil {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_VALUE_FORMAT,
+			err.Error(),
+			err,
+		)
+	}
+
+	return parsedVC, nil
+}
+
+// ConfirmationKey returns the holder key bound to the SD-JWT,
+// it returns nil if the SD-JWT was issued without key binding
+func ConfirmationKey(credential *vctypes.EnvelopedCredential) (*jwktype.Jwk, error) {
+	token, err := split(credential.Value)
+	if err != nil {
+		return nil, invalidCredentialErr(err)
+	}
+
+	message, err := jws.Parse([]byte(token.jwt))
+	if err != nil {
+		return nil, invalidCredentialErr(err)
+	}
+
+	var payload map[string]any
+
+	err = json.Unmarshal(message.Payload(), &payload)
+	if err != nil {
+		return nil, invalidCredentialErr(err)
+	}
+
+	holderKey, err := confirmationKey(payload[confirmationProperty])
+	if err != nil {
+		return nil, invalidCredentialErr(err)
+	}
+
+	return holderKey, nil
+}
+
+func VerifyAndParse(
+	jwks *jwktype.Jwks,
+	credential *vctypes.EnvelopedCredential,
+) (*vctypes.VerifiableCredential, error) {
+	err := Verify(jwks, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(credential)
+}
+
+func parse(credential *vctypes.EnvelopedCredential) (*vctypes.VerifiableCredential, error) {
+	token, err := split(credential.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := jws.Parse([]byte(token.jwt))
+	if err != nil {
+		return nil, err
+	}
+
+	var payload map[string]any
+
+	err = json.Unmarshal(message.Payload(), &payload)
+	if err != nil {
+		return nil, err
+	}
+
+	err = resolveDisclosures(payload, token.disclosures)
+	if err != nil {
+		return nil, err
+	}
+
+	// The disclosed badge properties are serialized back
+	// to match the badge claims of the other envelopes
+	if subject, ok := payload[credentialSubjectProperty].(map[string]any); ok {
+		if badge, ok := subject[badgeProperty].(map[string]any); ok {
+			raw, err := json.Marshal(badge)
+			if err != nil {
+				return nil, err
+			}
+
+			subject[badgeProperty] = string(raw)
+		}
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsedVC vctypes.VerifiableCredential
+
+	err = json.Unmarshal(raw, &parsedVC)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedVC.Proof = &vctypes.Proof{
+		Type:       ProofType,
+		ProofValue: credential.Value,
+	}
+
+	return &parsedVC, nil
+}
+
+// makeBadgeDisclosable makes the top-level properties
+// of the badge selectively disclosable
+func makeBadgeDisclosable(payload map[string]any) ([]*disclosure, error) {
+	subject, ok := payload[credentialSubjectProperty].(map[string]any)
+	if !ok {
+		return nil, errors.New("the credential subject is missing")
+	}
+
+	rawBadge, ok := subject[badgeProperty].(string)
+	if !ok {
+		return nil, errors.New("the credential subject does not contain a badge")
+	}
+
+	var badge map[string]any
+
+	err := json.Unmarshal([]byte(rawBadge), &badge)
+	if err != nil {
+		return nil, fmt.Errorf("the badge must be a JSON object: %w", err)
+	}
+
+	disclosable, disclosures, err := makeDisclosable(badge)
+	if err != nil {
+		return nil, err
+	}
+
+	subject[badgeProperty] = disclosable
+
+	return disclosures, nil
+}
+
+// split splits the SD-JWT in the issuer JWT, the disclosures
+// and the optional Key Binding JWT
+func split(value string) (*sdJwt, error) {
+	parts := strings.Split(value, separator)
+
+	// The issuer JWT and the Key Binding JWT are always present, the latter can be empty
+	if len(parts) < 2 || parts[0] == "" {
+		return nil, errors.New("invalid SD-JWT format")
+	}
+
+	token := sdJwt{
+		jwt:           parts[0],
+		keyBindingJwt: parts[len(parts)-1],
+	}
+
+	for _, encoded := range parts[1 : len(parts)-1] {
+		d, err := parseDisclosure(encoded)
+		if err != nil {
+			return nil, err
+		}
+
+		token.disclosures = append(token.disclosures, d)
+	}
+
+	return &token, nil
+}
+
+// String serializes the SD-JWT as <JWT>~<Disclosure 1>~...~<Disclosure N>~<KB-JWT>
+func (t *sdJwt) String() string {
+	return t.withoutKeyBinding() + t.keyBindingJwt
+}
+
+// withoutKeyBinding serializes the SD-JWT without the Key Binding JWT,
+// this is the input of the sd_hash claim
+func (t *sdJwt) withoutKeyBinding() string {
+	var builder strings.Builder
+
+	builder.WriteString(t.jwt)
+	builder.WriteString(separator)
+
+	for _, d := range t.disclosures {
+		builder.WriteString(d.encoded)
+		builder.WriteString(separator)
+	}
+
+	return builder.String()
+}
+
+func toMap(value any) (map[string]any, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]any
+
+	err = json.Unmarshal(raw, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func invalidCredentialErr(err error) error {
+	return errutil.ErrInfo(
+		errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+		err.Error(),
+		err,
+	)
+}
@@ -0,0 +1,268 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package sdjwt_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/vc/sdjwt"
+	vctesting "github.com/agntcy/identity/internal/core/vc/testing"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/pkg/joseutil"
+	jwktype "github.com/agntcy/identity/pkg/jwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	nameClaim    = "name"
+	testNonce    = "1234"
+	testAudience = "verifier"
+)
+
+func TestIssue_Should_Hide_Badge_Claims(t *testing.T) {
+	t.Parallel()
+
+	issuerKey := newIssuerKey(t)
+
+	envelope, err := sdjwt.Issue(vctesting.NewCredential(), issuerKey, nil)
+	require.NoError(t, err)
+	assert.Equal(t, vctypes.CREDENTIAL_ENVELOPE_TYPE_SD_JWT, envelope.EnvelopeType)
+
+	// The issuer JWT only contains the digests of the badge claims
+	jwt, _, _ := strings.Cut(envelope.Value, "~")
+	assert.NotContains(t, jwt, "weather-agent")
+
+	parsed, err := sdjwt.VerifyAndParse(issuerKey.PublicKey().Jwks(), envelope)
+	require.NoError(t, err)
+	assert.Equal(t, sdjwt.ProofType, parsed.Proof.Type)
+	assertBadge(t, parsed, map[string]any{
+		nameClaim: "weather-agent",
+		"url":     "https://agent.example.com",
+		"skills":  []any{"forecast"},
+	})
+}
+
+func TestPresent_Should_Disclose_A_Subset_With_Key_Binding(t *testing.T) {
+	t.Parallel()
+
+	issuerKey := newIssuerKey(t)
+	holderKey := newHolderKey(t)
+
+	envelope, err := sdjwt.Issue(vctesting.NewCredential(), issuerKey, holderKey.PublicKey())
+	require.NoError(t, err)
+
+	presentation, err := sdjwt.Present(envelope, []string{nameClaim}, holderKey, testAudience, testNonce)
+	require.NoError(t, err)
+
+	parsed, err := sdjwt.VerifyAndParse(issuerKey.PublicKey().Jwks(), presentation)
+	require.NoError(t, err)
+	assertBadge(t, parsed, map[string]any{nameClaim: "weather-agent"})
+
+	claims := vctypes.BadgeClaims{}
+	require.NoError(t, claims.FromMap(parsed.CredentialSubject))
+	assert.Equal(t, vctesting.CredentialSubjectID, claims.ID)
+}
+
+func TestVerify_Should_Reject_Key_Binding_From_Another_Holder(t *testing.T) {
+	t.Parallel()
+
+	issuerKey := newIssuerKey(t)
+	holderKey := newHolderKey(t)
+
+	envelope, err := sdjwt.Issue(vctesting.NewCredential(), issuerKey, holderKey.PublicKey())
+	require.NoError(t, err)
+
+	presentation, err := sdjwt.Present(envelope, []string{nameClaim}, newHolderKey(t), testAudience, testNonce)
+	require.NoError(t, err)
+
+	err = sdjwt.Verify(issuerKey.PublicKey().Jwks(), presentation)
+	assert.ErrorContains(t, err, "Key Binding JWT")
+}
+
+func TestVerify_Should_Reject_Disclosures_Added_After_Key_Binding(t *testing.T) {
+	t.Parallel()
+
+	issuerKey := newIssuerKey(t)
+	holderKey := newHolderKey(t)
+
+	envelope, err := sdjwt.Issue(vctesting.NewCredential(), issuerKey, holderKey.PublicKey())
+	require.NoError(t, err)
+
+	presentation, err := sdjwt.Present(envelope, []string{nameClaim}, holderKey, testAudience, testNonce)
+	require.NoError(t, err)
+
+	// Add the url disclosure of the issued SD-JWT to the presentation
+	issuedParts := strings.Split(envelope.Value, "~")
+	presentedParts := strings.Split(presentation.Value, "~")
+
+	for _, disclosure := range issuedParts[1 : len(issuedParts)-1] {
+		if disclosure != presentedParts[1] {
+			presentedParts = append(presentedParts[:2], append([]string{disclosure}, presentedParts[2:]...)...)
+			break
+		}
+	}
+
+	presentation.Value = strings.Join(presentedParts, "~")
+
+	err = sdjwt.Verify(issuerKey.PublicKey().Jwks(), presentation)
+	assert.ErrorContains(t, err, "does not match the presented disclosures")
+}
+
+func TestVerifyPresentation_Should_Require_Key_Binding(t *testing.T) {
+	t.Parallel()
+
+	issuerKey := newIssuerKey(t)
+	holderKey := newHolderKey(t)
+
+	envelope, err := sdjwt.Issue(vctesting.NewCredential(), issuerKey, holderKey.PublicKey())
+	require.NoError(t, err)
+
+	// The issued SD-JWT is valid but cannot be presented without Key Binding JWT
+	require.NoError(t, sdjwt.Verify(issuerKey.PublicKey().Jwks(), envelope))
+
+	err = sdjwt.VerifyPresentation(issuerKey.PublicKey().Jwks(), envelope, testNonce, testAudience)
+	assert.ErrorContains(t, err, "must be presented with a Key Binding JWT")
+
+	presentation, err := sdjwt.Present(envelope, []string{nameClaim}, holderKey, testAudience, testNonce)
+	require.NoError(t, err)
+
+	err = sdjwt.VerifyPresentation(issuerKey.PublicKey().Jwks(), presentation, testNonce, testAudience)
+	assert.NoError(t, err)
+
+	err = sdjwt.VerifyPresentation(issuerKey.PublicKey().Jwks(), presentation, "other", testAudience)
+	assert.ErrorContains(t, err, "nonce")
+
+	err = sdjwt.VerifyPresentation(issuerKey.PublicKey().Jwks(), presentation, testNonce, "other")
+	assert.ErrorContains(t, err, "audience")
+}
+
+func TestVerifyPresentation_Should_Accept_Unbound_SD_JWT(t *testing.T) {
+	t.Parallel()
+
+	issuerKey := newIssuerKey(t)
+
+	envelope, err := sdjwt.Issue(vctesting.NewCredential(), issuerKey, nil)
+	require.NoError(t, err)
+
+	err = sdjwt.VerifyPresentation(issuerKey.PublicKey().Jwks(), envelope, testNonce, testAudience)
+	assert.NoError(t, err)
+}
+
+func TestValidateKeyBinding(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	for name, tc := range map[string]struct {
+		issuedAt time.Time
+		nonce    string
+		audience string
+		valid    bool
+	}{
+		"recent":         {now, testNonce, testAudience, true},
+		"stale":          {now.Add(-time.Hour), testNonce, testAudience, false},
+		"future":         {now.Add(time.Hour), testNonce, testAudience, false},
+		"missing nonce":  {now, "", testAudience, false},
+		"other audience": {now, testNonce, "other", false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := sdjwt.ValidateKeyBinding(tc.issuedAt, tc.nonce, tc.audience, testNonce, testAudience)
+			assert.Equal(t, tc.valid, err == nil, err)
+		})
+	}
+
+	// The verifier does not have to restrict the audience
+	assert.NoError(t, sdjwt.ValidateKeyBinding(now, testNonce, "other", testNonce, ""))
+}
+
+func TestVerify_Should_Reject_Forged_Disclosure(t *testing.T) {
+	t.Parallel()
+
+	issuerKey := newIssuerKey(t)
+
+	envelope, err := sdjwt.Issue(vctesting.NewCredential(), issuerKey, nil)
+	require.NoError(t, err)
+
+	// ["salt","name","forged"]
+	envelope.Value += "WyJzYWx0IiwibmFtZSIsImZvcmdlZCJd~"
+
+	err = sdjwt.Verify(issuerKey.PublicKey().Jwks(), envelope)
+	assert.ErrorContains(t, err, "not referenced")
+}
+
+func TestVerify_Should_Accept_A_Key_Set_With_AKP_Keys(t *testing.T) {
+	t.Parallel()
+
+	issuerKey, err := joseutil.GenerateJWK("ES256", "sig", "")
+	require.NoError(t, err)
+
+	pqKey, err := joseutil.GenerateJWK("ML-DSA-44", "sig", "")
+	require.NoError(t, err)
+
+	envelope, err := sdjwt.Issue(vctesting.NewCredential(), issuerKey, nil)
+	require.NoError(t, err)
+
+	jwks := &jwktype.Jwks{Keys: []*jwktype.Jwk{pqKey.PublicKey(), issuerKey.PublicKey()}}
+
+	assert.NoError(t, sdjwt.Verify(jwks, envelope))
+}
+
+func TestConfirmationKey_Should_Return_The_Holder_Key(t *testing.T) {
+	t.Parallel()
+
+	issuerKey := newIssuerKey(t)
+	holderKey := newHolderKey(t)
+
+	bound, err := sdjwt.Issue(vctesting.NewCredential(), issuerKey, holderKey.PublicKey())
+	require.NoError(t, err)
+
+	key, err := sdjwt.ConfirmationKey(bound)
+	require.NoError(t, err)
+	assert.Equal(t, holderKey.PublicKey(), key)
+
+	unbound, err := sdjwt.Issue(vctesting.NewCredential(), issuerKey, nil)
+	require.NoError(t, err)
+
+	key, err = sdjwt.ConfirmationKey(unbound)
+	require.NoError(t, err)
+	assert.Nil(t, key)
+}
+
+func assertBadge(t *testing.T, credential *vctypes.VerifiableCredential, expected map[string]any) {
+	t.Helper()
+
+	var badge map[string]any
+
+	rawBadge, ok := credential.CredentialSubject["badge"].(string)
+	require.True(t, ok)
+	require.NoError(t, json.Unmarshal([]byte(rawBadge), &badge))
+	assert.Equal(t, expected, badge)
+}
+
+func newIssuerKey(t *testing.T) *jwktype.Jwk {
+	t.Helper()
+
+	key, err := joseutil.GenerateJWK("RS256", "sig", "")
+	require.NoError(t, err)
+
+	return key
+}
+
+func newHolderKey(t *testing.T) *jwktype.Jwk {
+	t.Helper()
+
+	raw, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return vctesting.ToJwk(t, raw, "")
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package statuslist
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// Size is the number of entries of a status list.
+	// It is larger than the 131,072 entries minimum required by the specification
+	// to reduce collisions, since indexes are picked randomly by the issuers.
+	Size = 1 << 20
+
+	// The multibase prefix of base64url encoded values without padding
+	multibaseBase64URL = "u"
+
+	bitsPerByte = 8
+)
+
+// Bitstring is the uncompressed representation of a status list.
+// The index 0 is the left-most bit of the first byte.
+type Bitstring []byte
+
+// NewBitstring returns a bitstring of the default Size with all the bits cleared
+func NewBitstring() Bitstring {
+	return make(Bitstring, Size/bitsPerByte)
+}
+
+// Get returns the value of the bit at the specified index
+func (b Bitstring) Get(index int) (bool, error) {
+	if err := b.validateIndex(index); err != nil {
+		return false, err
+	}
+
+	return b[index/bitsPerByte]&(1<<(bitsPerByte-1-index%bitsPerByte)) != 0, nil
+}
+
+// Set updates the value of the bit at the specified index
+func (b Bitstring) Set(index int, value bool) error {
+	if err := b.validateIndex(index); err != nil {
+		return err
+	}
+
+	mask := byte(1 << (bitsPerByte - 1 - index%bitsPerByte))
+	if value {
+		b[index/bitsPerByte] |= mask
+	} else {
+		b[index/bitsPerByte] &^= mask
+	}
+
+	return nil
+}
+
+// Encode returns the Multibase base64url representation of
+// the GZIP-compressed bitstring
+func (b Bitstring) Encode() (string, error) {
+	var buf bytes.Buffer
+
+	writer := gzip.NewWriter(&buf)
+
+	if _, err := writer.Write(b); err != nil {
+		return "", err
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	return multibaseBase64URL + base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// DecodeBitstring parses an encoded list produced by [Bitstring.Encode]
+func DecodeBitstring(encoded string) (Bitstring, error) {
+	if len(encoded) <= len(multibaseBase64URL) ||
+		encoded[:len(multibaseBase64URL)] != multibaseBase64URL {
+		return nil, errors.New("the encoded list is not multibase base64url encoded")
+	}
+
+	compressed, err := base64.RawURLEncoding.DecodeString(encoded[len(multibaseBase64URL):])
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	// Protect against decompression bombs
+	raw, err := io.ReadAll(io.LimitReader(reader, Size/bitsPerByte+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(raw) > Size/bitsPerByte {
+		return nil, errors.New("the encoded list is larger than the maximum size")
+	}
+
+	return Bitstring(raw), nil
+}
+
+func (b Bitstring) validateIndex(index int) error {
+	if index < 0 || index >= len(b)*bitsPerByte {
+		return fmt.Errorf("the index %d is out of the status list range", index)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package statuslist_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/agntcy/identity/internal/core/vc/statuslist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBitstring_Encode_And_Decode(t *testing.T) {
+	t.Parallel()
+
+	list := statuslist.NewBitstring()
+	assert.NoError(t, list.Set(0, true))
+	assert.NoError(t, list.Set(1337, true))
+	assert.NoError(t, list.Set(statuslist.Size-1, true))
+
+	encoded, err := list.Encode()
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(encoded, "u"))
+
+	decoded, err := statuslist.DecodeBitstring(encoded)
+	assert.NoError(t, err)
+
+	for _, index := range []int{0, 1337, statuslist.Size - 1} {
+		set, err := decoded.Get(index)
+		assert.NoError(t, err)
+		assert.True(t, set)
+	}
+
+	set, err := decoded.Get(1338)
+	assert.NoError(t, err)
+	assert.False(t, set)
+}
+
+func TestBitstring_Should_Reject_Out_Of_Range_Index(t *testing.T) {
+	t.Parallel()
+
+	list := statuslist.NewBitstring()
+
+	assert.Error(t, list.Set(statuslist.Size, true))
+	assert.Error(t, list.Set(-1, true))
+
+	_, err := list.Get(statuslist.Size)
+	assert.Error(t, err)
+}
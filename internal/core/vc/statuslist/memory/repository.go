@@ -0,0 +1,123 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	errcore "github.com/agntcy/identity/internal/core/errors"
+	"github.com/agntcy/identity/internal/core/vc/statuslist"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db/memory"
+)
+
+const statusListEntriesTable = "status_list_entries"
+
+type statusListMemoryRepository struct {
+	entries *memory.Table[*statuslist.Entry]
+}
+
+// NewRepository creates a new instance of the status list Repository
+// storing the entries in the in-memory store
+func NewRepository(store *memory.Store) (statuslist.Repository, error) {
+	entries, err := memory.NewTable[*statuslist.Entry](store, statusListEntriesTable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &statusListMemoryRepository{
+		entries: entries,
+	}, nil
+}
+
+func (r *statusListMemoryRepository) Allocate(
+	ctx context.Context,
+	entry *statuslist.Entry,
+) error {
+	stored := *entry
+
+	return r.entries.Update(ctx, func(rows map[string]*statuslist.Entry) error {
+		if _, ok := rows[key(entry)]; ok {
+			return errcore.ErrResourceAlreadyExists
+		}
+
+		rows[key(entry)] = &stored
+
+		return nil
+	})
+}
+
+func (r *statusListMemoryRepository) Update(
+	ctx context.Context,
+	entry *statuslist.Entry,
+) error {
+	err := r.entries.Update(ctx, func(rows map[string]*statuslist.Entry) error {
+		if stored, ok := rows[key(entry)]; ok {
+			stored.Set = entry.Set
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errutil.Err(err, "there was an error updating the status list entry")
+	}
+
+	return nil
+}
+
+func (r *statusListMemoryRepository) GetByCredential(
+	ctx context.Context,
+	credentialID string,
+) ([]*statuslist.Entry, error) {
+	return r.find(ctx, func(entry *statuslist.Entry) bool {
+		return entry.CredentialID == credentialID
+	})
+}
+
+func (r *statusListMemoryRepository) GetSet(
+	ctx context.Context,
+	issuerCommonName string,
+	purpose vctypes.CredentialStatusPurpose,
+) ([]*statuslist.Entry, error) {
+	return r.find(ctx, func(entry *statuslist.Entry) bool {
+		return entry.IssuerCommonName == issuerCommonName &&
+			entry.Purpose == purpose &&
+			entry.Set
+	})
+}
+
+// find returns the entries matching the filter ordered by index
+func (r *statusListMemoryRepository) find(
+	ctx context.Context,
+	filter func(entry *statuslist.Entry) bool,
+) ([]*statuslist.Entry, error) {
+	result := make([]*statuslist.Entry, 0)
+
+	_ = r.entries.View(ctx, func(rows map[string]*statuslist.Entry) error {
+		for _, entry := range rows {
+			if filter(entry) {
+				found := *entry
+				result = append(result, &found)
+			}
+		}
+
+		return nil
+	})
+
+	slices.SortFunc(result, func(a, b *statuslist.Entry) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+
+	return result, nil
+}
+
+// key returns the primary key of the entry:
+// the Issuer common name, the purpose and the index
+func key(entry *statuslist.Entry) string {
+	return fmt.Sprintf("%s/%d/%d", entry.IssuerCommonName, entry.Purpose, entry.Index)
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"time"
+
+	"github.com/agntcy/identity/internal/core/vc/statuslist"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+)
+
+type StatusListEntry struct {
+	IssuerCommonName       string                          `gorm:"primaryKey"`
+	Purpose                vctypes.CredentialStatusPurpose `gorm:"primaryKey;autoIncrement:false"`
+	StatusListIndex        int                             `gorm:"primaryKey;autoIncrement:false"`
+	VerifiableCredentialID string                          `gorm:"index"`
+	IsSet                  bool
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+}
+
+func (e *StatusListEntry) ToCoreType() *statuslist.Entry {
+	return &statuslist.Entry{
+		IssuerCommonName: e.IssuerCommonName,
+		Purpose:          e.Purpose,
+		Index:            e.StatusListIndex,
+		CredentialID:     e.VerifiableCredentialID,
+		Set:              e.IsSet,
+	}
+}
+
+func newStatusListEntryModel(src *statuslist.Entry) *StatusListEntry {
+	return &StatusListEntry{
+		IssuerCommonName:       src.IssuerCommonName,
+		Purpose:                src.Purpose,
+		StatusListIndex:        src.Index,
+		VerifiableCredentialID: src.CredentialID,
+		IsSet:                  src.Set,
+	}
+}
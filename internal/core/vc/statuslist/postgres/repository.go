@@ -0,0 +1,102 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+
+	errcore "github.com/agntcy/identity/internal/core/errors"
+	"github.com/agntcy/identity/internal/core/vc/statuslist"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/pkg/convertutil"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db"
+	"gorm.io/gorm/clause"
+)
+
+type statusListPostgresRepository struct {
+	dbContext db.Context
+}
+
+func NewRepository(dbContext db.Context) statuslist.Repository {
+	return &statusListPostgresRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r *statusListPostgresRepository) Allocate(
+	ctx context.Context,
+	entry *statuslist.Entry,
+) error {
+	result := db.Client(ctx, r.dbContext).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(newStatusListEntryModel(entry))
+	if result.Error != nil {
+		return errutil.Err(
+			result.Error, "there was an error allocating the status list entry",
+		)
+	}
+
+	if result.RowsAffected == 0 {
+		return errcore.ErrResourceAlreadyExists
+	}
+
+	return nil
+}
+
+func (r *statusListPostgresRepository) Update(
+	ctx context.Context,
+	entry *statuslist.Entry,
+) error {
+	err := db.Client(ctx, r.dbContext).
+		Model(newStatusListEntryModel(entry)).
+		Update("is_set", entry.Set).Error
+	if err != nil {
+		return errutil.Err(err, "there was an error updating the status list entry")
+	}
+
+	return nil
+}
+
+func (r *statusListPostgresRepository) GetByCredential(
+	ctx context.Context,
+	credentialID string,
+) ([]*statuslist.Entry, error) {
+	var entries []*StatusListEntry
+
+	err := db.Client(ctx, r.dbContext).
+		Where("verifiable_credential_id = ?", credentialID).
+		Find(&entries).Error
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error fetching the status list entries")
+	}
+
+	return convertutil.ConvertSlice(entries, func(e *StatusListEntry) *statuslist.Entry {
+		return e.ToCoreType()
+	}), nil
+}
+
+func (r *statusListPostgresRepository) GetSet(
+	ctx context.Context,
+	issuerCommonName string,
+	purpose vctypes.CredentialStatusPurpose,
+) ([]*statuslist.Entry, error) {
+	var entries []*StatusListEntry
+
+	err := db.Client(ctx, r.dbContext).
+		Where(
+			"issuer_common_name = ? AND purpose = ? AND is_set = ?",
+			issuerCommonName,
+			purpose,
+			true,
+		).
+		Find(&entries).Error
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error fetching the status list entries")
+	}
+
+	return convertutil.ConvertSlice(entries, func(e *StatusListEntry) *statuslist.Entry {
+		return e.ToCoreType()
+	}), nil
+}
@@ -0,0 +1,30 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package statuslist
+
+import (
+	"context"
+
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+)
+
+type Repository interface {
+	// Allocate reserves the index of the entry in the Issuer's status list.
+	// Returns errcore.ErrResourceAlreadyExists if the index is already taken.
+	Allocate(ctx context.Context, entry *Entry) error
+
+	// Update stores the status of an allocated entry
+	Update(ctx context.Context, entry *Entry) error
+
+	// GetByCredential returns the entries allocated to a credential
+	GetByCredential(ctx context.Context, credentialID string) ([]*Entry, error)
+
+	// GetSet returns the entries of the Issuer's status list
+	// whose status is set
+	GetSet(
+		ctx context.Context,
+		issuerCommonName string,
+		purpose vctypes.CredentialStatusPurpose,
+	) ([]*Entry, error)
+}
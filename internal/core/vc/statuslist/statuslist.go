@@ -0,0 +1,231 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package statuslist
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/google/uuid"
+)
+
+const (
+	// The type of the credential holding a status list
+	CredentialType = "BitstringStatusListCredential"
+
+	// The type of the credential subject of a status list credential
+	ListType = "BitstringStatusList"
+
+	statusListPath = "/v1alpha1/vc/status/"
+	jwksPath       = "/v1alpha1/vc/.well-known/jwks.json"
+)
+
+var purposeNames = map[vctypes.CredentialStatusPurpose]string{
+	vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION: "revocation",
+	vctypes.CREDENTIAL_STATUS_PURPOSE_SUSPENSION: "suspension",
+}
+
+// Entry represents the allocation of an index in the status list of an Issuer
+type Entry struct {
+	// The common name of the Issuer owning the status list
+	IssuerCommonName string
+
+	// The purpose of the status list
+	Purpose vctypes.CredentialStatusPurpose
+
+	// The position of the credential in the status list
+	Index int
+
+	// The ID of the credential assigned to the index
+	CredentialID string
+
+	// True when the status applies to the credential (ex: the credential is revoked)
+	Set bool
+}
+
+// PurposeName returns the statusPurpose value defined by the specification
+func PurposeName(purpose vctypes.CredentialStatusPurpose) (string, bool) {
+	name, ok := purposeNames[purpose]
+	return name, ok
+}
+
+// ParsePurposeName returns the purpose matching a statusPurpose value
+func ParsePurposeName(name string) (vctypes.CredentialStatusPurpose, bool) {
+	for purpose, n := range purposeNames {
+		if n == name {
+			return purpose, true
+		}
+	}
+
+	return vctypes.CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED, false
+}
+
+// CredentialURL returns the URL of the status list credential of an Issuer
+// served by the Node
+func CredentialURL(
+	nodeURL, issuerCommonName string,
+	purpose vctypes.CredentialStatusPurpose,
+) (string, error) {
+	name, ok := PurposeName(purpose)
+	if !ok {
+		return "", fmt.Errorf("unsupported status purpose: %s", purpose)
+	}
+
+	return strings.TrimSuffix(nodeURL, "/") +
+		statusListPath +
+		url.PathEscape(issuerCommonName) + "/" + name, nil
+}
+
+// ParseCredentialURL extracts the Node URL, the Issuer's common name and
+// the purpose from a status list credential URL
+func ParseCredentialURL(
+	credentialURL string,
+) (string, string, vctypes.CredentialStatusPurpose, error) {
+	nodeURL, path, found := strings.Cut(credentialURL, statusListPath)
+	if !found {
+		return "", "", 0, errors.New("invalid status list credential URL")
+	}
+
+	issuerCommonName, name, found := strings.Cut(path, "/")
+	if !found {
+		return "", "", 0, errors.New("invalid status list credential URL")
+	}
+
+	issuerCommonName, err := url.PathUnescape(issuerCommonName)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	purpose, ok := ParsePurposeName(name)
+	if !ok {
+		return "", "", 0, fmt.Errorf("unsupported status purpose: %s", name)
+	}
+
+	return nodeURL, issuerCommonName, purpose, nil
+}
+
+// JwksURL returns the URL of the keys used by the Node to sign the status lists
+func JwksURL(nodeURL string) string {
+	return strings.TrimSuffix(nodeURL, "/") + jwksPath
+}
+
+// RandomIndex returns a random position in the status list.
+// Random allocation is recommended by the specification to prevent correlation.
+func RandomIndex() (int, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(Size))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(n.Int64()), nil
+}
+
+// NewStatusEntry returns a credentialStatus entry referencing a status list
+func NewStatusEntry(
+	credentialURL string,
+	purpose vctypes.CredentialStatusPurpose,
+	index int,
+) *vctypes.CredentialStatus {
+	return &vctypes.CredentialStatus{
+		ID:                   credentialURL + "#" + strconv.Itoa(index),
+		Type:                 vctypes.BitstringStatusListEntryType,
+		CreatedAt:            time.Now().UTC(),
+		Purpose:              purpose,
+		StatusListIndex:      strconv.Itoa(index),
+		StatusListCredential: credentialURL,
+	}
+}
+
+// EntryIndex parses and validates the statusListIndex of an entry
+func EntryIndex(status *vctypes.CredentialStatus) (int, error) {
+	index, err := strconv.Atoi(status.StatusListIndex)
+	if err != nil {
+		return 0, fmt.Errorf("invalid statusListIndex: %w", err)
+	}
+
+	if index < 0 || index >= Size {
+		return 0, fmt.Errorf("the statusListIndex %d is out of range", index)
+	}
+
+	return index, nil
+}
+
+// NewCredential creates the status list credential of an Issuer.
+// The credential is valid for the ttl duration, after which verifiers
+// should fetch a fresh copy.
+func NewCredential(
+	credentialURL string,
+	nodeURL string,
+	purpose vctypes.CredentialStatusPurpose,
+	list Bitstring,
+	ttl time.Duration,
+) (*vctypes.VerifiableCredential, error) {
+	name, ok := PurposeName(purpose)
+	if !ok {
+		return nil, fmt.Errorf("unsupported status purpose: %s", purpose)
+	}
+
+	encodedList, err := list.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	return &vctypes.VerifiableCredential{
+		Context: []string{"https://www.w3.org/ns/credentials/v2"},
+		Type:    []string{"VerifiableCredential", CredentialType},
+		Issuer:  nodeURL,
+		ID:      uuid.NewString(),
+		CredentialSubject: map[string]any{
+			"id":            credentialURL + "#list",
+			"type":          ListType,
+			"statusPurpose": name,
+			"encodedList":   encodedList,
+			"ttl":           ttl.Milliseconds(),
+		},
+		IssuanceDate:   now.Format(time.RFC3339),
+		ExpirationDate: now.Add(ttl).Format(time.RFC3339),
+	}, nil
+}
+
+// IsSet checks the status of an entry against a status list credential
+func IsSet(
+	credential *vctypes.VerifiableCredential,
+	status *vctypes.CredentialStatus,
+) (bool, error) {
+	if !slices.Contains(credential.Type, CredentialType) {
+		return false, errors.New("the credential is not a status list credential")
+	}
+
+	name, ok := PurposeName(status.Purpose)
+	if !ok || credential.CredentialSubject["statusPurpose"] != name {
+		return false, errors.New("the status list purpose does not match the entry")
+	}
+
+	encodedList, ok := credential.CredentialSubject["encodedList"].(string)
+	if !ok {
+		return false, errors.New("the status list credential has no encodedList")
+	}
+
+	list, err := DecodeBitstring(encodedList)
+	if err != nil {
+		return false, err
+	}
+
+	index, err := EntryIndex(status)
+	if err != nil {
+		return false, err
+	}
+
+	return list.Get(index)
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package testing
+
+import (
+	"context"
+	"fmt"
+
+	errcore "github.com/agntcy/identity/internal/core/errors"
+	"github.com/agntcy/identity/internal/core/vc/statuslist"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+)
+
+type FakeStatusListRepository struct {
+	store map[string]*statuslist.Entry
+}
+
+func NewFakeStatusListRepository() statuslist.Repository {
+	return &FakeStatusListRepository{
+		store: make(map[string]*statuslist.Entry),
+	}
+}
+
+func (r *FakeStatusListRepository) Allocate(
+	ctx context.Context,
+	entry *statuslist.Entry,
+) error {
+	if _, ok := r.store[key(entry)]; ok {
+		return errcore.ErrResourceAlreadyExists
+	}
+
+	r.store[key(entry)] = entry
+
+	return nil
+}
+
+func (r *FakeStatusListRepository) Update(
+	ctx context.Context,
+	entry *statuslist.Entry,
+) error {
+	if _, ok := r.store[key(entry)]; !ok {
+		return errcore.ErrResourceNotFound
+	}
+
+	r.store[key(entry)] = entry
+
+	return nil
+}
+
+func (r *FakeStatusListRepository) GetByCredential(
+	ctx context.Context,
+	credentialID string,
+) ([]*statuslist.Entry, error) {
+	result := make([]*statuslist.Entry, 0)
+
+	for _, entry := range r.store {
+		if entry.CredentialID == credentialID {
+			result = append(result, entry)
+		}
+	}
+
+	return result, nil
+}
+
+func (r *FakeStatusListRepository) GetSet(
+	ctx context.Context,
+	issuerCommonName string,
+	purpose vctypes.CredentialStatusPurpose,
+) ([]*statuslist.Entry, error) {
+	result := make([]*statuslist.Entry, 0)
+
+	for _, entry := range r.store {
+		if entry.IssuerCommonName == issuerCommonName && entry.Purpose == purpose && entry.Set {
+			result = append(result, entry)
+		}
+	}
+
+	return result, nil
+}
+
+func key(entry *statuslist.Entry) string {
+	return fmt.Sprintf("%s/%d/%d", entry.IssuerCommonName, entry.Purpose, entry.Index)
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package testing
+
+import (
+	"encoding/json"
+	"testing"
+
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	jwktype "github.com/agntcy/identity/pkg/jwk"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	CredentialSubjectID = "AGNTCY-1234"
+	CredentialBadge     = `{"name":"weather-agent","url":"https://agent.example.com","skills":["forecast"]}`
+)
+
+// NewCredential returns an unsigned badge credential of the CredentialSubjectID
+func NewCredential() *vctypes.VerifiableCredential {
+	claims := vctypes.BadgeClaims{
+		ID:    CredentialSubjectID,
+		Badge: CredentialBadge,
+	}
+
+	return &vctypes.VerifiableCredential{
+		Context:           []string{"https://www.w3.org/ns/credentials/v2"},
+		Type:              []string{"VerifiableCredential", "AgentBadge"},
+		ID:                "urn:uuid:1234",
+		Issuer:            "did:web:example.com",
+		IssuanceDate:      "2025-01-01T00:00:00Z",
+		CredentialSubject: claims.ToMap(),
+	}
+}
+
+// ToJwk converts a raw crypto key to a JWK, with the key ID when it is not empty
+func ToJwk(t *testing.T, raw any, keyID string) *jwktype.Jwk {
+	t.Helper()
+
+	key, err := jwk.Import(raw)
+	require.NoError(t, err)
+
+	if keyID != "" {
+		require.NoError(t, key.Set(jwk.KeyIDKey, keyID))
+	}
+
+	data, err := json.Marshal(key)
+	require.NoError(t, err)
+
+	var result jwktype.Jwk
+	require.NoError(t, json.Unmarshal(data, &result))
+
+	return &result
+}
@@ -4,7 +4,9 @@
 package testing
 
 import (
+	"cmp"
 	"context"
+	"slices"
 
 	errcore "github.com/agntcy/identity/internal/core/errors"
 	vccore "github.com/agntcy/identity/internal/core/vc"
@@ -72,3 +74,40 @@ func (r *FakeVCRepository) Update(
 	r.store[credential.ID] = credential
 	return credential, nil
 }
+
+func (r *FakeVCRepository) Search(
+	ctx context.Context,
+	criteria *vccore.SearchCriteria,
+	cursor *vccore.SearchCursor,
+	limit int,
+) ([]*vctypes.VerifiableCredential, *vccore.SearchCursor, error) {
+	result := make([]*vctypes.VerifiableCredential, 0)
+
+	for _, vc := range r.store {
+		if criteria != nil && !criteria.Matches(vc) {
+			continue
+		}
+
+		if cursor != nil && !cursor.Precedes(vc) {
+			continue
+		}
+
+		result = append(result, vc)
+	}
+
+	slices.SortFunc(result, func(a, b *vctypes.VerifiableCredential) int {
+		return cmp.Or(
+			cmp.Compare(b.IssuanceDate, a.IssuanceDate),
+			cmp.Compare(b.ID, a.ID),
+		)
+	})
+
+	if len(result) <= limit {
+		return result, nil, nil
+	}
+
+	result = result[:limit]
+	last := result[len(result)-1]
+
+	return result, &vccore.SearchCursor{IssuanceDate: last.IssuanceDate, ID: last.ID}, nil
+}
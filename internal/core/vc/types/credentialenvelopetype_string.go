@@ -11,11 +11,13 @@ func _() {
 	_ = x[CREDENTIAL_ENVELOPE_TYPE_UNSPECIFIED-0]
 	_ = x[CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF-1]
 	_ = x[CREDENTIAL_ENVELOPE_TYPE_JOSE-2]
+	_ = x[CREDENTIAL_ENVELOPE_TYPE_COSE-3]
+	_ = x[CREDENTIAL_ENVELOPE_TYPE_SD_JWT-4]
 }
 
-const _CredentialEnvelopeType_name = "CREDENTIAL_ENVELOPE_TYPE_UNSPECIFIEDCREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOFCREDENTIAL_ENVELOPE_TYPE_JOSE"
+const _CredentialEnvelopeType_name = "CREDENTIAL_ENVELOPE_TYPE_UNSPECIFIEDCREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOFCREDENTIAL_ENVELOPE_TYPE_JOSECREDENTIAL_ENVELOPE_TYPE_COSECREDENTIAL_ENVELOPE_TYPE_SD_JWT"
 
-var _CredentialEnvelopeType_index = [...]uint8{0, 36, 75, 104}
+var _CredentialEnvelopeType_index = [...]uint8{0, 36, 75, 104, 133, 164}
 
 func (i CredentialEnvelopeType) String() string {
 	if i < 0 || i >= CredentialEnvelopeType(len(_CredentialEnvelopeType_index)-1) {
@@ -7,6 +7,7 @@
 package types
 
 import (
+	"cmp"
 	"fmt"
 	"slices"
 	"strings"
@@ -29,6 +30,12 @@ const (
 
 	// JOSE Envelope Type.
 	CREDENTIAL_ENVELOPE_TYPE_JOSE
+
+	// COSE Envelope Type (COSE_Sign1).
+	CREDENTIAL_ENVELOPE_TYPE_COSE
+
+	// SD-JWT Envelope Type with selectively disclosable claims.
+	CREDENTIAL_ENVELOPE_TYPE_SD_JWT
 )
 
 func (t *CredentialEnvelopeType) UnmarshalText(text []byte) error {
@@ -37,6 +44,10 @@ func (t *CredentialEnvelopeType) UnmarshalText(text []byte) error {
 		*t = CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF
 	case CREDENTIAL_ENVELOPE_TYPE_JOSE.String():
 		*t = CREDENTIAL_ENVELOPE_TYPE_JOSE
+	case CREDENTIAL_ENVELOPE_TYPE_COSE.String():
+		*t = CREDENTIAL_ENVELOPE_TYPE_COSE
+	case CREDENTIAL_ENVELOPE_TYPE_SD_JWT.String():
+		*t = CREDENTIAL_ENVELOPE_TYPE_SD_JWT
 	default:
 		*t = CREDENTIAL_ENVELOPE_TYPE_UNSPECIFIED
 	}
@@ -141,12 +152,18 @@ const (
 	// Used to cancel the validity of a verifiable credential.
 	// This status is not reversible.
 	CREDENTIAL_STATUS_PURPOSE_REVOCATION
+
+	// Used to temporarily prevent the acceptance of a verifiable credential.
+	// This status is reversible.
+	CREDENTIAL_STATUS_PURPOSE_SUSPENSION
 )
 
 func (t *CredentialStatusPurpose) UnmarshalText(text []byte) error {
 	switch string(text) {
 	case CREDENTIAL_STATUS_PURPOSE_REVOCATION.String():
 		*t = CREDENTIAL_STATUS_PURPOSE_REVOCATION
+	case CREDENTIAL_STATUS_PURPOSE_SUSPENSION.String():
+		*t = CREDENTIAL_STATUS_PURPOSE_SUSPENSION
 	default:
 		*t = CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED
 	}
@@ -174,6 +191,24 @@ type CredentialStatus struct {
 
 	// The value of the purpose for the status entry
 	Purpose CredentialStatusPurpose `json:"purpose" protobuf:"bytes,4,opt,name=purpose"`
+
+	// The position of the credential in the Bitstring Status List
+	StatusListIndex string `json:"statusListIndex,omitempty" protobuf:"bytes,5,opt,name=status_list_index"`
+
+	// The URL of the Bitstring Status List Credential
+	StatusListCredential string `json:"statusListCredential,omitempty" protobuf:"bytes,6,opt,name=status_list_credential"`
+}
+
+// The type of the status entries referencing a Bitstring Status List
+// more information can be found [here]
+//
+// [here]: https://www.w3.org/TR/vc-bitstring-status-list/
+const BitstringStatusListEntryType = "BitstringStatusListEntry"
+
+// IsStatusListEntry returns true if the status is held in a Bitstring Status List
+// instead of the credential itself.
+func (s *CredentialStatus) IsStatusListEntry() bool {
+	return s.Type == BitstringStatusListEntryType
 }
 
 // DataModel represents the W3C Verifiable Credential Data Model defined [here]
@@ -209,6 +244,12 @@ type VerifiableCredential struct {
 
 	// https://w3id.org/security#proof
 	Proof *Proof `json:"proof,omitempty" protobuf:"bytes,10,opt,name=proof"`
+
+	// https://www.w3.org/TR/vc-data-model-2.0/#validity-period
+	ValidFrom string `json:"validFrom,omitempty" protobuf:"bytes,11,opt,name=valid_from"`
+
+	// https://www.w3.org/TR/vc-data-model-2.0/#validity-period
+	ValidUntil string `json:"validUntil,omitempty" protobuf:"bytes,12,opt,name=valid_until"`
 }
 
 func (vc *VerifiableCredential) GetDID() (string, bool) {
@@ -221,18 +262,137 @@ func (vc *VerifiableCredential) GetDID() (string, bool) {
 	return "", false
 }
 
+// ValidateStatus validates the status entries embedded in the credential.
+// Status list entries are skipped since their state lives in the status list.
+// A revocation takes precedence over a suspension.
 func (vc *VerifiableCredential) ValidateStatus() error {
+	if vc.HasStatus(CREDENTIAL_STATUS_PURPOSE_REVOCATION) {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED,
+			"The Verifiable Credential is revoked.",
+			nil,
+		)
+	}
+
+	if vc.HasStatus(CREDENTIAL_STATUS_PURPOSE_SUSPENSION) {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED,
+			"The Verifiable Credential is suspended.",
+			nil,
+		)
+	}
+
+	return nil
+}
+
+// The tolerance applied to the validity period to account for clock differences
+const validityClockSkew = time.Minute
+
+// ValidateValidityPeriod validates that the credential is valid at the given time.
+// The validFrom and validUntil dates are used, falling back to issuanceDate and
+// expirationDate for credentials following the data model 1.1.
+func (vc *VerifiableCredential) ValidateValidityPeriod(now time.Time) error {
+	validFrom, err := parseValidityDate(cmp.Or(vc.ValidFrom, vc.IssuanceDate))
+	if err != nil {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+			"The validFrom date of the Verifiable Credential is invalid.",
+			err,
+		)
+	}
+
+	if !validFrom.IsZero() && now.Add(validityClockSkew).Before(validFrom) {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALID,
+			"The Verifiable Credential is not yet valid.",
+			nil,
+		)
+	}
+
+	validUntil, err := parseValidityDate(cmp.Or(vc.ValidUntil, vc.ExpirationDate))
+	if err != nil {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+			"The validUntil date of the Verifiable Credential is invalid.",
+			err,
+		)
+	}
+
+	if !validUntil.IsZero() && now.Add(-validityClockSkew).After(validUntil) {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED,
+			"The Verifiable Credential is expired.",
+			nil,
+		)
+	}
+
+	return nil
+}
+
+// ValidateExpiration validates that the credential expires at most maxValidity
+// after the start of its validity period, the credentials that never expire are invalid.
+// The validity period is not limited when maxValidity is zero.
+func (vc *VerifiableCredential) ValidateExpiration(maxValidity time.Duration) error {
+	validUntil, err := parseValidityDate(cmp.Or(vc.ValidUntil, vc.ExpirationDate))
+	if err != nil {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+			"The validUntil date of the Verifiable Credential is invalid.",
+			err,
+		)
+	}
+
+	if validUntil.IsZero() {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+			"The Verifiable Credential must have a validUntil date.",
+			nil,
+		)
+	}
+
+	if maxValidity <= 0 {
+		return nil
+	}
+
+	validFrom, err := parseValidityDate(cmp.Or(vc.ValidFrom, vc.IssuanceDate))
+	if err != nil || validFrom.IsZero() {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+			"The Verifiable Credential must have a valid validFrom date.",
+			err,
+		)
+	}
+
+	if validUntil.Sub(validFrom) > maxValidity {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+			fmt.Sprintf("The validity period of the Verifiable Credential exceeds %s.", maxValidity),
+			nil,
+		)
+	}
+
+	return nil
+}
+
+// Returns the zero time when the date is not specified
+func parseValidityDate(date string) (time.Time, error) {
+	if date == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, date)
+}
+
+// HasStatus returns true if the credential embeds a status entry
+// with the purpose, status list entries are ignored
+func (vc *VerifiableCredential) HasStatus(purpose CredentialStatusPurpose) bool {
 	for _, status := range vc.Status {
-		if status.Purpose == CREDENTIAL_STATUS_PURPOSE_REVOCATION {
-			return errutil.ErrInfo(
-				errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED,
-				"The Verifiable Credential is revoked.",
-				nil,
-			)
+		if !status.IsStatusListEntry() && status.Purpose == purpose {
+			return true
 		}
 	}
 
-	return nil
+	return false
 }
 
 // DataModel represents the W3C Verifiable Presentation Data Model defined [here]
@@ -250,6 +410,9 @@ type VerifiablePresentation struct {
 
 	// https://w3id.org/security#proof
 	Proof *Proof `json:"proof,omitempty" protobuf:"bytes,4,opt,name=proof"`
+
+	// https://www.w3.org/TR/vc-data-model-2.0/#defn-holder
+	Holder string `json:"holder,omitempty" protobuf:"bytes,5,opt,name=holder"`
 }
 
 // BadgeClaims represents the content of a Badge VC defined [here]
@@ -325,3 +488,19 @@ type VerificationResult struct {
 	// A list represents zero or more errors generated by the verification process
 	Errors []errtypes.ErrorInfo `json:"errors" protobuf:"bytes,7,opt,name=errors"`
 }
+
+// The result returned from the verification process of a Verifiable Presentation
+// and of each of the Verifiable Credentials it contains
+type PresentationVerificationResult struct {
+	// A boolean status, true when the presentation and all its credentials are valid
+	Status bool `json:"status" protobuf:"bytes,1,opt,name=status"`
+
+	// A conforming document which represents the Verifiable Presentation
+	Document *VerifiablePresentation `json:"document" protobuf:"bytes,2,opt,name=document"`
+
+	// The verification results of the Verifiable Credentials of the presentation
+	Results []*VerificationResult `json:"results" protobuf:"bytes,3,opt,name=results"`
+
+	// A list represents zero or more errors generated by the verification of the presentation
+	Errors []errtypes.ErrorInfo `json:"errors" protobuf:"bytes,4,opt,name=errors"`
+}
@@ -6,6 +6,7 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	errtesting "github.com/agntcy/identity/internal/core/errors/testing"
 	errtypes "github.com/agntcy/identity/internal/core/errors/types"
@@ -64,3 +65,135 @@ func TestValidateStatus(t *testing.T) {
 	assert.Error(t, err)
 	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED)
 }
+
+func TestValidateStatus_Should_Skip_Status_List_Entries(t *testing.T) {
+	t.Parallel()
+
+	vc := &types.VerifiableCredential{
+		Status: []*types.CredentialStatus{
+			{ //nolint:gosec // not a credential
+				Type:                 types.BitstringStatusListEntryType,
+				Purpose:              types.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
+				StatusListIndex:      "42",
+				StatusListCredential: "http://localhost/v1alpha1/vc/status/issuer/revocation",
+			},
+		},
+	}
+	err := vc.ValidateStatus()
+	assert.NoError(t, err)
+}
+
+func TestValidateStatus_Should_Return_Suspended(t *testing.T) {
+	t.Parallel()
+
+	vc := &types.VerifiableCredential{
+		Status: []*types.CredentialStatus{
+			{
+				Purpose: types.CREDENTIAL_STATUS_PURPOSE_SUSPENSION,
+			},
+		},
+	}
+	err := vc.ValidateStatus()
+	assert.Error(t, err)
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED)
+
+	vc.Status = append(vc.Status, &types.CredentialStatus{
+		Purpose: types.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
+	})
+	err = vc.ValidateStatus()
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED)
+}
+
+func TestValidateValidityPeriod(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	t.Run("Valid credential", func(t *testing.T) {
+		t.Parallel()
+
+		vc := &types.VerifiableCredential{
+			ValidFrom:  now.Add(-time.Hour).Format(time.RFC3339),
+			ValidUntil: now.Add(time.Hour).Format(time.RFC3339),
+		}
+
+		assert.NoError(t, vc.ValidateValidityPeriod(now))
+	})
+
+	t.Run("Expired credential", func(t *testing.T) {
+		t.Parallel()
+
+		vc := &types.VerifiableCredential{
+			ExpirationDate: now.Add(-time.Hour).Format(time.RFC3339),
+		}
+
+		err := vc.ValidateValidityPeriod(now)
+		errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED)
+	})
+
+	t.Run("Not yet valid credential", func(t *testing.T) {
+		t.Parallel()
+
+		vc := &types.VerifiableCredential{
+			IssuanceDate: now.Add(-time.Hour).Format(time.RFC3339),
+			ValidFrom:    now.Add(time.Hour).Format(time.RFC3339),
+		}
+
+		err := vc.ValidateValidityPeriod(now)
+		errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALID)
+	})
+
+	t.Run("Invalid date", func(t *testing.T) {
+		t.Parallel()
+
+		vc := &types.VerifiableCredential{
+			ValidUntil: "tomorrow",
+		}
+
+		err := vc.ValidateValidityPeriod(now)
+		errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL)
+	})
+}
+
+func TestValidateExpiration(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	t.Run("Credential within the maximum validity", func(t *testing.T) {
+		t.Parallel()
+
+		vc := &types.VerifiableCredential{
+			IssuanceDate: now.Format(time.RFC3339),
+			ValidUntil:   now.Add(time.Hour).Format(time.RFC3339),
+		}
+
+		assert.NoError(t, vc.ValidateExpiration(24*time.Hour))
+	})
+
+	t.Run("Credential without expiration", func(t *testing.T) {
+		t.Parallel()
+
+		vc := &types.VerifiableCredential{
+			IssuanceDate: now.Format(time.RFC3339),
+		}
+
+		err := vc.ValidateExpiration(0)
+		errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL)
+	})
+
+	t.Run("Credential beyond the maximum validity", func(t *testing.T) {
+		t.Parallel()
+
+		vc := &types.VerifiableCredential{
+			ValidFrom:      now.Format(time.RFC3339),
+			ExpirationDate: now.Add(48 * time.Hour).Format(time.RFC3339),
+		}
+
+		err := vc.ValidateExpiration(24 * time.Hour)
+		errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL)
+
+		// The validity period is not limited without maximum
+		assert.NoError(t, vc.ValidateExpiration(0))
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	errcore "github.com/agntcy/identity/internal/core/errors"
+	"github.com/agntcy/identity/internal/core/webhook"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db/memory"
+)
+
+const webhookDeliveriesTable = "webhook_deliveries"
+
+type webhookMemoryRepository struct {
+	deliveries *memory.Table[*webhook.Delivery]
+}
+
+// NewRepository creates a new instance of the webhook Repository
+// storing the deliveries in the in-memory store
+func NewRepository(store *memory.Store) (webhook.Repository, error) {
+	deliveries, err := memory.NewTable[*webhook.Delivery](store, webhookDeliveriesTable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookMemoryRepository{
+		deliveries: deliveries,
+	}, nil
+}
+
+func (r *webhookMemoryRepository) Enqueue(
+	ctx context.Context,
+	delivery *webhook.Delivery,
+) error {
+	stored := *delivery
+
+	return r.deliveries.Update(ctx, func(rows map[string]*webhook.Delivery) error {
+		if _, ok := rows[delivery.ID]; ok {
+			return errcore.ErrResourceAlreadyExists
+		}
+
+		rows[delivery.ID] = &stored
+
+		return nil
+	})
+}
+
+func (r *webhookMemoryRepository) Claim(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]*webhook.Delivery, error) {
+	result := make([]*webhook.Delivery, 0)
+
+	err := r.deliveries.Update(ctx, func(rows map[string]*webhook.Delivery) error {
+		due := make([]*webhook.Delivery, 0)
+
+		for _, delivery := range rows {
+			if delivery.Status == webhook.DeliveryStatusPending && !delivery.NextAttemptAt.After(now) {
+				due = append(due, delivery)
+			}
+		}
+
+		slices.SortFunc(due, func(a, b *webhook.Delivery) int {
+			return a.NextAttemptAt.Compare(b.NextAttemptAt)
+		})
+
+		for _, delivery := range due[:min(limit, len(due))] {
+			delivery.NextAttemptAt = now.Add(lease)
+
+			claimed := *delivery
+			result = append(result, &claimed)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error claiming the webhook deliveries")
+	}
+
+	return result, nil
+}
+
+func (r *webhookMemoryRepository) Update(
+	ctx context.Context,
+	delivery *webhook.Delivery,
+) error {
+	err := r.deliveries.Update(ctx, func(rows map[string]*webhook.Delivery) error {
+		if _, ok := rows[delivery.ID]; !ok {
+			return errcore.ErrResourceNotFound
+		}
+
+		stored := *delivery
+		rows[delivery.ID] = &stored
+
+		return nil
+	})
+	if err != nil {
+		return errutil.Err(err, "there was an error updating the webhook delivery")
+	}
+
+	return nil
+}
+
+func (r *webhookMemoryRepository) Delete(ctx context.Context, id string) error {
+	err := r.deliveries.Update(ctx, func(rows map[string]*webhook.Delivery) error {
+		delete(rows, id)
+
+		return nil
+	})
+	if err != nil {
+		return errutil.Err(err, "there was an error deleting the webhook delivery")
+	}
+
+	return nil
+}
+
+func (r *webhookMemoryRepository) PurgeFailed(ctx context.Context, before time.Time) error {
+	err := r.deliveries.Update(ctx, func(rows map[string]*webhook.Delivery) error {
+		for id, delivery := range rows {
+			if delivery.Status == webhook.DeliveryStatusFailed && delivery.CreatedAt.Before(before) {
+				delete(rows, id)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errutil.Err(err, "there was an error purging the failed webhook deliveries")
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"time"
+
+	"github.com/agntcy/identity/internal/core/webhook"
+)
+
+type WebhookDelivery struct {
+	ID            string `gorm:"primaryKey"`
+	URL           string
+	EventID       string
+	Payload       []byte
+	Attempts      int
+	NextAttemptAt time.Time `gorm:"index"`
+	Status        webhook.DeliveryStatus
+	LastError     string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+func (d *WebhookDelivery) ToCoreType() *webhook.Delivery {
+	return &webhook.Delivery{
+		ID:            d.ID,
+		URL:           d.URL,
+		EventID:       d.EventID,
+		Payload:       d.Payload,
+		Attempts:      d.Attempts,
+		NextAttemptAt: d.NextAttemptAt,
+		Status:        d.Status,
+		LastError:     d.LastError,
+		CreatedAt:     d.CreatedAt,
+	}
+}
+
+func newWebhookDeliveryModel(src *webhook.Delivery) *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:            src.ID,
+		URL:           src.URL,
+		EventID:       src.EventID,
+		Payload:       src.Payload,
+		Attempts:      src.Attempts,
+		NextAttemptAt: src.NextAttemptAt,
+		Status:        src.Status,
+		LastError:     src.LastError,
+		CreatedAt:     src.CreatedAt,
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/webhook"
+	"github.com/agntcy/identity/internal/pkg/convertutil"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type webhookPostgresRepository struct {
+	dbContext db.Context
+}
+
+func NewRepository(dbContext db.Context) webhook.Repository {
+	return &webhookPostgresRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r *webhookPostgresRepository) Enqueue(
+	ctx context.Context,
+	delivery *webhook.Delivery,
+) error {
+	err := db.Client(ctx, r.dbContext).Create(newWebhookDeliveryModel(delivery)).Error
+	if err != nil {
+		return errutil.Err(err, "there was an error enqueuing the webhook delivery")
+	}
+
+	return nil
+}
+
+// Claim locks the due deliveries, skipping the ones locked by another Node,
+// and postpones them within the same transaction
+func (r *webhookPostgresRepository) Claim(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]*webhook.Delivery, error) {
+	var deliveries []*WebhookDelivery
+
+	err := db.Client(ctx, r.dbContext).Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", webhook.DeliveryStatusPending, now).
+			Order("next_attempt_at").
+			Limit(limit).
+			Find(&deliveries).Error
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+
+		ids := make([]string, 0, len(deliveries))
+
+		for _, delivery := range deliveries {
+			delivery.NextAttemptAt = now.Add(lease)
+			ids = append(ids, delivery.ID)
+		}
+
+		return tx.Model(&WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil {
+		return nil, errutil.Err(err, "there was an error claiming the webhook deliveries")
+	}
+
+	return convertutil.ConvertSlice(deliveries, func(d *WebhookDelivery) *webhook.Delivery {
+		return d.ToCoreType()
+	}), nil
+}
+
+func (r *webhookPostgresRepository) Update(
+	ctx context.Context,
+	delivery *webhook.Delivery,
+) error {
+	err := db.Client(ctx, r.dbContext).
+		Model(&WebhookDelivery{ID: delivery.ID}).
+		Updates(map[string]any{
+			"attempts":        delivery.Attempts,
+			"next_attempt_at": delivery.NextAttemptAt,
+			"status":          delivery.Status,
+			"last_error":      delivery.LastError,
+		}).Error
+	if err != nil {
+		return errutil.Err(err, "there was an error updating the webhook delivery")
+	}
+
+	return nil
+}
+
+func (r *webhookPostgresRepository) Delete(ctx context.Context, id string) error {
+	err := db.Client(ctx, r.dbContext).Delete(&WebhookDelivery{ID: id}).Error
+	if err != nil {
+		return errutil.Err(err, "there was an error deleting the webhook delivery")
+	}
+
+	return nil
+}
+
+func (r *webhookPostgresRepository) PurgeFailed(ctx context.Context, before time.Time) error {
+	err := db.Client(ctx, r.dbContext).
+		Where("status = ? AND created_at < ?", webhook.DeliveryStatusFailed, before).
+		Delete(&WebhookDelivery{}).Error
+	if err != nil {
+		return errutil.Err(err, "there was an error purging the failed webhook deliveries")
+	}
+
+	return nil
+}
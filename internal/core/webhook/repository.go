@@ -0,0 +1,33 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	// Enqueue stores a new pending delivery
+	Enqueue(ctx context.Context, delivery *Delivery) error
+
+	// Claim returns up to limit pending deliveries due at now, oldest first,
+	// and postpones their next attempt by the lease so concurrent
+	// dispatchers do not send them twice
+	Claim(
+		ctx context.Context,
+		now time.Time,
+		lease time.Duration,
+		limit int,
+	) ([]*Delivery, error)
+
+	// Update stores the attempts and the state of a delivery
+	Update(ctx context.Context, delivery *Delivery) error
+
+	// Delete removes a delivery once it is sent
+	Delete(ctx context.Context, id string) error
+
+	// PurgeFailed removes the failed deliveries queued before the time
+	PurgeFailed(ctx context.Context, before time.Time) error
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/jwk"
+)
+
+const (
+	// The ID of the event, identical across the attempts of a delivery
+	EventIDHeader = "X-Identity-Webhook-Id"
+
+	// The time of the attempt in seconds since the epoch
+	TimestampHeader = "X-Identity-Webhook-Timestamp"
+
+	// The HMAC signature of the timestamp and the body
+	SignatureHeader = "X-Identity-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+
+	// The protected header of the JWS deliveries holding the time of the attempt
+	// in seconds since the epoch, the TimestampHeader is not signed with a JWS
+	jwsTimestampHeader = "iat"
+
+	contentTypeJSON = "application/json"
+	contentTypeJOSE = "application/jose"
+)
+
+// Signer authenticates the payload of a delivery.
+// Returns the body to send and the headers authenticating it.
+type Signer interface {
+	Sign(payload []byte, timestamp time.Time) ([]byte, http.Header, error)
+}
+
+type hmacSigner struct {
+	secret []byte
+}
+
+// NewHMACSigner signs the deliveries with a secret shared with the receivers.
+// The payload is sent as is and the SignatureHeader holds
+// sha256=hex(HMAC-SHA256(secret, timestamp + "." + body)).
+func NewHMACSigner(secret []byte) Signer {
+	return &hmacSigner{secret: secret}
+}
+
+func (s *hmacSigner) Sign(payload []byte, timestamp time.Time) ([]byte, http.Header, error) {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+
+	headers := http.Header{}
+	headers.Set("Content-Type", contentTypeJSON)
+	headers.Set(TimestampHeader, ts)
+	headers.Set(SignatureHeader, signaturePrefix+hex.EncodeToString(computeHMAC(s.secret, ts, payload)))
+
+	return payload, headers, nil
+}
+
+// VerifyHMAC reports whether the signature header matches the timestamp
+// header and the body of a delivery signed with the secret
+func VerifyHMAC(secret, body []byte, timestamp, signature string) bool {
+	sig, ok := strings.CutPrefix(signature, signaturePrefix)
+	if !ok {
+		return false
+	}
+
+	decoded, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(decoded, computeHMAC(secret, timestamp, body))
+}
+
+func computeHMAC(secret []byte, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return mac.Sum(nil)
+}
+
+type jwsSigner struct {
+	key *jwk.Jwk
+}
+
+// NewJWSSigner signs the deliveries with a private key.
+// The body is the compact JWS of the payload, the time of the attempt is set
+// in the iat member of its protected header. The receivers verify it with
+// the public key of the Node using VerifyJWS.
+func NewJWSSigner(key *jwk.Jwk) Signer {
+	return &jwsSigner{key: key}
+}
+
+func (s *jwsSigner) Sign(payload []byte, timestamp time.Time) ([]byte, http.Header, error) {
+	body, err := joseutil.SignWithHeaders(s.key, payload, map[string]any{
+		jwsTimestampHeader: timestamp.Unix(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	headers := http.Header{}
+	headers.Set("Content-Type", contentTypeJOSE)
+	headers.Set(TimestampHeader, strconv.FormatInt(timestamp.Unix(), 10))
+
+	return body, headers, nil
+}
+
+// VerifyJWS verifies the body of a delivery signed with the private key of the public key,
+// returns the payload and the time of the attempt signed in the protected header
+func VerifyJWS(publicKey *jwk.Jwk, body []byte) ([]byte, time.Time, error) {
+	payload, err := joseutil.Verify(publicKey, body)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	encoded, _, _ := strings.Cut(string(body), ".")
+
+	rawHeader, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("invalid protected header: %w", err)
+	}
+
+	var header map[string]any
+
+	err = json.Unmarshal(rawHeader, &header)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("invalid protected header: %w", err)
+	}
+
+	timestamp, ok := header[jwsTimestampHeader].(float64)
+	if !ok {
+		return nil, time.Time{}, errors.New("the protected header has no timestamp")
+	}
+
+	return payload, time.Unix(int64(timestamp), 0), nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/webhook"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHMACSigner_Should_Sign_The_Timestamp_And_The_Body(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("secret")
+	payload := []byte(`{"id":"1"}`)
+	sut := webhook.NewHMACSigner(secret)
+
+	body, headers, err := sut.Sign(payload, time.Unix(1700000000, 0))
+
+	assert.NoError(t, err)
+	assert.Equal(t, payload, body)
+	assert.Equal(t, "1700000000", headers.Get(webhook.TimestampHeader))
+	assert.True(t, webhook.VerifyHMAC(
+		secret, body, headers.Get(webhook.TimestampHeader), headers.Get(webhook.SignatureHeader),
+	))
+	assert.False(t, webhook.VerifyHMAC(
+		secret, body, "1700000001", headers.Get(webhook.SignatureHeader),
+	))
+	assert.False(t, webhook.VerifyHMAC(
+		[]byte("other"), body, headers.Get(webhook.TimestampHeader), headers.Get(webhook.SignatureHeader),
+	))
+}
+
+func TestJWSSigner_Should_Sign_The_Body_And_The_Timestamp(t *testing.T) {
+	t.Parallel()
+
+	for _, alg := range []string{"RS256", "ML-DSA-44"} {
+		t.Run(alg, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := joseutil.GenerateJWK(alg, "sig", "key-1")
+			assert.NoError(t, err)
+
+			payload := []byte(`{"id":"1"}`)
+			sut := webhook.NewJWSSigner(key)
+
+			body, headers, err := sut.Sign(payload, time.Unix(1700000000, 0))
+			assert.NoError(t, err)
+			assert.Equal(t, "application/jose", headers.Get("Content-Type"))
+
+			verified, timestamp, err := webhook.VerifyJWS(key.PublicKey(), body)
+
+			assert.NoError(t, err)
+			assert.Equal(t, payload, verified)
+			assert.Equal(t, int64(1700000000), timestamp.Unix())
+
+			// The body is still a JWS of the payload
+			verified, err = joseutil.Verify(key.PublicKey(), body)
+			assert.NoError(t, err)
+			assert.Equal(t, payload, verified)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package webhook defines the outbound webhook deliveries of the Node.
+// The deliveries are persisted before being sent so the events survive
+// a restart of the Node and failed deliveries can be retried.
+package webhook
+
+import (
+	"time"
+)
+
+// DeliveryStatus is the state of a delivery in the queue
+type DeliveryStatus string
+
+const (
+	// The delivery is waiting for its next attempt
+	DeliveryStatusPending DeliveryStatus = "pending"
+
+	// The delivery has exhausted its attempts, it is kept for inspection
+	DeliveryStatusFailed DeliveryStatus = "failed"
+)
+
+// Delivery is an event to send to a webhook endpoint
+type Delivery struct {
+	// The unique identifier of the delivery
+	ID string
+
+	// The URL of the webhook endpoint
+	URL string
+
+	// The ID of the delivered event, the receivers use it to
+	// discard the events received more than once
+	EventID string
+
+	// The serialized event
+	Payload []byte
+
+	// The number of failed attempts
+	Attempts int
+
+	// The time of the next attempt
+	NextAttemptAt time.Time
+
+	// The state of the delivery
+	Status DeliveryStatus
+
+	// The error of the last failed attempt
+	LastError string
+
+	// The time the delivery was queued
+	CreatedAt time.Time
+}
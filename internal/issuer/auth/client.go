@@ -5,6 +5,7 @@ package auth
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/agntcy/identity/internal/issuer/issuer/types"
 	idptypes "github.com/agntcy/identity/internal/issuer/types"
 	"github.com/agntcy/identity/internal/issuer/vault"
+	"github.com/agntcy/identity/pkg/jwk"
 	"github.com/agntcy/identity/pkg/oidc"
 	"github.com/google/uuid"
 )
@@ -21,6 +23,7 @@ type authInput struct {
 	keyID     string
 	clientID  string
 	idpConfig *idptypes.IdpConfig
+	audience  string
 }
 
 type AuthOption func(in *authInput)
@@ -39,6 +42,14 @@ func WithSelfIssuing(vaultID, keyID, clientID string) AuthOption {
 	}
 }
 
+// WithAudience sets the audience of the token,
+// the identifier of the Node the token is sent to
+func WithAudience(audience string) AuthOption {
+	return func(in *authInput) {
+		in.audience = audience
+	}
+}
+
 type Client interface {
 	// Authentices will generate a JWT token based on the issuer auth type
 	// (self issuing or IdP issuing)
@@ -48,10 +59,16 @@ type Client interface {
 		options ...AuthOption,
 	) (string, error)
 
-	// Token generates a JWT token for the issuer using an IdP.
-	Token(ctx context.Context, idpConfig *idptypes.IdpConfig) (string, error)
+	// Token generates a JWT token for the issuer and the audience using an IdP.
+	// The private_key_jwt client assertions are signed by a key of the vault.
+	Token(
+		ctx context.Context,
+		idpConfig *idptypes.IdpConfig,
+		vaultID, keyID string,
+		audience string,
+	) (string, error)
 
-	// SelfIssuedToken generates a JWT token using the issuer's private key.
+	// SelfIssuedToken generates a JWT token for the audience using the issuer's private key.
 	// If clientID is provided, it will be used as the subject of the JWT.
 	// Otherwise, one will be generated.
 	SelfIssuedToken(
@@ -59,6 +76,18 @@ type Client interface {
 		issuer *types.Issuer,
 		vaultID, keyID string,
 		clientID string,
+		audience string,
+	) (string, error)
+
+	// KeyRotationToken generates a JWT token signed by the issuer's private key
+	// authorizing only the rotation of the issuer's key to the new key.
+	KeyRotationToken(
+		ctx context.Context,
+		issuer *types.Issuer,
+		vaultID, keyID string,
+		clientID string,
+		audience string,
+		newKey *jwk.Jwk,
 	) (string, error)
 }
 
@@ -92,9 +121,9 @@ func (s *client) Authenticate(
 
 	switch issuer.AuthType {
 	case issuercoretypes.ISSUER_AUTH_TYPE_IDP:
-		token, err = s.Token(ctx, in.idpConfig)
+		token, err = s.Token(ctx, in.idpConfig, in.vaultID, in.keyID, in.audience)
 	case issuercoretypes.ISSUER_AUTH_TYPE_SELF:
-		token, err = s.SelfIssuedToken(ctx, issuer, in.vaultID, in.keyID, in.clientID)
+		token, err = s.SelfIssuedToken(ctx, issuer, in.vaultID, in.keyID, in.clientID, in.audience)
 	default:
 		err = errors.New("unknown authentication type")
 	}
@@ -107,6 +136,7 @@ func (s *client) SelfIssuedToken(
 	issuer *types.Issuer,
 	vaultID, keyID string,
 	clientID string,
+	audience string,
 ) (string, error) {
 	prvKey, err := s.vaultSrv.RetrievePrivKey(ctx, vaultID, keyID)
 	if err != nil {
@@ -121,15 +151,89 @@ func (s *client) SelfIssuedToken(
 	return oidc.SelfIssueJWT(
 		issuer.CommonName,
 		sub,
+		audience,
 		prvKey,
 	)
 }
 
-func (s *client) Token(ctx context.Context, idpConfig *idptypes.IdpConfig) (string, error) {
-	return s.auth.Token(
-		ctx,
-		idpConfig.IssuerUrl,
-		idpConfig.ClientId,
-		idpConfig.ClientSecret,
+func (s *client) KeyRotationToken(
+	ctx context.Context,
+	issuer *types.Issuer,
+	vaultID, keyID string,
+	clientID string,
+	audience string,
+	newKey *jwk.Jwk,
+) (string, error) {
+	prvKey, err := s.vaultSrv.RetrievePrivKey(ctx, vaultID, keyID)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving public key: %w", err)
+	}
+
+	sub := clientID
+	if sub == "" {
+		sub = uuid.NewString()
+	}
+
+	return oidc.SelfIssueKeyRotationJWT(
+		issuer.CommonName,
+		sub,
+		audience,
+		prvKey,
+		newKey,
 	)
 }
+
+func (s *client) Token(
+	ctx context.Context,
+	idpConfig *idptypes.IdpConfig,
+	vaultID, keyID string,
+	audience string,
+) (string, error) {
+	credentials, err := s.clientCredentials(ctx, idpConfig, vaultID, keyID)
+	if err != nil {
+		return "", err
+	}
+
+	return s.auth.Token(ctx, idpConfig.IssuerUrl, credentials, audience)
+}
+
+// clientCredentials loads the credentials of the authentication method of the IdP configuration
+func (s *client) clientCredentials(
+	ctx context.Context,
+	idpConfig *idptypes.IdpConfig,
+	vaultID, keyID string,
+) (*oidc.ClientCredentials, error) {
+	method, err := oidc.ParseClientAuthMethod(idpConfig.AuthMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := &oidc.ClientCredentials{
+		ClientID:   idpConfig.ClientId,
+		AuthMethod: method,
+	}
+
+	switch method {
+	case oidc.ClientAuthMethodSecret:
+		credentials.ClientSecret = idpConfig.ClientSecret
+	case oidc.ClientAuthMethodPrivateKeyJWT:
+		// The key registered with the IdP client must not be able to sign as the issuer
+		if idpConfig.ClientKeyId == "" || idpConfig.ClientKeyId == keyID {
+			return nil, errors.New("private_key_jwt requires a vault key dedicated to the IdP client")
+		}
+
+		credentials.PrivateKey, err = s.vaultSrv.RetrievePrivKey(ctx, vaultID, idpConfig.ClientKeyId)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving the client key: %w", err)
+		}
+	case oidc.ClientAuthMethodTLS:
+		certificate, err := tls.LoadX509KeyPair(idpConfig.ClientCertFile, idpConfig.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading the client certificate: %w", err)
+		}
+
+		credentials.Certificate = &certificate
+	}
+
+	return credentials, nil
+}
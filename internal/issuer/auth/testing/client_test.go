@@ -11,7 +11,9 @@ import (
 	"github.com/agntcy/identity/internal/issuer/issuer/types"
 	idptypes "github.com/agntcy/identity/internal/issuer/types"
 	vaulttesting "github.com/agntcy/identity/internal/issuer/vault/testing"
+	"github.com/agntcy/identity/pkg/joseutil"
 	"github.com/agntcy/identity/pkg/oidc"
+	"github.com/lestrrat-go/jwx/v3/jwt"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,12 +31,48 @@ func TestToken_Should_Issue_A_Self_Issued_Token(t *testing.T) {
 		"vaultId",
 		"keyId",
 		"clientId",
+		"https://node.example.com",
 	)
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, "token", "Expected a token to be issued, but got an empty string")
 }
 
+func TestToken_Should_Issue_A_Key_Rotation_Token_Bound_To_The_New_Key(t *testing.T) {
+	t.Parallel()
+
+	authClient := auth.NewClient(
+		oidc.NewAuthenticator(),
+		vaulttesting.NewFakeVaultService(),
+	)
+
+	newKey, err := joseutil.GenerateJWK("ES256", "sig", "new-key")
+	assert.NoError(t, err)
+
+	token, err := authClient.KeyRotationToken(
+		context.Background(),
+		&types.Issuer{},
+		"vaultId",
+		"keyId",
+		"clientId",
+		"https://node.example.com",
+		newKey.PublicKey(),
+	)
+	assert.NoError(t, err)
+
+	claims, err := jwt.ParseInsecure([]byte(token))
+	assert.NoError(t, err)
+
+	var purpose, thumbprint string
+	assert.NoError(t, claims.Get(oidc.SelfIssuedTokenPurposeClaimName, &purpose))
+	assert.NoError(t, claims.Get(oidc.SelfIssuedTokenNewKeyClaimName, &thumbprint))
+
+	expected, err := newKey.Thumbprint()
+	assert.NoError(t, err)
+	assert.Equal(t, oidc.SelfIssuedTokenPurposeRotateKey, purpose)
+	assert.Equal(t, expected, thumbprint)
+}
+
 func TestToken_Should_Issue_A_JWT_Signed_Token(t *testing.T) {
 	t.Parallel()
 
@@ -50,6 +88,9 @@ func TestToken_Should_Issue_A_JWT_Signed_Token(t *testing.T) {
 			ClientSecret: "client-secret",
 			IssuerUrl:    "https://example.com",
 		},
+		"vaultId",
+		"keyId",
+		"https://node.example.com",
 	)
 
 	assert.Error(t, err, "Expected an error when issuing a JWT signed token without a private key")
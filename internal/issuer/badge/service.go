@@ -7,7 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
+	"time"
 
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
 	"github.com/agntcy/identity/internal/issuer/auth"
 	"github.com/agntcy/identity/internal/issuer/badge/data"
 	issdata "github.com/agntcy/identity/internal/issuer/issuer/data"
@@ -16,13 +18,26 @@ import (
 	"github.com/agntcy/identity/internal/pkg/nodeapi"
 	"github.com/agntcy/identity/pkg/joseutil"
 	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/agntcy/identity/pkg/log"
 	"github.com/google/uuid"
 
 	"github.com/agntcy/identity/internal/core/vc"
+	"github.com/agntcy/identity/internal/core/vc/cose"
+	"github.com/agntcy/identity/internal/core/vc/dataintegrity"
+	"github.com/agntcy/identity/internal/core/vc/sdjwt"
+	"github.com/agntcy/identity/internal/core/vc/statuslist"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
 	internalIssuerTypes "github.com/agntcy/identity/internal/issuer/types"
 )
 
+const (
+	// The type of the status entry added to a revoked badge
+	revocationStatusType = "CredentialStatusEntry"
+
+	// The attempts to publish a badge whose status list indexes are in use
+	maxPublishAttempts = 3
+)
+
 type BadgeService interface {
 	IssueBadge(
 		vaultId string,
@@ -31,6 +46,9 @@ type BadgeService interface {
 		metadataId string,
 		content *vctypes.CredentialContent,
 		privateKey *jwk.Jwk,
+		expiresIn time.Duration,
+		envelopeType vctypes.CredentialEnvelopeType,
+		holderKey *jwk.Jwk,
 	) (string, error)
 	PublishBadge(
 		ctx context.Context,
@@ -39,6 +57,17 @@ type BadgeService interface {
 		issuerId string,
 		metadataId string,
 		badge *internalIssuerTypes.Badge,
+		privateKey *jwk.Jwk,
+		identityNodeURL *string,
+	) (*internalIssuerTypes.Badge, error)
+	RevokeBadge(
+		ctx context.Context,
+		vaultId string,
+		keyId string,
+		issuerId string,
+		metadataId string,
+		badge *internalIssuerTypes.Badge,
+		privateKey *jwk.Jwk,
 		identityNodeURL *string,
 	) (*internalIssuerTypes.Badge, error)
 	GetAllBadges(vaultId, keyId, issuerId, metadataId string) ([]*internalIssuerTypes.Badge, error)
@@ -79,6 +108,9 @@ func (s *badgeService) IssueBadge(
 	metadataId string,
 	content *vctypes.CredentialContent,
 	privateKey *jwk.Jwk,
+	expiresIn time.Duration,
+	envelopeType vctypes.CredentialEnvelopeType,
+	holderKey *jwk.Jwk,
 ) (string, error) {
 	issuer, err := s.issuerRepository.GetIssuer(vaultId, keyId, issuerId)
 	if err != nil {
@@ -98,32 +130,41 @@ func (s *badgeService) IssueBadge(
 		return "", errutil.Err(nil, "invalid privateKey argument")
 	}
 
-	credential, err := vc.New(
+	options := []vc.VerifiableCredentialOption{
 		vc.WithIssuer(&issuer.Issuer),
 		vc.WithCredentialContent(content),
-	)
-	if err != nil {
-		return "", err
+		vc.WithExpiration(expiresIn),
 	}
 
-	payload, err := json.Marshal(credential)
+	for _, purpose := range []vctypes.CredentialStatusPurpose{
+		vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
+		vctypes.CREDENTIAL_STATUS_PURPOSE_SUSPENSION,
+	} {
+		statusListURL, err := statuslist.CredentialURL(
+			issuer.IdentityNodeURL,
+			issuer.CommonName,
+			purpose,
+		)
+		if err != nil {
+			return "", err
+		}
+
+		options = append(options, vc.WithStatusList(statusListURL, purpose))
+	}
+
+	credential, err := vc.New(options...)
 	if err != nil {
 		return "", err
 	}
 
-	signed, err := joseutil.Sign(privateKey, payload)
+	envelopedCredential, err := signBadge(credential, privateKey, envelopeType, holderKey)
 	if err != nil {
 		return "", errutil.Err(err, "unable to sign the badge")
 	}
 
-	envelopedCredential := vctypes.EnvelopedCredential{
-		EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE,
-		Value:        string(signed),
-	}
-
 	badge := internalIssuerTypes.Badge{
 		Id:                  uuid.New().String(),
-		EnvelopedCredential: &envelopedCredential,
+		EnvelopedCredential: envelopedCredential,
 	}
 
 	badgeId, err := s.badgeRepository.AddBadge(vaultId, keyId, issuerId, metadataId, &badge)
@@ -134,6 +175,44 @@ func (s *badgeService) IssueBadge(
 	return badgeId, nil
 }
 
+// signBadge secures the badge using the requested envelope type,
+// the holder key is only used by SD-JWT badges for key binding
+func signBadge(
+	credential *vctypes.VerifiableCredential,
+	privateKey *jwk.Jwk,
+	envelopeType vctypes.CredentialEnvelopeType,
+	holderKey *jwk.Jwk,
+) (*vctypes.EnvelopedCredential, error) {
+	switch envelopeType {
+	case vctypes.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF:
+		return dataintegrity.Sign(credential, privateKey)
+	case vctypes.CREDENTIAL_ENVELOPE_TYPE_COSE:
+		return cose.Sign(credential, privateKey)
+	case vctypes.CREDENTIAL_ENVELOPE_TYPE_SD_JWT:
+		return sdjwt.Issue(credential, privateKey, holderKey)
+	case vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE:
+		payload, err := json.Marshal(credential)
+		if err != nil {
+			return nil, err
+		}
+
+		signed, err := joseutil.Sign(privateKey, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return &vctypes.EnvelopedCredential{
+			EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE,
+			Value:        string(signed),
+		}, nil
+	default:
+		return nil, errutil.Err(nil, "unsupported envelope type")
+	}
+}
+
+// PublishBadge sends the badge to the Identity node. The status list indexes
+// of a badge are drawn at random and may already be allocated to another badge
+// of the issuer, the badge is then signed again with new indexes and sent again.
 func (s *badgeService) PublishBadge(
 	ctx context.Context,
 	vaultId string,
@@ -141,16 +220,209 @@ func (s *badgeService) PublishBadge(
 	issuerId string,
 	metadataId string,
 	badge *internalIssuerTypes.Badge,
+	privateKey *jwk.Jwk,
 	identityNodeURL *string,
 ) (*internalIssuerTypes.Badge, error) {
-	issuer, err := s.issuerRepository.GetIssuer(vaultId, keyId, issuerId)
+	for attempt := 1; ; attempt++ {
+		client, proof, err := s.authenticate(ctx, vaultId, keyId, issuerId, metadataId, identityNodeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		err = client.PublishVerifiableCredential(badge.EnvelopedCredential, proof)
+		if err == nil {
+			return badge, nil
+		}
+
+		if attempt == maxPublishAttempts || privateKey == nil ||
+			!errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_STATUS_LIST_INDEX_IN_USE) {
+			return nil, err
+		}
+
+		log.Debug("The status list indexes of the badge are in use, drawing new indexes")
+
+		badge, err = s.reassignStatusListIndexes(vaultId, keyId, issuerId, metadataId, badge, privateKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// reassignStatusListIndexes draws new status list indexes for the badge,
+// the badge signed again replaces the badge in the local store
+func (s *badgeService) reassignStatusListIndexes(
+	vaultId string,
+	keyId string,
+	issuerId string,
+	metadataId string,
+	badge *internalIssuerTypes.Badge,
+	privateKey *jwk.Jwk,
+) (*internalIssuerTypes.Badge, error) {
+	credential, err := vc.ParseEnvelopedCredential(badge.EnvelopedCredential)
+	if err != nil {
+		return nil, errutil.Err(err, "unable to parse the badge")
+	}
+
+	for idx, status := range credential.Status {
+		if !status.IsStatusListEntry() {
+			continue
+		}
+
+		index, err := statuslist.RandomIndex()
+		if err != nil {
+			return nil, err
+		}
+
+		credential.Status[idx] = statuslist.NewStatusEntry(
+			status.StatusListCredential,
+			status.Purpose,
+			index,
+		)
+	}
+
+	envelopedCredential, err := resignBadge(badge, credential, privateKey)
 	if err != nil {
 		return nil, err
 	}
 
+	reassigned := internalIssuerTypes.Badge{
+		Id:                  badge.Id,
+		EnvelopedCredential: envelopedCredential,
+		Revoked:             badge.Revoked,
+	}
+
+	_, err = s.badgeRepository.AddBadge(vaultId, keyId, issuerId, metadataId, &reassigned)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reassigned, nil
+}
+
+// RevokeBadge adds a revocation status to the badge, signs it again with the
+// same envelope and sends it to the Identity node. The revoked badge
+// replaces the badge in the local store.
+func (s *badgeService) RevokeBadge(
+	ctx context.Context,
+	vaultId string,
+	keyId string,
+	issuerId string,
+	metadataId string,
+	badge *internalIssuerTypes.Badge,
+	privateKey *jwk.Jwk,
+	identityNodeURL *string,
+) (*internalIssuerTypes.Badge, error) {
+	if badge.EnvelopedCredential == nil {
+		return nil, errutil.Err(nil, "the badge has no enveloped credential")
+	}
+
+	if badge.Revoked {
+		return nil, errutil.Err(nil, "the badge is already revoked")
+	}
+
+	if privateKey == nil {
+		return nil, errutil.Err(nil, "invalid privateKey argument")
+	}
+
+	credential, err := vc.ParseEnvelopedCredential(badge.EnvelopedCredential)
+	if err != nil {
+		return nil, errutil.Err(err, "unable to parse the badge")
+	}
+
+	credential.Status = append(credential.Status, &vctypes.CredentialStatus{
+		ID:        credential.ID,
+		Type:      revocationStatusType,
+		CreatedAt: time.Now().UTC(),
+		Purpose:   vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
+	})
+
+	envelopedCredential, err := resignBadge(badge, credential, privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	client, proof, err := s.authenticate(ctx, vaultId, keyId, issuerId, metadataId, identityNodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.RevokeVerifiableCredential(envelopedCredential, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked := internalIssuerTypes.Badge{
+		Id:                  badge.Id,
+		EnvelopedCredential: envelopedCredential,
+		Revoked:             true,
+	}
+
+	_, err = s.badgeRepository.AddBadge(vaultId, keyId, issuerId, metadataId, &revoked)
+	if err != nil {
+		return nil, err
+	}
+
+	return &revoked, nil
+}
+
+// resignBadge signs the credential of the badge again with the same envelope,
+// SD-JWT badges keep the holder key they were bound to
+func resignBadge(
+	badge *internalIssuerTypes.Badge,
+	credential *vctypes.VerifiableCredential,
+	privateKey *jwk.Jwk,
+) (*vctypes.EnvelopedCredential, error) {
+	var (
+		holderKey *jwk.Jwk
+		err       error
+	)
+
+	if badge.EnvelopedCredential.EnvelopeType == vctypes.CREDENTIAL_ENVELOPE_TYPE_SD_JWT {
+		holderKey, err = sdjwt.ConfirmationKey(badge.EnvelopedCredential)
+		if err != nil {
+			return nil, errutil.Err(err, "unable to parse the badge")
+		}
+	}
+
+	credential.Proof = nil
+
+	envelopedCredential, err := signBadge(
+		credential,
+		privateKey,
+		badge.EnvelopedCredential.EnvelopeType,
+		holderKey,
+	)
+	if err != nil {
+		return nil, errutil.Err(err, "unable to sign the badge")
+	}
+
+	return envelopedCredential, nil
+}
+
+// authenticate issues the proof of the metadata and returns
+// a client of the Identity node the proof is sent to
+func (s *badgeService) authenticate(
+	ctx context.Context,
+	vaultId string,
+	keyId string,
+	issuerId string,
+	metadataId string,
+	identityNodeURL *string,
+) (nodeapi.NodeClient, *vctypes.Proof, error) {
+	issuer, err := s.issuerRepository.GetIssuer(vaultId, keyId, issuerId)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	md, err := s.metadataRepository.GetMetadata(vaultId, keyId, issuerId, metadataId)
 	if err != nil {
-		return nil, errutil.Err(err, "unable to fetch the metadata")
+		return nil, nil, errutil.Err(err, "unable to fetch the metadata")
+	}
+
+	// The proof is bound to the Identity node it is sent to
+	iNodeURL := issuer.IdentityNodeURL
+	if identityNodeURL != nil && *identityNodeURL != "" {
+		iNodeURL = *identityNodeURL
 	}
 
 	token, err := s.authClient.Authenticate(
@@ -158,9 +430,10 @@ func (s *badgeService) PublishBadge(
 		issuer,
 		auth.WithIdpIssuing(md.IdpConfig),
 		auth.WithSelfIssuing(vaultId, keyId, strings.TrimPrefix(md.ID, "AGNTCY-")),
+		auth.WithAudience(iNodeURL),
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	proof := vctypes.Proof{
@@ -168,22 +441,12 @@ func (s *badgeService) PublishBadge(
 		ProofValue: token,
 	}
 
-	iNodeURL := issuer.IdentityNodeURL
-	if identityNodeURL != nil && *identityNodeURL != "" {
-		iNodeURL = *identityNodeURL
-	}
-
 	client, err := s.nodeClientPrv.New(iNodeURL)
 	if err != nil {
-		return nil, err
-	}
-
-	err = client.PublishVerifiableCredential(badge.EnvelopedCredential, &proof)
-	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return badge, nil
+	return client, &proof, nil
 }
 
 func (s *badgeService) GetAllBadges(
@@ -163,3 +163,49 @@ func (r *issuerFilesystemRepository) RemoveIssuer(vaultId, keyId, issuerId strin
 
 	return nil
 }
+
+// MoveIssuers moves the issuers of a key, with their metadata and badges,
+// to another key of the same vault
+func (r *issuerFilesystemRepository) MoveIssuers(vaultId, keyId, newKeyId string) error {
+	issuersDir, err := getIssuersDirectory(vaultId, keyId)
+	if err != nil {
+		return err
+	}
+
+	newIssuersDir, err := getIssuersDirectory(vaultId, newKeyId)
+	if err != nil {
+		return err
+	}
+
+	// Check if the new key already has issuers
+	if _, err := os.Stat(newIssuersDir); err == nil {
+		return errors.New("the new key already has issuers")
+	}
+
+	return os.Rename(issuersDir, newIssuersDir)
+}
+
+// MoveIssuer moves an issuer of a key, with its metadata and badges,
+// to another key of the same vault
+func (r *issuerFilesystemRepository) MoveIssuer(vaultId, keyId, newKeyId, issuerId string) error {
+	issuerDir, err := GetIssuerIdDirectory(vaultId, keyId, issuerId)
+	if err != nil {
+		return err
+	}
+
+	newIssuerDir, err := GetIssuerIdDirectory(vaultId, newKeyId, issuerId)
+	if err != nil {
+		return err
+	}
+
+	// Check if the issuer already exists for the new key
+	if _, err := os.Stat(newIssuerDir); err == nil {
+		return errors.New("the issuer already exists for the new key")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(newIssuerDir), internalIssuerConstants.DirPerm); err != nil {
+		return err
+	}
+
+	return os.Rename(issuerDir, newIssuerDir)
+}
@@ -12,4 +12,6 @@ type IssuerRepository interface {
 	GetAllIssuers(vaultId, keyId string) ([]*types.Issuer, error)
 	GetIssuer(vaultId, keyId, issuerId string) (*types.Issuer, error)
 	RemoveIssuer(vaultId, keyId, issuerId string) error
+	MoveIssuers(vaultId, keyId, newKeyId string) error
+	MoveIssuer(vaultId, keyId, newKeyId, issuerId string) error
 }
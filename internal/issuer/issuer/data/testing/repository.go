@@ -35,3 +35,11 @@ func (i *FakeIssuerRepository) GetIssuer(vaultId, keyId, issuerId string) (*type
 func (i *FakeIssuerRepository) RemoveIssuer(vaultId, keyId, issuerId string) error {
 	return nil
 }
+
+func (i *FakeIssuerRepository) MoveIssuers(vaultId, keyId, newKeyId string) error {
+	return nil
+}
+
+func (i *FakeIssuerRepository) MoveIssuer(vaultId, keyId, newKeyId, issuerId string) error {
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/agntcy/identity/internal/issuer/issuer/data"
 	"github.com/agntcy/identity/internal/issuer/issuer/types"
 	"github.com/agntcy/identity/internal/pkg/nodeapi"
+	"github.com/agntcy/identity/pkg/jwk"
 )
 
 type IssuerService interface {
@@ -18,6 +19,15 @@ type IssuerService interface {
 	GetAllIssuers(vaultId, keyId string) ([]*types.Issuer, error)
 	GetIssuer(vaultId, keyId, issuerId string) (*types.Issuer, error)
 	ForgetIssuer(vaultId, keyId, issuerId string) error
+	RotateKey(
+		ctx context.Context,
+		vaultId, keyId string,
+		issuer *types.Issuer,
+		publicKey *jwk.Jwk,
+		retireImmediately bool,
+	) error
+	MoveIssuers(vaultId, keyId, newKeyId string) error
+	MoveIssuer(vaultId, keyId, newKeyId, issuerId string) error
 }
 
 type issuerService struct {
@@ -48,6 +58,7 @@ func (s *issuerService) RegisterIssuer(
 		issuer,
 		auth.WithIdpIssuing(issuer.IdpConfig),
 		auth.WithSelfIssuing(vaultId, keyId, issuer.ID),
+		auth.WithAudience(issuer.IdentityNodeURL),
 	)
 	if err != nil {
 		return "", err
@@ -102,3 +113,69 @@ func (s *issuerService) ForgetIssuer(vaultId, keyId, issuerId string) error {
 
 	return nil
 }
+
+// RotateKey replaces the key of the issuer in the Identity node with the public key,
+// the request is authenticated with a token signed by the current key
+// and bound to the new key
+func (s *issuerService) RotateKey(
+	ctx context.Context,
+	vaultId, keyId string,
+	issuer *types.Issuer,
+	publicKey *jwk.Jwk,
+	retireImmediately bool,
+) error {
+	token, err := s.authClient.KeyRotationToken(
+		ctx,
+		issuer,
+		vaultId,
+		keyId,
+		issuer.ID,
+		issuer.IdentityNodeURL,
+		publicKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	proof := vctypes.Proof{
+		Type:       "JWT",
+		ProofValue: token,
+	}
+
+	client, err := s.nodeClientPrv.New(issuer.IdentityNodeURL)
+	if err != nil {
+		return err
+	}
+
+	err = client.RotateIssuerKey(ctx, issuer.CommonName, publicKey, &proof, retireImmediately)
+	if err != nil {
+		return err
+	}
+
+	issuer.PublicKey = publicKey.PublicKey()
+
+	_, err = s.issuerRepository.AddIssuer(vaultId, keyId, issuer)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *issuerService) MoveIssuers(vaultId, keyId, newKeyId string) error {
+	err := s.issuerRepository.MoveIssuers(vaultId, keyId, newKeyId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *issuerService) MoveIssuer(vaultId, keyId, newKeyId, issuerId string) error {
+	err := s.issuerRepository.MoveIssuer(vaultId, keyId, newKeyId, issuerId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
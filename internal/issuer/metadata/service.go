@@ -59,11 +59,18 @@ func (s *metadataService) GenerateMetadata(
 		return "", err
 	}
 
+	// The proof is bound to the Identity node it is sent to
+	iNodeURL := issuer.IdentityNodeURL
+	if identityNodeURL != nil && *identityNodeURL != "" {
+		iNodeURL = *identityNodeURL
+	}
+
 	token, err := s.authClient.Authenticate(
 		ctx,
 		issuer,
 		auth.WithIdpIssuing(idpConfig),
 		auth.WithSelfIssuing(vaultId, keyId, ""),
+		auth.WithAudience(iNodeURL),
 	)
 	if err != nil {
 		return "", err
@@ -74,11 +81,6 @@ func (s *metadataService) GenerateMetadata(
 		ProofValue: token,
 	}
 
-	iNodeURL := issuer.IdentityNodeURL
-	if identityNodeURL != nil && *identityNodeURL != "" {
-		iNodeURL = *identityNodeURL
-	}
-
 	client, err := s.nodeClientPrv.New(iNodeURL)
 	if err != nil {
 		return "", err
@@ -13,4 +13,7 @@ type Badge struct {
 
 	// The verifiable credential
 	EnvelopedCredential *vctypes.EnvelopedCredential `json:"badge,omitempty"`
+
+	// Whether the badge has been revoked
+	Revoked bool `json:"revoked,omitempty"`
 }
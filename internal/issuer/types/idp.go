@@ -10,4 +10,14 @@ type IdpConfig struct {
 	ClientSecret string `json:"client_secret,omitempty"`
 	// The issuer url of the identity provider
 	IssuerUrl string `json:"issuer_url,omitempty"`
+	// The authentication of the client to the identity provider:
+	// client_secret, private_key_jwt or tls_client_auth, client_secret when empty
+	AuthMethod string `json:"auth_method,omitempty"`
+	// The ID of the key of the vault signing the client assertions of private_key_jwt,
+	// a key dedicated to the client, distinct from the key of the issuer
+	ClientKeyId string `json:"client_key_id,omitempty"`
+	// The PEM file of the client certificate of tls_client_auth
+	ClientCertFile string `json:"client_cert_file,omitempty"`
+	// The PEM file of the private key of the client certificate of tls_client_auth
+	ClientKeyFile string `json:"client_key_file,omitempty"`
 }
@@ -5,14 +5,13 @@ package verify
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"time"
 
-	"github.com/agntcy/identity/internal/core/vc/jose"
+	vccore "github.com/agntcy/identity/internal/core/vc"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
 	"github.com/agntcy/identity/internal/pkg/nodeapi"
 	jwktype "github.com/agntcy/identity/pkg/jwk"
-	"github.com/lestrrat-go/jwx/v3/jws"
 )
 
 type VerifyService interface {
@@ -20,6 +19,7 @@ type VerifyService interface {
 		ctx context.Context,
 		credential *vctypes.EnvelopedCredential,
 		identityNodeURL string,
+		checkInclusion bool,
 	) (*vctypes.VerifiableCredential, error)
 }
 
@@ -39,63 +39,74 @@ func (v *verifyService) VerifyCredential(
 	ctx context.Context,
 	credential *vctypes.EnvelopedCredential,
 	identityNodeURL string,
+	checkInclusion bool,
 ) (*vctypes.VerifiableCredential, error) {
-	nodeClientPrv := nodeapi.NewNodeClientProvider()
-
-	client, err := nodeClientPrv.New(identityNodeURL)
+	client, err := v.nodeClientPrv.New(identityNodeURL)
 	if err != nil {
 		return nil, err
 	}
 
+	var validatedVC *vctypes.VerifiableCredential
+
 	switch credential.EnvelopeType {
-	case vctypes.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF:
-		return nil, fmt.Errorf("badge verification is not supported for embedded proof badges yet")
-	case vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE:
-		// Decode the JWT
-		raw, err := jws.Parse([]byte(credential.Value))
+	case vctypes.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF,
+		vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE,
+		vctypes.CREDENTIAL_ENVELOPE_TYPE_COSE,
+		vctypes.CREDENTIAL_ENVELOPE_TYPE_SD_JWT:
+		validatedVC, err = vccore.ParseEnvelopedCredential(credential)
 		if err != nil {
-			return nil, fmt.Errorf("error parsing JWT: %w", err)
+			return nil, fmt.Errorf("error parsing badge: %w", err)
 		}
+	default:
+		return nil, fmt.Errorf("unsupported badge envelope type: %s", credential.EnvelopeType)
+	}
 
-		var validatedVC vctypes.VerifiableCredential
+	claims := &vctypes.BadgeClaims{}
 
-		err = json.Unmarshal(raw.Payload(), &validatedVC)
-		if err != nil {
-			return nil, fmt.Errorf("error unmarshaling JWT payload: %w", err)
-		}
+	err = claims.FromMap(validatedVC.CredentialSubject)
+	if err != nil {
+		return nil, err
+	}
 
-		claims := &vctypes.BadgeClaims{}
+	// Resolve the Resolver Metadata ID to get the public key
+	resolvedMetadata, err := client.ResolveMetadataByID(ctx, claims.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving Resolver Metadata ID: %w", err)
+	}
 
-		err = claims.FromMap(validatedVC.CredentialSubject)
-		if err != nil {
-			return nil, err
-		}
+	// convert resolvedMetadata.VerificationMethods to JWKs
+	var jwks jwktype.Jwks
+	for _, vm := range resolvedMetadata.VerificationMethod {
+		jwks.Keys = append(jwks.Keys, vm.PublicKeyJwk)
+	}
 
-		// Resolve the Resolver Metadata ID to get the public key
-		resolvedMetadata, err := client.ResolveMetadataByID(ctx, claims.ID)
-		if err != nil {
-			return nil, fmt.Errorf("error resolving Resolver Metadata ID: %w", err)
-		}
+	// Verify the badge using the Resolver Metadata public key
+	err = vccore.VerifyEnvelopedCredential(credential, &jwks, false)
+	if err != nil {
+		return nil, fmt.Errorf("error verifying badge: %w", err)
+	}
 
-		// convert resolvedMetadata.VerificationMethods to JWKs
-		var jwks jwktype.Jwks
-		for _, vm := range resolvedMetadata.VerificationMethod {
-			jwks.Keys = append(jwks.Keys, vm.PublicKeyJwk)
-		}
+	err = validatedVC.ValidateValidityPeriod(time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error verifying badge validity period: %w", err)
+	}
 
-		// Verify the badge using the Resolver Metadata public key
-		parsedVC, err := jose.VerifyAndParse(&jwks, credential)
-		if err != nil {
-			return nil, fmt.Errorf("error verifying badge: %w", err)
-		}
+	err = validatedVC.ValidateStatus()
+	if err != nil {
+		return nil, fmt.Errorf("error verifying badge status: %w", err)
+	}
+
+	err = validateStatusLists(ctx, validatedVC)
+	if err != nil {
+		return nil, fmt.Errorf("error verifying badge status: %w", err)
+	}
 
-		err = parsedVC.ValidateStatus()
+	if checkInclusion {
+		err = validateInclusion(ctx, client, credential, validatedVC)
 		if err != nil {
-			return nil, fmt.Errorf("error verifying badge status: %w", err)
+			return nil, fmt.Errorf("error verifying badge inclusion in the transparency log: %w", err)
 		}
-
-		return parsedVC, nil
-	default:
-		return nil, fmt.Errorf("unsupported badge envelope type: %s", credential.EnvelopeType)
 	}
+
+	return validatedVC, nil
 }
@@ -0,0 +1,155 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package verify
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/vc/jose"
+	"github.com/agntcy/identity/internal/core/vc/statuslist"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/pkg/httputil"
+	jwktype "github.com/agntcy/identity/pkg/jwk"
+)
+
+const (
+	statusListCacheDirPerm  = 0o700
+	statusListCacheFilePerm = 0o600
+)
+
+// The response of the status list well-known endpoint
+type statusListWellKnown struct {
+	Jwks *jwktype.Jwks `json:"jwks"`
+}
+
+// validateStatusLists checks the status list entries of the credential
+// against the status list credentials published by the Node.
+// The status lists are cached locally until they expire.
+func validateStatusLists(ctx context.Context, credential *vctypes.VerifiableCredential) error {
+	for _, status := range credential.Status {
+		if !status.IsStatusListEntry() {
+			continue
+		}
+
+		statusList, err := getStatusList(ctx, status.StatusListCredential)
+		if err != nil {
+			return fmt.Errorf("error fetching the status list: %w", err)
+		}
+
+		set, err := statuslist.IsSet(statusList, status)
+		if err != nil {
+			return err
+		}
+
+		if !set {
+			continue
+		}
+
+		switch status.Purpose {
+		case vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION:
+			return errors.New("the badge is revoked")
+		case vctypes.CREDENTIAL_STATUS_PURPOSE_SUSPENSION:
+			return errors.New("the badge is suspended")
+		default:
+		}
+	}
+
+	return nil
+}
+
+func getStatusList(
+	ctx context.Context,
+	credentialURL string,
+) (*vctypes.VerifiableCredential, error) {
+	cachePath, err := statusListCachePath(credentialURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if credential, err := readCachedStatusList(cachePath); err == nil {
+		return credential, nil
+	}
+
+	nodeURL, _, _, err := statuslist.ParseCredentialURL(credentialURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var wellKnown statusListWellKnown
+
+	err = httputil.GetJSON(ctx, statuslist.JwksURL(nodeURL), &wellKnown)
+	if err != nil {
+		return nil, err
+	}
+
+	if wellKnown.Jwks == nil {
+		return nil, errors.New("the node did not return the status list keys")
+	}
+
+	var envelope vctypes.EnvelopedCredential
+
+	err = httputil.GetJSON(ctx, credentialURL, &envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := jose.VerifyAndParse(wellKnown.Jwks, &envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(credential)
+	if err == nil {
+		_ = os.WriteFile(cachePath, raw, statusListCacheFilePerm)
+	}
+
+	return credential, nil
+}
+
+func readCachedStatusList(cachePath string) (*vctypes.VerifiableCredential, error) {
+	raw, err := os.ReadFile(cachePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var credential vctypes.VerifiableCredential
+
+	err = json.Unmarshal(raw, &credential)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, credential.ExpirationDate)
+	if err != nil || time.Now().After(expiresAt) {
+		return nil, errors.New("the cached status list has expired")
+	}
+
+	return &credential, nil
+}
+
+func statusListCachePath(credentialURL string) (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(homeDir, ".identity", "status_lists")
+
+	err = os.MkdirAll(dir, statusListCacheDirPerm)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(credentialURL))
+
+	return filepath.Join(dir, hex.EncodeToString(sum[:])+".json"), nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package verify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/agntcy/identity/internal/core/translog"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/pkg/nodeapi"
+)
+
+// validateInclusion checks that the publication of the credential
+// is recorded in the transparency log of the Node.
+// The leaf is computed locally from the credential, the audit path
+// is verified against the tree head signed by the Node.
+func validateInclusion(
+	ctx context.Context,
+	client nodeapi.NodeClient,
+	envelope *vctypes.EnvelopedCredential,
+	credential *vctypes.VerifiableCredential,
+) error {
+	leafHash, err := translog.NewEntry(
+		translog.EntryTypeVcPublish,
+		credential.ID,
+		[]byte(envelope.Value),
+	).LeafHash()
+	if err != nil {
+		return err
+	}
+
+	proof, err := client.GetInclusionProof(ctx, leafHash)
+	if err != nil {
+		return fmt.Errorf("error fetching the inclusion proof: %w", err)
+	}
+
+	jwks, err := client.GetTransparencyLogJwks(ctx)
+	if err != nil {
+		return fmt.Errorf("error fetching the transparency log keys: %w", err)
+	}
+
+	err = proof.SignedTreeHead.VerifySignature(jwks)
+	if err != nil {
+		return err
+	}
+
+	return proof.Verify()
+}
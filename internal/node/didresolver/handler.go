@@ -0,0 +1,172 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package didresolver
+
+import (
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"strings"
+
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/core/id/did"
+	"github.com/agntcy/identity/internal/node"
+	"github.com/agntcy/identity/pkg/log"
+)
+
+// IdentifiersPath is the path of the DID resolution endpoint,
+// compatible with the drivers of the Universal Resolver
+const IdentifiersPath = "/1.0/identifiers/"
+
+// The representations of the DID resolution endpoint
+const (
+	MediaTypeDIDLdJSON     = "application/did+ld+json"
+	MediaTypeDIDJSON       = "application/did+json"
+	MediaTypeResolution    = `application/ld+json;profile="https://w3id.org/did-resolution"`
+	mediaTypeLdJSON        = "application/ld+json"
+	mediaTypeJSON          = "application/json"
+	mediaTypeAny           = "*/*"
+	mediaTypeAnyDocument   = "application/*"
+	didResolutionProfile   = "https://w3id.org/did-resolution"
+	didResolutionMediaType = "application/did-resolution"
+)
+
+// The error codes of the DID resolution metadata
+const (
+	errorInvalidDID                 = "invalidDid"
+	errorNotFound                   = "notFound"
+	errorMethodNotSupported         = "methodNotSupported"
+	errorRepresentationNotSupported = "representationNotSupported"
+	errorInternal                   = "internalError"
+)
+
+type handler struct {
+	idService node.IdService
+}
+
+// NewHandler returns an HTTP handler resolving DIDs into DID Documents.
+// The handler serves GET requests on IdentifiersPath followed by the DID.
+func NewHandler(idService node.IdService) http.Handler {
+	return &handler{
+		idService: idService,
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	// The DID is taken from the escaped path to keep its percent-encoded characters
+	rawDID := strings.TrimPrefix(r.URL.EscapedPath(), IdentifiersPath)
+
+	mediaType, ok := negotiate(r.Header.Get("Accept"))
+	if !ok {
+		writeError(w, http.StatusNotAcceptable, errorRepresentationNotSupported)
+		return
+	}
+
+	id, err := did.ToID(rawDID)
+	if err != nil {
+		if errors.Is(err, did.ErrMethodNotSupported) {
+			writeError(w, http.StatusNotImplemented, errorMethodNotSupported)
+		} else {
+			writeError(w, http.StatusBadRequest, errorInvalidDID)
+		}
+
+		return
+	}
+
+	md, err := h.idService.Resolve(r.Context(), id)
+	if err != nil {
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_RESOLVER_METADATA_NOT_FOUND) {
+			writeError(w, http.StatusNotFound, errorNotFound)
+		} else {
+			log.Error("unable to resolve the DID ", rawDID, ": ", err)
+			writeError(w, http.StatusInternalServerError, errorInternal)
+		}
+
+		return
+	}
+
+	doc := did.NewDocument(md)
+
+	// A deactivated DID is resolved with the Gone status
+	status := http.StatusOK
+	if md.Deactivated {
+		status = http.StatusGone
+	}
+
+	switch mediaType {
+	case MediaTypeDIDLdJSON:
+		writeJSON(w, status, MediaTypeDIDLdJSON, doc)
+	case MediaTypeDIDJSON:
+		// The JSON representation has no JSON-LD context
+		doc.Context = nil
+		writeJSON(w, status, MediaTypeDIDJSON, doc)
+	default:
+		writeJSON(w, status, MediaTypeResolution, &did.ResolutionResult{
+			Context:     did.ContextDIDResolution,
+			DIDDocument: doc,
+			DIDResolutionMetadata: &did.ResolutionMetadata{
+				ContentType: MediaTypeDIDLdJSON,
+			},
+			DIDDocumentMetadata: &did.DocumentMetadata{
+				Deactivated: md.Deactivated,
+			},
+		})
+	}
+}
+
+// negotiate returns the representation matching the Accept header,
+// the media ranges are considered in the order they are listed
+func negotiate(accept string) (string, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return MediaTypeResolution, true
+	}
+
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+
+		switch mediaType {
+		case MediaTypeDIDLdJSON, MediaTypeDIDJSON:
+			return mediaType, true
+		case mediaTypeLdJSON:
+			if params["profile"] == "" || params["profile"] == didResolutionProfile {
+				return MediaTypeResolution, true
+			}
+		case didResolutionMediaType, mediaTypeJSON, mediaTypeAny, mediaTypeAnyDocument:
+			return MediaTypeResolution, true
+		}
+	}
+
+	return "", false
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	writeJSON(w, status, MediaTypeResolution, &did.ResolutionResult{
+		Context: did.ContextDIDResolution,
+		DIDResolutionMetadata: &did.ResolutionMetadata{
+			Error: code,
+		},
+		DIDDocumentMetadata: &did.DocumentMetadata{},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, contentType string, body any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+
+	err := json.NewEncoder(w).Encode(body)
+	if err != nil {
+		log.Error("unable to write the DID resolution response: ", err)
+	}
+}
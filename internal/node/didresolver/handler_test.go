@@ -0,0 +1,147 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package didresolver_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/agntcy/identity/internal/core/id/did"
+	idtesting "github.com/agntcy/identity/internal/core/id/testing"
+	idtypes "github.com/agntcy/identity/internal/core/id/types"
+	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
+	"github.com/agntcy/identity/internal/node"
+	"github.com/agntcy/identity/internal/node/didresolver"
+	dbtesting "github.com/agntcy/identity/pkg/db/testing"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testID        = "AGNTCY-test"
+	deactivatedID = "AGNTCY-deactivated"
+)
+
+func setupHandler(t *testing.T) http.Handler {
+	t.Helper()
+
+	key, err := joseutil.GenerateJWK("ES256", "sig", "key-1")
+	assert.NoError(t, err)
+
+	idRepo := idtesting.NewFakeIdRepository()
+	issuer := &issuertypes.Issuer{CommonName: "example.com"}
+
+	_, _ = idRepo.CreateID(t.Context(), &idtypes.ResolverMetadata{
+		ID: testID,
+		VerificationMethod: []*idtypes.VerificationMethod{
+			{ID: testID + "#key-1", PublicKeyJwk: key.PublicKey()},
+		},
+		AssertionMethod: []string{testID + "#key-1"},
+	}, issuer)
+	_, _ = idRepo.CreateID(t.Context(), &idtypes.ResolverMetadata{
+		ID:          deactivatedID,
+		Deactivated: true,
+	}, issuer)
+
+	return didresolver.NewHandler(node.NewIdService(idRepo, nil, nil, nil, dbtesting.NewFakeTransactor()))
+}
+
+func resolve(t *testing.T, handler http.Handler, identifier, accept string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequestWithContext(
+		t.Context(),
+		http.MethodGet,
+		didresolver.IdentifiersPath+identifier,
+		http.NoBody,
+	)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestResolve_Should_Return_The_Resolution_Result(t *testing.T) {
+	t.Parallel()
+
+	rec := resolve(t, setupHandler(t), did.FromID(testID), "")
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, didresolver.MediaTypeResolution, rec.Header().Get("Content-Type"))
+
+	var result did.ResolutionResult
+
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, did.FromID(testID), result.DIDDocument.ID)
+	assert.Equal(t, didresolver.MediaTypeDIDLdJSON, result.DIDResolutionMetadata.ContentType)
+	assert.False(t, result.DIDDocumentMetadata.Deactivated)
+	assert.Len(t, result.DIDDocument.VerificationMethod, 1)
+}
+
+func TestResolve_Should_Negotiate_The_DID_Document_Representation(t *testing.T) {
+	t.Parallel()
+
+	handler := setupHandler(t)
+
+	for _, mediaType := range []string{didresolver.MediaTypeDIDLdJSON, didresolver.MediaTypeDIDJSON} {
+		rec := resolve(t, handler, did.FromID(testID), mediaType)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, mediaType, rec.Header().Get("Content-Type"))
+
+		var doc did.Document
+
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+		assert.Equal(t, did.FromID(testID), doc.ID)
+		assert.Equal(t, mediaType == didresolver.MediaTypeDIDLdJSON, len(doc.Context) > 0)
+	}
+
+	rec := resolve(t, handler, did.FromID(testID), "text/html")
+
+	assert.Equal(t, http.StatusNotAcceptable, rec.Code)
+}
+
+func TestResolve_Should_Return_Gone_For_Deactivated_DIDs(t *testing.T) {
+	t.Parallel()
+
+	rec := resolve(t, setupHandler(t), did.FromID(deactivatedID), "")
+
+	assert.Equal(t, http.StatusGone, rec.Code)
+
+	var result did.ResolutionResult
+
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.True(t, result.DIDDocumentMetadata.Deactivated)
+	assert.Empty(t, result.DIDDocument.VerificationMethod)
+}
+
+func TestResolve_Should_Return_Resolution_Errors(t *testing.T) {
+	t.Parallel()
+
+	handler := setupHandler(t)
+
+	tests := map[string]int{
+		did.FromID("AGNTCY-unknown"): http.StatusNotFound,
+		"did:web:example.com":        http.StatusNotImplemented,
+		"AGNTCY-test":                http.StatusBadRequest,
+	}
+
+	for identifier, status := range tests {
+		rec := resolve(t, handler, identifier, "")
+
+		assert.Equal(t, status, rec.Code, identifier)
+
+		var result did.ResolutionResult
+
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+		assert.NotEmpty(t, result.DIDResolutionMetadata.Error)
+		assert.Nil(t, result.DIDDocument)
+	}
+}
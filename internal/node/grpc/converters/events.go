@@ -0,0 +1,33 @@
+// Copyright 2025 Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package converters
+
+import (
+	nodeapi "github.com/agntcy/identity/api/server/agntcy/identity/node/v1alpha1"
+	"github.com/agntcy/identity/internal/core/vc/events"
+)
+
+var eventTypes = map[events.EventType]nodeapi.VcEventType{
+	events.EventTypePublished:  nodeapi.VcEventType_VC_EVENT_TYPE_PUBLISHED,
+	events.EventTypeRevoked:    nodeapi.VcEventType_VC_EVENT_TYPE_REVOKED,
+	events.EventTypeSuspended:  nodeapi.VcEventType_VC_EVENT_TYPE_SUSPENDED,
+	events.EventTypeReinstated: nodeapi.VcEventType_VC_EVENT_TYPE_REINSTATED,
+}
+
+func FromVcEvent(src *events.Event) *nodeapi.VcEvent {
+	if src == nil {
+		return nil
+	}
+
+	return &nodeapi.VcEvent{
+		Id:                 src.ID,
+		Type:               eventTypes[src.Type],
+		VcId:               src.VcID,
+		Issuer:             src.Issuer,
+		ResolverMetadataId: src.ResolverMetadataID,
+		Timestamp:          src.Timestamp.UnixMilli(),
+		Vc:                 FromEnvelopedCredential(src.Credential),
+		Sequence:           src.Sequence,
+	}
+}
@@ -16,7 +16,7 @@ func FromResolverMetadata(src *idtypes.ResolverMetadata) *coreapi.ResolverMetada
 		return nil
 	}
 
-	return &coreapi.ResolverMetadata{
+	md := &coreapi.ResolverMetadata{
 		Id:              ptrutil.Ptr(src.ID),
 		AssertionMethod: src.AssertionMethod,
 		VerificationMethod: convertutil.ConvertSlice(
@@ -28,6 +28,32 @@ func FromResolverMetadata(src *idtypes.ResolverMetadata) *coreapi.ResolverMetada
 			FromService,
 		),
 	}
+
+	if src.Deactivated {
+		md.Deactivated = ptrutil.Ptr(true)
+	}
+
+	return md
+}
+
+func ToResolverMetadata(src *coreapi.ResolverMetadata) *idtypes.ResolverMetadata {
+	if src == nil {
+		return nil
+	}
+
+	return &idtypes.ResolverMetadata{
+		ID:              ptrutil.DerefStr(src.Id),
+		AssertionMethod: src.AssertionMethod,
+		VerificationMethod: convertutil.ConvertSlice(
+			src.VerificationMethod,
+			ToVerificationMethod,
+		),
+		Service: convertutil.ConvertSlice(
+			src.Service,
+			ToService,
+		),
+		Controller: ptrutil.DerefStr(src.Controller),
+	}
 }
 
 func FromVerificationMethod(src *idtypes.VerificationMethod) *coreapi.VerificationMethod {
@@ -41,6 +67,17 @@ func FromVerificationMethod(src *idtypes.VerificationMethod) *coreapi.Verificati
 	}
 }
 
+func ToVerificationMethod(src *coreapi.VerificationMethod) *idtypes.VerificationMethod {
+	if src == nil {
+		return nil
+	}
+
+	return &idtypes.VerificationMethod{
+		ID:           ptrutil.DerefStr(src.Id),
+		PublicKeyJwk: ToJwk(src.PublicKeyJwk),
+	}
+}
+
 func FromService(src *idtypes.Service) *coreapi.Service {
 	if src == nil {
 		return nil
@@ -51,6 +88,16 @@ func FromService(src *idtypes.Service) *coreapi.Service {
 	}
 }
 
+func ToService(src *coreapi.Service) *idtypes.Service {
+	if src == nil {
+		return nil
+	}
+
+	return &idtypes.Service{
+		ServiceEndpoint: src.ServiceEndpoint,
+	}
+}
+
 func FromJwk(src *jwk.Jwk) *coreapi.Jwk {
 	if src == nil {
 		return nil
@@ -72,6 +119,9 @@ func FromJwk(src *jwk.Jwk) *coreapi.Jwk {
 		Dp:   ptrutil.Ptr(src.DP),
 		Dq:   ptrutil.Ptr(src.DQ),
 		Qi:   ptrutil.Ptr(src.QI),
+		Crv:  ptrutil.Ptr(src.CRV),
+		X:    ptrutil.Ptr(src.X),
+		Y:    ptrutil.Ptr(src.Y),
 	}
 }
 
@@ -96,6 +146,9 @@ func ToJwk(src *coreapi.Jwk) *jwk.Jwk {
 		DP:   ptrutil.DerefStr(src.Dp),
 		DQ:   ptrutil.DerefStr(src.Dq),
 		QI:   ptrutil.DerefStr(src.Qi),
+		CRV:  ptrutil.DerefStr(src.Crv),
+		X:    ptrutil.DerefStr(src.X),
+		Y:    ptrutil.DerefStr(src.Y),
 	}
 }
 
@@ -0,0 +1,36 @@
+// Copyright 2025 Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package converters
+
+import (
+	nodeapi "github.com/agntcy/identity/api/server/agntcy/identity/node/v1alpha1"
+	"github.com/agntcy/identity/internal/core/translog"
+)
+
+func FromSignedTreeHead(src *translog.SignedTreeHead) *nodeapi.SignedTreeHead {
+	if src == nil {
+		return nil
+	}
+
+	return &nodeapi.SignedTreeHead{
+		TreeSize:  src.TreeSize,
+		RootHash:  src.RootHash,
+		Timestamp: src.Timestamp,
+		Signature: src.Signature,
+	}
+}
+
+func FromLeaf(src *translog.Leaf) *nodeapi.LogLeaf {
+	if src == nil || src.Entry == nil {
+		return nil
+	}
+
+	return &nodeapi.LogLeaf{
+		Index:   src.Index,
+		Type:    string(src.Entry.Type),
+		Subject: src.Entry.Subject,
+		Digest:  src.Entry.Digest,
+		Hash:    src.Hash,
+	}
+}
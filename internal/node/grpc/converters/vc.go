@@ -136,6 +136,8 @@ func FromVerifiableCredential(src *vctypes.VerifiableCredential) *coreapi.Verifi
 		),
 		CredentialStatus: convertutil.ConvertSlice(src.Status, FromCredentialStatus),
 		Proof:            FromProof(src.Proof),
+		ValidFrom:        ptrutil.Ptr(src.ValidFrom),
+		ValidUntil:       ptrutil.Ptr(src.ValidUntil),
 	}
 }
 
@@ -156,7 +158,9 @@ func ToVerifiableCredential(src *coreapi.VerifiableCredential) *vctypes.Verifiab
 			src.CredentialSchema,
 			ToCredentialSchema,
 		),
-		Proof: ToProof(src.Proof),
+		Proof:      ToProof(src.Proof),
+		ValidFrom:  ptrutil.DerefStr(src.ValidFrom),
+		ValidUntil: ptrutil.DerefStr(src.ValidUntil),
 	}
 }
 
@@ -180,14 +184,52 @@ func FromVerificationResult(src *vctypes.VerificationResult) *coreapi.Verificati
 	}
 }
 
+func FromVerifiablePresentation(src *vctypes.VerifiablePresentation) *coreapi.VerifiablePresentation {
+	if src == nil {
+		return nil
+	}
+
+	return &coreapi.VerifiablePresentation{
+		Context: src.Context,
+		Type:    src.Type,
+		VerifiableCredential: convertutil.ConvertSlice(
+			src.VerifiableCredential,
+			func(vc vctypes.VerifiableCredential) *coreapi.VerifiableCredential {
+				return FromVerifiableCredential(&vc)
+			},
+		),
+		Proof:  FromProof(src.Proof),
+		Holder: ptrutil.Ptr(src.Holder),
+	}
+}
+
+func FromPresentationVerificationResult(
+	src *vctypes.PresentationVerificationResult,
+) *coreapi.PresentationVerificationResult {
+	if src == nil {
+		return nil
+	}
+
+	return &coreapi.PresentationVerificationResult{
+		Status:   ptrutil.Ptr(src.Status),
+		Document: FromVerifiablePresentation(src.Document),
+		Results:  convertutil.ConvertSlice(src.Results, FromVerificationResult),
+		Errors: convertutil.ConvertSlice(src.Errors, func(err errtypes.ErrorInfo) *coreapi.ErrorInfo {
+			return FromErrorInfo(&err)
+		}),
+	}
+}
+
 func FromCredentialStatus(src *vctypes.CredentialStatus) *coreapi.CredentialStatus {
 	if src == nil {
 		return nil
 	}
 
 	return &coreapi.CredentialStatus{
-		Id:      ptrutil.Ptr(src.ID),
-		Type:    ptrutil.Ptr(src.Type),
-		Purpose: ptrutil.Ptr(coreapi.CredentialStatusPurpose(src.Purpose)),
+		Id:                   ptrutil.Ptr(src.ID),
+		Type:                 ptrutil.Ptr(src.Type),
+		Purpose:              ptrutil.Ptr(coreapi.CredentialStatusPurpose(src.Purpose)),
+		StatusListIndex:      ptrutil.Ptr(src.StatusListIndex),
+		StatusListCredential: ptrutil.Ptr(src.StatusListCredential),
 	}
 }
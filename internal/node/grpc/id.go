@@ -11,6 +11,7 @@ import (
 	"github.com/agntcy/identity/internal/node"
 	"github.com/agntcy/identity/internal/node/grpc/converters"
 	"github.com/agntcy/identity/internal/pkg/grpcutil"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type idService struct {
@@ -64,3 +65,51 @@ func (s *idService) Resolve(
 		ResolverMetadata: converters.FromResolverMetadata(md),
 	}, nil
 }
+
+// Update the verification methods and the services of the ResolverMetadata of an Id
+func (s *idService) Update(
+	ctx context.Context,
+	req *nodeapi.UpdateRequest,
+) (*nodeapi.UpdateResponse, error) {
+	md, err := s.idSrv.Update(
+		ctx,
+		converters.ToResolverMetadata(req.ResolverMetadata),
+		converters.ToProof(req.Proof),
+	)
+	if err != nil {
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INTERNAL) {
+			return nil, grpcutil.InternalError(err)
+		}
+
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_RESOLVER_METADATA_NOT_FOUND) {
+			return nil, grpcutil.NotFoundError(err)
+		}
+
+		return nil, grpcutil.BadRequestError(err)
+	}
+
+	return &nodeapi.UpdateResponse{
+		ResolverMetadata: converters.FromResolverMetadata(md),
+	}, nil
+}
+
+// Permanently deactivate an Id
+func (s *idService) Deactivate(
+	ctx context.Context,
+	req *nodeapi.DeactivateRequest,
+) (*emptypb.Empty, error) {
+	err := s.idSrv.Deactivate(ctx, req.Id, converters.ToProof(req.Proof))
+	if err != nil {
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INTERNAL) {
+			return nil, grpcutil.InternalError(err)
+		}
+
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_RESOLVER_METADATA_NOT_FOUND) {
+			return nil, grpcutil.NotFoundError(err)
+		}
+
+		return nil, grpcutil.BadRequestError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
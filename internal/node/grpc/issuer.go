@@ -7,6 +7,7 @@ import (
 	"context"
 
 	nodeapi "github.com/agntcy/identity/api/server/agntcy/identity/node/v1alpha1"
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
 	"github.com/agntcy/identity/internal/node"
 	"github.com/agntcy/identity/internal/node/grpc/converters"
 	grpcutil "github.com/agntcy/identity/internal/pkg/grpcutil"
@@ -62,3 +63,29 @@ func (i *issuerService) GetWellKnown(
 		Jwks: converters.FromJwks(jwks),
 	}, nil
 }
+
+// Rotate the key of an issuer by providing a proof signed by the current key
+// and the new public key
+func (i *issuerService) RotateKey(
+	ctx context.Context,
+	req *nodeapi.RotateIssuerKeyRequest,
+) (*nodeapi.RotateIssuerKeyResponse, error) {
+	log.Debug("RotateIssuerKey: ", req.CommonName)
+
+	err := i.nodeIssuerService.RotateKey(
+		ctx,
+		req.CommonName,
+		converters.ToJwk(req.PublicKey),
+		converters.ToProof(req.Proof),
+		req.RetireImmediately,
+	)
+	if err != nil {
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INTERNAL) {
+			return nil, grpcutil.InternalError(err)
+		}
+
+		return nil, grpcutil.BadRequestError(err)
+	}
+
+	return &nodeapi.RotateIssuerKeyResponse{}, nil
+}
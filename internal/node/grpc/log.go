@@ -0,0 +1,107 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+
+	nodeapi "github.com/agntcy/identity/api/server/agntcy/identity/node/v1alpha1"
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/node"
+	"github.com/agntcy/identity/internal/node/grpc/converters"
+	"github.com/agntcy/identity/internal/pkg/grpcutil"
+	"github.com/agntcy/identity/pkg/log"
+)
+
+type logService struct {
+	logSrv node.TransparencyLogService
+}
+
+func NewLogService(logSrv node.TransparencyLogService) nodeapi.LogServiceServer {
+	return &logService{
+		logSrv: logSrv,
+	}
+}
+
+// Returns the current tree head of the transparency log signed by the node
+func (s *logService) GetSignedTreeHead(
+	ctx context.Context,
+	req *nodeapi.GetSignedTreeHeadRequest,
+) (*nodeapi.GetSignedTreeHeadResponse, error) {
+	sth, err := s.logSrv.GetSignedTreeHead(ctx)
+	if err != nil {
+		return nil, grpcutil.InternalError(err)
+	}
+
+	return &nodeapi.GetSignedTreeHeadResponse{
+		SignedTreeHead: converters.FromSignedTreeHead(sth),
+	}, nil
+}
+
+// Returns the proof that a leaf is included in the transparency log
+func (s *logService) GetInclusionProof(
+	ctx context.Context,
+	req *nodeapi.GetInclusionProofRequest,
+) (*nodeapi.GetInclusionProofResponse, error) {
+	log.Debug("GetInclusionProof: ", req.TreeSize)
+
+	proof, err := s.logSrv.GetInclusionProof(ctx, req.LeafHash, req.TreeSize)
+	if err != nil {
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INTERNAL) {
+			return nil, grpcutil.InternalError(err)
+		}
+
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_TRANSPARENCY_LOG_ENTRY_NOT_FOUND) {
+			return nil, grpcutil.NotFoundError(err)
+		}
+
+		return nil, grpcutil.BadRequestError(err)
+	}
+
+	return &nodeapi.GetInclusionProofResponse{
+		Leaf:           converters.FromLeaf(proof.Leaf),
+		AuditPath:      proof.AuditPath,
+		SignedTreeHead: converters.FromSignedTreeHead(proof.SignedTreeHead),
+	}, nil
+}
+
+// Returns the proof that a tree of the transparency log is a prefix
+// of a larger tree
+func (s *logService) GetConsistencyProof(
+	ctx context.Context,
+	req *nodeapi.GetConsistencyProofRequest,
+) (*nodeapi.GetConsistencyProofResponse, error) {
+	log.Debug("GetConsistencyProof: ", req.FirstSize, " ", req.SecondSize)
+
+	proof, err := s.logSrv.GetConsistencyProof(ctx, req.FirstSize, req.SecondSize)
+	if err != nil {
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INTERNAL) {
+			return nil, grpcutil.InternalError(err)
+		}
+
+		return nil, grpcutil.BadRequestError(err)
+	}
+
+	return &nodeapi.GetConsistencyProofResponse{
+		FirstSize:  proof.FirstSize,
+		SecondSize: proof.SecondSize,
+		Path:       proof.Path,
+	}, nil
+}
+
+// Returns the public keys used by the node to sign the tree heads in
+// Json Web Key Set (JWKS) format
+func (s *logService) GetJwks(
+	ctx context.Context,
+	req *nodeapi.GetLogJwksRequest,
+) (*nodeapi.GetLogJwksResponse, error) {
+	jwks, err := s.logSrv.GetJwks(ctx)
+	if err != nil {
+		return nil, grpcutil.InternalError(err)
+	}
+
+	return &nodeapi.GetLogJwksResponse{
+		Jwks: converters.FromJwks(jwks),
+	}, nil
+}
@@ -5,25 +5,42 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	coreapi "github.com/agntcy/identity/api/server/agntcy/identity/core/v1alpha1"
 	nodeapi "github.com/agntcy/identity/api/server/agntcy/identity/node/v1alpha1"
 	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	vccore "github.com/agntcy/identity/internal/core/vc"
+	"github.com/agntcy/identity/internal/core/vc/events"
+	"github.com/agntcy/identity/internal/core/vc/statuslist"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
 	"github.com/agntcy/identity/internal/node"
 	"github.com/agntcy/identity/internal/node/grpc/converters"
+	"github.com/agntcy/identity/internal/pkg/convertutil"
+	"github.com/agntcy/identity/internal/pkg/errutil"
 	"github.com/agntcy/identity/internal/pkg/grpcutil"
 	"github.com/agntcy/identity/internal/pkg/ptrutil"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type vcService struct {
-	vcSrv node.VerifiableCredentialService
+	vcSrv         node.VerifiableCredentialService
+	statusListSrv node.StatusListService
+	broker        *events.Broker
 }
 
-func NewVcService(vcSrv node.VerifiableCredentialService) nodeapi.VcServiceServer {
+func NewVcService(
+	vcSrv node.VerifiableCredentialService,
+	statusListSrv node.StatusListService,
+	broker *events.Broker,
+) nodeapi.VcServiceServer {
 	return &vcService{
-		vcSrv: vcSrv,
+		vcSrv:         vcSrv,
+		statusListSrv: statusListSrv,
+		broker:        broker,
 	}
 }
 
@@ -53,7 +70,12 @@ func (s *vcService) Verify(
 	ctx context.Context,
 	req *nodeapi.VerifyRequest,
 ) (*coreapi.VerificationResult, error) {
-	result, err := s.vcSrv.Verify(ctx, converters.ToEnvelopedCredential(req.Vc))
+	result, err := s.vcSrv.Verify(
+		ctx,
+		converters.ToEnvelopedCredential(req.Vc),
+		req.Nonce,
+		req.Audience,
+	)
 	if err != nil {
 		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INTERNAL) {
 			return nil, grpcutil.InternalError(err)
@@ -65,6 +87,28 @@ func (s *vcService) Verify(
 	return converters.FromVerificationResult(result), nil
 }
 
+// Verify a Verifiable Presentation and the Verifiable Credentials it contains
+func (s *vcService) VerifyPresentation(
+	ctx context.Context,
+	req *nodeapi.VerifyPresentationRequest,
+) (*coreapi.PresentationVerificationResult, error) {
+	result, err := s.vcSrv.VerifyPresentation(
+		ctx,
+		converters.ToEnvelopedCredential(req.Vp),
+		req.Nonce,
+		req.Audience,
+	)
+	if err != nil {
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INTERNAL) {
+			return nil, grpcutil.InternalError(err)
+		}
+
+		return nil, grpcutil.BadRequestError(err)
+	}
+
+	return converters.FromPresentationVerificationResult(result), nil
+}
+
 // Returns the well-known Verifiable Credentials for the specified Id
 func (s *vcService) GetWellKnown(
 	ctx context.Context,
@@ -96,11 +140,72 @@ func (s *vcService) GetWellKnown(
 }
 
 // Search for Verifiable Credentials based on the specified criteria
-func (vcService) Search(
+func (s *vcService) Search(
 	ctx context.Context,
 	req *nodeapi.SearchRequest,
 ) (*nodeapi.SearchResponse, error) {
-	return nil, fmt.Errorf("not implemented")
+	criteria, err := toSearchCriteria(req)
+	if err != nil {
+		return nil, grpcutil.BadRequestError(err)
+	}
+
+	vcs, nextPageToken, err := s.vcSrv.Search(
+		ctx,
+		criteria,
+		req.PageToken,
+		int(req.PageSize),
+	)
+	if err != nil {
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INTERNAL) {
+			return nil, grpcutil.InternalError(err)
+		}
+
+		return nil, grpcutil.BadRequestError(err)
+	}
+
+	return &nodeapi.SearchResponse{
+		Vcs:           convertutil.ConvertSlice(vcs, converters.FromEnvelopedCredential),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func toSearchCriteria(req *nodeapi.SearchRequest) (*vccore.SearchCriteria, error) {
+	criteria := &vccore.SearchCriteria{
+		IssuerCommonName:   req.Issuer,
+		ContentType:        vctypes.CredentialContentType(req.ContentType),
+		ResolverMetadataID: req.Id,
+		Revoked:            req.Revoked,
+	}
+
+	dates := []struct {
+		name  string
+		value string
+		dst   **time.Time
+	}{
+		{"issued_after", req.IssuedAfter, &criteria.IssuedAfter},
+		{"issued_before", req.IssuedBefore, &criteria.IssuedBefore},
+		{"expires_after", req.ExpiresAfter, &criteria.ExpiresAfter},
+		{"expires_before", req.ExpiresBefore, &criteria.ExpiresBefore},
+	}
+
+	for _, date := range dates {
+		if date.value == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, date.value)
+		if err != nil {
+			return nil, errutil.ErrInfo(
+				errtypes.ERROR_REASON_INVALID_SEARCH_CRITERIA,
+				fmt.Sprintf("%s must be a RFC3339 date", date.name),
+				err,
+			)
+		}
+
+		*date.dst = &t
+	}
+
+	return criteria, nil
 }
 
 // Revoke an existing Verifiable Credential
@@ -123,3 +228,137 @@ func (s *vcService) Revoke(
 
 	return &emptypb.Empty{}, nil
 }
+
+// Suspend an existing Verifiable Credential
+func (s *vcService) Suspend(
+	ctx context.Context,
+	req *nodeapi.SuspendRequest,
+) (*emptypb.Empty, error) {
+	err := s.vcSrv.Suspend(
+		ctx,
+		converters.ToEnvelopedCredential(req.Vc),
+		converters.ToProof(req.Proof),
+	)
+	if err != nil {
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INTERNAL) {
+			return nil, grpcutil.InternalError(err)
+		}
+
+		return nil, grpcutil.BadRequestError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Reinstate a suspended Verifiable Credential
+func (s *vcService) Reinstate(
+	ctx context.Context,
+	req *nodeapi.ReinstateRequest,
+) (*emptypb.Empty, error) {
+	err := s.vcSrv.Reinstate(
+		ctx,
+		converters.ToEnvelopedCredential(req.Vc),
+		converters.ToProof(req.Proof),
+	)
+	if err != nil {
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INTERNAL) {
+			return nil, grpcutil.InternalError(err)
+		}
+
+		return nil, grpcutil.BadRequestError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Returns the signed Bitstring Status List credential of an Issuer
+func (s *vcService) GetStatusList(
+	ctx context.Context,
+	req *nodeapi.GetStatusListRequest,
+) (*coreapi.EnvelopedCredential, error) {
+	purpose, ok := statuslist.ParsePurposeName(req.Purpose)
+	if !ok {
+		return nil, grpcutil.BadRequestError(
+			fmt.Errorf("unsupported status purpose: %s", req.Purpose),
+		)
+	}
+
+	credential, err := s.statusListSrv.GetStatusList(ctx, req.Issuer, purpose)
+	if err != nil {
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INTERNAL) {
+			return nil, grpcutil.InternalError(err)
+		}
+
+		return nil, grpcutil.BadRequestError(err)
+	}
+
+	return converters.FromEnvelopedCredential(credential), nil
+}
+
+// Returns the public keys used to verify the Status List credentials
+func (s *vcService) GetStatusListWellKnown(
+	ctx context.Context,
+	req *nodeapi.GetStatusListWellKnownRequest,
+) (*nodeapi.GetStatusListWellKnownResponse, error) {
+	jwks, err := s.statusListSrv.GetJwks(ctx)
+	if err != nil {
+		return nil, grpcutil.InternalError(err)
+	}
+
+	return &nodeapi.GetStatusListWellKnownResponse{
+		Jwks: converters.FromJwks(jwks),
+	}, nil
+}
+
+// Stream the publish and status change events of the Verifiable Credentials,
+// from the stored events following the requested sequence
+func (s *vcService) Watch(
+	req *nodeapi.WatchRequest,
+	stream grpc.ServerStreamingServer[nodeapi.WatchResponse],
+) error {
+	ctx := stream.Context()
+
+	sub, err := s.broker.Subscribe(ctx, events.Filter{
+		Issuer:             req.Issuer,
+		ResolverMetadataID: req.ResolverMetadataId,
+	}, req.AfterSequence)
+	if err != nil {
+		return watchError(err)
+	}
+	defer sub.Close()
+
+	for {
+		nextEvents, err := sub.Next(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return grpcutil.InternalError(err)
+		}
+
+		for _, event := range nextEvents {
+			err = stream.Send(&nodeapi.WatchResponse{
+				Event: converters.FromVcEvent(event),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func watchError(err error) error {
+	switch {
+	case errors.Is(err, events.ErrTooManySubscribers):
+		return grpcutil.TooManyRequestsError(errutil.ErrInfo(
+			errtypes.ERROR_REASON_RATE_LIMIT_EXCEEDED,
+			err.Error(),
+			err,
+		))
+	case errors.Is(err, events.ErrEventsExpired):
+		return grpcutil.BadRequestError(err)
+	default:
+		return grpcutil.InternalError(err)
+	}
+}
@@ -16,59 +16,63 @@ import (
 	"github.com/agntcy/identity/pkg/oidc"
 )
 
-// All IDP schemes supported by the ID generator.
-// The ID generator creates IDs based on the proof and issuer information.
-const (
-	OktaScheme = "OKTA-"
-	DuoScheme  = "DUO-"
-	OryScheme  = "ORY-"
-	IdpScheme  = "IDP-"
-	SelfScheme = "AGNTCY-"
-)
+// The scheme of the IDs generated from self-issued proofs.
+// The IDs generated from the proofs of an identity provider
+// use the ID scheme of the provider in the registry.
+const SelfScheme = "AGNTCY-"
 
 type IDGenerator interface {
 	GenerateFromProof(
 		ctx context.Context,
 		proof *vctypes.Proof,
-	) (string, *issuertypes.Issuer, error)
+	) (*GeneratedID, error)
+}
+
+// GeneratedID is the ID of the subject of a proof
+type GeneratedID struct {
+	// The ID generated with the scheme of the provider
+	ID string
+
+	// The IDs previous versions of the Node generated for the subject
+	// with the legacy schemes of the provider
+	LegacyIDs []string
+
+	// The issuer of the proof
+	Issuer *issuertypes.Issuer
+}
+
+// IDs returns the ID followed by the legacy IDs of the subject
+func (g *GeneratedID) IDs() []string {
+	return append([]string{g.ID}, g.LegacyIDs...)
 }
 
 type idGenerator struct {
 	verifService issuerverification.Service
+	registry     *oidc.Registry
 }
 
 func NewIDGenerator(
 	verifService issuerverification.Service,
+	registry *oidc.Registry,
 ) IDGenerator {
 	return &idGenerator{
 		verifService: verifService,
+		registry:     registry,
 	}
 }
 
 func (g *idGenerator) GenerateFromProof(
 	ctx context.Context,
 	proof *vctypes.Proof,
-) (string, *issuertypes.Issuer, error) {
+) (*GeneratedID, error) {
 	verifRes, err := g.verifService.VerifyExistingIssuer(ctx, proof)
 	if err != nil {
-		return "", nil, err
+		return nil, err
 	}
 
-	var scheme string
-
-	switch verifRes.Provider {
-	case oidc.OktaProviderName:
-		scheme = OktaScheme
-	case oidc.DuoProviderName:
-		scheme = DuoScheme
-	case oidc.OryProviderName:
-		scheme = OryScheme
-	case oidc.IdpProviderName:
-		scheme = IdpScheme
-	case oidc.SelfProviderName:
-		scheme = SelfScheme
-	default:
-		return "", nil, errutil.ErrInfo(
+	scheme, legacySchemes, ok := g.schemes(verifRes.Provider)
+	if !ok {
+		return nil, errutil.ErrInfo(
 			errtypes.ERROR_REASON_UNKNOWN_IDP,
 			"unknown JWT provider name",
 			nil,
@@ -78,5 +82,29 @@ func (g *idGenerator) GenerateFromProof(
 	log.Debug("Issuer is verified: ", verifRes.Issuer.Verified)
 	log.Debug("JWT scheme: ", scheme)
 
-	return fmt.Sprintf("%s%s", scheme, verifRes.Subject), verifRes.Issuer, nil
+	legacyIDs := make([]string, 0, len(legacySchemes))
+	for _, legacyScheme := range legacySchemes {
+		legacyIDs = append(legacyIDs, fmt.Sprintf("%s%s", legacyScheme, verifRes.Subject))
+	}
+
+	return &GeneratedID{
+		ID:        fmt.Sprintf("%s%s", scheme, verifRes.Subject),
+		LegacyIDs: legacyIDs,
+		Issuer:    verifRes.Issuer,
+	}, nil
+}
+
+// schemes returns the prefix of the IDs generated from the proofs of the provider
+// and the prefixes previous versions of the Node used for the provider
+func (g *idGenerator) schemes(name oidc.ProviderName) (string, []string, bool) {
+	if name == oidc.SelfProviderName {
+		return SelfScheme, nil, true
+	}
+
+	provider, ok := g.registry.Get(name)
+	if !ok {
+		return "", nil, false
+	}
+
+	return provider.IDScheme, provider.LegacyIDSchemes, true
 }
@@ -5,8 +5,12 @@ package node
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	errcore "github.com/agntcy/identity/internal/core/errors"
 	errtypes "github.com/agntcy/identity/internal/core/errors/types"
@@ -14,8 +18,11 @@ import (
 	idtypes "github.com/agntcy/identity/internal/core/id/types"
 	issuercore "github.com/agntcy/identity/internal/core/issuer"
 	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
+	"github.com/agntcy/identity/internal/core/translog"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
 	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db"
+	"github.com/agntcy/identity/pkg/joseutil"
 	"github.com/agntcy/identity/pkg/log"
 	"github.com/google/uuid"
 )
@@ -30,23 +37,39 @@ type IdService interface {
 		ctx context.Context,
 		id string,
 	) (*idtypes.ResolverMetadata, error)
+	Update(
+		ctx context.Context,
+		resolverMetadata *idtypes.ResolverMetadata,
+		proof *vctypes.Proof,
+	) (*idtypes.ResolverMetadata, error)
+	Deactivate(
+		ctx context.Context,
+		id string,
+		proof *vctypes.Proof,
+	) error
 }
 
 type idService struct {
 	idRepository     idcore.IdRepository
 	issuerRepository issuercore.Repository
 	idGenerator      IDGenerator
+	transparencyLog  TransparencyLogService
+	transactor       db.Transactor
 }
 
 func NewIdService(
 	idRepository idcore.IdRepository,
 	issuerRepository issuercore.Repository,
 	idGenerator IDGenerator,
+	transparencyLog TransparencyLogService,
+	transactor db.Transactor,
 ) IdService {
 	return &idService{
 		idRepository:     idRepository,
 		issuerRepository: issuerRepository,
 		idGenerator:      idGenerator,
+		transparencyLog:  transparencyLog,
+		transactor:       transactor,
 	}
 }
 
@@ -55,14 +78,20 @@ func (s *idService) Generate(
 	issuer *issuertypes.Issuer,
 	proof *vctypes.Proof,
 ) (*idtypes.ResolverMetadata, error) {
-	id, storedIss, err := s.idGenerator.GenerateFromProof(ctx, proof)
+	generated, err := s.idGenerator.GenerateFromProof(ctx, proof)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.checkUniqueID(ctx, id)
-	if err != nil {
-		return nil, err
+	id, storedIss := generated.ID, generated.Issuer
+
+	// A subject owning an ID generated by a previous version of the Node
+	// keeps it instead of getting a second one
+	for _, existing := range generated.IDs() {
+		err = s.checkUniqueID(ctx, existing)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	log.Debug("ID generated ", id)
@@ -74,7 +103,12 @@ func (s *idService) Generate(
 
 	log.Debug("Generating a ResolverMetadata")
 
-	keyID := fmt.Sprintf("%s#%s", id, uuid.NewString())
+	verificationMethods := newVerificationMethods(id, storedIss, time.Now())
+
+	assertionMethods := make([]string, 0, len(verificationMethods))
+	for _, vm := range verificationMethods {
+		assertionMethods = append(assertionMethods, vm.ID)
+	}
 
 	services := make([]*idtypes.Service, 0)
 	if storedIss.AuthType == issuertypes.ISSUER_AUTH_TYPE_IDP {
@@ -84,32 +118,61 @@ func (s *idService) Generate(
 	}
 
 	resolverMetadata := &idtypes.ResolverMetadata{
-		ID: id,
-		VerificationMethod: []*idtypes.VerificationMethod{
-			{
-				ID:           keyID,
-				PublicKeyJwk: storedIss.PublicKey, // Should we compare issuer.PK with iss.PK?
-			},
-		},
-		AssertionMethod: []string{keyID},
-		Service:         services,
-		Controller:      issuer.CommonName,
+		ID:                 id,
+		VerificationMethod: verificationMethods,
+		AssertionMethod:    assertionMethods,
+		Service:            services,
+		Controller:         issuer.CommonName,
 	}
 
 	log.Debug("Storing the ResolverMetadata")
 
-	_, err = s.idRepository.CreateID(ctx, resolverMetadata, issuer)
+	err = s.transactor.Transaction(ctx, func(ctx context.Context) error {
+		_, err := s.idRepository.CreateID(ctx, resolverMetadata, issuer)
+		if err != nil {
+			return errutil.ErrInfo(
+				errtypes.ERROR_REASON_INTERNAL,
+				"unable to store the resolver metadata",
+				err,
+			)
+		}
+
+		return s.appendToTransparencyLog(ctx, translog.EntryTypeIdGenerate, resolverMetadata)
+	})
 	if err != nil {
-		return nil, errutil.ErrInfo(
-			errtypes.ERROR_REASON_INTERNAL,
-			"unable to store the resolver metadata",
-			err,
-		)
+		return nil, err
 	}
 
 	return resolverMetadata, nil
 }
 
+// newVerificationMethods creates a verification method for the current key
+// of the issuer and for each previous key that is not yet retired
+func newVerificationMethods(
+	id string,
+	issuer *issuertypes.Issuer,
+	now time.Time,
+) []*idtypes.VerificationMethod {
+	vms := []*idtypes.VerificationMethod{
+		{
+			ID:           fmt.Sprintf("%s#%s", id, uuid.NewString()),
+			PublicKeyJwk: issuer.PublicKey,
+		},
+	}
+
+	for _, key := range issuer.RetiredKeys {
+		if key.PublicKey != nil && key.RetiredAt.After(now) {
+			vms = append(vms, &idtypes.VerificationMethod{
+				ID:           fmt.Sprintf("%s#%s", id, uuid.NewString()),
+				PublicKeyJwk: key.PublicKey,
+				RetiredAt:    &key.RetiredAt,
+			})
+		}
+	}
+
+	return vms
+}
+
 func (s *idService) verifyIssuer(
 	input *issuertypes.Issuer,
 	existing *issuertypes.Issuer,
@@ -147,6 +210,186 @@ func (s *idService) checkUniqueID(ctx context.Context, id string) error {
 }
 
 func (s *idService) Resolve(ctx context.Context, id string) (*idtypes.ResolverMetadata, error) {
+	resolverMD, err := s.resolveStored(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if resolverMD.Deactivated {
+		return resolverMD.Tombstone(), nil
+	}
+
+	// Only the active keys are published
+	return resolverMD.ActiveMetadata(time.Now()), nil
+}
+
+// Update replaces the active verification methods, the assertion methods
+// and the services of the resolver metadata of an ID.
+// The retired verification methods are kept.
+func (s *idService) Update(
+	ctx context.Context,
+	resolverMetadata *idtypes.ResolverMetadata,
+	proof *vctypes.Proof,
+) (*idtypes.ResolverMetadata, error) {
+	if resolverMetadata == nil {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_RESOLVER_METADATA,
+			"the resolver metadata is required",
+			nil,
+		)
+	}
+
+	stored, err := s.authorizeController(ctx, resolverMetadata.ID, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	verificationMethods, err := mergeVerificationMethods(
+		stored,
+		resolverMetadata.VerificationMethod,
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	assertionMethods, err := validateAssertionMethods(
+		resolverMetadata.AssertionMethod,
+		verificationMethods,
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	services := make([]*idtypes.Service, 0, len(resolverMetadata.Service))
+
+	for _, service := range resolverMetadata.Service {
+		if service == nil || len(service.ServiceEndpoint) == 0 {
+			return nil, errutil.ErrInfo(
+				errtypes.ERROR_REASON_INVALID_RESOLVER_METADATA,
+				"a service must have at least one endpoint",
+				nil,
+			)
+		}
+
+		services = append(services, service)
+	}
+
+	stored.VerificationMethod = verificationMethods
+	stored.AssertionMethod = assertionMethods
+	stored.Service = services
+
+	log.Debug("Storing the updated ResolverMetadata ", stored.ID)
+
+	err = s.storeMutation(ctx, translog.EntryTypeIdUpdate, stored)
+	if err != nil {
+		return nil, err
+	}
+
+	return stored.ActiveMetadata(now), nil
+}
+
+// Deactivate permanently tombstones an ID, the VCs bound to the ID
+// can no longer be verified
+func (s *idService) Deactivate(
+	ctx context.Context,
+	id string,
+	proof *vctypes.Proof,
+) error {
+	stored, err := s.authorizeController(ctx, id, proof)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	stored.Deactivated = true
+	stored.DeactivatedAt = &now
+
+	log.Debug("Deactivating the ID ", id)
+
+	return s.storeMutation(ctx, translog.EntryTypeIdDeactivate, stored)
+}
+
+// storeMutation stores the updated resolver metadata and records it in the transparency log
+// in the same transaction, a mutation is never stored without its log entry
+func (s *idService) storeMutation(
+	ctx context.Context,
+	entryType translog.EntryType,
+	resolverMetadata *idtypes.ResolverMetadata,
+) error {
+	return s.transactor.Transaction(ctx, func(ctx context.Context) error {
+		_, err := s.idRepository.UpdateID(ctx, resolverMetadata)
+		if err != nil {
+			return errutil.ErrInfo(
+				errtypes.ERROR_REASON_INTERNAL,
+				"unable to store the resolver metadata",
+				err,
+			)
+		}
+
+		return s.appendToTransparencyLog(ctx, entryType, resolverMetadata)
+	})
+}
+
+// appendToTransparencyLog records the stored resolver metadata of a mutation
+func (s *idService) appendToTransparencyLog(
+	ctx context.Context,
+	entryType translog.EntryType,
+	resolverMetadata *idtypes.ResolverMetadata,
+) error {
+	content, err := json.Marshal(resolverMetadata)
+	if err != nil {
+		return errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
+	}
+
+	return s.transparencyLog.Append(ctx, entryType, resolverMetadata.ID, content)
+}
+
+// authorizeController returns the resolver metadata of the ID if the proof
+// is issued for the ID by its controller and the ID is not deactivated
+func (s *idService) authorizeController(
+	ctx context.Context,
+	id string,
+	proof *vctypes.Proof,
+) (*idtypes.ResolverMetadata, error) {
+	// The generator verifies the proof with the existing issuer
+	// and computes the IDs the proof is issued for
+	generated, err := s.idGenerator.GenerateFromProof(ctx, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	resolverMD, err := s.resolveStored(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if resolverMD.Deactivated {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_ID_DEACTIVATED,
+			fmt.Sprintf("the ID (%s) is deactivated", id),
+			nil,
+		)
+	}
+
+	issuer := generated.Issuer
+	if !slices.Contains(generated.IDs(), id) || issuer == nil || issuer.CommonName != resolverMD.Controller {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_PROOF,
+			"the proof is not issued for the ID by its controller",
+			nil,
+		)
+	}
+
+	return resolverMD, nil
+}
+
+// resolveStored returns the stored resolver metadata of the ID,
+// including the retired verification methods
+func (s *idService) resolveStored(ctx context.Context, id string) (*idtypes.ResolverMetadata, error) {
 	resolverMD, err := s.idRepository.ResolveID(ctx, id)
 	if err != nil {
 		if errors.Is(err, errcore.ErrResourceNotFound) {
@@ -162,3 +405,124 @@ func (s *idService) Resolve(ctx context.Context, id string) (*idtypes.ResolverMe
 
 	return resolverMD, nil
 }
+
+// mergeVerificationMethods validates the new verification methods and keeps
+// the retirement date of the existing ones, the retired ones are preserved
+func mergeVerificationMethods(
+	stored *idtypes.ResolverMetadata,
+	verificationMethods []*idtypes.VerificationMethod,
+	now time.Time,
+) ([]*idtypes.VerificationMethod, error) {
+	existing := make(map[string]*idtypes.VerificationMethod, len(stored.VerificationMethod))
+	for _, vm := range stored.VerificationMethod {
+		existing[vm.ID] = vm
+	}
+
+	merged := make([]*idtypes.VerificationMethod, 0, len(verificationMethods))
+	seen := make(map[string]bool, len(verificationMethods))
+
+	for _, vm := range verificationMethods {
+		err := validateVerificationMethod(stored.ID, vm)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[vm.ID] {
+			return nil, errutil.ErrInfo(
+				errtypes.ERROR_REASON_INVALID_RESOLVER_METADATA,
+				fmt.Sprintf("the verification method %s is duplicated", vm.ID),
+				nil,
+			)
+		}
+
+		seen[vm.ID] = true
+
+		updated := &idtypes.VerificationMethod{
+			ID:           vm.ID,
+			PublicKeyJwk: vm.PublicKeyJwk,
+		}
+
+		if prev, ok := existing[vm.ID]; ok {
+			if !prev.IsActive(now) {
+				return nil, errutil.ErrInfo(
+					errtypes.ERROR_REASON_INVALID_RESOLVER_METADATA,
+					fmt.Sprintf("the verification method %s is retired", vm.ID),
+					nil,
+				)
+			}
+
+			updated.RetiredAt = prev.RetiredAt
+		}
+
+		merged = append(merged, updated)
+	}
+
+	if len(merged) == 0 {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_RESOLVER_METADATA,
+			"at least one verification method is required",
+			nil,
+		)
+	}
+
+	for _, vm := range stored.VerificationMethod {
+		if !vm.IsActive(now) {
+			merged = append(merged, vm)
+		}
+	}
+
+	return merged, nil
+}
+
+func validateVerificationMethod(id string, vm *idtypes.VerificationMethod) error {
+	if vm == nil || !strings.HasPrefix(vm.ID, id+"#") || len(vm.ID) == len(id)+1 {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_RESOLVER_METADATA,
+			fmt.Sprintf("the ID of a verification method must start with %s#", id),
+			nil,
+		)
+	}
+
+	err := joseutil.ValidatePubKey(vm.PublicKeyJwk)
+	if err != nil {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_RESOLVER_METADATA,
+			fmt.Sprintf("the verification method %s has an invalid public key", vm.ID),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// validateAssertionMethods checks that the assertion methods reference active
+// verification methods, all the active verification methods are used by default
+func validateAssertionMethods(
+	assertionMethods []string,
+	verificationMethods []*idtypes.VerificationMethod,
+	now time.Time,
+) ([]string, error) {
+	active := make([]string, 0, len(verificationMethods))
+
+	for _, vm := range verificationMethods {
+		if vm.IsActive(now) {
+			active = append(active, vm.ID)
+		}
+	}
+
+	if len(assertionMethods) == 0 {
+		return active, nil
+	}
+
+	for _, am := range assertionMethods {
+		if !slices.Contains(active, am) {
+			return nil, errutil.ErrInfo(
+				errtypes.ERROR_REASON_INVALID_RESOLVER_METADATA,
+				fmt.Sprintf("the assertion method %s is not an active verification method", am),
+				nil,
+			)
+		}
+	}
+
+	return assertionMethods, nil
+}
@@ -13,11 +13,14 @@ import (
 	idtesting "github.com/agntcy/identity/internal/core/id/testing"
 	idtypes "github.com/agntcy/identity/internal/core/id/types"
 	issuertesting "github.com/agntcy/identity/internal/core/issuer/testing"
+	"github.com/agntcy/identity/internal/core/issuer/trust"
 	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
 	issuerverif "github.com/agntcy/identity/internal/core/issuer/verification"
 	verificationtesting "github.com/agntcy/identity/internal/core/issuer/verification/testing"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
 	"github.com/agntcy/identity/internal/node"
+	dbtesting "github.com/agntcy/identity/pkg/db/testing"
+	"github.com/agntcy/identity/pkg/joseutil"
 	"github.com/agntcy/identity/pkg/jwk"
 	"github.com/agntcy/identity/pkg/oidc"
 	oidctesting "github.com/agntcy/identity/pkg/oidc/testing"
@@ -41,8 +44,11 @@ func TestGenerateID_Should_Not_Return_Errors(t *testing.T) {
 		issuerverif.NewService(
 			oidctesting.NewFakeParser(jwt, nil),
 			issuerRepo,
-		))
-	sut := node.NewIdService(idRepo, issuerRepo, idGen)
+			trust.NewStaticSource(trust.DefaultPolicy()),
+		),
+		oidc.NewDefaultRegistry(),
+	)
+	sut := node.NewIdService(idRepo, issuerRepo, idGen, newTransparencyLog(t), dbtesting.NewFakeTransactor())
 	issuer := &issuertypes.Issuer{
 		CommonName:   verificationtesting.ValidProofIssuer,
 		Organization: "Some Org",
@@ -72,9 +78,11 @@ func TestGenerateID_Should_Not_Return_Error_With_Self_Provider(t *testing.T) {
 		issuerverif.NewService(
 			oidctesting.NewFakeParser(jwt, nil),
 			issuerRepo,
+			trust.NewStaticSource(trust.DefaultPolicy()),
 		),
+		oidc.NewDefaultRegistry(),
 	)
-	sut := node.NewIdService(idRepo, issuerRepo, idGen)
+	sut := node.NewIdService(idRepo, issuerRepo, idGen, newTransparencyLog(t), dbtesting.NewFakeTransactor())
 	issuer := &issuertypes.Issuer{
 		CommonName:   verificationtesting.ValidProofIssuer,
 		Organization: "Some Org",
@@ -104,9 +112,11 @@ func TestGenerateID_Should_Return_Error_With_Idp_And_Self_Proof(t *testing.T) {
 		issuerverif.NewService(
 			oidctesting.NewFakeParser(jwt, nil),
 			issuerRepo,
+			trust.NewStaticSource(trust.DefaultPolicy()),
 		),
+		oidc.NewDefaultRegistry(),
 	)
-	sut := node.NewIdService(idRepo, issuerRepo, idGen)
+	sut := node.NewIdService(idRepo, issuerRepo, idGen, newTransparencyLog(t), dbtesting.NewFakeTransactor())
 	issuer := &issuertypes.Issuer{
 		CommonName:   verificationtesting.ValidProofIssuer,
 		Organization: "Some Org",
@@ -121,12 +131,56 @@ func TestGenerateID_Should_Return_Error_With_Idp_And_Self_Proof(t *testing.T) {
 	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_IDP_REQUIRED)
 }
 
+func TestGenerateID_Should_Reject_Denied_Identity_Provider(t *testing.T) {
+	t.Parallel()
+
+	issuerRepo := issuertesting.NewFakeIssuerRepository()
+	jwt := &oidc.ParsedJWT{
+		Provider: oidc.DuoProviderName,
+		Claims: &oidc.Claims{
+			Issuer:  "http://" + verificationtesting.ValidProofIssuer,
+			Subject: "test",
+		},
+		CommonName: verificationtesting.ValidProofIssuer,
+	}
+	idGen := node.NewIDGenerator(
+		issuerverif.NewService(
+			oidctesting.NewFakeParser(jwt, nil),
+			issuerRepo,
+			trust.NewStaticSource(&trust.Policy{
+				DeniedIssuers: []string{"http://" + verificationtesting.ValidProofIssuer},
+			}),
+		),
+		oidc.NewDefaultRegistry(),
+	)
+	sut := node.NewIdService(
+		idtesting.NewFakeIdRepository(),
+		issuerRepo,
+		idGen,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+	)
+	issuer := &issuertypes.Issuer{
+		CommonName:   verificationtesting.ValidProofIssuer,
+		Organization: "Some Org",
+	}
+	_, _ = issuerRepo.CreateIssuer(context.Background(), issuer)
+
+	_, err := sut.Generate(context.Background(), issuer, &vctypes.Proof{Type: "JWT"})
+
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_UNTRUSTED_ISSUER)
+}
+
 func TestGenerateID_Should_Return_Invalid_Proof_If_Empty(t *testing.T) {
 	t.Parallel()
 
 	oidcParser := oidctesting.NewFakeParser(&oidc.ParsedJWT{}, nil)
-	idGen := node.NewIDGenerator(issuerverif.NewService(oidcParser, nil))
-	sut := node.NewIdService(nil, nil, idGen)
+	idGen := node.NewIDGenerator(issuerverif.NewService(
+		oidcParser,
+		nil,
+		trust.NewStaticSource(trust.DefaultPolicy()),
+	), oidc.NewDefaultRegistry())
+	sut := node.NewIdService(nil, nil, idGen, newTransparencyLog(t), dbtesting.NewFakeTransactor())
 	issuer := &issuertypes.Issuer{
 		CommonName:   verificationtesting.ValidProofIssuer,
 		Organization: "Some Org",
@@ -144,9 +198,11 @@ func TestGenerateID_Should_Return_Invalid_Proof_Error(t *testing.T) {
 		issuerverif.NewService(
 			oidctesting.NewFakeParser(nil, errors.New("")),
 			nil,
+			trust.NewStaticSource(trust.DefaultPolicy()),
 		),
+		oidc.NewDefaultRegistry(),
 	)
-	sut := node.NewIdService(nil, nil, idGen)
+	sut := node.NewIdService(nil, nil, idGen, newTransparencyLog(t), dbtesting.NewFakeTransactor())
 	issuer := &issuertypes.Issuer{
 		CommonName:   verificationtesting.ValidProofIssuer,
 		Organization: "Some Org",
@@ -173,10 +229,12 @@ func TestGenerateID_Should_Return_Invalid_Issuer_Error(t *testing.T) {
 		issuerverif.NewService(
 			oidctesting.NewFakeParser(jwt, nil),
 			issuerRepo,
+			trust.NewStaticSource(trust.DefaultPolicy()),
 		),
+		oidc.NewDefaultRegistry(),
 	)
 	idRepo := idtesting.NewFakeIdRepository()
-	sut := node.NewIdService(idRepo, issuerRepo, idGen)
+	sut := node.NewIdService(idRepo, issuerRepo, idGen, newTransparencyLog(t), dbtesting.NewFakeTransactor())
 	issuer := &issuertypes.Issuer{
 		CommonName:   verificationtesting.ValidProofIssuer,
 		Organization: "Some Org",
@@ -203,9 +261,11 @@ func TestGenerateID_Should_Return_Unregistred_Issuer_Error(t *testing.T) {
 		issuerverif.NewService(
 			oidctesting.NewFakeParser(jwt, nil),
 			issuerRepo,
+			trust.NewStaticSource(trust.DefaultPolicy()),
 		),
+		oidc.NewDefaultRegistry(),
 	)
-	sut := node.NewIdService(nil, issuerRepo, idGen)
+	sut := node.NewIdService(nil, issuerRepo, idGen, newTransparencyLog(t), dbtesting.NewFakeTransactor())
 	issuer := &issuertypes.Issuer{
 		CommonName:   verificationtesting.ValidProofIssuer,
 		Organization: "Some Org",
@@ -234,9 +294,11 @@ func TestGenerateID_Should_Return_ID_Already_Exists_Error(t *testing.T) {
 		issuerverif.NewService(
 			oidctesting.NewFakeParser(jwt, nil),
 			issuerRepo,
+			trust.NewStaticSource(trust.DefaultPolicy()),
 		),
+		oidc.NewDefaultRegistry(),
 	)
-	sut := node.NewIdService(idRepo, nil, idGen)
+	sut := node.NewIdService(idRepo, nil, idGen, newTransparencyLog(t), dbtesting.NewFakeTransactor())
 	issuer := &issuertypes.Issuer{
 		CommonName:   verificationtesting.ValidProofIssuer,
 		Organization: "Some Org",
@@ -262,7 +324,7 @@ func TestResolveID_Should_Return_Resolver_Metadata(t *testing.T) {
 	}
 
 	idRepo := idtesting.NewFakeIdRepository()
-	sut := node.NewIdService(idRepo, nil, nil)
+	sut := node.NewIdService(idRepo, nil, nil, newTransparencyLog(t), dbtesting.NewFakeTransactor())
 	md := &idtypes.ResolverMetadata{
 		ID: "SOME_ID",
 	}
@@ -277,9 +339,205 @@ func TestResolveID_Should_Return_Resolver_Metadata_Not_Found_Error(t *testing.T)
 	t.Parallel()
 
 	idRepo := idtesting.NewFakeIdRepository()
-	sut := node.NewIdService(idRepo, nil, nil)
+	sut := node.NewIdService(idRepo, nil, nil, newTransparencyLog(t), dbtesting.NewFakeTransactor())
 
 	_, err := sut.Resolve(context.Background(), "SOME_ID")
 
 	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_RESOLVER_METADATA_NOT_FOUND)
 }
+
+const updateTestID = "DUO-" + verificationtesting.ValidProofSub
+
+func setupIdServiceWithResolverMD(
+	t *testing.T,
+	subject string,
+) (node.IdService, *idtypes.ResolverMetadata) {
+	t.Helper()
+
+	return setupIdServiceWithProviderID(t, oidc.DuoProviderName, subject, updateTestID)
+}
+
+// setupIdServiceWithProviderID creates a service verifying the proofs of the provider
+// for the subject, with the resolver metadata of the ID already stored
+func setupIdServiceWithProviderID(
+	t *testing.T,
+	provider oidc.ProviderName,
+	subject string,
+	id string,
+) (node.IdService, *idtypes.ResolverMetadata) {
+	t.Helper()
+
+	idRepo := idtesting.NewFakeIdRepository()
+	issuerRepo := issuertesting.NewFakeIssuerRepository()
+	jwt := &oidc.ParsedJWT{
+		Provider: provider,
+		Claims: &oidc.Claims{
+			Issuer:  "http://" + verificationtesting.ValidProofIssuer,
+			Subject: subject,
+		},
+		CommonName: verificationtesting.ValidProofIssuer,
+	}
+	idGen := node.NewIDGenerator(
+		issuerverif.NewService(
+			oidctesting.NewFakeParser(jwt, nil),
+			issuerRepo,
+			trust.NewStaticSource(trust.DefaultPolicy()),
+		),
+		oidc.NewDefaultRegistry(),
+	)
+	issuer := &issuertypes.Issuer{
+		CommonName:   verificationtesting.ValidProofIssuer,
+		Organization: "Some Org",
+	}
+	_, _ = issuerRepo.CreateIssuer(t.Context(), issuer)
+
+	key, err := joseutil.GenerateJWK("ES256", "sig", "")
+	assert.NoError(t, err)
+
+	md := &idtypes.ResolverMetadata{
+		ID: id,
+		VerificationMethod: []*idtypes.VerificationMethod{
+			{
+				ID:           id + "#key-1",
+				PublicKeyJwk: key.PublicKey(),
+			},
+		},
+		AssertionMethod: []string{id + "#key-1"},
+		Controller:      verificationtesting.ValidProofIssuer,
+	}
+	_, _ = idRepo.CreateID(t.Context(), md, issuer)
+
+	return node.NewIdService(idRepo, issuerRepo, idGen, newTransparencyLog(t), dbtesting.NewFakeTransactor()), md
+}
+
+func TestUpdateID_Should_Replace_Verification_Methods_And_Services(t *testing.T) {
+	t.Parallel()
+
+	sut, _ := setupIdServiceWithResolverMD(t, verificationtesting.ValidProofSub)
+	key, err := joseutil.GenerateJWK("ES256", "sig", "")
+	assert.NoError(t, err)
+
+	updated, err := sut.Update(
+		t.Context(),
+		&idtypes.ResolverMetadata{
+			ID: updateTestID,
+			VerificationMethod: []*idtypes.VerificationMethod{
+				{
+					ID:           updateTestID + "#key-2",
+					PublicKeyJwk: key.PublicKey(),
+				},
+			},
+			Service: []*idtypes.Service{
+				{ServiceEndpoint: []string{"https://agent.example.com"}},
+			},
+		},
+		&vctypes.Proof{Type: "JWT"},
+	)
+
+	assert.NoError(t, err)
+	assert.Len(t, updated.VerificationMethod, 1)
+	assert.Equal(t, updateTestID+"#key-2", updated.VerificationMethod[0].ID)
+	assert.Equal(t, []string{updateTestID + "#key-2"}, updated.AssertionMethod)
+	assert.Len(t, updated.Service, 1)
+
+	resolved, err := sut.Resolve(t.Context(), updateTestID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, updated.VerificationMethod, resolved.VerificationMethod)
+	assert.Equal(t, updated.Service, resolved.Service)
+}
+
+func TestUpdateID_Should_Reject_A_Proof_For_Another_ID(t *testing.T) {
+	t.Parallel()
+
+	sut, md := setupIdServiceWithResolverMD(t, "another-subject")
+
+	_, err := sut.Update(t.Context(), md, &vctypes.Proof{Type: "JWT"})
+
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_PROOF)
+}
+
+func TestUpdateID_Should_Reject_Invalid_Verification_Methods(t *testing.T) {
+	t.Parallel()
+
+	sut, md := setupIdServiceWithResolverMD(t, verificationtesting.ValidProofSub)
+
+	invalid := []*idtypes.ResolverMetadata{
+		// No verification method
+		{ID: updateTestID},
+		// The verification method belongs to another ID
+		{
+			ID: updateTestID,
+			VerificationMethod: []*idtypes.VerificationMethod{
+				{ID: "OTHER#key-1", PublicKeyJwk: md.VerificationMethod[0].PublicKeyJwk},
+			},
+		},
+		// The verification method has no public key
+		{
+			ID: updateTestID,
+			VerificationMethod: []*idtypes.VerificationMethod{
+				{ID: updateTestID + "#key-2"},
+			},
+		},
+		// The assertion method is unknown
+		{
+			ID:                 updateTestID,
+			VerificationMethod: md.VerificationMethod,
+			AssertionMethod:    []string{updateTestID + "#unknown"},
+		},
+	}
+
+	for _, resolverMD := range invalid {
+		_, err := sut.Update(t.Context(), resolverMD, &vctypes.Proof{Type: "JWT"})
+
+		errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_RESOLVER_METADATA)
+	}
+}
+
+func TestDeactivateID_Should_Tombstone_The_ID(t *testing.T) {
+	t.Parallel()
+
+	sut, md := setupIdServiceWithResolverMD(t, verificationtesting.ValidProofSub)
+
+	err := sut.Deactivate(t.Context(), updateTestID, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	resolved, err := sut.Resolve(t.Context(), updateTestID)
+
+	assert.NoError(t, err)
+	assert.True(t, resolved.Deactivated)
+	assert.NotNil(t, resolved.DeactivatedAt)
+	assert.Empty(t, resolved.VerificationMethod)
+	assert.Empty(t, resolved.AssertionMethod)
+
+	_, err = sut.Update(t.Context(), md, &vctypes.Proof{Type: "JWT"})
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_ID_DEACTIVATED)
+
+	err = sut.Deactivate(t.Context(), updateTestID, &vctypes.Proof{Type: "JWT"})
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_ID_DEACTIVATED)
+}
+
+func TestIdService_Should_Keep_The_Legacy_IDs_Of_The_Subject(t *testing.T) {
+	t.Parallel()
+
+	// The ID generated by a previous version of the Node for Okta on a custom domain
+	legacyID := "OKTA-" + verificationtesting.ValidProofSub
+	sut, md := setupIdServiceWithProviderID(
+		t,
+		oidc.IdpProviderName,
+		verificationtesting.ValidProofSub,
+		legacyID,
+	)
+	issuer := &issuertypes.Issuer{CommonName: verificationtesting.ValidProofIssuer}
+
+	// The subject cannot get a second ID with the current scheme
+	_, err := sut.Generate(t.Context(), issuer, &vctypes.Proof{Type: "JWT"})
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_ID_ALREADY_REGISTERED)
+
+	// The proofs of the subject are still accepted for the legacy ID
+	_, err = sut.Update(t.Context(), md, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	err = sut.Deactivate(t.Context(), legacyID, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package interceptor
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+
+	nodeapi "github.com/agntcy/identity/api/server/agntcy/identity/node/v1alpha1"
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/internal/pkg/grpcutil"
+	"google.golang.org/grpc"
+)
+
+// AuthMode is the authentication required to call the write RPCs
+type AuthMode string
+
+const (
+	// The write RPCs are open to anyone
+	AuthModeNone AuthMode = "none"
+
+	// The write RPCs require one of the configured API keys
+	AuthModeApiKey AuthMode = "api-key"
+
+	// The write RPCs require a client certificate verified by the server
+	AuthModeMtls AuthMode = "mtls"
+)
+
+// The RPCs mutating the state of the node
+var writeMethods = map[string]bool{
+	nodeapi.IssuerService_Register_FullMethodName:  true,
+	nodeapi.IssuerService_RotateKey_FullMethodName: true,
+	nodeapi.IdService_Generate_FullMethodName:      true,
+	nodeapi.IdService_Update_FullMethodName:        true,
+	nodeapi.IdService_Deactivate_FullMethodName:    true,
+	nodeapi.VcService_Publish_FullMethodName:       true,
+	nodeapi.VcService_Revoke_FullMethodName:        true,
+	nodeapi.VcService_Suspend_FullMethodName:       true,
+	nodeapi.VcService_Reinstate_FullMethodName:     true,
+}
+
+// ParseAuthMode parses the authentication mode of the configuration
+func ParseAuthMode(mode string) (AuthMode, error) {
+	switch AuthMode(mode) {
+	case AuthModeNone, AuthModeApiKey, AuthModeMtls:
+		return AuthMode(mode), nil
+	case "":
+		return AuthModeNone, nil
+	default:
+		return "", fmt.Errorf("unknown authentication mode: %s", mode)
+	}
+}
+
+// Authenticate returns the interceptor authenticating the callers of the write RPCs
+func Authenticate(config *Config) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if !writeMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		err := authenticate(config, ClientFromContext(ctx, config))
+		if err != nil {
+			return nil, grpcutil.UnauthorizedError(err)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// HTTPAuthenticate requires the authentication of the write RPCs
+// to call the HTTP handler served outside the gateway
+func HTTPAuthenticate(next http.Handler, config *Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := authenticate(config, ClientFromRequest(r, config))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func authenticate(config *Config, client *Client) error {
+	switch config.AuthMode {
+	case AuthModeApiKey:
+		if !validApiKey(config.ApiKeys, client.ApiKey) {
+			return unauthenticated("a valid API key is required")
+		}
+	case AuthModeMtls:
+		if !client.CertificateVerified {
+			return unauthenticated("a verified client certificate is required")
+		}
+	case AuthModeNone:
+	}
+
+	return nil
+}
+
+func validApiKey(apiKeys []string, apiKey string) bool {
+	if apiKey == "" {
+		return false
+	}
+
+	valid := false
+
+	// Compare with every key in constant time
+	for _, key := range apiKeys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(apiKey)) == 1 {
+			valid = true
+		}
+	}
+
+	return valid
+}
+
+func unauthenticated(message string) error {
+	return errutil.ErrInfo(
+		errtypes.ERROR_REASON_UNAUTHENTICATED,
+		message,
+		errors.New("unauthenticated"),
+	)
+}
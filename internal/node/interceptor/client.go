@@ -0,0 +1,243 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// The header and the metadata key carrying the API key of the client
+	ApiKeyHeader = "X-Api-Key" //nolint:gosec // This is the name of the header
+	apiKeyKey    = "x-api-key"
+
+	// The metadata keys set by the HTTP gateway
+	gatewayMetadataPrefix = "x-identity-"
+	gatewayTokenKey       = gatewayMetadataPrefix + "gateway-token"
+	clientIPKey           = gatewayMetadataPrefix + "client-ip"
+	clientVerifiedKey     = gatewayMetadataPrefix + "client-verified"
+
+	// The header and the metadata key listing the addresses
+	// of the client and of the proxies forwarding the request
+	forwardedForHeader = "X-Forwarded-For"
+	forwardedForKey    = "x-forwarded-for"
+
+	gatewayTokenSize = 32
+)
+
+// Client is the caller of an RPC
+type Client struct {
+	// The IP address of the client
+	IP string
+
+	// The API key sent by the client
+	ApiKey string
+
+	// Whether the client presented a certificate verified by the server
+	CertificateVerified bool
+}
+
+// ClientFromContext returns the caller of the RPC.
+// The client information forwarded in the metadata is trusted only when
+// the request comes from the HTTP gateway sharing the token, the
+// forwarded addresses only when the peer is one of the trusted proxies.
+func ClientFromContext(ctx context.Context, config *Config) *Client {
+	md, _ := metadata.FromIncomingContext(ctx)
+	client := &Client{
+		ApiKey: firstValue(md, apiKeyKey),
+	}
+
+	if config.GatewayToken != "" && firstValue(md, gatewayTokenKey) == config.GatewayToken {
+		client.IP = firstValue(md, clientIPKey)
+		client.CertificateVerified = firstValue(md, clientVerifiedKey) == "true"
+
+		return client
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		client.IP = forwardedIP(hostOf(p.Addr.String()), md.Get(forwardedForKey), config.TrustedProxies)
+
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			client.CertificateVerified = len(tlsInfo.State.VerifiedChains) > 0
+		}
+	}
+
+	return client
+}
+
+// ClientFromRequest returns the caller of an HTTP request,
+// the forwarded addresses are trusted only when the request
+// comes from one of the trusted proxies
+func ClientFromRequest(r *http.Request, config *Config) *Client {
+	return &Client{
+		IP: forwardedIP(
+			hostOf(r.RemoteAddr),
+			r.Header.Values(forwardedForHeader),
+			config.TrustedProxies,
+		),
+		ApiKey:              r.Header.Get(ApiKeyHeader),
+		CertificateVerified: r.TLS != nil && len(r.TLS.VerifiedChains) > 0,
+	}
+}
+
+// ParseTrustedProxies parses the addresses or the CIDR ranges of the trusted proxies
+func ParseTrustedProxies(proxies []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(proxies))
+
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+
+		if !strings.Contains(proxy, "/") {
+			addr, err := netip.ParseAddr(proxy)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %s: %w", proxy, err)
+			}
+
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %s: %w", proxy, err)
+		}
+
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
+
+// forwardedIP returns the address of the client of a request received from the remote address.
+// The addresses of the forwarded-for lists are read from the right, as long as they are
+// appended by trusted proxies: the first untrusted address is the client.
+func forwardedIP(remote string, forwardedFor []string, trustedProxies []netip.Prefix) string {
+	if !isTrustedProxy(remote, trustedProxies) {
+		return remote
+	}
+
+	hops := make([]string, 0, len(forwardedFor))
+	for _, value := range forwardedFor {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+
+	ip := remote
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+
+		ip = addr.Unmap().String()
+
+		if !isTrustedProxy(ip, trustedProxies) {
+			break
+		}
+	}
+
+	return ip
+}
+
+func isTrustedProxy(ip string, trustedProxies []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewGatewayToken generates a random token to share with the HTTP gateway
+func NewGatewayToken() (string, error) {
+	token := make([]byte, gatewayTokenSize)
+
+	_, err := rand.Read(token)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+// GatewayMetadata returns the function forwarding the client information
+// of the HTTP requests to the gRPC server, to install with runtime.WithMetadata
+func GatewayMetadata(config *Config) func(context.Context, *http.Request) metadata.MD {
+	return func(_ context.Context, r *http.Request) metadata.MD {
+		client := ClientFromRequest(r, config)
+		md := metadata.Pairs(
+			gatewayTokenKey, config.GatewayToken,
+			clientIPKey, client.IP,
+		)
+
+		if client.ApiKey != "" {
+			md.Set(apiKeyKey, client.ApiKey)
+		}
+
+		if client.CertificateVerified {
+			md.Set(clientVerifiedKey, "true")
+		}
+
+		return md
+	}
+}
+
+// GatewayHandler wraps the HTTP gateway to limit the size of the request bodies
+// and to drop the headers a client could use to spoof the gateway metadata
+func GatewayHandler(next http.Handler, maxBodySize int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for name := range r.Header {
+			key := strings.ToLower(name)
+			if strings.HasPrefix(key, "grpc-metadata-"+gatewayMetadataPrefix) {
+				r.Header.Del(name)
+			}
+		}
+
+		if maxBodySize > 0 {
+			r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+func hostOf(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+
+	return host
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package interceptor protects the node API with a chain of gRPC interceptors
+// recording the metrics and enforcing the rate limits, the authentication of
+// the write RPCs and the payload limits. The HTTP gateway proxies its requests to the gRPC server,
+// the same rules apply to both once the gateway is set up with
+// GatewayMetadata and GatewayHandler. The other HTTP handlers are protected
+// with HTTPRateLimit and HTTPAuthenticate.
+package interceptor
+
+import (
+	"net/netip"
+
+	"google.golang.org/grpc"
+)
+
+// Config of the interceptors
+type Config struct {
+	// The requests per second and the burst allowed for a client IP,
+	// the rate is not limited when zero
+	IPRateLimit float64
+	IPBurst     int
+
+	// The requests per second and the burst allowed for an issuer common name,
+	// the rate is not limited when zero
+	IssuerRateLimit float64
+	IssuerBurst     int
+
+	// The authentication required to call the write RPCs
+	AuthMode AuthMode
+
+	// The API keys accepted when AuthMode is AuthModeApiKey
+	ApiKeys []string
+
+	// The maximum length of a string or bytes field of a request,
+	// the fields are not checked when zero
+	MaxFieldSize int
+
+	// The maximum number of elements of a repeated or map field of a request,
+	// the fields are not checked when zero
+	MaxListSize int
+
+	// The secret shared with the HTTP gateway to trust the client information
+	// it forwards
+	GatewayToken string
+
+	// The proxies whose X-Forwarded-For addresses are trusted
+	// to identify the client IP
+	TrustedProxies []netip.Prefix
+}
+
+// NewChain returns the interceptors to install on the gRPC server, in order
+func NewChain(config *Config, limiter *RateLimiter) []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		Metrics(),
+		RateLimit(config, limiter),
+		Authenticate(config),
+		ValidatePayload(config),
+	}
+}
+
+// NewStreamChain returns the stream interceptors to install on the gRPC server, in order
+func NewStreamChain(config *Config, limiter *RateLimiter) []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{
+		StreamRateLimit(config, limiter),
+	}
+}
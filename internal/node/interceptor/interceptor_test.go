@@ -0,0 +1,300 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package interceptor_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	coreapi "github.com/agntcy/identity/api/server/agntcy/identity/core/v1alpha1"
+	nodeapi "github.com/agntcy/identity/api/server/agntcy/identity/node/v1alpha1"
+	"github.com/agntcy/identity/internal/node/interceptor"
+	"github.com/agntcy/identity/internal/pkg/ptrutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const gatewayToken = "gateway-token"
+
+func TestRateLimit_Should_Limit_Each_Client_IP(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	config := &interceptor.Config{
+		IPRateLimit: 1,
+		IPBurst:     2,
+	}
+	sut := interceptor.RateLimit(config, interceptor.NewRateLimiter(config, func() time.Time { return now }))
+
+	first := contextFromIP("10.0.0.1")
+	second := contextFromIP("10.0.0.2")
+	req := &nodeapi.ResolveRequest{}
+
+	assert.NoError(t, call(first, sut, nodeapi.IdService_Resolve_FullMethodName, req))
+	assert.NoError(t, call(first, sut, nodeapi.IdService_Resolve_FullMethodName, req))
+	assertCode(t, codes.ResourceExhausted, call(first, sut, nodeapi.IdService_Resolve_FullMethodName, req))
+	assert.NoError(t, call(second, sut, nodeapi.IdService_Resolve_FullMethodName, req))
+
+	// The bucket is refilled over time
+	now = now.Add(time.Second)
+
+	assert.NoError(t, call(first, sut, nodeapi.IdService_Resolve_FullMethodName, req))
+}
+
+func TestRateLimit_Should_Trust_Forwarded_Addresses_Only_From_Trusted_Proxies(t *testing.T) {
+	t.Parallel()
+
+	trustedProxies, err := interceptor.ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.0.1"})
+	assert.NoError(t, err)
+
+	config := &interceptor.Config{
+		IPRateLimit:    1,
+		IPBurst:        1,
+		TrustedProxies: trustedProxies,
+	}
+	sut := interceptor.RateLimit(config, interceptor.NewRateLimiter(config, time.Now))
+	req := &nodeapi.ResolveRequest{}
+
+	forwardedFrom := func(ip, forwardedFor string) context.Context {
+		return metadata.NewIncomingContext(contextFromIP(ip), metadata.Pairs("x-forwarded-for", forwardedFor))
+	}
+
+	// The clients behind the proxies have their own limits,
+	// the address prepended by a client is ignored
+	assert.NoError(t, call(forwardedFrom("10.0.0.1", "203.0.113.1"), sut, nodeapi.IdService_Resolve_FullMethodName, req))
+	assert.NoError(t, call(
+		forwardedFrom("10.0.0.2", "203.0.113.2, 192.168.0.1"),
+		sut,
+		nodeapi.IdService_Resolve_FullMethodName,
+		req,
+	))
+	assertCode(t, codes.ResourceExhausted, call(
+		forwardedFrom("10.0.0.1", "198.51.100.1, 203.0.113.1"),
+		sut,
+		nodeapi.IdService_Resolve_FullMethodName,
+		req,
+	))
+
+	// An untrusted client cannot spoof its address
+	assert.NoError(t, call(
+		forwardedFrom("203.0.113.3", "198.51.100.2"),
+		sut,
+		nodeapi.IdService_Resolve_FullMethodName,
+		req,
+	))
+	assertCode(t, codes.ResourceExhausted, call(
+		forwardedFrom("203.0.113.3", "198.51.100.3"),
+		sut,
+		nodeapi.IdService_Resolve_FullMethodName,
+		req,
+	))
+}
+
+func TestRateLimiter_Should_Limit_Each_Issuer(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	sut := interceptor.NewRateLimiter(&interceptor.Config{
+		IssuerRateLimit: 1,
+		IssuerBurst:     1,
+	}, func() time.Time { return now })
+
+	assert.True(t, sut.AllowIssuer("example.com"))
+	assert.False(t, sut.AllowIssuer("example.com"))
+	assert.True(t, sut.AllowIssuer("other.com"))
+
+	// The bucket is refilled over time
+	now = now.Add(time.Second)
+
+	assert.True(t, sut.AllowIssuer("example.com"))
+}
+
+func TestHTTPRateLimit_Should_Limit_Each_Client_IP(t *testing.T) {
+	t.Parallel()
+
+	config := &interceptor.Config{
+		IPRateLimit: 1,
+		IPBurst:     1,
+	}
+	sut := interceptor.HTTPRateLimit(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+		config,
+		interceptor.NewRateLimiter(config, time.Now),
+	)
+
+	serve := func(remoteAddr string) int {
+		r := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/metrics", http.NoBody)
+		r.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+
+		sut.ServeHTTP(w, r)
+
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve("10.0.0.1:1234"))
+	assert.Equal(t, http.StatusTooManyRequests, serve("10.0.0.1:1235"))
+	assert.Equal(t, http.StatusOK, serve("10.0.0.2:1234"))
+}
+
+func TestHTTPAuthenticate_Should_Require_Api_Key(t *testing.T) {
+	t.Parallel()
+
+	sut := interceptor.HTTPAuthenticate(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+		&interceptor.Config{
+			AuthMode: interceptor.AuthModeApiKey,
+			ApiKeys:  []string{"key-1"},
+		},
+	)
+
+	serve := func(apiKey string) int {
+		r := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/metrics", http.NoBody)
+		r.Header.Set(interceptor.ApiKeyHeader, apiKey)
+		w := httptest.NewRecorder()
+
+		sut.ServeHTTP(w, r)
+
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, serve(""))
+	assert.Equal(t, http.StatusUnauthorized, serve("key-2"))
+	assert.Equal(t, http.StatusOK, serve("key-1"))
+}
+
+func TestAuthenticate_Should_Require_Api_Key_For_Write_RPCs(t *testing.T) {
+	t.Parallel()
+
+	sut := interceptor.Authenticate(&interceptor.Config{
+		AuthMode: interceptor.AuthModeApiKey,
+		ApiKeys:  []string{"key-1", "key-2"},
+	})
+
+	anonymous := contextFromIP("10.0.0.1")
+	invalid := metadata.NewIncomingContext(anonymous, metadata.Pairs("x-api-key", "key-3"))
+	valid := metadata.NewIncomingContext(anonymous, metadata.Pairs("x-api-key", "key-2"))
+
+	assert.NoError(t, call(anonymous, sut, nodeapi.IdService_Resolve_FullMethodName, &nodeapi.ResolveRequest{}))
+	assertCode(t, codes.Unauthenticated, call(anonymous, sut, nodeapi.VcService_Publish_FullMethodName, nil))
+	assertCode(t, codes.Unauthenticated, call(invalid, sut, nodeapi.VcService_Publish_FullMethodName, nil))
+	assert.NoError(t, call(valid, sut, nodeapi.VcService_Publish_FullMethodName, nil))
+}
+
+func TestAuthenticate_Should_Trust_Gateway_Metadata_Only_With_Token(t *testing.T) {
+	t.Parallel()
+
+	config := &interceptor.Config{
+		AuthMode:     interceptor.AuthModeMtls,
+		GatewayToken: gatewayToken,
+	}
+	sut := interceptor.Authenticate(config)
+
+	r := httptest.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		"/v1alpha1/vc/publish",
+		http.NoBody,
+	)
+	forwarded := interceptor.GatewayMetadata(config)(context.Background(), r)
+	forwarded.Set("x-identity-client-verified", "true")
+
+	spoofed := forwarded.Copy()
+	spoofed.Set("x-identity-gateway-token", "guess")
+
+	ctx := contextFromIP("127.0.0.1")
+
+	assert.NoError(t, call(
+		metadata.NewIncomingContext(ctx, forwarded),
+		sut,
+		nodeapi.VcService_Publish_FullMethodName,
+		nil,
+	))
+	assertCode(t, codes.Unauthenticated, call(
+		metadata.NewIncomingContext(ctx, spoofed),
+		sut,
+		nodeapi.VcService_Publish_FullMethodName,
+		nil,
+	))
+}
+
+func TestGatewayHandler_Should_Drop_Spoofed_Metadata(t *testing.T) {
+	t.Parallel()
+
+	var headers http.Header
+
+	sut := interceptor.GatewayHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+	}), 1024)
+
+	r := httptest.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		"/v1alpha1/vc/publish",
+		strings.NewReader("{}"),
+	)
+	r.Header.Set("Grpc-Metadata-X-Identity-Client-Verified", "true")
+	r.Header.Set("Grpc-Metadata-Other", "value")
+
+	sut.ServeHTTP(httptest.NewRecorder(), r)
+
+	assert.Empty(t, headers.Get("Grpc-Metadata-X-Identity-Client-Verified"))
+	assert.Equal(t, "value", headers.Get("Grpc-Metadata-Other"))
+}
+
+func TestValidatePayload_Should_Reject_Large_Fields(t *testing.T) {
+	t.Parallel()
+
+	sut := interceptor.ValidatePayload(&interceptor.Config{
+		MaxFieldSize: 8,
+		MaxListSize:  1,
+	})
+
+	small := &nodeapi.RegisterIssuerRequest{
+		Issuer: &coreapi.Issuer{CommonName: ptrutil.Ptr("small")},
+	}
+	large := &nodeapi.RegisterIssuerRequest{
+		Issuer: &coreapi.Issuer{CommonName: ptrutil.Ptr("much-too-large")},
+	}
+	long := &nodeapi.GetConsistencyProofResponse{Path: [][]byte{{1}, {2}}}
+
+	assert.NoError(t, call(context.Background(), sut, nodeapi.IssuerService_Register_FullMethodName, small))
+	assertCode(t, codes.InvalidArgument, call(
+		context.Background(),
+		sut,
+		nodeapi.IssuerService_Register_FullMethodName,
+		large,
+	))
+	assertCode(t, codes.InvalidArgument, call(context.Background(), sut, "", long))
+}
+
+func contextFromIP(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234},
+	})
+}
+
+func call(ctx context.Context, sut grpc.UnaryServerInterceptor, method string, req any) error {
+	_, err := sut(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+		return struct{}{}, nil
+	})
+
+	return err
+}
+
+func assertCode(t *testing.T, expected codes.Code, err error) {
+	t.Helper()
+
+	assert.Error(t, err)
+	assert.Equal(t, expected, status.Code(err))
+}
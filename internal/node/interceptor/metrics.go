@@ -0,0 +1,57 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	coreapi "github.com/agntcy/identity/api/server/agntcy/identity/core/v1alpha1"
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/pkg/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Metrics returns the interceptor recording the latency
+// and the error reason of every RPC
+func Metrics() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		metrics.ObserveRPC(
+			info.FullMethod,
+			status.Code(err).String(),
+			time.Since(start),
+			errorReason(err),
+		)
+
+		return resp, err
+	}
+}
+
+// errorReason returns the reason in the details of the error,
+// or ERROR_REASON_UNSPECIFIED when the error has no reason
+func errorReason(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	if st, ok := status.FromError(err); ok {
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*coreapi.ErrorInfo); ok {
+				return errtypes.ErrorReason(info.GetReason()).String()
+			}
+		}
+	}
+
+	return errtypes.ERROR_REASON_UNSPECIFIED.String()
+}
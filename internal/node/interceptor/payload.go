@@ -0,0 +1,116 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package interceptor
+
+import (
+	"context"
+	"fmt"
+
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/internal/pkg/grpcutil"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ValidatePayload returns the interceptor rejecting the requests
+// with a field larger than the configured limits
+func ValidatePayload(config *Config) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if msg, ok := req.(proto.Message); ok {
+			err := checkMessage(msg.ProtoReflect(), config.MaxFieldSize, config.MaxListSize)
+			if err != nil {
+				return nil, grpcutil.BadRequestError(errutil.ErrInfo(
+					errtypes.ERROR_REASON_PAYLOAD_TOO_LARGE,
+					err.Error(),
+					err,
+				))
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// checkMessage checks the fields of the message and of its nested messages
+func checkMessage(msg protoreflect.Message, maxFieldSize, maxListSize int) error {
+	var err error
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			err = checkList(fd, v.List(), maxFieldSize, maxListSize)
+		case fd.IsMap():
+			err = checkMap(fd, v.Map(), maxFieldSize, maxListSize)
+		default:
+			err = checkValue(fd, v, maxFieldSize, maxListSize)
+		}
+
+		return err == nil
+	})
+
+	return err
+}
+
+func checkList(fd protoreflect.FieldDescriptor, list protoreflect.List, maxFieldSize, maxListSize int) error {
+	if maxListSize > 0 && list.Len() > maxListSize {
+		return fmt.Errorf("the field %s has more than %d elements", fd.Name(), maxListSize)
+	}
+
+	for i := range list.Len() {
+		err := checkValue(fd, list.Get(i), maxFieldSize, maxListSize)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkMap(fd protoreflect.FieldDescriptor, m protoreflect.Map, maxFieldSize, maxListSize int) error {
+	if maxListSize > 0 && m.Len() > maxListSize {
+		return fmt.Errorf("the field %s has more than %d elements", fd.Name(), maxListSize)
+	}
+
+	var err error
+
+	m.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		if maxFieldSize > 0 && len(k.String()) > maxFieldSize {
+			err = fmt.Errorf("a key of the field %s is larger than %d bytes", fd.Name(), maxFieldSize)
+			return false
+		}
+
+		err = checkValue(fd.MapValue(), v, maxFieldSize, maxListSize)
+
+		return err == nil
+	})
+
+	return err
+}
+
+func checkValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, maxFieldSize, maxListSize int) error {
+	size := 0
+
+	//nolint:exhaustive // Only the variable length fields are checked
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		size = len(v.String())
+	case protoreflect.BytesKind:
+		size = len(v.Bytes())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return checkMessage(v.Message(), maxFieldSize, maxListSize)
+	}
+
+	if maxFieldSize > 0 && size > maxFieldSize {
+		return fmt.Errorf("the field %s is larger than %d bytes", fd.Name(), maxFieldSize)
+	}
+
+	return nil
+}
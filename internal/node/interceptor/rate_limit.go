@@ -0,0 +1,166 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/internal/pkg/grpcutil"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+)
+
+// The limiters of the clients idle for longer are released
+const limiterIdleTimeout = 10 * time.Minute
+
+// RateLimiter limits the rate of the requests of every client IP and of every issuer.
+// The limits of the client IPs are shared by the gRPC interceptors and the HTTP handlers,
+// the issuers are limited by the verification of their proofs.
+type RateLimiter struct {
+	ips     *limiters
+	issuers *limiters
+}
+
+// NewRateLimiter creates the limiter of the rates of the configuration
+func NewRateLimiter(config *Config, now func() time.Time) *RateLimiter {
+	return &RateLimiter{
+		ips:     newLimiters(config.IPRateLimit, config.IPBurst, now),
+		issuers: newLimiters(config.IssuerRateLimit, config.IssuerBurst, now),
+	}
+}
+
+// AllowIssuer consumes a request of the issuer of a verified proof
+func (l *RateLimiter) AllowIssuer(commonName string) bool {
+	return l.issuers.allow(commonName)
+}
+
+// RateLimit returns the interceptor limiting the rate of the requests of every client IP
+func RateLimit(config *Config, limiter *RateLimiter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		client := ClientFromContext(ctx, config)
+
+		if !limiter.ips.allow(client.IP) {
+			return nil, rateLimitExceeded("client " + client.IP)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamRateLimit returns the interceptor limiting the rate of the streams
+// opened by every client IP
+func StreamRateLimit(config *Config, limiter *RateLimiter) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		client := ClientFromContext(stream.Context(), config)
+
+		if !limiter.ips.allow(client.IP) {
+			return rateLimitExceeded("client " + client.IP)
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+// HTTPRateLimit limits the rate of the HTTP requests of every client IP
+// served outside the gateway
+func HTTPRateLimit(next http.Handler, config *Config, limiter *RateLimiter) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client := ClientFromRequest(r, config)
+
+		if !limiter.ips.allow(client.IP) {
+			http.Error(w, "too many requests from the client "+client.IP, http.StatusTooManyRequests)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func rateLimitExceeded(key string) error {
+	return grpcutil.TooManyRequestsError(errutil.ErrInfo(
+		errtypes.ERROR_REASON_RATE_LIMIT_EXCEEDED,
+		"too many requests from the "+key,
+		errors.New("rate limit exceeded"),
+	))
+}
+
+type limiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// limiters holds a token bucket for every key
+type limiters struct {
+	mu        sync.Mutex
+	limit     rate.Limit
+	burst     int
+	now       func() time.Time
+	lastSweep time.Time
+	byKey     map[string]*limiter
+}
+
+func newLimiters(limit float64, burst int, now func() time.Time) *limiters {
+	return &limiters{
+		limit:     rate.Limit(limit),
+		burst:     max(burst, 1),
+		now:       now,
+		lastSweep: now(),
+		byKey:     make(map[string]*limiter),
+	}
+}
+
+// allow consumes a token of the key, the empty key is never limited
+func (l *limiters) allow(key string) bool {
+	if l.limit <= 0 || key == "" {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	entry, ok := l.byKey[key]
+	if !ok {
+		entry = &limiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.byKey[key] = entry
+	}
+
+	entry.lastSeen = now
+
+	return entry.limiter.AllowN(now, 1)
+}
+
+// sweep releases the limiters of the idle keys,
+// the caller must hold the lock
+func (l *limiters) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < limiterIdleTimeout {
+		return
+	}
+
+	for key, entry := range l.byKey {
+		if now.Sub(entry.lastSeen) >= limiterIdleTimeout {
+			delete(l.byKey, key)
+		}
+	}
+
+	l.lastSweep = now
+}
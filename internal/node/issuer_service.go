@@ -4,19 +4,29 @@
 package node
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
 	errcore "github.com/agntcy/identity/internal/core/errors"
 	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	idcore "github.com/agntcy/identity/internal/core/id"
+	idtypes "github.com/agntcy/identity/internal/core/id/types"
 	issuercore "github.com/agntcy/identity/internal/core/issuer"
 	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
 	"github.com/agntcy/identity/internal/core/issuer/verification"
+	"github.com/agntcy/identity/internal/core/translog"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
 	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/db"
 	"github.com/agntcy/identity/pkg/joseutil"
 	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/agntcy/identity/pkg/log"
 	"github.com/agntcy/identity/pkg/oidc"
+	"github.com/google/uuid"
 )
 
 // The IssuerService interface defines the Node methods for Issuers
@@ -28,22 +38,46 @@ type IssuerService interface {
 	// Find the issuer by common name
 	// Return the public keys of the Issuer
 	GetJwks(ctx context.Context, commonName string) (*jwk.Jwks, error)
+
+	// Replace the current key of the Issuer with a new public key
+	// The proof must be signed by the current key of the Issuer
+	RotateKey(
+		ctx context.Context,
+		commonName string,
+		publicKey *jwk.Jwk,
+		proof *vctypes.Proof,
+		retireImmediately bool,
+	) error
 }
 
 // The issuerService struct implements the IssuerService interface
 type issuerService struct {
 	issuerRepository   issuercore.Repository
+	idRepository       idcore.IdRepository
 	verficationService verification.Service
+	retirementPeriod   time.Duration
+	transparencyLog    TransparencyLogService
+	transactor         db.Transactor
 }
 
 // NewIssuerService creates a new instance of the IssuerService
+// The previous keys of an Issuer stay active for the retirement period
+// after a key rotation
 func NewIssuerService(
 	issuerRepository issuercore.Repository,
+	idRepository idcore.IdRepository,
 	verficationService verification.Service,
+	retirementPeriod time.Duration,
+	transparencyLog TransparencyLogService,
+	transactor db.Transactor,
 ) IssuerService {
 	return &issuerService{
 		issuerRepository,
+		idRepository,
 		verficationService,
+		retirementPeriod,
+		transparencyLog,
+		transactor,
 	}
 }
 
@@ -80,6 +114,11 @@ func (i *issuerService) Register(
 		proof,
 	)
 	if err != nil {
+		// The rejections of the trust policy keep their reason
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_UNTRUSTED_ISSUER) {
+			return err
+		}
+
 		return errutil.ErrInfo(
 			errtypes.ERROR_REASON_INVALID_ISSUER,
 			"failed to verify common name",
@@ -110,20 +149,27 @@ func (i *issuerService) Register(
 		issuer.AuthType = issuertypes.ISSUER_AUTH_TYPE_IDP
 	}
 
-	// Save the issuer in the database
-	_, repositoryErr := i.issuerRepository.CreateIssuer(
-		ctx,
-		issuer,
-	)
-	if repositoryErr != nil {
-		return errutil.ErrInfo(
-			errtypes.ERROR_REASON_INTERNAL,
-			"unexpected error",
-			repositoryErr,
-		)
+	content, err := json.Marshal(issuer)
+	if err != nil {
+		return errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
 	}
 
-	return nil
+	// Save the issuer in the database with its transparency log entry
+	return i.transactor.Transaction(ctx, func(ctx context.Context) error {
+		_, repositoryErr := i.issuerRepository.CreateIssuer(
+			ctx,
+			issuer,
+		)
+		if repositoryErr != nil {
+			return errutil.ErrInfo(
+				errtypes.ERROR_REASON_INTERNAL,
+				"unexpected error",
+				repositoryErr,
+			)
+		}
+
+		return i.transparencyLog.Append(ctx, translog.EntryTypeIssuerRegister, issuer.CommonName, content)
+	})
 }
 
 // GetJwks returns the public keys of the Issuers
@@ -142,27 +188,197 @@ func (i *issuerService) GetJwks(
 	}
 
 	// Find the issuer by common name
-	issuer, err := i.issuerRepository.GetIssuer(ctx, commonName)
+	issuer, err := i.getIssuer(ctx, commonName)
 	if err != nil {
-		if errors.Is(err, errcore.ErrResourceNotFound) {
-			return nil, errutil.ErrInfo(
-				errtypes.ERROR_REASON_ISSUER_NOT_REGISTERED,
-				"the issuer is not registered",
+		return nil, err
+	}
+
+	// Return the active public keys of the Issuer
+	return &jwk.Jwks{
+		Keys: issuer.ActiveKeys(time.Now()),
+	}, nil
+}
+
+// RotateKey replaces the current key of the Issuer with a new public key
+// The previous key stays active until the end of the retirement period,
+// or is retired immediately in case of a key compromise
+func (i *issuerService) RotateKey(
+	ctx context.Context,
+	commonName string,
+	publicKey *jwk.Jwk,
+	proof *vctypes.Proof,
+	retireImmediately bool,
+) error {
+	if commonName == "" {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_ISSUER,
+			"issuer common name is empty",
+			nil,
+		)
+	}
+
+	err := joseutil.ValidatePubKey(publicKey)
+	if err != nil || publicKey.KID == "" {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_ISSUER,
+			"the new public key is invalid or has no key ID",
+			err,
+		)
+	}
+
+	issuer, err := i.getIssuer(ctx, commonName)
+	if err != nil {
+		return err
+	}
+
+	// The proof must be signed by the current key for the rotation to the new key
+	_, err = i.verficationService.VerifyKeyOwnership(ctx, issuer, publicKey, proof)
+	if err != nil {
+		return err
+	}
+
+	retiredAt := time.Now().UTC()
+	if !retireImmediately {
+		retiredAt = retiredAt.Add(i.retirementPeriod)
+	}
+
+	log.Debug("Storing the rotated keys of the issuer ", commonName)
+
+	// The issuer and its resolver metadata are never left with different keys
+	return i.transactor.Transaction(ctx, func(ctx context.Context) error {
+		// The issuer is read again and locked so that concurrent rotations
+		// cannot overwrite the retired keys of each other
+		locked, err := i.getIssuerForUpdate(ctx, commonName)
+		if err != nil {
+			return err
+		}
+
+		// The proof was verified with the key read before the lock
+		if locked.PublicKey.KID != issuer.PublicKey.KID {
+			return errutil.ErrInfo(
+				errtypes.ERROR_REASON_INVALID_ISSUER,
+				"the key of the issuer was rotated during the rotation",
 				nil,
 			)
 		}
 
-		return nil, errutil.ErrInfo(
-			errtypes.ERROR_REASON_INTERNAL,
-			"unexpected error",
-			err,
+		// The key IDs are used to select the verification key
+		if locked.HasKeyID(publicKey.KID) {
+			return errutil.ErrInfo(
+				errtypes.ERROR_REASON_INVALID_ISSUER,
+				fmt.Sprintf("the key ID %s is already used by the issuer", publicKey.KID),
+				nil,
+			)
+		}
+
+		previousKey := locked.PublicKey
+
+		locked.RetiredKeys = append(locked.RetiredKeys, &issuertypes.RetiredKey{
+			PublicKey: previousKey,
+			RetiredAt: retiredAt,
+		})
+		locked.PublicKey = publicKey
+
+		_, err = i.issuerRepository.UpdateIssuer(ctx, locked)
+		if err != nil {
+			return errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unable to store the issuer", err)
+		}
+
+		err = i.rotateVerificationMethods(ctx, commonName, previousKey, publicKey, retiredAt)
+		if err != nil {
+			return err
+		}
+
+		return i.transparencyLog.Append(
+			ctx,
+			translog.EntryTypeIssuerRotateKey,
+			commonName,
+			publicKey.PublicKey().ToJSON(),
 		)
+	})
+}
+
+// rotateVerificationMethods retires the verification methods of the previous key
+// and adds the new key to every resolver metadata controlled by the Issuer
+func (i *issuerService) rotateVerificationMethods(
+	ctx context.Context,
+	commonName string,
+	previousKey *jwk.Jwk,
+	publicKey *jwk.Jwk,
+	retiredAt time.Time,
+) error {
+	mds, err := i.idRepository.GetByController(ctx, commonName)
+	if err != nil {
+		return errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unable to fetch the resolver metadata", err)
 	}
 
-	// Return the public keys of the Issuer
-	return &jwk.Jwks{
-		Keys: []*jwk.Jwk{
-			issuer.PublicKey,
-		},
-	}, nil
+	previousKeyJSON := previousKey.PublicKey().ToJSON()
+
+	for _, md := range mds {
+		for _, vm := range md.VerificationMethod {
+			if vm.RetiredAt == nil &&
+				vm.PublicKeyJwk != nil &&
+				bytes.Equal(vm.PublicKeyJwk.PublicKey().ToJSON(), previousKeyJSON) {
+				vm.RetiredAt = &retiredAt
+			}
+		}
+
+		keyID := fmt.Sprintf("%s#%s", md.ID, uuid.NewString())
+
+		md.VerificationMethod = append(md.VerificationMethod, &idtypes.VerificationMethod{
+			ID:           keyID,
+			PublicKeyJwk: publicKey,
+		})
+		md.AssertionMethod = append(md.AssertionMethod, keyID)
+
+		_, err = i.idRepository.UpdateID(ctx, md)
+		if err != nil {
+			return errutil.ErrInfo(
+				errtypes.ERROR_REASON_INTERNAL,
+				"unable to store the resolver metadata",
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (i *issuerService) getIssuer(
+	ctx context.Context,
+	commonName string,
+) (*issuertypes.Issuer, error) {
+	issuer, err := i.issuerRepository.GetIssuer(ctx, commonName)
+
+	return issuer, i.issuerError(err)
+}
+
+func (i *issuerService) getIssuerForUpdate(
+	ctx context.Context,
+	commonName string,
+) (*issuertypes.Issuer, error) {
+	issuer, err := i.issuerRepository.GetIssuerForUpdate(ctx, commonName)
+
+	return issuer, i.issuerError(err)
+}
+
+// issuerError converts the errors of the issuer repository
+func (i *issuerService) issuerError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, errcore.ErrResourceNotFound) {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_ISSUER_NOT_REGISTERED,
+			"the issuer is not registered",
+			nil,
+		)
+	}
+
+	return errutil.ErrInfo(
+		errtypes.ERROR_REASON_INTERNAL,
+		"unexpected error",
+		err,
+	)
 }
@@ -9,13 +9,25 @@ import (
 	"crypto/rsa"
 	"encoding/json"
 	"testing"
+	"time"
 
+	errtesting "github.com/agntcy/identity/internal/core/errors/testing"
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	idcore "github.com/agntcy/identity/internal/core/id"
+	idtesting "github.com/agntcy/identity/internal/core/id/testing"
+	idtypes "github.com/agntcy/identity/internal/core/id/types"
+	issuercore "github.com/agntcy/identity/internal/core/issuer"
 	issuertesting "github.com/agntcy/identity/internal/core/issuer/testing"
+	"github.com/agntcy/identity/internal/core/issuer/trust"
 	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
+	issuerverif "github.com/agntcy/identity/internal/core/issuer/verification"
 	verificationtesting "github.com/agntcy/identity/internal/core/issuer/verification/testing"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
 	"github.com/agntcy/identity/internal/node"
+	dbtesting "github.com/agntcy/identity/pkg/db/testing"
 	jwktype "github.com/agntcy/identity/pkg/jwk"
+	"github.com/agntcy/identity/pkg/oidc"
+	oidctesting "github.com/agntcy/identity/pkg/oidc/testing"
 	"github.com/lestrrat-go/jwx/v3/jwa"
 	"github.com/lestrrat-go/jwx/v3/jwk"
 	"github.com/stretchr/testify/assert"
@@ -26,7 +38,14 @@ func TestRegisterIssuer_Should_Not_Register_Same_Issuer_Twice(t *testing.T) {
 
 	verficationSrv := verificationtesting.NewFakeVerifiedVerificationServiceStub()
 	issuerRepo := issuertesting.NewFakeIssuerRepository()
-	sut := node.NewIssuerService(issuerRepo, verficationSrv)
+	sut := node.NewIssuerService(
+		issuerRepo,
+		idtesting.NewFakeIdRepository(),
+		verficationSrv,
+		time.Hour,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+	)
 	pubKey, _ := generatePubKey()
 
 	issuer := &issuertypes.Issuer{
@@ -54,7 +73,14 @@ func TestRegisterIssuer_Should_Register_Verified_Issuer(t *testing.T) {
 
 	verficationSrv := verificationtesting.NewFakeVerifiedVerificationServiceStub()
 	issuerRepo := issuertesting.NewFakeIssuerRepository()
-	sut := node.NewIssuerService(issuerRepo, verficationSrv)
+	sut := node.NewIssuerService(
+		issuerRepo,
+		idtesting.NewFakeIdRepository(),
+		verficationSrv,
+		time.Hour,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+	)
 	pubKey, _ := generatePubKey()
 
 	issuer := &issuertypes.Issuer{
@@ -87,7 +113,14 @@ func TestRegisterIssuer_Should_Register_Unverified_Issuer(t *testing.T) {
 
 	verficationSrv := verificationtesting.NewFakeUnverifiedVerificationServiceStub()
 	issuerRepo := issuertesting.NewFakeIssuerRepository()
-	sut := node.NewIssuerService(issuerRepo, verficationSrv)
+	sut := node.NewIssuerService(
+		issuerRepo,
+		idtesting.NewFakeIdRepository(),
+		verficationSrv,
+		time.Hour,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+	)
 	pubKey, _ := generatePubKey()
 
 	issuer := &issuertypes.Issuer{
@@ -115,6 +148,253 @@ func TestRegisterIssuer_Should_Register_Unverified_Issuer(t *testing.T) {
 	assert.Equal(t, registeredIssuer.Verified, false)
 }
 
+func TestRegisterIssuer_Should_Reject_Untrusted_Issuer(t *testing.T) {
+	t.Parallel()
+
+	jwt := &oidc.ParsedJWT{
+		Provider: oidc.SelfProviderName,
+		Claims: &oidc.Claims{
+			Issuer: oidc.SelfIssuedIssScheme + ":" + verificationtesting.ValidProofIssuer,
+		},
+		CommonName: verificationtesting.ValidProofIssuer,
+	}
+	issuerRepo := issuertesting.NewFakeIssuerRepository()
+	sut := node.NewIssuerService(
+		issuerRepo,
+		idtesting.NewFakeIdRepository(),
+		issuerverif.NewService(
+			oidctesting.NewFakeParser(jwt, nil),
+			issuerRepo,
+			trust.NewStaticSource(&trust.Policy{AllowSelfIssued: false}),
+		),
+		time.Hour,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+	)
+	pubKey, _ := generatePubKey()
+
+	issuer := &issuertypes.Issuer{
+		CommonName:   verificationtesting.ValidProofIssuer,
+		Organization: "Some Org",
+		PublicKey:    pubKey,
+	}
+
+	err := sut.Register(context.Background(), issuer, &vctypes.Proof{Type: "JWT"})
+
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_UNTRUSTED_ISSUER)
+
+	_, err = issuerRepo.GetIssuer(context.Background(), verificationtesting.ValidProofIssuer)
+	assert.Error(t, err)
+}
+
+func TestRotateKey_Should_Publish_The_Active_Keys(t *testing.T) {
+	t.Parallel()
+
+	issuerRepo, idRepo, previousKey := setupIssuerWithMetadata(t)
+	sut := node.NewIssuerService(
+		issuerRepo,
+		idRepo,
+		verificationtesting.NewFakeVerifiedVerificationServiceStub(),
+		time.Hour,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+	)
+	newKey := generateKeyWithID(t, "key-2")
+
+	err := sut.RotateKey(
+		t.Context(),
+		verificationtesting.ValidProofIssuer,
+		newKey,
+		&vctypes.Proof{Type: "JWT"},
+		false,
+	)
+	assert.NoError(t, err)
+
+	jwks, err := sut.GetJwks(t.Context(), verificationtesting.ValidProofIssuer)
+	assert.NoError(t, err)
+	assert.Equal(t, []*jwktype.Jwk{newKey, previousKey}, jwks.Keys)
+
+	md, err := node.NewIdService(
+		idRepo,
+		issuerRepo,
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+	).Resolve(t.Context(), rotationTestID)
+	assert.NoError(t, err)
+	assert.Len(t, md.VerificationMethod, 2)
+	assert.Len(t, md.AssertionMethod, 2)
+	assert.ElementsMatch(t, []*jwktype.Jwk{newKey, previousKey}, md.GetJwks().Keys)
+}
+
+func TestRotateKey_Should_Retire_The_Previous_Key_Immediately(t *testing.T) {
+	t.Parallel()
+
+	issuerRepo, idRepo, _ := setupIssuerWithMetadata(t)
+	sut := node.NewIssuerService(
+		issuerRepo,
+		idRepo,
+		verificationtesting.NewFakeVerifiedVerificationServiceStub(),
+		time.Hour,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+	)
+	newKey := generateKeyWithID(t, "key-2")
+
+	err := sut.RotateKey(
+		t.Context(),
+		verificationtesting.ValidProofIssuer,
+		newKey,
+		&vctypes.Proof{Type: "JWT"},
+		true,
+	)
+	assert.NoError(t, err)
+
+	jwks, err := sut.GetJwks(t.Context(), verificationtesting.ValidProofIssuer)
+	assert.NoError(t, err)
+	assert.Equal(t, []*jwktype.Jwk{newKey}, jwks.Keys)
+
+	md, err := node.NewIdService(
+		idRepo,
+		issuerRepo,
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+	).Resolve(t.Context(), rotationTestID)
+	assert.NoError(t, err)
+	assert.Len(t, md.VerificationMethod, 1)
+	assert.Equal(t, newKey, md.VerificationMethod[0].PublicKeyJwk)
+	assert.Equal(t, []string{md.VerificationMethod[0].ID}, md.AssertionMethod)
+}
+
+func TestRotateKey_Should_Reject_A_Known_Key_ID(t *testing.T) {
+	t.Parallel()
+
+	issuerRepo, idRepo, previousKey := setupIssuerWithMetadata(t)
+	sut := node.NewIssuerService(
+		issuerRepo,
+		idRepo,
+		verificationtesting.NewFakeVerifiedVerificationServiceStub(),
+		time.Hour,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+	)
+
+	err := sut.RotateKey(
+		t.Context(),
+		verificationtesting.ValidProofIssuer,
+		generateKeyWithID(t, previousKey.KID),
+		&vctypes.Proof{Type: "JWT"},
+		false,
+	)
+	assert.True(t, errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INVALID_ISSUER))
+}
+
+func TestRotateKey_Should_Reject_A_Concurrent_Rotation(t *testing.T) {
+	t.Parallel()
+
+	issuerRepo, idRepo, previousKey := setupIssuerWithMetadata(t)
+	concurrentKey := generateKeyWithID(t, "key-3")
+	sut := node.NewIssuerService(
+		&concurrentRotationRepository{Repository: issuerRepo, publicKey: concurrentKey},
+		idRepo,
+		verificationtesting.NewFakeVerifiedVerificationServiceStub(),
+		time.Hour,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+	)
+
+	err := sut.RotateKey(
+		t.Context(),
+		verificationtesting.ValidProofIssuer,
+		generateKeyWithID(t, "key-2"),
+		&vctypes.Proof{Type: "JWT"},
+		false,
+	)
+	assert.True(t, errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_INVALID_ISSUER))
+
+	issuer, err := issuerRepo.GetIssuer(t.Context(), verificationtesting.ValidProofIssuer)
+	assert.NoError(t, err)
+	assert.Equal(t, concurrentKey, issuer.PublicKey)
+	assert.Len(t, issuer.RetiredKeys, 1)
+	assert.Equal(t, previousKey, issuer.RetiredKeys[0].PublicKey)
+}
+
+// concurrentRotationRepository rotates the key of the issuer before it is locked,
+// as a concurrent rotation committed between the read and the lock would
+type concurrentRotationRepository struct {
+	issuercore.Repository
+	publicKey *jwktype.Jwk
+}
+
+func (r *concurrentRotationRepository) GetIssuerForUpdate(
+	ctx context.Context,
+	commonName string,
+) (*issuertypes.Issuer, error) {
+	issuer, err := r.GetIssuer(ctx, commonName)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.UpdateIssuer(ctx, &issuertypes.Issuer{
+		CommonName: issuer.CommonName,
+		PublicKey:  r.publicKey,
+		AuthType:   issuer.AuthType,
+		RetiredKeys: append(issuer.RetiredKeys, &issuertypes.RetiredKey{
+			PublicKey: issuer.PublicKey,
+			RetiredAt: time.Now().Add(time.Hour),
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Repository.GetIssuerForUpdate(ctx, commonName)
+}
+
+const rotationTestID = "AGNTCY-rotation"
+
+func setupIssuerWithMetadata(
+	t *testing.T,
+) (issuercore.Repository, idcore.IdRepository, *jwktype.Jwk) {
+	t.Helper()
+
+	issuerRepo := issuertesting.NewFakeIssuerRepository()
+	idRepo := idtesting.NewFakeIdRepository()
+	pubKey := generateKeyWithID(t, "key-1")
+
+	issuer := &issuertypes.Issuer{
+		CommonName: verificationtesting.ValidProofIssuer,
+		PublicKey:  pubKey,
+		AuthType:   issuertypes.ISSUER_AUTH_TYPE_SELF,
+	}
+	_, err := issuerRepo.CreateIssuer(t.Context(), issuer)
+	assert.NoError(t, err)
+
+	_, err = idRepo.CreateID(t.Context(), &idtypes.ResolverMetadata{
+		ID: rotationTestID,
+		VerificationMethod: []*idtypes.VerificationMethod{
+			{ID: rotationTestID + "#key-1", PublicKeyJwk: pubKey},
+		},
+		AssertionMethod: []string{rotationTestID + "#key-1"},
+		Controller:      issuer.CommonName,
+	}, issuer)
+	assert.NoError(t, err)
+
+	return issuerRepo, idRepo, pubKey
+}
+
+func generateKeyWithID(t *testing.T, kid string) *jwktype.Jwk {
+	t.Helper()
+
+	pubKey, err := generatePubKey()
+	assert.NoError(t, err)
+
+	pubKey.KID = kid
+
+	return pubKey
+}
+
 func generatePubKey() (*jwktype.Jwk, error) {
 	pk, err := rsa.GenerateKey(rand.Reader, 2048)
 	if err != nil {
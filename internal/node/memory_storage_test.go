@@ -0,0 +1,365 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package node_test
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	idcore "github.com/agntcy/identity/internal/core/id"
+	idmemory "github.com/agntcy/identity/internal/core/id/memory"
+	idtypes "github.com/agntcy/identity/internal/core/id/types"
+	issuercore "github.com/agntcy/identity/internal/core/issuer"
+	issuermemory "github.com/agntcy/identity/internal/core/issuer/memory"
+	"github.com/agntcy/identity/internal/core/issuer/trust"
+	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
+	issuerverif "github.com/agntcy/identity/internal/core/issuer/verification"
+	verificationtesting "github.com/agntcy/identity/internal/core/issuer/verification/testing"
+	"github.com/agntcy/identity/internal/core/translog"
+	translogmemory "github.com/agntcy/identity/internal/core/translog/memory"
+	vccore "github.com/agntcy/identity/internal/core/vc"
+	"github.com/agntcy/identity/internal/core/vc/events"
+	eventsmemory "github.com/agntcy/identity/internal/core/vc/events/memory"
+	vcmemory "github.com/agntcy/identity/internal/core/vc/memory"
+	"github.com/agntcy/identity/internal/core/vc/statuslist"
+	statuslistmemory "github.com/agntcy/identity/internal/core/vc/statuslist/memory"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/core/webhook"
+	webhookmemory "github.com/agntcy/identity/internal/core/webhook/memory"
+	"github.com/agntcy/identity/internal/node"
+	"github.com/agntcy/identity/pkg/db"
+	"github.com/agntcy/identity/pkg/db/memory"
+	"github.com/agntcy/identity/pkg/joseutil"
+	jwktype "github.com/agntcy/identity/pkg/jwk"
+	"github.com/agntcy/identity/pkg/oidc"
+	oidctesting "github.com/agntcy/identity/pkg/oidc/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryRepositories struct {
+	issuer          issuercore.Repository
+	id              idcore.IdRepository
+	vc              vccore.Repository
+	statusList      statuslist.Repository
+	transparencyLog translog.Repository
+	events          events.Repository
+	webhook         webhook.Repository
+	transactor      db.Transactor
+}
+
+func newMemoryRepositories(t *testing.T, path string) *memoryRepositories {
+	t.Helper()
+
+	store, err := memory.NewStore(path)
+	assert.NoError(t, err)
+
+	repos := &memoryRepositories{transactor: store}
+
+	repos.issuer, err = issuermemory.NewRepository(store)
+	assert.NoError(t, err)
+
+	repos.id, err = idmemory.NewIdRepository(store)
+	assert.NoError(t, err)
+
+	repos.vc, err = vcmemory.NewRepository(store)
+	assert.NoError(t, err)
+
+	repos.statusList, err = statuslistmemory.NewRepository(store)
+	assert.NoError(t, err)
+
+	repos.transparencyLog, err = translogmemory.NewRepository(store)
+	assert.NoError(t, err)
+
+	repos.events, err = eventsmemory.NewRepository(store)
+	assert.NoError(t, err)
+
+	repos.webhook, err = webhookmemory.NewRepository(store)
+	assert.NoError(t, err)
+
+	return repos
+}
+
+func TestMemoryStorage_Should_Restore_The_Node_State(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "node.json")
+	repos := newMemoryRepositories(t, path)
+	jwt := &oidc.ParsedJWT{
+		Provider: oidc.DuoProviderName,
+		Claims: &oidc.Claims{
+			Issuer:  "http://" + verificationtesting.ValidProofIssuer,
+			Subject: verificationtesting.ValidProofSub,
+		},
+		CommonName: verificationtesting.ValidProofIssuer,
+	}
+	verifSrv := issuerverif.NewService(
+		oidctesting.NewFakeParser(jwt, nil),
+		repos.issuer,
+		trust.NewStaticSource(trust.DefaultPolicy()),
+	)
+	key, _ := joseutil.GenerateJWK("ES256", "sig", "")
+	transparencyLog := node.NewTransparencyLogService(repos.transparencyLog, key, maxCachedLeaves)
+	vcSrv := node.NewVerifiableCredentialService(
+		repos.id,
+		verifSrv,
+		repos.vc,
+		repos.statusList,
+		transparencyLog,
+		repos.transactor,
+		0,
+	)
+	issuer := &issuertypes.Issuer{CommonName: verificationtesting.ValidProofIssuer}
+	_, _ = repos.issuer.CreateIssuer(context.Background(), issuer)
+	envelope := generateValidVC(t, repos.id, issuer)
+
+	err := vcSrv.Publish(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	// Restart the node from the persisted store
+	restored := newMemoryRepositories(t, path)
+
+	restoredIssuer, err := restored.issuer.GetIssuer(context.Background(), issuer.CommonName)
+	assert.NoError(t, err)
+	assert.Equal(t, issuer.CommonName, restoredIssuer.CommonName)
+
+	md, err := restored.id.ResolveID(context.Background(), "DUO-"+verificationtesting.ValidProofSub)
+	assert.NoError(t, err)
+	assert.Equal(t, issuer.CommonName, md.Controller)
+
+	credential, err := restored.vc.GetByID(context.Background(), "VC_ID")
+	assert.NoError(t, err)
+	assert.Equal(t, "VC_ID", credential.ID)
+
+	leafHash, _ := translog.NewEntry(
+		translog.EntryTypeVcPublish,
+		"VC_ID",
+		[]byte(envelope.Value),
+	).LeafHash()
+	restoredLog := node.NewTransparencyLogService(restored.transparencyLog, key, maxCachedLeaves)
+
+	proof, err := restoredLog.GetInclusionProof(context.Background(), leafHash, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, proof.Verify())
+}
+
+func TestMemoryStorage_Should_Not_Store_A_Mutation_Without_Its_Log_Entry(t *testing.T) {
+	t.Parallel()
+
+	repos := newMemoryRepositories(t, filepath.Join(t.TempDir(), "node.json"))
+	jwt := &oidc.ParsedJWT{
+		Provider: oidc.DuoProviderName,
+		Claims: &oidc.Claims{
+			Issuer:  "http://" + verificationtesting.ValidProofIssuer,
+			Subject: verificationtesting.ValidProofSub,
+		},
+		CommonName: verificationtesting.ValidProofIssuer,
+	}
+	verifSrv := issuerverif.NewService(
+		oidctesting.NewFakeParser(jwt, nil),
+		repos.issuer,
+		trust.NewStaticSource(trust.DefaultPolicy()),
+	)
+	vcSrv := node.NewVerifiableCredentialService(
+		repos.id,
+		verifSrv,
+		repos.vc,
+		repos.statusList,
+		&failingTransparencyLog{},
+		repos.transactor,
+		0,
+	)
+	issuer := &issuertypes.Issuer{CommonName: verificationtesting.ValidProofIssuer}
+	_, _ = repos.issuer.CreateIssuer(context.Background(), issuer)
+	envelope := generateValidVC(t, repos.id, issuer)
+
+	err := vcSrv.Publish(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.Error(t, err)
+
+	_, err = repos.vc.GetByID(context.Background(), "VC_ID")
+	assert.Error(t, err)
+}
+
+func TestMemoryStorage_Should_Store_The_Events_With_The_Mutation(t *testing.T) {
+	t.Parallel()
+
+	for _, failing := range []bool{false, true} {
+		repos := newMemoryRepositories(t, filepath.Join(t.TempDir(), "node.json"))
+		listeners := []events.Listener{
+			events.NewBroker(repos.events, events.DefaultMaxSubscribers, events.DefaultPollInterval),
+			node.NewWebhookDispatcher(
+				repos.webhook,
+				webhook.NewHMACSigner(webhookSecret),
+				[]string{"https://example.com/webhook"},
+				time.Second,
+				1,
+			),
+		}
+
+		if failing {
+			listeners = append(listeners, &failingListener{})
+		}
+
+		vcSrv := newMemoryVcService(t, repos, listeners...)
+		issuer := &issuertypes.Issuer{CommonName: verificationtesting.ValidProofIssuer}
+		_, _ = repos.issuer.CreateIssuer(context.Background(), issuer)
+		envelope := generateValidVC(t, repos.id, issuer)
+
+		err := vcSrv.Publish(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
+
+		_, last, _ := repos.events.GetSequenceRange(context.Background())
+		deliveries, _ := repos.webhook.Claim(context.Background(), time.Now(), time.Second, 10)
+
+		if failing {
+			// The credential, its event and its deliveries are rolled back together
+			assert.Error(t, err)
+
+			_, err = repos.vc.GetByID(context.Background(), "VC_ID")
+			assert.Error(t, err)
+			assert.Zero(t, last)
+			assert.Empty(t, deliveries)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(1), last)
+			assert.Len(t, deliveries, 1)
+		}
+	}
+}
+
+func TestMemoryStorage_Should_Update_The_Status_List_With_The_Status(t *testing.T) {
+	t.Parallel()
+
+	repos := newMemoryRepositories(t, filepath.Join(t.TempDir(), "node.json"))
+	vcSrv := newMemoryVcService(t, repos, &failingListener{eventType: events.EventTypeRevoked})
+	privKey, pubKey := createMemoryID(t, repos)
+	envelope, err := signVCWithJose(newStatusListCredential(t, "VC_ID", 7), privKey, pubKey.KID)
+	assert.NoError(t, err)
+	err = vcSrv.Publish(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	err = vcSrv.Revoke(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.Error(t, err)
+
+	// The status list entry is rolled back with the revocation
+	entries, err := repos.statusList.GetByCredential(context.Background(), "VC_ID")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.False(t, entries[0].Set)
+}
+
+func TestMemoryStorage_Should_Update_The_Status_List_With_The_Suspension(t *testing.T) {
+	t.Parallel()
+
+	repos := newMemoryRepositories(t, filepath.Join(t.TempDir(), "node.json"))
+	vcSrv := newMemoryVcService(t, repos, &failingListener{eventType: events.EventTypeReinstated})
+	privKey, pubKey := createMemoryID(t, repos)
+	credential := newStatusListCredential(t, "VC_ID", 7)
+	credential.Status = []*vctypes.CredentialStatus{
+		newStatusListEntry(t, vctypes.CREDENTIAL_STATUS_PURPOSE_SUSPENSION, 7),
+	}
+	envelope, err := signVCWithJose(credential, privKey, pubKey.KID)
+	assert.NoError(t, err)
+	err = vcSrv.Publish(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	err = vcSrv.Suspend(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	err = vcSrv.Reinstate(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.Error(t, err)
+
+	// The status list entry is still set after the failed reinstatement
+	entries, err := repos.statusList.GetByCredential(context.Background(), "VC_ID")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.True(t, entries[0].Set)
+}
+
+// createMemoryID creates the valid proof issuer and the ID of the subject
+// with a new key
+func createMemoryID(t *testing.T, repos *memoryRepositories) (*rsa.PrivateKey, *jwktype.Jwk) {
+	t.Helper()
+
+	issuer := &issuertypes.Issuer{CommonName: verificationtesting.ValidProofIssuer}
+	_, _ = repos.issuer.CreateIssuer(context.Background(), issuer)
+
+	privKey, pubKey, err := genKey()
+	assert.NoError(t, err)
+
+	_, err = repos.id.CreateID(context.Background(), &idtypes.ResolverMetadata{
+		ID: "DUO-" + verificationtesting.ValidProofSub,
+		VerificationMethod: []*idtypes.VerificationMethod{
+			{
+				ID:           pubKey.KID,
+				PublicKeyJwk: pubKey,
+			},
+		},
+	}, issuer)
+	assert.NoError(t, err)
+
+	return privKey, pubKey
+}
+
+// newMemoryVcService creates the credential service on the repositories,
+// the proofs are issued by the valid proof issuer
+func newMemoryVcService(
+	t *testing.T,
+	repos *memoryRepositories,
+	listeners ...events.Listener,
+) node.VerifiableCredentialService {
+	t.Helper()
+
+	jwt := &oidc.ParsedJWT{
+		Provider: oidc.DuoProviderName,
+		Claims: &oidc.Claims{
+			Issuer:  "http://" + verificationtesting.ValidProofIssuer,
+			Subject: verificationtesting.ValidProofSub,
+		},
+		CommonName: verificationtesting.ValidProofIssuer,
+	}
+	verifSrv := issuerverif.NewService(
+		oidctesting.NewFakeParser(jwt, nil),
+		repos.issuer,
+		trust.NewStaticSource(trust.DefaultPolicy()),
+	)
+
+	return node.NewVerifiableCredentialService(
+		repos.id,
+		verifSrv,
+		repos.vc,
+		repos.statusList,
+		newTransparencyLog(t),
+		repos.transactor,
+		0,
+		listeners...,
+	)
+}
+
+// failingListener fails to store the events, or only the events
+// of a type when eventType is set
+type failingListener struct {
+	eventType events.EventType
+}
+
+func (l *failingListener) Store(_ context.Context, event *events.Event) error {
+	if l.eventType != "" && event.Type != l.eventType {
+		return nil
+	}
+
+	return errors.New("the events are unavailable")
+}
+
+func (*failingListener) OnEvent(context.Context, *events.Event) {}
+
+// failingTransparencyLog fails to append the mutations
+type failingTransparencyLog struct {
+	node.TransparencyLogService
+}
+
+func (*failingTransparencyLog) Append(context.Context, translog.EntryType, string, []byte) error {
+	return errors.New("the transparency log is unavailable")
+}
@@ -0,0 +1,10 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+DROP TABLE credential_statuses;
+DROP TABLE credential_schemas;
+DROP TABLE verifiable_credentials;
+DROP TABLE verification_methods;
+DROP TABLE services;
+DROP TABLE resolver_metadata;
+DROP TABLE issuers;
@@ -0,0 +1,101 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+-- The schema created by the automatic migration of the first versions of the Node.
+
+CREATE TABLE issuers (
+  common_name text,
+  verified boolean NOT NULL DEFAULT false,
+  organization varchar(256) NOT NULL,
+  sub_organization varchar(256) NOT NULL,
+  public_key_alg text,
+  public_key_kty text,
+  public_key_use text,
+  public_key_k_id text,
+  public_key_pub text,
+  public_key_priv text,
+  public_key_seed text,
+  public_key_e text,
+  public_key_n text,
+  public_key_d text,
+  public_key_p text,
+  public_key_q text,
+  public_key_dp text,
+  public_key_dq text,
+  public_key_qi text,
+  auth_type bigint,
+  PRIMARY KEY (common_name)
+);
+
+CREATE TABLE resolver_metadata (
+  id text,
+  assertion_method text[],
+  controller text,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_issuers_resolver_metadata FOREIGN KEY (controller) REFERENCES issuers (common_name)
+);
+
+CREATE TABLE services (
+  id text,
+  service_endpoint text[],
+  resolver_metadata_id text,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_resolver_metadata_service FOREIGN KEY (resolver_metadata_id) REFERENCES resolver_metadata (id)
+);
+
+CREATE TABLE verification_methods (
+  id text,
+  public_key_jwk_alg text,
+  public_key_jwk_kty text,
+  public_key_jwk_use text,
+  public_key_jwk_k_id text,
+  public_key_jwk_pub text,
+  public_key_jwk_priv text,
+  public_key_jwk_seed text,
+  public_key_jwk_e text,
+  public_key_jwk_n text,
+  public_key_jwk_d text,
+  public_key_jwk_p text,
+  public_key_jwk_q text,
+  public_key_jwk_dp text,
+  public_key_jwk_dq text,
+  public_key_jwk_qi text,
+  resolver_metadata_id text,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_resolver_metadata_verification_method FOREIGN KEY (resolver_metadata_id) REFERENCES resolver_metadata (id)
+);
+
+CREATE TABLE verifiable_credentials (
+  id text,
+  created_at timestamptz,
+  context text[],
+  type text[],
+  issuer text,
+  credential_subject bytea,
+  issuance_date text,
+  expiration_date text,
+  proof_type text,
+  proof_proof_purpose text,
+  proof_proof_value text,
+  resolver_metadata_id text,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_resolver_metadata_vc FOREIGN KEY (resolver_metadata_id) REFERENCES resolver_metadata (id)
+);
+
+CREATE TABLE credential_schemas (
+  id text,
+  verifiable_credential_id text,
+  type text,
+  PRIMARY KEY (id, verifiable_credential_id),
+  CONSTRAINT fk_verifiable_credentials_credential_schema FOREIGN KEY (verifiable_credential_id) REFERENCES verifiable_credentials (id)
+);
+
+CREATE TABLE credential_statuses (
+  id text,
+  verifiable_credential_id text,
+  type text,
+  created_at timestamptz,
+  purpose bigint,
+  PRIMARY KEY (id, verifiable_credential_id),
+  CONSTRAINT fk_verifiable_credentials_status FOREIGN KEY (verifiable_credential_id) REFERENCES verifiable_credentials (id)
+);
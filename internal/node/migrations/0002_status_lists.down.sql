@@ -0,0 +1,8 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+DROP TABLE status_list_entries;
+
+ALTER TABLE credential_statuses
+  DROP COLUMN status_list_credential,
+  DROP COLUMN status_list_index;
@@ -0,0 +1,21 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+-- The entries of the Bitstring Status Lists of the Issuers.
+
+ALTER TABLE credential_statuses
+  ADD COLUMN status_list_index text,
+  ADD COLUMN status_list_credential text;
+
+CREATE TABLE status_list_entries (
+  issuer_common_name text,
+  purpose bigint,
+  status_list_index bigint,
+  verifiable_credential_id text,
+  is_set boolean,
+  created_at timestamptz,
+  updated_at timestamptz,
+  PRIMARY KEY (issuer_common_name, purpose, status_list_index)
+);
+
+CREATE INDEX idx_status_list_entries_verifiable_credential_id ON status_list_entries (verifiable_credential_id);
@@ -0,0 +1,6 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE verifiable_credentials
+  DROP COLUMN valid_until,
+  DROP COLUMN valid_from;
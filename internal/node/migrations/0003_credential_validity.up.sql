@@ -0,0 +1,8 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+-- The validity period of the VC Data Model 2.0 credentials.
+
+ALTER TABLE verifiable_credentials
+  ADD COLUMN valid_from text,
+  ADD COLUMN valid_until text;
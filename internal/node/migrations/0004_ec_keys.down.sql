@@ -0,0 +1,12 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE verification_methods
+  DROP COLUMN public_key_jwk_y,
+  DROP COLUMN public_key_jwk_x,
+  DROP COLUMN public_key_jwk_crv;
+
+ALTER TABLE issuers
+  DROP COLUMN public_key_y,
+  DROP COLUMN public_key_x,
+  DROP COLUMN public_key_crv;
@@ -0,0 +1,14 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+-- The curve and the coordinates of the EC and OKP keys.
+
+ALTER TABLE issuers
+  ADD COLUMN public_key_crv text,
+  ADD COLUMN public_key_x text,
+  ADD COLUMN public_key_y text;
+
+ALTER TABLE verification_methods
+  ADD COLUMN public_key_jwk_crv text,
+  ADD COLUMN public_key_jwk_x text,
+  ADD COLUMN public_key_jwk_y text;
@@ -0,0 +1,6 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE verification_methods DROP COLUMN retired_at;
+
+DROP TABLE retired_keys;
@@ -0,0 +1,34 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+-- The previous keys of the Issuers, active until their retirement.
+
+CREATE TABLE retired_keys (
+  id bigserial,
+  public_key_alg text,
+  public_key_kty text,
+  public_key_use text,
+  public_key_k_id text,
+  public_key_pub text,
+  public_key_priv text,
+  public_key_seed text,
+  public_key_e text,
+  public_key_n text,
+  public_key_d text,
+  public_key_p text,
+  public_key_q text,
+  public_key_dp text,
+  public_key_dq text,
+  public_key_qi text,
+  public_key_crv text,
+  public_key_x text,
+  public_key_y text,
+  retired_at timestamptz,
+  issuer_common_name text,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_issuers_retired_keys FOREIGN KEY (issuer_common_name) REFERENCES issuers (common_name)
+);
+
+CREATE INDEX idx_retired_keys_issuer_common_name ON retired_keys (issuer_common_name);
+
+ALTER TABLE verification_methods ADD COLUMN retired_at timestamptz;
@@ -0,0 +1,6 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE resolver_metadata
+  DROP COLUMN deactivated_at,
+  DROP COLUMN deactivated;
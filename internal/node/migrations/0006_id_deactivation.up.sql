@@ -0,0 +1,8 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+-- The tombstone of the deactivated IDs.
+
+ALTER TABLE resolver_metadata
+  ADD COLUMN deactivated boolean,
+  ADD COLUMN deactivated_at timestamptz;
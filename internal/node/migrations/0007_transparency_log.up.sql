@@ -0,0 +1,18 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+-- The leaves of the transparency log of the mutations of the Node.
+
+CREATE TABLE transparency_log_leaves (
+  leaf_index bigint,
+  type text,
+  subject text,
+  digest text,
+  hash bytea,
+  created_at timestamptz,
+  PRIMARY KEY (leaf_index)
+);
+
+CREATE INDEX idx_transparency_log_leaves_hash ON transparency_log_leaves (hash);
+
+CREATE INDEX idx_transparency_log_leaves_subject ON transparency_log_leaves (subject);
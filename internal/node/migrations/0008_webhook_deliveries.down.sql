@@ -0,0 +1,4 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+DROP TABLE IF EXISTS webhook_deliveries;
@@ -0,0 +1,20 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+-- The queue of the outbound webhook deliveries.
+
+CREATE TABLE webhook_deliveries (
+  id text,
+  url text,
+  event_id text,
+  payload bytea,
+  attempts bigint,
+  next_attempt_at timestamptz,
+  status text,
+  last_error text,
+  created_at timestamptz,
+  updated_at timestamptz,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_webhook_deliveries_next_attempt_at ON webhook_deliveries (next_attempt_at);
@@ -0,0 +1,13 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+-- The IDs of the proofs accepted by the Node, kept until the proofs expire
+-- to reject the replayed proofs.
+
+CREATE TABLE proof_ids (
+  id text,
+  expires_at timestamptz,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_proof_ids_expires_at ON proof_ids (expires_at);
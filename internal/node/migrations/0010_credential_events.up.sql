@@ -0,0 +1,21 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+-- The events of the credentials, streamed to the watchers from their sequence.
+
+CREATE TABLE credential_events (
+  sequence bigint,
+  id text,
+  type text,
+  vc_id text,
+  issuer text,
+  resolver_metadata_id text,
+  timestamp timestamptz,
+  payload bytea,
+  PRIMARY KEY (sequence)
+);
+
+CREATE UNIQUE INDEX idx_credential_events_id ON credential_events (id);
+CREATE INDEX idx_credential_events_issuer ON credential_events (issuer);
+CREATE INDEX idx_credential_events_resolver_metadata_id ON credential_events (resolver_metadata_id);
+CREATE INDEX idx_credential_events_timestamp ON credential_events (timestamp);
@@ -0,0 +1,14 @@
+-- Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+-- SPDX-License-Identifier: Apache-2.0
+
+-- The size of the transparency log, its row is locked by the appends
+-- so the leaves get their indexes one at a time without gap.
+
+CREATE TABLE transparency_log_size (
+  id smallint,
+  size bigint NOT NULL,
+  PRIMARY KEY (id)
+);
+
+INSERT INTO transparency_log_size (id, size)
+SELECT 1, COUNT(*) FROM transparency_log_leaves;
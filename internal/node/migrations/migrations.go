@@ -0,0 +1,21 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package migrations holds the versioned migrations of the Node database schema.
+// A change of the postgres models must come with a new pair of scripts named
+// <version>_<name>.up.sql and <version>_<name>.down.sql.
+package migrations
+
+import (
+	"embed"
+
+	"github.com/agntcy/identity/pkg/db/migrate"
+)
+
+//go:embed *.sql
+var scripts embed.FS
+
+// Load returns the migrations of the Node ordered by version
+func Load() ([]*migrate.Migration, error) {
+	return migrate.Load(scripts)
+}
@@ -0,0 +1,25 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/agntcy/identity/internal/node/migrations"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad_Should_Number_The_Migrations_Without_Gap(t *testing.T) {
+	t.Parallel()
+
+	actual, err := migrations.Load()
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, actual)
+	assert.Equal(t, "initial_schema", actual[0].Name)
+
+	for idx, migration := range actual {
+		assert.Equal(t, uint64(idx+1), migration.Version, migration.Name)
+	}
+}
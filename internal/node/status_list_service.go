@@ -0,0 +1,111 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/core/vc/statuslist"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/jwk"
+)
+
+// The StatusListService interface defines the Node methods for the
+// Bitstring Status Lists of the Issuers
+type StatusListService interface {
+	// Build and sign the status list credential of an Issuer for a purpose
+	GetStatusList(
+		ctx context.Context,
+		issuerCommonName string,
+		purpose vctypes.CredentialStatusPurpose,
+	) (*vctypes.EnvelopedCredential, error)
+
+	// Return the public keys used by the Node to sign the status lists
+	GetJwks(ctx context.Context) (*jwk.Jwks, error)
+}
+
+// The statusListService struct implements the StatusListService interface
+type statusListService struct {
+	statusListRepository statuslist.Repository
+	signingKey           *jwk.Jwk
+	nodeURL              string
+	ttl                  time.Duration
+}
+
+// NewStatusListService creates a new instance of the StatusListService
+func NewStatusListService(
+	statusListRepository statuslist.Repository,
+	signingKey *jwk.Jwk,
+	nodeURL string,
+	ttl time.Duration,
+) StatusListService {
+	return &statusListService{
+		statusListRepository: statusListRepository,
+		signingKey:           signingKey,
+		nodeURL:              nodeURL,
+		ttl:                  ttl,
+	}
+}
+
+func (s *statusListService) GetStatusList(
+	ctx context.Context,
+	issuerCommonName string,
+	purpose vctypes.CredentialStatusPurpose,
+) (*vctypes.EnvelopedCredential, error) {
+	credentialURL, err := statuslist.CredentialURL(s.nodeURL, issuerCommonName, purpose)
+	if err != nil {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+			err.Error(),
+			err,
+		)
+	}
+
+	entries, err := s.statusListRepository.GetSet(ctx, issuerCommonName, purpose)
+	if err != nil {
+		return nil, errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
+	}
+
+	list := statuslist.NewBitstring()
+
+	for _, entry := range entries {
+		err = list.Set(entry.Index, true)
+		if err != nil {
+			return nil, errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
+		}
+	}
+
+	credential, err := statuslist.NewCredential(credentialURL, s.nodeURL, purpose, list, s.ttl)
+	if err != nil {
+		return nil, errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
+	}
+
+	payload, err := json.Marshal(credential)
+	if err != nil {
+		return nil, errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
+	}
+
+	signed, err := joseutil.Sign(s.signingKey, payload)
+	if err != nil {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INTERNAL,
+			"unable to sign the status list",
+			err,
+		)
+	}
+
+	return &vctypes.EnvelopedCredential{
+		EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE,
+		Value:        string(signed),
+	}, nil
+}
+
+func (s *statusListService) GetJwks(ctx context.Context) (*jwk.Jwks, error) {
+	return s.signingKey.PublicKey().Jwks(), nil
+}
@@ -0,0 +1,321 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package node
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	errcore "github.com/agntcy/identity/internal/core/errors"
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/core/translog"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/agntcy/identity/pkg/log"
+)
+
+// The TransparencyLogService interface defines the Node methods for the
+// append-only Merkle tree recording the mutations of the Node
+type TransparencyLogService interface {
+	// Append an entry recording the content of a mutation
+	Append(
+		ctx context.Context,
+		entryType translog.EntryType,
+		subject string,
+		content []byte,
+	) error
+
+	// Sign the current tree head of the log
+	GetSignedTreeHead(ctx context.Context) (*translog.SignedTreeHead, error)
+
+	// Return the audit path of the leaf with the hash in the tree of the size,
+	// the current tree is used when the size is zero
+	GetInclusionProof(
+		ctx context.Context,
+		leafHash []byte,
+		treeSize uint64,
+	) (*translog.InclusionProof, error)
+
+	// Return the proof that the tree of the first size is a prefix of the tree
+	// of the second size, the current tree is used when the second size is zero
+	GetConsistencyProof(
+		ctx context.Context,
+		firstSize uint64,
+		secondSize uint64,
+	) (*translog.ConsistencyProof, error)
+
+	// Return the public keys used by the Node to sign the tree heads
+	GetJwks(ctx context.Context) (*jwk.Jwks, error)
+}
+
+// The number of signed tree heads kept in memory
+const maxCachedTreeHeads = 64
+
+// The transparencyLogService struct implements the TransparencyLogService interface
+type transparencyLogService struct {
+	repository      translog.Repository
+	signingKey      *jwk.Jwk
+	maxCachedLeaves int
+
+	// The leaves of the log are immutable once committed, the hashes of the first
+	// maxCachedLeaves leaves are cached so only the newer leaves are loaded
+	mu         sync.Mutex
+	leafHashes [][]byte
+
+	// The tree heads signed for each tree size, the oldest are evicted first
+	treeHeads     map[uint64]*translog.SignedTreeHead
+	treeHeadSizes []uint64
+}
+
+// NewTransparencyLogService creates a new instance of the TransparencyLogService,
+// the hashes of up to maxCachedLeaves leaves are kept in memory
+func NewTransparencyLogService(
+	repository translog.Repository,
+	signingKey *jwk.Jwk,
+	maxCachedLeaves int,
+) TransparencyLogService {
+	return &transparencyLogService{
+		repository:      repository,
+		signingKey:      signingKey,
+		maxCachedLeaves: maxCachedLeaves,
+		treeHeads:       make(map[uint64]*translog.SignedTreeHead),
+	}
+}
+
+func (s *transparencyLogService) Append(
+	ctx context.Context,
+	entryType translog.EntryType,
+	subject string,
+	content []byte,
+) error {
+	leaf, err := s.repository.Append(ctx, translog.NewEntry(entryType, subject, content))
+	if err != nil {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INTERNAL,
+			"unable to append the mutation to the transparency log",
+			err,
+		)
+	}
+
+	log.Debug("Appended the leaf ", leaf.Index, " to the transparency log for ", subject)
+
+	return nil
+}
+
+func (s *transparencyLogService) GetSignedTreeHead(
+	ctx context.Context,
+) (*translog.SignedTreeHead, error) {
+	treeSize, err := s.getTreeSize(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if sth, ok := s.cachedTreeHead(treeSize); ok {
+		return sth, nil
+	}
+
+	leafHashes, err := s.getLeafHashes(ctx, treeSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.signTreeHead(leafHashes)
+}
+
+func (s *transparencyLogService) GetInclusionProof(
+	ctx context.Context,
+	leafHash []byte,
+	treeSize uint64,
+) (*translog.InclusionProof, error) {
+	leaf, err := s.repository.GetLeafByHash(ctx, leafHash)
+	if err != nil {
+		if errors.Is(err, errcore.ErrResourceNotFound) {
+			return nil, errutil.ErrInfo(
+				errtypes.ERROR_REASON_TRANSPARENCY_LOG_ENTRY_NOT_FOUND,
+				"the leaf is not in the transparency log",
+				err,
+			)
+		}
+
+		return nil, errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
+	}
+
+	treeSize, err = s.getTreeSize(ctx, treeSize)
+	if err != nil {
+		return nil, err
+	}
+
+	leafHashes, err := s.getLeafHashes(ctx, treeSize)
+	if err != nil {
+		return nil, err
+	}
+
+	auditPath, err := translog.AuditPath(leafHashes, leaf.Index)
+	if err != nil {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_TREE_SIZE,
+			"the leaf is not in the tree of the requested size",
+			err,
+		)
+	}
+
+	sth, err := s.signTreeHead(leafHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &translog.InclusionProof{
+		Leaf:           leaf,
+		AuditPath:      auditPath,
+		SignedTreeHead: sth,
+	}, nil
+}
+
+func (s *transparencyLogService) GetConsistencyProof(
+	ctx context.Context,
+	firstSize uint64,
+	secondSize uint64,
+) (*translog.ConsistencyProof, error) {
+	secondSize, err := s.getTreeSize(ctx, secondSize)
+	if err != nil {
+		return nil, err
+	}
+
+	leafHashes, err := s.getLeafHashes(ctx, secondSize)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := translog.ConsistencyPath(leafHashes, firstSize)
+	if err != nil {
+		return nil, errutil.ErrInfo(errtypes.ERROR_REASON_INVALID_TREE_SIZE, err.Error(), err)
+	}
+
+	return &translog.ConsistencyProof{
+		FirstSize:  firstSize,
+		SecondSize: uint64(len(leafHashes)),
+		Path:       path,
+	}, nil
+}
+
+func (s *transparencyLogService) GetJwks(ctx context.Context) (*jwk.Jwks, error) {
+	return s.signingKey.PublicKey().Jwks(), nil
+}
+
+// getTreeSize checks that the tree of the size is in the log,
+// returns the size of the current tree when the size is zero
+func (s *transparencyLogService) getTreeSize(
+	ctx context.Context,
+	treeSize uint64,
+) (uint64, error) {
+	currentSize, err := s.repository.GetTreeSize(ctx)
+	if err != nil {
+		return 0, errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
+	}
+
+	if treeSize > currentSize {
+		return 0, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_TREE_SIZE,
+			"the tree size is larger than the transparency log",
+			nil,
+		)
+	}
+
+	if treeSize == 0 {
+		return currentSize, nil
+	}
+
+	return treeSize, nil
+}
+
+// getLeafHashes returns the leaf hashes of the tree of the size
+func (s *transparencyLogService) getLeafHashes(
+	ctx context.Context,
+	treeSize uint64,
+) ([][]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cachedSize := uint64(len(s.leafHashes))
+
+	// The hashes appended later to the cache never change the returned tree
+	if treeSize <= cachedSize {
+		return s.leafHashes[:treeSize:treeSize], nil
+	}
+
+	leafHashes, err := s.repository.GetLeafHashes(ctx, cachedSize, treeSize)
+	if err != nil {
+		return nil, errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
+	}
+
+	if uint64(len(leafHashes)) != treeSize-cachedSize {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INTERNAL,
+			"the transparency log has missing leaves",
+			nil,
+		)
+	}
+
+	// Only the first leaves are cached to bound the memory of the Node,
+	// the leaves past the cache are loaded on every request
+	cached := min(len(leafHashes), max(s.maxCachedLeaves-int(cachedSize), 0))
+	s.leafHashes = append(s.leafHashes, leafHashes[:cached]...)
+
+	return append(s.leafHashes[:cachedSize:cachedSize], leafHashes...), nil
+}
+
+// cachedTreeHead returns the tree head signed for the tree size
+func (s *transparencyLogService) cachedTreeHead(treeSize uint64) (*translog.SignedTreeHead, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sth, ok := s.treeHeads[treeSize]
+
+	return sth, ok
+}
+
+// signTreeHead signs the tree head of the leaves, the tree heads are signed
+// once for each tree size and the oldest are evicted from the cache
+func (s *transparencyLogService) signTreeHead(
+	leafHashes [][]byte,
+) (*translog.SignedTreeHead, error) {
+	treeSize := uint64(len(leafHashes))
+
+	if sth, ok := s.cachedTreeHead(treeSize); ok {
+		return sth, nil
+	}
+
+	sth, err := translog.NewSignedTreeHead(
+		&translog.TreeHead{
+			TreeSize:  treeSize,
+			RootHash:  translog.RootHash(leafHashes),
+			Timestamp: time.Now().UnixMilli(),
+		},
+		s.signingKey,
+	)
+	if err != nil {
+		return nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INTERNAL,
+			"unable to sign the tree head",
+			err,
+		)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.treeHeads[treeSize]; !ok {
+		if len(s.treeHeadSizes) == maxCachedTreeHeads {
+			delete(s.treeHeads, s.treeHeadSizes[0])
+			s.treeHeadSizes = s.treeHeadSizes[1:]
+		}
+
+		s.treeHeads[treeSize] = sth
+		s.treeHeadSizes = append(s.treeHeadSizes, treeSize)
+	}
+
+	return sth, nil
+}
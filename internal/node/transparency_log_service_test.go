@@ -0,0 +1,276 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package node_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	errtesting "github.com/agntcy/identity/internal/core/errors/testing"
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	idtesting "github.com/agntcy/identity/internal/core/id/testing"
+	issuertesting "github.com/agntcy/identity/internal/core/issuer/testing"
+	"github.com/agntcy/identity/internal/core/issuer/trust"
+	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
+	issuerverif "github.com/agntcy/identity/internal/core/issuer/verification"
+	verificationtesting "github.com/agntcy/identity/internal/core/issuer/verification/testing"
+	"github.com/agntcy/identity/internal/core/translog"
+	translogtesting "github.com/agntcy/identity/internal/core/translog/testing"
+	vctesting "github.com/agntcy/identity/internal/core/vc/testing"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/node"
+	dbtesting "github.com/agntcy/identity/pkg/db/testing"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/oidc"
+	oidctesting "github.com/agntcy/identity/pkg/oidc/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+// The number of leaf hashes cached by the transparency logs of the tests
+const maxCachedLeaves = 1024
+
+func TestTransparencyLog_Should_Prove_Inclusion_Of_Published_VC(t *testing.T) {
+	t.Parallel()
+
+	idRepo := idtesting.NewFakeIdRepository()
+	issuerRepo := issuertesting.NewFakeIssuerRepository()
+	jwt := &oidc.ParsedJWT{
+		Provider: oidc.DuoProviderName,
+		Claims: &oidc.Claims{
+			Issuer:  "http://" + verificationtesting.ValidProofIssuer,
+			Subject: verificationtesting.ValidProofSub,
+		},
+		CommonName: verificationtesting.ValidProofIssuer,
+	}
+	verifSrv := issuerverif.NewService(
+		oidctesting.NewFakeParser(jwt, nil),
+		issuerRepo,
+		trust.NewStaticSource(trust.DefaultPolicy()),
+	)
+	transparencyLog := newTransparencyLog(t)
+	sut := node.NewVerifiableCredentialService(
+		idRepo,
+		verifSrv,
+		vctesting.NewFakeVCRepository(),
+		nil,
+		transparencyLog,
+		dbtesting.NewFakeTransactor(),
+		0,
+	)
+	issuer := &issuertypes.Issuer{CommonName: verificationtesting.ValidProofIssuer}
+	_, _ = issuerRepo.CreateIssuer(context.Background(), issuer)
+	envelope := generateValidVC(t, idRepo, issuer)
+
+	err := sut.Publish(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	leafHash, err := translog.NewEntry(
+		translog.EntryTypeVcPublish,
+		"VC_ID",
+		[]byte(envelope.Value),
+	).LeafHash()
+	assert.NoError(t, err)
+
+	proof, err := transparencyLog.GetInclusionProof(context.Background(), leafHash, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, proof.Verify())
+
+	jwks, err := transparencyLog.GetJwks(context.Background())
+	assert.NoError(t, err)
+	assert.NoError(t, proof.SignedTreeHead.VerifySignature(jwks))
+}
+
+func TestTransparencyLog_Should_Prove_Consistency(t *testing.T) {
+	t.Parallel()
+
+	sut := newTransparencyLog(t)
+	appendEntries(t, sut, 0, 5)
+
+	first, err := sut.GetSignedTreeHead(context.Background())
+	assert.NoError(t, err)
+
+	appendEntries(t, sut, 5, 13)
+
+	second, err := sut.GetSignedTreeHead(context.Background())
+	assert.NoError(t, err)
+
+	proof, err := sut.GetConsistencyProof(context.Background(), first.TreeSize, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(13), proof.SecondSize)
+	assert.NoError(t, translog.VerifyConsistency(
+		proof.FirstSize,
+		proof.SecondSize,
+		first.RootHash,
+		second.RootHash,
+		proof.Path,
+	))
+}
+
+func TestTransparencyLog_Should_Prove_Inclusion_In_Older_Tree(t *testing.T) {
+	t.Parallel()
+
+	sut := newTransparencyLog(t)
+	appendEntries(t, sut, 0, 10)
+
+	leafHash, _ := translog.NewEntry(translog.EntryTypeIdGenerate, "ID-3", nil).LeafHash()
+
+	proof, err := sut.GetInclusionProof(context.Background(), leafHash, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), proof.SignedTreeHead.TreeSize)
+	assert.NoError(t, proof.Verify())
+}
+
+func TestTransparencyLog_Should_Return_Entry_Not_Found(t *testing.T) {
+	t.Parallel()
+
+	sut := newTransparencyLog(t)
+	appendEntries(t, sut, 0, 2)
+
+	leafHash, _ := translog.NewEntry(translog.EntryTypeIdGenerate, "unknown", nil).LeafHash()
+
+	_, err := sut.GetInclusionProof(context.Background(), leafHash, 0)
+
+	assert.Error(t, err)
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_TRANSPARENCY_LOG_ENTRY_NOT_FOUND)
+}
+
+func TestTransparencyLog_Should_Return_Invalid_Tree_Size(t *testing.T) {
+	t.Parallel()
+
+	sut := newTransparencyLog(t)
+	appendEntries(t, sut, 0, 4)
+
+	leafHash, _ := translog.NewEntry(translog.EntryTypeIdGenerate, "ID-3", nil).LeafHash()
+
+	_, err := sut.GetInclusionProof(context.Background(), leafHash, 2)
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_TREE_SIZE)
+
+	_, err = sut.GetConsistencyProof(context.Background(), 2, 5)
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_TREE_SIZE)
+
+	_, err = sut.GetConsistencyProof(context.Background(), 0, 4)
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_TREE_SIZE)
+}
+
+func TestTransparencyLog_Should_Only_Load_The_New_Leaves(t *testing.T) {
+	t.Parallel()
+
+	key, err := joseutil.GenerateJWK("ES256", "sig", "")
+	assert.NoError(t, err)
+
+	repo := &loadedLeavesRepository{Repository: translogtesting.NewFakeTransparencyLogRepository()}
+	sut := node.NewTransparencyLogService(repo, key, maxCachedLeaves)
+
+	appendEntries(t, sut, 0, 5)
+
+	_, err = sut.GetSignedTreeHead(context.Background())
+	assert.NoError(t, err)
+
+	appendEntries(t, sut, 5, 8)
+
+	sth, err := sut.GetSignedTreeHead(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(8), sth.TreeSize)
+
+	leafHash, _ := translog.NewEntry(translog.EntryTypeIdGenerate, "ID-2", nil).LeafHash()
+
+	proof, err := sut.GetInclusionProof(context.Background(), leafHash, 4)
+	assert.NoError(t, err)
+	assert.NoError(t, proof.Verify())
+
+	// Every leaf is loaded once
+	assert.Equal(t, uint64(8), repo.loaded)
+}
+
+func TestTransparencyLog_Should_Load_The_Leaves_Past_The_Cache(t *testing.T) {
+	t.Parallel()
+
+	key, err := joseutil.GenerateJWK("ES256", "sig", "")
+	assert.NoError(t, err)
+
+	repo := &loadedLeavesRepository{Repository: translogtesting.NewFakeTransparencyLogRepository()}
+	sut := node.NewTransparencyLogService(repo, key, 4)
+
+	appendEntries(t, sut, 0, 6)
+
+	for range 2 {
+		leafHash, _ := translog.NewEntry(translog.EntryTypeIdGenerate, "ID-5", nil).LeafHash()
+
+		proof, err := sut.GetInclusionProof(context.Background(), leafHash, 0)
+		assert.NoError(t, err)
+		assert.NoError(t, proof.Verify())
+	}
+
+	// The first 4 leaves are loaded once, the 2 others on every request
+	assert.Equal(t, uint64(8), repo.loaded)
+}
+
+func TestTransparencyLog_Should_Sign_A_Tree_Head_Once_Per_Size(t *testing.T) {
+	t.Parallel()
+
+	key, err := joseutil.GenerateJWK("ES256", "sig", "")
+	assert.NoError(t, err)
+
+	repo := &loadedLeavesRepository{Repository: translogtesting.NewFakeTransparencyLogRepository()}
+	sut := node.NewTransparencyLogService(repo, key, 0)
+
+	appendEntries(t, sut, 0, 3)
+
+	sth, err := sut.GetSignedTreeHead(context.Background())
+	assert.NoError(t, err)
+
+	cached, err := sut.GetSignedTreeHead(context.Background())
+	assert.NoError(t, err)
+	assert.Same(t, sth, cached)
+
+	// The leaves are not loaded again for the signed tree size
+	assert.Equal(t, uint64(3), repo.loaded)
+
+	appendEntries(t, sut, 3, 4)
+
+	sth, err = sut.GetSignedTreeHead(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), sth.TreeSize)
+	assert.NotSame(t, cached, sth)
+}
+
+// loadedLeavesRepository counts the leaf hashes loaded from the repository
+type loadedLeavesRepository struct {
+	translog.Repository
+	loaded uint64
+}
+
+func (r *loadedLeavesRepository) GetLeafHashes(
+	ctx context.Context,
+	start, end uint64,
+) ([][]byte, error) {
+	hashes, err := r.Repository.GetLeafHashes(ctx, start, end)
+	r.loaded += uint64(len(hashes))
+
+	return hashes, err
+}
+
+func newTransparencyLog(t *testing.T) node.TransparencyLogService {
+	t.Helper()
+
+	key, err := joseutil.GenerateJWK("ES256", "sig", "")
+	assert.NoError(t, err)
+
+	return node.NewTransparencyLogService(translogtesting.NewFakeTransparencyLogRepository(), key, maxCachedLeaves)
+}
+
+func appendEntries(t *testing.T, sut node.TransparencyLogService, from, to int) {
+	t.Helper()
+
+	for i := from; i < to; i++ {
+		err := sut.Append(
+			context.Background(),
+			translog.EntryTypeIdGenerate,
+			fmt.Sprintf("ID-%d", i),
+			nil,
+		)
+		assert.NoError(t, err)
+	}
+}
@@ -9,15 +9,25 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	errcore "github.com/agntcy/identity/internal/core/errors"
 	errtypes "github.com/agntcy/identity/internal/core/errors/types"
 	idcore "github.com/agntcy/identity/internal/core/id"
 	idtypes "github.com/agntcy/identity/internal/core/id/types"
 	issuerverification "github.com/agntcy/identity/internal/core/issuer/verification"
+	"github.com/agntcy/identity/internal/core/translog"
 	vccore "github.com/agntcy/identity/internal/core/vc"
+	"github.com/agntcy/identity/internal/core/vc/cose"
+	"github.com/agntcy/identity/internal/core/vc/dataintegrity"
+	"github.com/agntcy/identity/internal/core/vc/events"
+	"github.com/agntcy/identity/internal/core/vc/presentation"
+	"github.com/agntcy/identity/internal/core/vc/sdjwt"
+	"github.com/agntcy/identity/internal/core/vc/statuslist"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
 	"github.com/agntcy/identity/internal/pkg/errutil"
+	"github.com/agntcy/identity/internal/pkg/metrics"
+	"github.com/agntcy/identity/pkg/db"
 	"github.com/agntcy/identity/pkg/log"
 )
 
@@ -34,35 +44,94 @@ type VerifiableCredentialService interface {
 		resolverMetadataID string,
 	) ([]*vctypes.EnvelopedCredential, error)
 
-	// Parse and verify a Verifiable Credential
+	// Parse and verify a Verifiable Credential, the credentials bound
+	// to a holder key must be presented for the nonce and the audience
 	Verify(
 		ctx context.Context,
 		credential *vctypes.EnvelopedCredential,
+		nonce string,
+		audience string,
 	) (*vctypes.VerificationResult, error)
 
+	// Verify a Verifiable Presentation bound to the nonce and the audience
+	// and each of the Verifiable Credentials it contains
+	VerifyPresentation(
+		ctx context.Context,
+		presentation *vctypes.EnvelopedCredential,
+		nonce string,
+		audience string,
+	) (*vctypes.PresentationVerificationResult, error)
+
 	// Revoke a Verifiable Credential. THIS ACTION IS NOT REVERSIBLE.
 	Revoke(
 		ctx context.Context,
 		credential *vctypes.EnvelopedCredential,
 		proof *vctypes.Proof,
 	) error
+
+	// Suspend a Verifiable Credential. The credential can be reinstated later.
+	Suspend(
+		ctx context.Context,
+		credential *vctypes.EnvelopedCredential,
+		proof *vctypes.Proof,
+	) error
+
+	// Reinstate a suspended Verifiable Credential
+	Reinstate(
+		ctx context.Context,
+		credential *vctypes.EnvelopedCredential,
+		proof *vctypes.Proof,
+	) error
+
+	// Search the published vcs matching the criteria.
+	// Returns the token of the next page, empty if there are no more results.
+	Search(
+		ctx context.Context,
+		criteria *vccore.SearchCriteria,
+		pageToken string,
+		pageSize int,
+	) ([]*vctypes.EnvelopedCredential, string, error)
 }
 
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
 type verifiableCredentialService struct {
-	idRepository idcore.IdRepository
-	verifService issuerverification.Service
-	vcRepository vccore.Repository
+	idRepository         idcore.IdRepository
+	verifService         issuerverification.Service
+	vcRepository         vccore.Repository
+	statusListRepository statuslist.Repository
+	transparencyLog      TransparencyLogService
+	transactor           db.Transactor
+	maxValidity          time.Duration
+	listeners            []events.Listener
 }
 
+// NewVerifiableCredentialService creates the service, the listeners store the events
+// of the credentials published or changing status in the transaction of the change
+// and are notified once it is committed.
+// The published credentials must expire within maxValidity, zero disables the limit
 func NewVerifiableCredentialService(
 	idRepository idcore.IdRepository,
 	verifService issuerverification.Service,
 	vcRepository vccore.Repository,
+	statusListRepository statuslist.Repository,
+	transparencyLog TransparencyLogService,
+	transactor db.Transactor,
+	maxValidity time.Duration,
+	listeners ...events.Listener,
 ) VerifiableCredentialService {
 	return &verifiableCredentialService{
-		idRepository: idRepository,
-		verifService: verifService,
-		vcRepository: vcRepository,
+		idRepository:         idRepository,
+		verifService:         verifService,
+		vcRepository:         vcRepository,
+		statusListRepository: statusListRepository,
+		transparencyLog:      transparencyLog,
+		transactor:           transactor,
+		maxValidity:          maxValidity,
+		listeners:            listeners,
 	}
 }
 
@@ -71,7 +140,18 @@ func (s *verifiableCredentialService) Publish(
 	credential *vctypes.EnvelopedCredential,
 	proof *vctypes.Proof,
 ) error {
-	parsedVC, _, err := s.verifyEnvelopedCredential(ctx, credential, false)
+	parsedVC, _, err := s.verifyEnvelopedCredential(ctx, credential, nil)
+	if err != nil {
+		return err
+	}
+
+	// Credentials that are not yet valid can be published ahead of time
+	err = parsedVC.ValidateValidityPeriod(time.Now())
+	if err != nil && !errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALID) {
+		return err
+	}
+
+	err = parsedVC.ValidateExpiration(s.maxValidity)
 	if err != nil {
 		return err
 	}
@@ -100,17 +180,44 @@ func (s *verifiableCredentialService) Publish(
 		)
 	}
 
-	log.Debug("Storing the Verifiable Credential")
+	event := events.NewEvent(events.EventTypePublished, parsedVC, id, credential)
 
-	_, err = s.vcRepository.Create(ctx, parsedVC, id)
-	if err != nil {
-		return errutil.ErrInfo(
-			errtypes.ERROR_REASON_INTERNAL,
-			"unable to store verifiable credential",
-			err,
+	// The status list entries are released when the credential cannot be stored
+	err = s.transactor.Transaction(ctx, func(ctx context.Context) error {
+		err := s.allocateStatusListEntries(ctx, parsedVC, issuerVerification.Issuer.CommonName)
+		if err != nil {
+			return err
+		}
+
+		log.Debug("Storing the Verifiable Credential")
+
+		_, err = s.vcRepository.Create(ctx, parsedVC, id)
+		if err != nil {
+			return errutil.ErrInfo(
+				errtypes.ERROR_REASON_INTERNAL,
+				"unable to store verifiable credential",
+				err,
+			)
+		}
+
+		err = s.transparencyLog.Append(
+			ctx,
+			translog.EntryTypeVcPublish,
+			parsedVC.ID,
+			[]byte(credential.Value),
 		)
+		if err != nil {
+			return err
+		}
+
+		return s.storeEvent(ctx, event)
+	})
+	if err != nil {
+		return err
 	}
 
+	s.notify(ctx, event)
+
 	return nil
 }
 
@@ -146,6 +253,75 @@ func (s *verifiableCredentialService) GetVcs(
 		resolverMetadataID,
 	)
 
+	return toEnvelopedCredentials(vcs), nil
+}
+
+func (s *verifiableCredentialService) Search(
+	ctx context.Context,
+	criteria *vccore.SearchCriteria,
+	pageToken string,
+	pageSize int,
+) ([]*vctypes.EnvelopedCredential, string, error) {
+	if pageSize < 0 || pageSize > maxSearchPageSize {
+		return nil, "", errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_SEARCH_CRITERIA,
+			fmt.Sprintf("the page size must be between 0 and %d", maxSearchPageSize),
+			nil,
+		)
+	}
+
+	if pageSize == 0 {
+		pageSize = defaultSearchPageSize
+	}
+
+	var cursor *vccore.SearchCursor
+
+	if pageToken != "" {
+		var err error
+
+		cursor, err = vccore.DecodeSearchCursor(pageToken)
+		if err != nil {
+			return nil, "", errutil.ErrInfo(
+				errtypes.ERROR_REASON_INVALID_SEARCH_CRITERIA,
+				"invalid page token",
+				err,
+			)
+		}
+	}
+
+	var credentials []*vctypes.EnvelopedCredential
+
+	// The credentials without a supported proof are skipped, so the search
+	// continues after them until the page is full or there is no next page
+	for {
+		vcs, next, err := s.vcRepository.Search(ctx, criteria, cursor, pageSize-len(credentials))
+		if err != nil {
+			return nil, "", errutil.ErrInfo(
+				errtypes.ERROR_REASON_INTERNAL,
+				"unable to search verifiable credentials",
+				err,
+			)
+		}
+
+		credentials = append(credentials, toEnvelopedCredentials(vcs)...)
+		cursor = next
+
+		if cursor == nil || len(credentials) == pageSize {
+			break
+		}
+	}
+
+	var nextPageToken string
+	if cursor != nil {
+		nextPageToken = cursor.Encode()
+	}
+
+	return credentials, nextPageToken, nil
+}
+
+func toEnvelopedCredentials(
+	vcs []*vctypes.VerifiableCredential,
+) []*vctypes.EnvelopedCredential {
 	var envelopedCredentials []*vctypes.EnvelopedCredential
 
 	for _, cred := range vcs {
@@ -170,6 +346,21 @@ func (s *verifiableCredentialService) GetVcs(
 				EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE,
 				Value:        cred.Proof.ProofValue,
 			})
+		case dataintegrity.ProofType:
+			envelopedCredentials = append(envelopedCredentials, &vctypes.EnvelopedCredential{
+				EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_EMBEDDED_PROOF,
+				Value:        cred.Proof.ProofValue,
+			})
+		case cose.ProofType:
+			envelopedCredentials = append(envelopedCredentials, &vctypes.EnvelopedCredential{
+				EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_COSE,
+				Value:        cred.Proof.ProofValue,
+			})
+		case sdjwt.ProofType:
+			envelopedCredentials = append(envelopedCredentials, &vctypes.EnvelopedCredential{
+				EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_SD_JWT,
+				Value:        cred.Proof.ProofValue,
+			})
 		default:
 			log.Debug(
 				"Skipping credential with unsupported proof type: ",
@@ -180,15 +371,51 @@ func (s *verifiableCredentialService) GetVcs(
 		}
 	}
 
-	return envelopedCredentials, nil
+	return envelopedCredentials
 }
 
 // Verify an existing Verifiable Credential
 func (s *verifiableCredentialService) Verify(
 	ctx context.Context,
 	credential *vctypes.EnvelopedCredential,
+	nonce string,
+	audience string,
+) (*vctypes.VerificationResult, error) {
+	result, err := s.verify(ctx, credential, &verifierChallenge{nonce: nonce, audience: audience})
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ObserveVerification(verificationOutcome(result))
+
+	return result, nil
+}
+
+// verificationOutcome returns the outcome of the verification
+// of a credential recorded in the metrics
+func verificationOutcome(result *vctypes.VerificationResult) string {
+	if result.Status {
+		return metrics.VerificationValid
+	}
+
+	for _, warning := range result.Warnings {
+		switch warning.Reason {
+		case errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED:
+			return metrics.VerificationSuspended
+		case errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED:
+			return metrics.VerificationRevoked
+		}
+	}
+
+	return metrics.VerificationInvalid
+}
+
+func (s *verifiableCredentialService) verify(
+	ctx context.Context,
+	credential *vctypes.EnvelopedCredential,
+	verifier *verifierChallenge,
 ) (*vctypes.VerificationResult, error) {
-	vc, resolverMD, err := s.verifyEnvelopedCredential(ctx, credential, true)
+	vc, resolverMD, err := s.verifyEnvelopedCredential(ctx, credential, verifier)
 	if err == nil {
 		return &vctypes.VerificationResult{
 			Status:                       true,
@@ -204,7 +431,8 @@ func (s *verifiableCredentialService) Verify(
 		return nil, err
 	}
 
-	if errInfo.Reason == errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED {
+	if errInfo.Reason == errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED ||
+		errInfo.Reason == errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED {
 		return &vctypes.VerificationResult{
 			Status:                       false,
 			Document:                     vc,
@@ -221,10 +449,145 @@ func (s *verifiableCredentialService) Verify(
 	}, nil
 }
 
+// Verify a Verifiable Presentation and the Verifiable Credentials it contains
+func (s *verifiableCredentialService) VerifyPresentation(
+	ctx context.Context,
+	envelope *vctypes.EnvelopedCredential,
+	nonce string,
+	audience string,
+) (*vctypes.PresentationVerificationResult, error) {
+	vp, credentials, err := s.verifyPresentation(ctx, envelope, nonce, audience)
+	if err != nil {
+		var errInfo errtypes.ErrorInfo
+		if !errors.As(err, &errInfo) {
+			return nil, err
+		}
+
+		metrics.ObservePresentationVerification(metrics.VerificationInvalid)
+
+		return &vctypes.PresentationVerificationResult{
+			Status:   false,
+			Document: vp,
+			Errors:   []errtypes.ErrorInfo{errInfo},
+		}, nil
+	}
+
+	result := &vctypes.PresentationVerificationResult{
+		Status:   true,
+		Document: vp,
+	}
+
+	for _, credential := range credentials {
+		// The credentials bound to the holder are presented for the same challenge
+		credResult, err := s.Verify(ctx, credential, nonce, audience)
+		if err != nil {
+			return nil, err
+		}
+
+		// The holder can only present its own credentials
+		if credResult.Document != nil {
+			if id, _ := credResult.Document.GetDID(); id != vp.Holder {
+				credResult.Status = false
+				credResult.Errors = append(credResult.Errors, errutil.ErrInfo(
+					errtypes.ERROR_REASON_INVALID_VERIFIABLE_PRESENTATION,
+					"the subject of the credential is not the holder of the presentation",
+					nil,
+				))
+			}
+		}
+
+		result.Status = result.Status && credResult.Status
+		result.Results = append(result.Results, credResult)
+	}
+
+	if result.Status {
+		metrics.ObservePresentationVerification(metrics.VerificationValid)
+	} else {
+		metrics.ObservePresentationVerification(metrics.VerificationInvalid)
+	}
+
+	return result, nil
+}
+
+func (s *verifiableCredentialService) verifyPresentation(
+	ctx context.Context,
+	envelope *vctypes.EnvelopedCredential,
+	nonce string,
+	audience string,
+) (*vctypes.VerifiablePresentation, []*vctypes.EnvelopedCredential, error) {
+	if envelope == nil || envelope.Value == "" {
+		return nil, nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_CREDENTIAL_ENVELOPE_VALUE_FORMAT,
+			"invalid presentation envelope value",
+			nil,
+		)
+	}
+
+	vp, challenge, err := presentation.Parse(envelope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if vp.Holder == "" {
+		return vp, nil, errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_VERIFIABLE_PRESENTATION,
+			"the holder of the presentation is missing",
+			nil,
+		)
+	}
+
+	log.Debug("Resolving the holder into a ResolverMetadata")
+
+	resolverMD, err := s.idRepository.ResolveID(ctx, vp.Holder)
+	if err != nil {
+		if errors.Is(err, errcore.ErrResourceNotFound) {
+			return vp, nil, errutil.ErrInfo(
+				errtypes.ERROR_REASON_RESOLVER_METADATA_NOT_FOUND,
+				fmt.Sprintf("could not resolve the holder (%s) to a resolver metadata", vp.Holder),
+				err,
+			)
+		}
+
+		return vp, nil, errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
+	}
+
+	err = ensureNotDeactivated(resolverMD)
+	if err != nil {
+		return vp, nil, err
+	}
+
+	log.Debug("Validating the verifiable presentation")
+
+	err = presentation.Verify(resolverMD.GetJwks(), envelope)
+	if err != nil {
+		return vp, nil, err
+	}
+
+	err = challenge.ValidateChallenge(time.Now(), nonce, audience)
+	if err != nil {
+		return vp, nil, err
+	}
+
+	credentials, err := presentation.Credentials(vp)
+	if err != nil {
+		return vp, nil, err
+	}
+
+	return vp, credentials, nil
+}
+
+// The nonce and the audience of the verifier a credential is presented to
+type verifierChallenge struct {
+	nonce    string
+	audience string
+}
+
+// verifyEnvelopedCredential verifies the credential as issued when the verifier is nil,
+// otherwise as presented to the verifier, including its holder binding and its status
 func (s *verifiableCredentialService) verifyEnvelopedCredential(
 	ctx context.Context,
 	credential *vctypes.EnvelopedCredential,
-	checkStatus bool,
+	verifier *verifierChallenge,
 ) (*vctypes.VerifiableCredential, *idtypes.ResolverMetadata, error) {
 	if credential.Value == "" {
 		return nil, nil, errutil.ErrInfo(
@@ -267,9 +630,33 @@ func (s *verifiableCredentialService) verifyEnvelopedCredential(
 		return nil, nil, errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
 	}
 
+	err = ensureNotDeactivated(resolverMD)
+	if err != nil {
+		return parsedVC, nil, err
+	}
+
 	log.Debug("Validating the verifiable credential")
 
-	err = vccore.VerifyEnvelopedCredential(credential, resolverMD.GetJwks(), checkStatus)
+	if verifier == nil {
+		err = vccore.VerifyEnvelopedCredential(credential, resolverMD.GetJwks(), false)
+
+		return parsedVC, resolverMD, err
+	}
+
+	err = vccore.VerifyPresentedCredential(
+		credential,
+		resolverMD.GetJwks(),
+		verifier.nonce,
+		verifier.audience,
+		false,
+	)
+	if err == nil {
+		err = parsedVC.ValidateValidityPeriod(time.Now())
+	}
+
+	if err == nil {
+		err = s.validateStatus(ctx, parsedVC)
+	}
 
 	return parsedVC, resolverMD, err
 }
@@ -279,14 +666,132 @@ func (s *verifiableCredentialService) Revoke(
 	credential *vctypes.EnvelopedCredential,
 	proof *vctypes.Proof,
 ) error {
-	parsedVC, _, err := s.verifyEnvelopedCredential(ctx, credential, false)
+	parsedVC, storedVC, id, err := s.prepareStatusUpdate(
+		ctx,
+		credential,
+		proof,
+		vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
+	)
 	if err != nil {
 		return err
 	}
 
+	err = s.validateStatus(ctx, storedVC)
+	if err != nil && !errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED) {
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED) {
+			return errutil.ErrInfo(
+				errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED,
+				"the Verifiable Credential is already revoked",
+				nil,
+			)
+		}
+
+		return err
+	}
+
+	return s.storeStatusUpdate(ctx, credential, parsedVC, storedVC, id, statusUpdate{
+		entryType: translog.EntryTypeVcRevoke,
+		purpose:   vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
+		set:       true,
+	})
+}
+
+func (s *verifiableCredentialService) Suspend(
+	ctx context.Context,
+	credential *vctypes.EnvelopedCredential,
+	proof *vctypes.Proof,
+) error {
+	parsedVC, storedVC, id, err := s.prepareStatusUpdate(
+		ctx,
+		credential,
+		proof,
+		vctypes.CREDENTIAL_STATUS_PURPOSE_SUSPENSION,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = s.validateStatus(ctx, storedVC)
+	if err != nil {
+		if errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED) {
+			return errutil.ErrInfo(
+				errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED,
+				"the Verifiable Credential is already suspended",
+				nil,
+			)
+		}
+
+		return err
+	}
+
+	return s.storeStatusUpdate(ctx, credential, parsedVC, storedVC, id, statusUpdate{
+		entryType: translog.EntryTypeVcSuspend,
+		purpose:   vctypes.CREDENTIAL_STATUS_PURPOSE_SUSPENSION,
+		set:       true,
+	})
+}
+
+func (s *verifiableCredentialService) Reinstate(
+	ctx context.Context,
+	credential *vctypes.EnvelopedCredential,
+	proof *vctypes.Proof,
+) error {
+	parsedVC, storedVC, id, err := s.prepareStatusUpdate(
+		ctx,
+		credential,
+		proof,
+		vctypes.CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED,
+	)
+	if err != nil {
+		return err
+	}
+
+	if parsedVC.HasStatus(vctypes.CREDENTIAL_STATUS_PURPOSE_SUSPENSION) {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+			"the suspension status must be removed from credentialStatus",
+			nil,
+		)
+	}
+
+	err = s.validateStatus(ctx, storedVC)
+	if err == nil {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+			"the Verifiable Credential is not suspended",
+			nil,
+		)
+	}
+
+	if !errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED) {
+		return err
+	}
+
+	return s.storeStatusUpdate(ctx, credential, parsedVC, storedVC, id, statusUpdate{
+		entryType: translog.EntryTypeVcReinstate,
+		purpose:   vctypes.CREDENTIAL_STATUS_PURPOSE_SUSPENSION,
+		set:       false,
+	})
+}
+
+// Verify the credential and the Issuer's proof before changing the status
+// of a published credential. When purpose is specified, the credential
+// must contain a status entry with that purpose.
+// Returns the parsed credential, the stored credential and the ID of the subject.
+func (s *verifiableCredentialService) prepareStatusUpdate(
+	ctx context.Context,
+	credential *vctypes.EnvelopedCredential,
+	proof *vctypes.Proof,
+	purpose vctypes.CredentialStatusPurpose,
+) (*vctypes.VerifiableCredential, *vctypes.VerifiableCredential, string, error) {
+	parsedVC, _, err := s.verifyEnvelopedCredential(ctx, credential, nil)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
 	id, ok := parsedVC.GetDID()
 	if !ok {
-		return errutil.ErrInfo(
+		return nil, nil, "", errutil.ErrInfo(
 			errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
 			"unable to find the ID inside the CredentialSubject",
 			nil,
@@ -297,23 +802,26 @@ func (s *verifiableCredentialService) Revoke(
 
 	issuerVerification, err := s.verifService.VerifyExistingIssuer(ctx, proof)
 	if err != nil {
-		return err
+		return nil, nil, "", err
 	}
 
 	if !strings.HasSuffix(id, issuerVerification.Subject) {
-		return errutil.ErrInfo(
+		return nil, nil, "", errutil.ErrInfo(
 			errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
 			"the ID in the Verifiable Credential does not match the ID in the proof",
 			nil,
 		)
 	}
 
-	if !slices.ContainsFunc(parsedVC.Status, func(status *vctypes.CredentialStatus) bool {
-		return status.Purpose == vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION
-	}) {
-		return errutil.ErrInfo(
+	if purpose != vctypes.CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED &&
+		!slices.ContainsFunc(parsedVC.Status, func(status *vctypes.CredentialStatus) bool {
+			return status.Purpose == purpose
+		}) {
+		name, _ := statuslist.PurposeName(purpose)
+
+		return nil, nil, "", errutil.ErrInfo(
 			errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
-			"unable to find the revocation status in credentialStatus",
+			fmt.Sprintf("unable to find the %s status in credentialStatus", name),
 			nil,
 		)
 	}
@@ -321,34 +829,272 @@ func (s *verifiableCredentialService) Revoke(
 	storedVC, err := s.vcRepository.GetByID(ctx, parsedVC.ID)
 	if err != nil {
 		if errors.Is(err, errcore.ErrResourceNotFound) {
-			return errutil.ErrInfo(
+			return nil, nil, "", errutil.ErrInfo(
 				errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
 				fmt.Sprintf("unable to find the Verifiable Credential %s", parsedVC.ID),
 				err,
 			)
 		}
 
-		return errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
+		return nil, nil, "", errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
+	}
+
+	return parsedVC, storedVC, id, nil
+}
+
+// A status update of a published credential
+type statusUpdate struct {
+	entryType translog.EntryType
+
+	// The status list entries of the stored credential with this purpose
+	// are set or cleared
+	purpose vctypes.CredentialStatusPurpose
+	set     bool
+}
+
+// Store the credential with its new status, update the status lists of the
+// stored credential and record the status update in the transparency log
+// in the same transaction
+func (s *verifiableCredentialService) storeStatusUpdate(
+	ctx context.Context,
+	envelope *vctypes.EnvelopedCredential,
+	credential *vctypes.VerifiableCredential,
+	storedVC *vctypes.VerifiableCredential,
+	id string,
+	update statusUpdate,
+) error {
+	log.Debug("Storing the Verifiable Credential")
+
+	var event *events.Event
+	if eventType, ok := statusEventTypes[update.entryType]; ok {
+		event = events.NewEvent(eventType, credential, id, envelope)
 	}
 
-	for _, status := range storedVC.Status {
-		if status.Purpose == vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION {
+	err := s.transactor.Transaction(ctx, func(ctx context.Context) error {
+		err := s.updateStatusListEntries(ctx, storedVC, update.purpose, update.set)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.vcRepository.Update(ctx, credential, id)
+		if err != nil {
 			return errutil.ErrInfo(
-				errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED,
-				"the Verifiable Credential is already revoked",
-				nil,
+				errtypes.ERROR_REASON_INTERNAL,
+				"unable to store verifiable credential",
+				err,
 			)
 		}
+
+		err = s.transparencyLog.Append(ctx, update.entryType, credential.ID, []byte(envelope.Value))
+		if err != nil {
+			return err
+		}
+
+		if event == nil {
+			return nil
+		}
+
+		return s.storeEvent(ctx, event)
+	})
+	if err != nil {
+		return err
 	}
 
-	log.Debug("Storing the Verifiable Credential")
+	if event != nil {
+		s.notify(ctx, event)
+	}
+
+	return nil
+}
 
-	_, err = s.vcRepository.Update(ctx, parsedVC, id)
+// The events emitted for the status updates recorded in the transparency log
+var statusEventTypes = map[translog.EntryType]events.EventType{
+	translog.EntryTypeVcRevoke:    events.EventTypeRevoked,
+	translog.EntryTypeVcSuspend:   events.EventTypeSuspended,
+	translog.EntryTypeVcReinstate: events.EventTypeReinstated,
+}
+
+// Store the event with the listeners in the transaction of the change
+func (s *verifiableCredentialService) storeEvent(ctx context.Context, event *events.Event) error {
+	for _, listener := range s.listeners {
+		err := listener.Store(ctx, event)
+		if err != nil {
+			return errutil.ErrInfo(
+				errtypes.ERROR_REASON_INTERNAL,
+				"unable to store the event of the verifiable credential",
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (s *verifiableCredentialService) notify(ctx context.Context, event *events.Event) {
+	for _, listener := range s.listeners {
+		listener.OnEvent(ctx, event)
+	}
+}
+
+// Validate the status of the credential, including the status lists.
+// A revocation takes precedence over a suspension.
+func (s *verifiableCredentialService) validateStatus(
+	ctx context.Context,
+	credential *vctypes.VerifiableCredential,
+) error {
+	err := credential.ValidateStatus()
+	if err != nil && !errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED) {
+		return err
+	}
+
+	listErr := s.validateStatusListEntries(ctx, credential)
+	if listErr != nil {
+		return listErr
+	}
+
+	return err
+}
+
+// Reserve the indexes of the status list entries of the credential
+// in the Issuer's status lists
+func (s *verifiableCredentialService) allocateStatusListEntries(
+	ctx context.Context,
+	credential *vctypes.VerifiableCredential,
+	issuerCommonName string,
+) error {
+	for _, status := range credential.Status {
+		if !status.IsStatusListEntry() {
+			continue
+		}
+
+		index, err := statuslist.EntryIndex(status)
+		if err != nil {
+			return errutil.ErrInfo(
+				errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+				err.Error(),
+				err,
+			)
+		}
+
+		_, listIssuer, purpose, err := statuslist.ParseCredentialURL(status.StatusListCredential)
+		if err != nil || listIssuer != issuerCommonName || purpose != status.Purpose {
+			return errutil.ErrInfo(
+				errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL,
+				"the statusListCredential does not reference the Issuer's status list",
+				err,
+			)
+		}
+
+		err = s.statusListRepository.Allocate(ctx, &statuslist.Entry{
+			IssuerCommonName: issuerCommonName,
+			Purpose:          status.Purpose,
+			Index:            index,
+			CredentialID:     credential.ID,
+		})
+		if err != nil {
+			if errors.Is(err, errcore.ErrResourceAlreadyExists) {
+				return errutil.ErrInfo(
+					errtypes.ERROR_REASON_STATUS_LIST_INDEX_IN_USE,
+					fmt.Sprintf("the statusListIndex %d is already in use", index),
+					err,
+				)
+			}
+
+			return errutil.ErrInfo(
+				errtypes.ERROR_REASON_INTERNAL,
+				"unable to allocate the status list entry",
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// Validate the state of the credential held in the status lists.
+// A revocation takes precedence over a suspension.
+func (s *verifiableCredentialService) validateStatusListEntries(
+	ctx context.Context,
+	credential *vctypes.VerifiableCredential,
+) error {
+	if !slices.ContainsFunc(credential.Status, (*vctypes.CredentialStatus).IsStatusListEntry) {
+		return nil
+	}
+
+	entries, err := s.statusListRepository.GetByCredential(ctx, credential.ID)
 	if err != nil {
+		return errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
+	}
+
+	isSet := func(purpose vctypes.CredentialStatusPurpose) bool {
+		return slices.ContainsFunc(entries, func(entry *statuslist.Entry) bool {
+			return entry.Set && entry.Purpose == purpose
+		})
+	}
+
+	if isSet(vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION) {
 		return errutil.ErrInfo(
-			errtypes.ERROR_REASON_INTERNAL,
-			"unable to store verifiable credential",
-			err,
+			errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED,
+			"The Verifiable Credential is revoked.",
+			nil,
+		)
+	}
+
+	if isSet(vctypes.CREDENTIAL_STATUS_PURPOSE_SUSPENSION) {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED,
+			"The Verifiable Credential is suspended.",
+			nil,
+		)
+	}
+
+	return nil
+}
+
+// Update the status of the credential in the status lists matching the purpose
+func (s *verifiableCredentialService) updateStatusListEntries(
+	ctx context.Context,
+	credential *vctypes.VerifiableCredential,
+	purpose vctypes.CredentialStatusPurpose,
+	set bool,
+) error {
+	if !slices.ContainsFunc(credential.Status, (*vctypes.CredentialStatus).IsStatusListEntry) {
+		return nil
+	}
+
+	entries, err := s.statusListRepository.GetByCredential(ctx, credential.ID)
+	if err != nil {
+		return errutil.ErrInfo(errtypes.ERROR_REASON_INTERNAL, "unexpected error", err)
+	}
+
+	for _, entry := range entries {
+		if entry.Purpose != purpose {
+			continue
+		}
+
+		entry.Set = set
+
+		err = s.statusListRepository.Update(ctx, entry)
+		if err != nil {
+			return errutil.ErrInfo(
+				errtypes.ERROR_REASON_INTERNAL,
+				"unable to update the status list entry",
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// ensureNotDeactivated fails when the ID of the resolver metadata has been
+// deactivated, every VC bound to a deactivated ID is rejected
+func ensureNotDeactivated(resolverMD *idtypes.ResolverMetadata) error {
+	if resolverMD.Deactivated {
+		return errutil.ErrInfo(
+			errtypes.ERROR_REASON_ID_DEACTIVATED,
+			fmt.Sprintf("the ID (%s) has been deactivated", resolverMD.ID),
+			nil,
 		)
 	}
 
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	errtesting "github.com/agntcy/identity/internal/core/errors/testing"
 	errtypes "github.com/agntcy/identity/internal/core/errors/types"
@@ -18,12 +19,27 @@ import (
 	idtesting "github.com/agntcy/identity/internal/core/id/testing"
 	idtypes "github.com/agntcy/identity/internal/core/id/types"
 	issuertesting "github.com/agntcy/identity/internal/core/issuer/testing"
+	"github.com/agntcy/identity/internal/core/issuer/trust"
 	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
 	issuerverif "github.com/agntcy/identity/internal/core/issuer/verification"
 	verificationtesting "github.com/agntcy/identity/internal/core/issuer/verification/testing"
+	vccore "github.com/agntcy/identity/internal/core/vc"
+	"github.com/agntcy/identity/internal/core/vc/cose"
+	"github.com/agntcy/identity/internal/core/vc/dataintegrity"
+	"github.com/agntcy/identity/internal/core/vc/events"
+	eventsmemory "github.com/agntcy/identity/internal/core/vc/events/memory"
+	"github.com/agntcy/identity/internal/core/vc/jose"
+	"github.com/agntcy/identity/internal/core/vc/presentation"
+	"github.com/agntcy/identity/internal/core/vc/sdjwt"
+	"github.com/agntcy/identity/internal/core/vc/statuslist"
+	statuslisttesting "github.com/agntcy/identity/internal/core/vc/statuslist/testing"
 	vctesting "github.com/agntcy/identity/internal/core/vc/testing"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
 	"github.com/agntcy/identity/internal/node"
+	"github.com/agntcy/identity/internal/pkg/ptrutil"
+	"github.com/agntcy/identity/pkg/db/memory"
+	dbtesting "github.com/agntcy/identity/pkg/db/testing"
+	"github.com/agntcy/identity/pkg/joseutil"
 	jwktype "github.com/agntcy/identity/pkg/jwk"
 	"github.com/agntcy/identity/pkg/oidc"
 	oidctesting "github.com/agntcy/identity/pkg/oidc/testing"
@@ -51,8 +67,17 @@ func TestPublishVC(t *testing.T) {
 	verifSrv := issuerverif.NewService(
 		oidctesting.NewFakeParser(jwt, nil),
 		issuerRepo,
+		trust.NewStaticSource(trust.DefaultPolicy()),
+	)
+	sut := node.NewVerifiableCredentialService(
+		idRepo,
+		verifSrv,
+		vcRepo,
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+		0,
 	)
-	sut := node.NewVerifiableCredentialService(idRepo, verifSrv, vcRepo)
 	issuer := &issuertypes.Issuer{
 		CommonName:   verificationtesting.ValidProofIssuer,
 		Organization: "Some Org",
@@ -69,7 +94,7 @@ func TestPublishVC(t *testing.T) {
 func TestPublishVC_Should_Return_Invalid_Credential_Format_Error(t *testing.T) {
 	t.Parallel()
 
-	sut := node.NewVerifiableCredentialService(nil, nil, nil)
+	sut := node.NewVerifiableCredentialService(nil, nil, nil, nil, newTransparencyLog(t), dbtesting.NewFakeTransactor(), 0)
 	invalidEnvelope := &vctypes.EnvelopedCredential{
 		Value: "",
 	}
@@ -84,8 +109,20 @@ func TestPublishVC_Should_Return_Invalid_Proof_Error_If_Empty(t *testing.T) {
 
 	idRepo := idtesting.NewFakeIdRepository()
 	vcRepo := vctesting.NewFakeVCRepository()
-	verifSrv := issuerverif.NewService(oidctesting.NewFakeParser(nil, nil), nil)
-	sut := node.NewVerifiableCredentialService(idRepo, verifSrv, vcRepo)
+	verifSrv := issuerverif.NewService(
+		oidctesting.NewFakeParser(nil, nil),
+		nil,
+		trust.NewStaticSource(trust.DefaultPolicy()),
+	)
+	sut := node.NewVerifiableCredentialService(
+		idRepo,
+		verifSrv,
+		vcRepo,
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+		0,
+	)
 	envelope := generateValidVC(t, idRepo, &issuertypes.Issuer{CommonName: "issuer"})
 
 	err := sut.Publish(context.Background(), envelope, nil)
@@ -98,8 +135,20 @@ func TestPublishVC_Should_Return_Invalid_Proof_Error(t *testing.T) {
 
 	idRepo := idtesting.NewFakeIdRepository()
 	vcRepo := vctesting.NewFakeVCRepository()
-	verifSrv := issuerverif.NewService(oidctesting.NewFakeParser(nil, errors.New("")), nil)
-	sut := node.NewVerifiableCredentialService(idRepo, verifSrv, vcRepo)
+	verifSrv := issuerverif.NewService(
+		oidctesting.NewFakeParser(nil, errors.New("")),
+		nil,
+		trust.NewStaticSource(trust.DefaultPolicy()),
+	)
+	sut := node.NewVerifiableCredentialService(
+		idRepo,
+		verifSrv,
+		vcRepo,
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+		0,
+	)
 	envelope := generateValidVC(t, idRepo, &issuertypes.Issuer{CommonName: "issuer"})
 	invalidProof := &vctypes.Proof{Type: "JWT"}
 
@@ -124,8 +173,17 @@ func TestPublishVC_Should_Return_Issuer_Not_Registered(t *testing.T) {
 	verifSrv := issuerverif.NewService(
 		oidctesting.NewFakeParser(jwt, nil),
 		issuerRepo,
+		trust.NewStaticSource(trust.DefaultPolicy()),
+	)
+	sut := node.NewVerifiableCredentialService(
+		idRepo,
+		verifSrv,
+		vcRepo,
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+		0,
 	)
-	sut := node.NewVerifiableCredentialService(idRepo, verifSrv, vcRepo)
 	issuer := &issuertypes.Issuer{
 		CommonName:   verificationtesting.ValidProofIssuer,
 		Organization: "Some Org",
@@ -142,7 +200,15 @@ func TestGetWellKnown_Should_Return_Items(t *testing.T) {
 	t.Parallel()
 
 	vcRepo := vctesting.NewFakeVCRepository()
-	sut := node.NewVerifiableCredentialService(nil, nil, vcRepo)
+	sut := node.NewVerifiableCredentialService(
+		nil,
+		nil,
+		vcRepo,
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+		0,
+	)
 	resolverMetadatID := "my-id"
 
 	validVC, _ := vcRepo.Create(t.Context(), &vctypes.VerifiableCredential{
@@ -164,6 +230,157 @@ func TestGetWellKnown_Should_Return_Items(t *testing.T) {
 	assert.Equal(t, validVC.Proof.ProofValue, actual[0].Value)
 }
 
+func TestSearchVC_Should_Filter_And_Paginate(t *testing.T) {
+	t.Parallel()
+
+	vcRepo := vctesting.NewFakeVCRepository()
+	sut := node.NewVerifiableCredentialService(
+		nil,
+		nil,
+		vcRepo,
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+		0,
+	)
+	resolverMetadatID := "my-id"
+
+	for idx := range 3 {
+		_, _ = vcRepo.Create(t.Context(), &vctypes.VerifiableCredential{
+			ID:                fmt.Sprintf("VC_%d", idx),
+			Type:              []string{vctypes.CREDENTIAL_CONTENT_TYPE_AGENT_BADGE.String()},
+			Issuer:            "issuer",
+			IssuanceDate:      fmt.Sprintf("2025-01-0%dT00:00:00Z", idx+1),
+			CredentialSubject: map[string]any{"id": resolverMetadatID},
+			Proof:             &vctypes.Proof{Type: "JWT", ProofValue: fmt.Sprintf("PROOF_%d", idx)},
+		}, resolverMetadatID)
+	}
+	_, _ = vcRepo.Create(t.Context(), &vctypes.VerifiableCredential{
+		ID:                "VC_MCP",
+		Type:              []string{vctypes.CREDENTIAL_CONTENT_TYPE_MCP_BADGE.String()},
+		Issuer:            "issuer",
+		IssuanceDate:      "2025-01-05T00:00:00Z",
+		CredentialSubject: map[string]any{"id": resolverMetadatID},
+		Proof:             &vctypes.Proof{Type: "JWT", ProofValue: "PROOF_MCP"},
+	}, resolverMetadatID)
+	criteria := &vccore.SearchCriteria{
+		IssuerCommonName: "issuer",
+		ContentType:      vctypes.CREDENTIAL_CONTENT_TYPE_AGENT_BADGE,
+	}
+
+	firstPage, token, err := sut.Search(t.Context(), criteria, "", 2)
+
+	assert.NoError(t, err)
+	assert.Len(t, firstPage, 2)
+	assert.Equal(t, "PROOF_2", firstPage[0].Value)
+	assert.Equal(t, "PROOF_1", firstPage[1].Value)
+	assert.NotEmpty(t, token)
+
+	secondPage, token, err := sut.Search(t.Context(), criteria, token, 2)
+
+	assert.NoError(t, err)
+	assert.Len(t, secondPage, 1)
+	assert.Equal(t, "PROOF_0", secondPage[0].Value)
+	assert.Empty(t, token)
+}
+
+func TestSearchVC_Should_Fill_The_Pages_With_Supported_Proofs(t *testing.T) {
+	t.Parallel()
+
+	vcRepo := vctesting.NewFakeVCRepository()
+	sut := node.NewVerifiableCredentialService(
+		nil,
+		nil,
+		vcRepo,
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+		0,
+	)
+
+	for idx := range 6 {
+		proof := &vctypes.Proof{Type: "JWT", ProofValue: fmt.Sprintf("PROOF_%d", idx)}
+		if idx%2 == 0 {
+			proof.Type = "UnsupportedProof"
+		}
+
+		_, _ = vcRepo.Create(t.Context(), &vctypes.VerifiableCredential{
+			ID:           fmt.Sprintf("VC_%d", idx),
+			IssuanceDate: fmt.Sprintf("2025-01-0%dT00:00:00Z", idx+1),
+			Proof:        proof,
+		}, "")
+	}
+
+	firstPage, token, err := sut.Search(t.Context(), &vccore.SearchCriteria{}, "", 2)
+
+	assert.NoError(t, err)
+	assert.Len(t, firstPage, 2)
+	assert.Equal(t, "PROOF_5", firstPage[0].Value)
+	assert.Equal(t, "PROOF_3", firstPage[1].Value)
+	assert.NotEmpty(t, token)
+
+	secondPage, token, err := sut.Search(t.Context(), &vccore.SearchCriteria{}, token, 2)
+
+	assert.NoError(t, err)
+	assert.Len(t, secondPage, 1)
+	assert.Equal(t, "PROOF_1", secondPage[0].Value)
+	assert.Empty(t, token)
+}
+
+func TestSearchVC_Should_Filter_By_Revocation_State(t *testing.T) {
+	t.Parallel()
+
+	vcRepo := vctesting.NewFakeVCRepository()
+	sut := node.NewVerifiableCredentialService(
+		nil,
+		nil,
+		vcRepo,
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+		0,
+	)
+	_, _ = vcRepo.Create(t.Context(), &vctypes.VerifiableCredential{
+		ID:    "VC_REVOKED",
+		Proof: &vctypes.Proof{Type: "JWT", ProofValue: "REVOKED"},
+		Status: []*vctypes.CredentialStatus{
+			{Purpose: vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION},
+		},
+	}, "")
+	_, _ = vcRepo.Create(t.Context(), &vctypes.VerifiableCredential{
+		ID:    "VC_VALID",
+		Proof: &vctypes.Proof{Type: "JWT", ProofValue: "VALID"},
+	}, "")
+
+	actual, _, err := sut.Search(t.Context(), &vccore.SearchCriteria{Revoked: ptrutil.Ptr(false)}, "", 0)
+
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, "VALID", actual[0].Value)
+}
+
+func TestSearchVC_Should_Return_Invalid_Search_Criteria_Error(t *testing.T) {
+	t.Parallel()
+
+	sut := node.NewVerifiableCredentialService(
+		nil,
+		nil,
+		vctesting.NewFakeVCRepository(),
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+		0,
+	)
+
+	_, _, err := sut.Search(t.Context(), &vccore.SearchCriteria{}, "%%%", 0)
+
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_SEARCH_CRITERIA)
+
+	_, _, err = sut.Search(t.Context(), &vccore.SearchCriteria{}, "", 1000)
+
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_SEARCH_CRITERIA)
+}
+
 func TestVerifyVC_Should_Succeed(t *testing.T) {
 	t.Parallel()
 
@@ -172,6 +389,7 @@ func TestVerifyVC_Should_Succeed(t *testing.T) {
 		CredentialSubject: map[string]any{
 			"id": "DUO-" + verificationtesting.ValidProofSub,
 		},
+		ValidUntil: validUntil(),
 	}
 	privKey, pubKey, _ := genKey()
 	sut := setupVcServiceWithResolverMD(t, pubKey)
@@ -179,13 +397,170 @@ func TestVerifyVC_Should_Succeed(t *testing.T) {
 	assert.NoError(t, err)
 	_ = sut.Publish(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
 
-	result, err := sut.Verify(t.Context(), envelope)
+	result, err := sut.Verify(t.Context(), envelope, "", "")
 
 	assert.NoError(t, err)
 	assert.Empty(t, result.Errors)
 	assert.Empty(t, result.Warnings)
 }
 
+func TestVerifyVC_Should_Succeed_With_Mldsa_Key(t *testing.T) {
+	t.Parallel()
+
+	credential := &vctypes.VerifiableCredential{
+		ID: "VC_ID",
+		CredentialSubject: map[string]any{
+			"id": "DUO-" + verificationtesting.ValidProofSub,
+		},
+		ValidUntil: validUntil(),
+	}
+	privKey, err := joseutil.GenerateJWK("ML-DSA-65", "sig", "")
+	assert.NoError(t, err)
+	sut := setupVcServiceWithResolverMD(t, privKey.PublicKey())
+	payload, err := json.Marshal(credential)
+	assert.NoError(t, err)
+	signed, err := joseutil.Sign(privKey, payload)
+	assert.NoError(t, err)
+	envelope := &vctypes.EnvelopedCredential{
+		EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE,
+		Value:        string(signed),
+	}
+	err = sut.Publish(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	result, err := sut.Verify(t.Context(), envelope, "", "")
+
+	assert.NoError(t, err)
+	assert.True(t, result.Status)
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, "VC_ID", result.Document.ID)
+}
+
+func TestVerifyVC_Should_Succeed_With_Cose_Envelope(t *testing.T) {
+	t.Parallel()
+
+	credential := &vctypes.VerifiableCredential{
+		ID: "VC_ID",
+		CredentialSubject: map[string]any{
+			"id": "DUO-" + verificationtesting.ValidProofSub,
+		},
+		ValidUntil: validUntil(),
+	}
+	privKey, err := joseutil.GenerateJWK("RS256", "sig", "")
+	assert.NoError(t, err)
+	sut := setupVcServiceWithResolverMD(t, privKey.PublicKey())
+	envelope, err := cose.Sign(credential, privKey)
+	assert.NoError(t, err)
+	err = sut.Publish(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	result, err := sut.Verify(t.Context(), envelope, "", "")
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, "VC_ID", result.Document.ID)
+
+	actual, err := sut.GetVcs(t.Context(), "DUO-"+verificationtesting.ValidProofSub)
+
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, vctypes.CREDENTIAL_ENVELOPE_TYPE_COSE, actual[0].EnvelopeType)
+	assert.Equal(t, envelope.Value, actual[0].Value)
+}
+
+func TestVerifyVC_Should_Succeed_With_Embedded_Proof_And_Ecdsa_Key(t *testing.T) {
+	t.Parallel()
+
+	credential := &vctypes.VerifiableCredential{
+		ID: "VC_ID",
+		CredentialSubject: map[string]any{
+			"id": "DUO-" + verificationtesting.ValidProofSub,
+		},
+		ValidUntil: validUntil(),
+	}
+	privKey, err := joseutil.GenerateJWK("ES256", "sig", "")
+	assert.NoError(t, err)
+	sut := setupVcServiceWithResolverMD(t, privKey.PublicKey())
+	envelope, err := dataintegrity.Sign(credential, privKey)
+	assert.NoError(t, err)
+	err = sut.Publish(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	result, err := sut.Verify(t.Context(), envelope, "", "")
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, "VC_ID", result.Document.ID)
+}
+
+func TestVerifyVC_Should_Succeed_With_Sd_Jwt_Presentation(t *testing.T) {
+	t.Parallel()
+
+	claims := vctypes.BadgeClaims{
+		ID:    "DUO-" + verificationtesting.ValidProofSub,
+		Badge: `{"name":"agent","url":"https://agent.example.com"}`,
+	}
+	credential := &vctypes.VerifiableCredential{
+		ID:                "VC_ID",
+		CredentialSubject: claims.ToMap(),
+		ValidUntil:        validUntil(),
+	}
+	privKey, err := joseutil.GenerateJWK("RS256", "sig", "")
+	assert.NoError(t, err)
+	holderKey, err := joseutil.GenerateJWK("RS256", "sig", "")
+	assert.NoError(t, err)
+	sut := setupVcServiceWithResolverMD(t, privKey.PublicKey())
+	envelope, err := sdjwt.Issue(credential, privKey, holderKey.PublicKey())
+	assert.NoError(t, err)
+	err = sut.Publish(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+	presented, err := sdjwt.Present(envelope, []string{"name"}, holderKey, "verifier", "nonce")
+	assert.NoError(t, err)
+
+	result, err := sut.Verify(t.Context(), presented, "nonce", "verifier")
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, `{"name":"agent"}`, result.Document.CredentialSubject["badge"])
+}
+
+func TestVerifyVC_Should_Require_The_Key_Binding_Of_Sd_Jwt(t *testing.T) {
+	t.Parallel()
+
+	claims := vctypes.BadgeClaims{
+		ID:    "DUO-" + verificationtesting.ValidProofSub,
+		Badge: `{"name":"agent"}`,
+	}
+	credential := &vctypes.VerifiableCredential{
+		ID:                "VC_ID",
+		CredentialSubject: claims.ToMap(),
+		ValidUntil:        validUntil(),
+	}
+	privKey, err := joseutil.GenerateJWK("RS256", "sig", "")
+	assert.NoError(t, err)
+	holderKey, err := joseutil.GenerateJWK("RS256", "sig", "")
+	assert.NoError(t, err)
+	sut := setupVcServiceWithResolverMD(t, privKey.PublicKey())
+	envelope, err := sdjwt.Issue(credential, privKey, holderKey.PublicKey())
+	assert.NoError(t, err)
+	err = sut.Publish(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	// The SD-JWT is presented without Key Binding JWT
+	result, err := sut.Verify(t.Context(), envelope, "nonce", "verifier")
+	assert.NoError(t, err)
+	assert.False(t, result.Status)
+	assert.Equal(t, errtypes.ERROR_REASON_INVALID_PROOF, result.Errors[0].Reason)
+
+	// The Key Binding JWT is presented for another challenge
+	presented, err := sdjwt.Present(envelope, nil, holderKey, "verifier", "nonce")
+	assert.NoError(t, err)
+
+	result, err = sut.Verify(t.Context(), presented, "other", "verifier")
+	assert.NoError(t, err)
+	assert.False(t, result.Status)
+}
+
 func TestVerifyVC_Should_Fail_When_Revoked(t *testing.T) {
 	t.Parallel()
 
@@ -199,6 +574,7 @@ func TestVerifyVC_Should_Fail_When_Revoked(t *testing.T) {
 				Purpose: vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
 			},
 		},
+		ValidUntil: validUntil(),
 	}
 	privKey, pubKey, _ := genKey()
 	sut := setupVcServiceWithResolverMD(t, pubKey)
@@ -206,12 +582,114 @@ func TestVerifyVC_Should_Fail_When_Revoked(t *testing.T) {
 	assert.NoError(t, err)
 	_ = sut.Publish(context.Background(), envelope, &vctypes.Proof{Type: "JWT"})
 
-	result, err := sut.Verify(t.Context(), envelope)
+	result, err := sut.Verify(t.Context(), envelope, "", "")
 
 	assert.NoError(t, err)
 	assert.Equal(t, result.Warnings[0].Reason, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED)
 }
 
+func TestVerifyVC_Should_Fail_When_ID_Deactivated(t *testing.T) {
+	t.Parallel()
+
+	idRepo := idtesting.NewFakeIdRepository()
+	sut := node.NewVerifiableCredentialService(
+		idRepo,
+		verificationtesting.NewFakeVerifiedVerificationServiceStub(),
+		vctesting.NewFakeVCRepository(),
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+		0,
+	)
+	issuer := &issuertypes.Issuer{
+		CommonName:   verificationtesting.ValidProofIssuer,
+		Organization: "Some Org",
+	}
+	envelope := generateValidVC(t, idRepo, issuer)
+
+	md, err := idRepo.ResolveID(t.Context(), "DUO-"+verificationtesting.ValidProofSub)
+	assert.NoError(t, err)
+
+	md.Deactivated = true
+	_, err = idRepo.UpdateID(t.Context(), md)
+	assert.NoError(t, err)
+
+	result, err := sut.Verify(t.Context(), envelope, "", "")
+
+	assert.NoError(t, err)
+	assert.False(t, result.Status)
+	assert.Equal(t, errtypes.ERROR_REASON_ID_DEACTIVATED, result.Errors[0].Reason)
+}
+
+func TestVerifyVC_Should_Fail_When_Expired(t *testing.T) {
+	t.Parallel()
+
+	credential := &vctypes.VerifiableCredential{
+		ID: "VC_ID",
+		CredentialSubject: map[string]any{
+			"id": "DUO-" + verificationtesting.ValidProofSub,
+		},
+		ValidUntil: time.Now().Add(-time.Hour).Format(time.RFC3339),
+	}
+	privKey, pubKey, _ := genKey()
+	sut := setupVcServiceWithResolverMD(t, pubKey)
+	envelope, err := signVCWithJose(credential, privKey, pubKey.KID)
+	assert.NoError(t, err)
+
+	err = sut.Publish(t.Context(), envelope, &vctypes.Proof{Type: "JWT"})
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED)
+
+	result, err := sut.Verify(t.Context(), envelope, "", "")
+
+	assert.NoError(t, err)
+	assert.False(t, result.Status)
+	assert.Equal(t, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED, result.Errors[0].Reason)
+}
+
+func TestPublishVC_Should_Fail_Without_Expiration(t *testing.T) {
+	t.Parallel()
+
+	credential := &vctypes.VerifiableCredential{
+		ID: "VC_ID",
+		CredentialSubject: map[string]any{
+			"id": "DUO-" + verificationtesting.ValidProofSub,
+		},
+	}
+	privKey, pubKey, _ := genKey()
+	sut := setupVcServiceWithResolverMD(t, pubKey)
+	envelope, err := signVCWithJose(credential, privKey, pubKey.KID)
+	assert.NoError(t, err)
+
+	err = sut.Publish(t.Context(), envelope, &vctypes.Proof{Type: "JWT"})
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL)
+}
+
+func TestVerifyVC_Should_Fail_When_Not_Yet_Valid(t *testing.T) {
+	t.Parallel()
+
+	credential := &vctypes.VerifiableCredential{
+		ID: "VC_ID",
+		CredentialSubject: map[string]any{
+			"id": "DUO-" + verificationtesting.ValidProofSub,
+		},
+		ValidFrom:  time.Now().Add(time.Hour).Format(time.RFC3339),
+		ValidUntil: validUntil(),
+	}
+	privKey, pubKey, _ := genKey()
+	sut := setupVcServiceWithResolverMD(t, pubKey)
+	envelope, err := signVCWithJose(credential, privKey, pubKey.KID)
+	assert.NoError(t, err)
+
+	err = sut.Publish(t.Context(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	result, err := sut.Verify(t.Context(), envelope, "", "")
+
+	assert.NoError(t, err)
+	assert.False(t, result.Status)
+	assert.Equal(t, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_NOT_YET_VALID, result.Errors[0].Reason)
+}
+
 func TestRevokeVC_Should_Succeed(t *testing.T) {
 	t.Parallel()
 
@@ -220,6 +698,7 @@ func TestRevokeVC_Should_Succeed(t *testing.T) {
 		CredentialSubject: map[string]any{
 			"id": "DUO-" + verificationtesting.ValidProofSub,
 		},
+		ValidUntil: validUntil(),
 	}
 	privKey, pubKey, _ := genKey()
 	sut := setupVcServiceWithResolverMD(t, pubKey)
@@ -241,6 +720,59 @@ func TestRevokeVC_Should_Succeed(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestPublishAndRevokeVC_Should_Notify_The_Listeners(t *testing.T) {
+	t.Parallel()
+
+	credential := &vctypes.VerifiableCredential{
+		ID:     "VC_ID",
+		Issuer: verificationtesting.ValidProofIssuer,
+		CredentialSubject: map[string]any{
+			"id": "DUO-" + verificationtesting.ValidProofSub,
+		},
+		ValidUntil: validUntil(),
+	}
+	privKey, pubKey, _ := genKey()
+	store, err := memory.NewStore("")
+	assert.NoError(t, err)
+	eventRepo, err := eventsmemory.NewRepository(store)
+	assert.NoError(t, err)
+	broker := events.NewBroker(eventRepo, events.DefaultMaxSubscribers, events.DefaultPollInterval)
+	sub, err := broker.Subscribe(t.Context(), events.Filter{Issuer: verificationtesting.ValidProofIssuer}, nil)
+	assert.NoError(t, err)
+	sut, _ := setupVcServiceWithStatusList(t, pubKey, broker)
+	envelope, err := signVCWithJose(credential, privKey, pubKey.KID)
+	assert.NoError(t, err)
+
+	err = sut.Publish(t.Context(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	credential.Status = []*vctypes.CredentialStatus{
+		{
+			Purpose: vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
+		},
+	}
+	revoked, err := signVCWithJose(credential, privKey, pubKey.KID)
+	assert.NoError(t, err)
+
+	err = sut.Revoke(t.Context(), revoked, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	received, err := sub.Next(t.Context())
+	assert.NoError(t, err)
+	assert.Len(t, received, 2)
+
+	published := received[0]
+	assert.Equal(t, events.EventTypePublished, published.Type)
+	assert.Equal(t, "VC_ID", published.VcID)
+	assert.Equal(t, "DUO-"+verificationtesting.ValidProofSub, published.ResolverMetadataID)
+	assert.Equal(t, envelope, published.Credential)
+
+	revocation := received[1]
+	assert.Equal(t, events.EventTypeRevoked, revocation.Type)
+	assert.Greater(t, revocation.Sequence, published.Sequence)
+	assert.Equal(t, revoked, revocation.Credential)
+}
+
 func TestRevokeVC_Should_Fail_When_VC_Not_Found(t *testing.T) {
 	t.Parallel()
 
@@ -249,6 +781,7 @@ func TestRevokeVC_Should_Fail_When_VC_Not_Found(t *testing.T) {
 		CredentialSubject: map[string]any{
 			"id": "DUO-" + verificationtesting.ValidProofSub,
 		},
+		ValidUntil: validUntil(),
 	}
 	privKey, pubKey, _ := genKey()
 	sut := setupVcServiceWithResolverMD(t, pubKey)
@@ -285,6 +818,7 @@ func TestRevoke_Should_Fail_When_VC_Already_Revoked(t *testing.T) {
 				Purpose: vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
 			},
 		},
+		ValidUntil: validUntil(),
 	}
 	privKey, pubKey, _ := genKey()
 	sut := setupVcServiceWithResolverMD(t, pubKey)
@@ -310,7 +844,7 @@ func TestRevoke_Should_Fail_When_VC_Already_Revoked(t *testing.T) {
 func TestRevokeVC_Should_Return_Invalid_Credential_Format_Error(t *testing.T) {
 	t.Parallel()
 
-	sut := node.NewVerifiableCredentialService(nil, nil, nil)
+	sut := node.NewVerifiableCredentialService(nil, nil, nil, nil, newTransparencyLog(t), dbtesting.NewFakeTransactor(), 0)
 	invalidEnvelope := &vctypes.EnvelopedCredential{
 		Value: "",
 	}
@@ -325,8 +859,20 @@ func TestRevokeVC_Should_Return_Invalid_Proof_Error_If_Empty(t *testing.T) {
 
 	idRepo := idtesting.NewFakeIdRepository()
 	vcRepo := vctesting.NewFakeVCRepository()
-	verifSrv := issuerverif.NewService(oidctesting.NewFakeParser(nil, nil), nil)
-	sut := node.NewVerifiableCredentialService(idRepo, verifSrv, vcRepo)
+	verifSrv := issuerverif.NewService(
+		oidctesting.NewFakeParser(nil, nil),
+		nil,
+		trust.NewStaticSource(trust.DefaultPolicy()),
+	)
+	sut := node.NewVerifiableCredentialService(
+		idRepo,
+		verifSrv,
+		vcRepo,
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+		0,
+	)
 	envelope := generateValidVC(t, idRepo, &issuertypes.Issuer{CommonName: "issuer"})
 
 	err := sut.Revoke(context.Background(), envelope, nil)
@@ -339,8 +885,20 @@ func TestRevokeVC_Should_Return_Invalid_Proof_Error(t *testing.T) {
 
 	idRepo := idtesting.NewFakeIdRepository()
 	vcRepo := vctesting.NewFakeVCRepository()
-	verifSrv := issuerverif.NewService(oidctesting.NewFakeParser(nil, errors.New("")), nil)
-	sut := node.NewVerifiableCredentialService(idRepo, verifSrv, vcRepo)
+	verifSrv := issuerverif.NewService(
+		oidctesting.NewFakeParser(nil, errors.New("")),
+		nil,
+		trust.NewStaticSource(trust.DefaultPolicy()),
+	)
+	sut := node.NewVerifiableCredentialService(
+		idRepo,
+		verifSrv,
+		vcRepo,
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+		0,
+	)
 	envelope := generateValidVC(t, idRepo, &issuertypes.Issuer{CommonName: "issuer"})
 	invalidProof := &vctypes.Proof{Type: "JWT"}
 
@@ -365,8 +923,17 @@ func TestRevokeVC_Should_Return_Issuer_Not_Registered(t *testing.T) {
 	verifSrv := issuerverif.NewService(
 		oidctesting.NewFakeParser(jwt, nil),
 		issuerRepo,
+		trust.NewStaticSource(trust.DefaultPolicy()),
+	)
+	sut := node.NewVerifiableCredentialService(
+		idRepo,
+		verifSrv,
+		vcRepo,
+		nil,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+		0,
 	)
-	sut := node.NewVerifiableCredentialService(idRepo, verifSrv, vcRepo)
 	issuer := &issuertypes.Issuer{
 		CommonName:   verificationtesting.ValidProofIssuer,
 		Organization: "Some Org",
@@ -387,6 +954,7 @@ func TestRevoke_Should_Fail_When_Status_Does_Not_Have_Revocation(t *testing.T) {
 		CredentialSubject: map[string]any{
 			"id": "DUO-" + verificationtesting.ValidProofSub,
 		},
+		ValidUntil: validUntil(),
 	}
 	privKey, pubKey, _ := genKey()
 	sut := setupVcServiceWithResolverMD(t, pubKey)
@@ -405,9 +973,303 @@ func TestRevoke_Should_Fail_When_Status_Does_Not_Have_Revocation(t *testing.T) {
 	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL)
 }
 
+func TestSuspendVC_Should_Be_Reversible(t *testing.T) {
+	t.Parallel()
+
+	credential := &vctypes.VerifiableCredential{
+		ID: "VC_ID",
+		CredentialSubject: map[string]any{
+			"id": "DUO-" + verificationtesting.ValidProofSub,
+		},
+		ValidUntil: validUntil(),
+	}
+	privKey, pubKey, _ := genKey()
+	sut := setupVcServiceWithResolverMD(t, pubKey)
+	original, err := signVCWithJose(credential, privKey, pubKey.KID)
+	assert.NoError(t, err)
+	_ = sut.Publish(t.Context(), original, &vctypes.Proof{Type: "JWT"})
+
+	credential.Status = []*vctypes.CredentialStatus{
+		{
+			Purpose: vctypes.CREDENTIAL_STATUS_PURPOSE_SUSPENSION,
+		},
+	}
+	suspended, err := signVCWithJose(credential, privKey, pubKey.KID)
+	assert.NoError(t, err)
+
+	err = sut.Suspend(t.Context(), suspended, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	result, err := sut.Verify(t.Context(), suspended, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED, result.Warnings[0].Reason)
+
+	err = sut.Suspend(t.Context(), suspended, &vctypes.Proof{Type: "JWT"})
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED)
+
+	err = sut.Reinstate(t.Context(), original, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	err = sut.Reinstate(t.Context(), original, &vctypes.Proof{Type: "JWT"})
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL)
+}
+
+func TestSuspendVC_Should_Fail_When_Status_Does_Not_Have_Suspension(t *testing.T) {
+	t.Parallel()
+
+	credential := &vctypes.VerifiableCredential{
+		ID: "VC_ID",
+		CredentialSubject: map[string]any{
+			"id": "DUO-" + verificationtesting.ValidProofSub,
+		},
+		ValidUntil: validUntil(),
+	}
+	privKey, pubKey, _ := genKey()
+	sut := setupVcServiceWithResolverMD(t, pubKey)
+	envelope, err := signVCWithJose(credential, privKey, pubKey.KID)
+	assert.NoError(t, err)
+	_ = sut.Publish(t.Context(), envelope, &vctypes.Proof{Type: "JWT"})
+
+	err = sut.Suspend(t.Context(), envelope, &vctypes.Proof{Type: "JWT"})
+
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_INVALID_VERIFIABLE_CREDENTIAL)
+}
+
+func TestSuspendVC_Should_Set_The_Status_List_Bit(t *testing.T) {
+	t.Parallel()
+
+	privKey, pubKey, _ := genKey()
+	sut := setupVcServiceWithResolverMD(t, pubKey)
+	credential := newStatusListCredential(t, "VC_ID", 7)
+	credential.Status = append(
+		credential.Status,
+		newStatusListEntry(t, vctypes.CREDENTIAL_STATUS_PURPOSE_SUSPENSION, 7),
+	)
+	envelope, err := signVCWithJose(credential, privKey, pubKey.KID)
+	assert.NoError(t, err)
+	err = sut.Publish(t.Context(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	err = sut.Suspend(t.Context(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	result, err := sut.Verify(t.Context(), envelope, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_SUSPENDED, result.Warnings[0].Reason)
+
+	err = sut.Reinstate(t.Context(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	result, err = sut.Verify(t.Context(), envelope, "", "")
+	assert.NoError(t, err)
+	assert.Empty(t, result.Warnings)
+}
+
+const statusListNodeURL = "http://localhost:4000"
+
+func newStatusListCredential(t *testing.T, id string, index int) *vctypes.VerifiableCredential {
+	t.Helper()
+
+	return &vctypes.VerifiableCredential{
+		ID: id,
+		CredentialSubject: map[string]any{
+			"id": "DUO-" + verificationtesting.ValidProofSub,
+		},
+		Status: []*vctypes.CredentialStatus{
+			newStatusListEntry(t, vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION, index),
+		},
+		ValidUntil: validUntil(),
+	}
+}
+
+func newStatusListEntry(
+	t *testing.T,
+	purpose vctypes.CredentialStatusPurpose,
+	index int,
+) *vctypes.CredentialStatus {
+	t.Helper()
+
+	credentialURL, err := statuslist.CredentialURL(
+		statusListNodeURL,
+		verificationtesting.ValidProofIssuer,
+		purpose,
+	)
+	assert.NoError(t, err)
+
+	return statuslist.NewStatusEntry(credentialURL, purpose, index)
+}
+
+func TestPublishVC_Should_Fail_When_Status_List_Index_Is_Taken(t *testing.T) {
+	t.Parallel()
+
+	privKey, pubKey, _ := genKey()
+	sut := setupVcServiceWithResolverMD(t, pubKey)
+
+	envelope, err := signVCWithJose(newStatusListCredential(t, "VC_1", 42), privKey, pubKey.KID)
+	assert.NoError(t, err)
+	err = sut.Publish(t.Context(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	envelope, err = signVCWithJose(newStatusListCredential(t, "VC_2", 42), privKey, pubKey.KID)
+	assert.NoError(t, err)
+	err = sut.Publish(t.Context(), envelope, &vctypes.Proof{Type: "JWT"})
+
+	assert.Error(t, err)
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_STATUS_LIST_INDEX_IN_USE)
+}
+
+func TestRevokeVC_Should_Set_The_Status_List_Bit(t *testing.T) {
+	t.Parallel()
+
+	privKey, pubKey, _ := genKey()
+	sut, statusListRepo := setupVcServiceWithStatusList(t, pubKey)
+	credential := newStatusListCredential(t, "VC_ID", 1337)
+	envelope, err := signVCWithJose(credential, privKey, pubKey.KID)
+	assert.NoError(t, err)
+	err = sut.Publish(t.Context(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	err = sut.Revoke(t.Context(), envelope, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+
+	result, err := sut.Verify(t.Context(), envelope, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED, result.Warnings[0].Reason)
+
+	err = sut.Revoke(t.Context(), envelope, &vctypes.Proof{Type: "JWT"})
+	errtesting.AssertErrorInfoReason(t, err, errtypes.ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED)
+
+	signingKey, err := joseutil.GenerateJWK("RS256", "sig", "")
+	assert.NoError(t, err)
+	statusListSrv := node.NewStatusListService(statusListRepo, signingKey, statusListNodeURL, time.Minute)
+
+	statusList, err := statusListSrv.GetStatusList(
+		t.Context(),
+		verificationtesting.ValidProofIssuer,
+		vctypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
+	)
+	assert.NoError(t, err)
+
+	jwks, err := statusListSrv.GetJwks(t.Context())
+	assert.NoError(t, err)
+	parsed, err := jose.VerifyAndParse(jwks, statusList)
+	assert.NoError(t, err)
+
+	set, err := statuslist.IsSet(parsed, credential.Status[0])
+	assert.NoError(t, err)
+	assert.True(t, set)
+}
+
+func TestVerifyPresentation_Should_Verify_The_Credentials(t *testing.T) {
+	t.Parallel()
+
+	holder := "DUO-" + verificationtesting.ValidProofSub
+	privKey, err := joseutil.GenerateJWK("RS256", "sig", "")
+	assert.NoError(t, err)
+	sut := setupVcServiceWithResolverMD(t, privKey.PublicKey())
+	credential, err := cose.Sign(&vctypes.VerifiableCredential{
+		ID:                "VC_ID",
+		CredentialSubject: map[string]any{"id": holder},
+		ValidUntil:        validUntil(),
+	}, privKey)
+	assert.NoError(t, err)
+	err = sut.Publish(t.Context(), credential, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+	vp, err := presentation.Create(
+		holder,
+		[]*vctypes.EnvelopedCredential{credential},
+		privKey,
+		&presentation.Challenge{Nonce: "1234", Audience: "verifier"},
+	)
+	assert.NoError(t, err)
+
+	result, err := sut.VerifyPresentation(t.Context(), vp, "1234", "verifier")
+
+	assert.NoError(t, err)
+	assert.True(t, result.Status)
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, holder, result.Document.Holder)
+	assert.Len(t, result.Results, 1)
+	assert.True(t, result.Results[0].Status)
+	assert.Equal(t, "VC_ID", result.Results[0].Document.ID)
+}
+
+func TestVerifyPresentation_Should_Reject_Invalid_Challenge(t *testing.T) {
+	t.Parallel()
+
+	holder := "DUO-" + verificationtesting.ValidProofSub
+	privKey, err := joseutil.GenerateJWK("RS256", "sig", "")
+	assert.NoError(t, err)
+	sut := setupVcServiceWithResolverMD(t, privKey.PublicKey())
+	credential, err := cose.Sign(&vctypes.VerifiableCredential{
+		ID:                "VC_ID",
+		CredentialSubject: map[string]any{"id": holder},
+		ValidUntil:        validUntil(),
+	}, privKey)
+	assert.NoError(t, err)
+	vp, err := presentation.Create(
+		holder,
+		[]*vctypes.EnvelopedCredential{credential},
+		privKey,
+		&presentation.Challenge{Nonce: "1234"},
+	)
+	assert.NoError(t, err)
+
+	result, err := sut.VerifyPresentation(t.Context(), vp, "5678", "")
+
+	assert.NoError(t, err)
+	assert.False(t, result.Status)
+	assert.Empty(t, result.Results)
+	assert.Len(t, result.Errors, 1)
+	assert.Equal(t, errtypes.ERROR_REASON_INVALID_PRESENTATION_CHALLENGE, result.Errors[0].Reason)
+}
+
+func TestVerifyPresentation_Should_Reject_Unknown_Holder(t *testing.T) {
+	t.Parallel()
+
+	holder := "DUO-" + verificationtesting.ValidProofSub
+	privKey, err := joseutil.GenerateJWK("RS256", "sig", "")
+	assert.NoError(t, err)
+	sut := setupVcServiceWithResolverMD(t, privKey.PublicKey())
+	credential, err := cose.Sign(&vctypes.VerifiableCredential{
+		ID:                "VC_ID",
+		CredentialSubject: map[string]any{"id": holder},
+		ValidUntil:        validUntil(),
+	}, privKey)
+	assert.NoError(t, err)
+	err = sut.Publish(t.Context(), credential, &vctypes.Proof{Type: "JWT"})
+	assert.NoError(t, err)
+	vp, err := presentation.Create(
+		"OTHER-HOLDER",
+		[]*vctypes.EnvelopedCredential{credential},
+		privKey,
+		&presentation.Challenge{Nonce: "1234"},
+	)
+	assert.NoError(t, err)
+
+	result, err := sut.VerifyPresentation(t.Context(), vp, "1234", "")
+
+	assert.NoError(t, err)
+	assert.False(t, result.Status)
+	assert.Equal(t, errtypes.ERROR_REASON_RESOLVER_METADATA_NOT_FOUND, result.Errors[0].Reason)
+}
+
 func setupVcServiceWithResolverMD(t *testing.T, pubKey *jwktype.Jwk) node.VerifiableCredentialService {
 	t.Helper()
 
+	sut, _ := setupVcServiceWithStatusList(t, pubKey)
+
+	return sut
+}
+
+func setupVcServiceWithStatusList(
+	t *testing.T,
+	pubKey *jwktype.Jwk,
+	listeners ...events.Listener,
+) (node.VerifiableCredentialService, statuslist.Repository) {
+	t.Helper()
+
+	statusListRepo := statuslisttesting.NewFakeStatusListRepository()
 	idRepo := idtesting.NewFakeIdRepository()
 	issuerRepo := issuertesting.NewFakeIssuerRepository()
 	vcRepo := vctesting.NewFakeVCRepository()
@@ -422,8 +1284,18 @@ func setupVcServiceWithResolverMD(t *testing.T, pubKey *jwktype.Jwk) node.Verifi
 	verifSrv := issuerverif.NewService(
 		oidctesting.NewFakeParser(jwt, nil),
 		issuerRepo,
+		trust.NewStaticSource(trust.DefaultPolicy()),
+	)
+	sut := node.NewVerifiableCredentialService(
+		idRepo,
+		verifSrv,
+		vcRepo,
+		statusListRepo,
+		newTransparencyLog(t),
+		dbtesting.NewFakeTransactor(),
+		0,
+		listeners...,
 	)
-	sut := node.NewVerifiableCredentialService(idRepo, verifSrv, vcRepo)
 	issuer := &issuertypes.Issuer{
 		CommonName:   verificationtesting.ValidProofIssuer,
 		Organization: "Some Org",
@@ -441,7 +1313,7 @@ func setupVcServiceWithResolverMD(t *testing.T, pubKey *jwktype.Jwk) node.Verifi
 	}
 	_, _ = idRepo.CreateID(context.Background(), resolverMD, issuer)
 
-	return sut
+	return sut, statusListRepo
 }
 
 func generateValidVC(
@@ -456,6 +1328,7 @@ func generateValidVC(
 		CredentialSubject: map[string]any{
 			"id": "DUO-" + verificationtesting.ValidProofSub,
 		},
+		ValidUntil: validUntil(),
 	}
 
 	privKey, pubKey, _ := genKey()
@@ -541,3 +1414,8 @@ func genKey() (*rsa.PrivateKey, *jwktype.Jwk, error) {
 
 	return priv, &pub, nil
 }
+
+// validUntil returns the validUntil date of the credentials published in the tests
+func validUntil() string {
+	return time.Now().Add(time.Hour).Format(time.RFC3339)
+}
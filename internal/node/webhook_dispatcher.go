@@ -0,0 +1,208 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/vc/events"
+	"github.com/agntcy/identity/internal/core/webhook"
+	"github.com/agntcy/identity/pkg/log"
+	"github.com/google/uuid"
+)
+
+const (
+	webhookPollInterval = 5 * time.Second
+	webhookBatchSize    = 20
+	webhookBaseBackoff  = 5 * time.Second
+	webhookMaxBackoff   = time.Hour
+
+	// The deliveries of a batch are sent one after the other,
+	// they are claimed for longer than the attempts of the whole batch last
+	webhookLeaseFactor = 2
+
+	// The response body read from the endpoint to report a failure
+	webhookMaxErrorBody = 256
+)
+
+// WebhookDispatcher sends the credential events to the webhook endpoints.
+// The events are queued in the repository with the changes they describe and sent by Run,
+// failed deliveries are retried with an exponential backoff.
+type WebhookDispatcher struct {
+	repository  webhook.Repository
+	signer      webhook.Signer
+	urls        []string
+	client      *http.Client
+	maxAttempts int
+	wake        chan struct{}
+	now         func() time.Time
+}
+
+func NewWebhookDispatcher(
+	repository webhook.Repository,
+	signer webhook.Signer,
+	urls []string,
+	timeout time.Duration,
+	maxAttempts int,
+) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		repository:  repository,
+		signer:      signer,
+		urls:        urls,
+		client:      &http.Client{Timeout: timeout},
+		maxAttempts: maxAttempts,
+		wake:        make(chan struct{}, 1),
+		now:         time.Now,
+	}
+}
+
+// Store queues a delivery of the event for each endpoint
+// in the transaction of the change
+func (d *WebhookDispatcher) Store(ctx context.Context, event *events.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("unable to serialize the webhook event %s: %w", event.ID, err)
+	}
+
+	now := d.now()
+
+	for _, url := range d.urls {
+		err = d.repository.Enqueue(ctx, &webhook.Delivery{
+			ID:            uuid.NewString(),
+			URL:           url,
+			EventID:       event.ID,
+			Payload:       payload,
+			NextAttemptAt: now,
+			Status:        webhook.DeliveryStatusPending,
+			CreatedAt:     now,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to queue the webhook event %s for %s: %w", event.ID, url, err)
+		}
+	}
+
+	return nil
+}
+
+// OnEvent wakes the dispatcher once the deliveries of the event are committed
+func (d *WebhookDispatcher) OnEvent(_ context.Context, _ *events.Event) {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run sends the due deliveries until the context is cancelled
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.DispatchDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// DispatchDue sends a batch of the due deliveries
+func (d *WebhookDispatcher) DispatchDue(ctx context.Context) {
+	deliveries, err := d.repository.Claim(
+		ctx,
+		d.now(),
+		webhookLeaseFactor*webhookBatchSize*d.client.Timeout,
+		webhookBatchSize,
+	)
+	if err != nil {
+		log.Error("Unable to fetch the due webhook deliveries: ", err)
+		return
+	}
+
+	for _, delivery := range deliveries {
+		d.deliver(ctx, delivery)
+	}
+}
+
+func (d *WebhookDispatcher) deliver(ctx context.Context, delivery *webhook.Delivery) {
+	err := d.send(ctx, delivery)
+	if err == nil {
+		err = d.repository.Delete(ctx, delivery.ID)
+		if err != nil {
+			log.Error("Unable to remove the webhook delivery ", delivery.ID, ": ", err)
+		}
+
+		return
+	}
+
+	delivery.Attempts++
+	delivery.LastError = err.Error()
+
+	if delivery.Attempts >= d.maxAttempts {
+		log.Warn("Giving up the webhook delivery ", delivery.ID, " to ", delivery.URL, ": ", err)
+
+		delivery.Status = webhook.DeliveryStatusFailed
+	} else {
+		log.Debug("Retrying the webhook delivery ", delivery.ID, " to ", delivery.URL, ": ", err)
+
+		delivery.NextAttemptAt = d.now().Add(webhookBackoff(delivery.Attempts))
+	}
+
+	err = d.repository.Update(ctx, delivery)
+	if err != nil {
+		log.Error("Unable to update the webhook delivery ", delivery.ID, ": ", err)
+	}
+}
+
+func (d *WebhookDispatcher) send(ctx context.Context, delivery *webhook.Delivery) error {
+	body, headers, err := d.signer.Sign(delivery.Payload, d.now())
+	if err != nil {
+		return fmt.Errorf("unable to sign the payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header = headers
+	req.Header.Set(webhook.EventIDHeader, delivery.EventID)
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, webhookMaxErrorBody))
+
+		return fmt.Errorf("the endpoint responded %s: %s", resp.Status, msg)
+	}
+
+	return nil
+}
+
+// webhookBackoff returns the delay before the next attempt
+// after the number of failed attempts
+func webhookBackoff(attempts int) time.Duration {
+	backoff := webhookBaseBackoff
+	for range attempts - 1 {
+		backoff *= 2
+		if backoff >= webhookMaxBackoff {
+			return webhookMaxBackoff
+		}
+	}
+
+	return backoff
+}
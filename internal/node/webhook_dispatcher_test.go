@@ -0,0 +1,179 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package node_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity/internal/core/vc/events"
+	"github.com/agntcy/identity/internal/core/webhook"
+	webhookmemory "github.com/agntcy/identity/internal/core/webhook/memory"
+	"github.com/agntcy/identity/internal/node"
+	"github.com/agntcy/identity/pkg/db/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+var webhookSecret = []byte("secret")
+
+func newWebhookRepository(t *testing.T) webhook.Repository {
+	t.Helper()
+
+	store, err := memory.NewStore("")
+	assert.NoError(t, err)
+
+	repo, err := webhookmemory.NewRepository(store)
+	assert.NoError(t, err)
+
+	return repo
+}
+
+func TestWebhookDispatcher_Should_Send_Signed_Events(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan *events.Event, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		if !webhook.VerifyHMAC(
+			webhookSecret,
+			body,
+			r.Header.Get(webhook.TimestampHeader),
+			r.Header.Get(webhook.SignatureHeader),
+		) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var event events.Event
+		_ = json.Unmarshal(body, &event)
+		assert.Equal(t, event.ID, r.Header.Get(webhook.EventIDHeader))
+		received <- &event
+	}))
+
+	defer server.Close()
+
+	repo := newWebhookRepository(t)
+	sut := node.NewWebhookDispatcher(
+		repo,
+		webhook.NewHMACSigner(webhookSecret),
+		[]string{server.URL},
+		time.Second,
+		3,
+	)
+
+	assert.NoError(t, sut.Store(t.Context(), &events.Event{
+		ID:     "EVENT_ID",
+		Type:   events.EventTypeRevoked,
+		VcID:   "VC_ID_1",
+		Issuer: "ISSUER",
+	}))
+	sut.DispatchDue(t.Context())
+
+	event := <-received
+	assert.Equal(t, "EVENT_ID", event.ID)
+	assert.Equal(t, events.EventTypeRevoked, event.Type)
+
+	// The sent deliveries are removed from the queue
+	due, err := repo.Claim(t.Context(), time.Now().Add(time.Hour), time.Second, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, due)
+}
+
+func TestWebhookDispatcher_Should_Retry_Until_Max_Attempts(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	repo := newWebhookRepository(t)
+	sut := node.NewWebhookDispatcher(
+		repo,
+		webhook.NewHMACSigner(webhookSecret),
+		[]string{server.URL},
+		time.Second,
+		2,
+	)
+
+	assert.NoError(t, sut.Store(t.Context(), &events.Event{ID: "EVENT_ID"}))
+	sut.DispatchDue(t.Context())
+
+	// The failed delivery is postponed
+	assert.Equal(t, int32(1), calls.Load())
+
+	sut.DispatchDue(t.Context())
+	assert.Equal(t, int32(1), calls.Load())
+
+	due, err := repo.Claim(t.Context(), time.Now().Add(time.Hour), time.Second, 10)
+	assert.NoError(t, err)
+	assert.Len(t, due, 1)
+	assert.Equal(t, 1, due[0].Attempts)
+	assert.Equal(t, webhook.DeliveryStatusPending, due[0].Status)
+	assert.Contains(t, due[0].LastError, "503")
+
+	// The last attempt marks the delivery as failed
+	due[0].NextAttemptAt = time.Now()
+	assert.NoError(t, repo.Update(t.Context(), due[0]))
+
+	sut.DispatchDue(t.Context())
+	assert.Equal(t, int32(2), calls.Load())
+
+	due, err = repo.Claim(t.Context(), time.Now().Add(time.Hour), time.Second, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, due)
+}
+
+func TestWebhookDispatcher_Should_Claim_The_Batch_For_Its_Attempts(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		started <- struct{}{}
+
+		<-release
+	}))
+	defer server.Close()
+
+	repo := newWebhookRepository(t)
+	sut := node.NewWebhookDispatcher(
+		repo,
+		webhook.NewHMACSigner(webhookSecret),
+		[]string{server.URL},
+		time.Second,
+		3,
+	)
+
+	assert.NoError(t, sut.Store(t.Context(), &events.Event{ID: "BATCH_EVENT_ID"}))
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		sut.DispatchDue(t.Context())
+	}()
+
+	<-started
+
+	// Another dispatcher does not claim the delivery while the batch is sent
+	due, err := repo.Claim(t.Context(), time.Now().Add(10*time.Second), time.Second, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, due)
+
+	close(release)
+
+	<-done
+}
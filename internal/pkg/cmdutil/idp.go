@@ -0,0 +1,59 @@
+// Copyright 2025 Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package cmdutil
+
+import (
+	"fmt"
+
+	"github.com/agntcy/identity/pkg/oidc"
+)
+
+// IdpCredentials points to the flags of the credentials of an IdP client
+type IdpCredentials struct {
+	AuthMethod     string
+	ClientSecret   *string
+	ClientKeyID    *string
+	ClientCertFile *string
+	ClientKeyFile  *string
+}
+
+// ScanIdpCredentials prompts the user for the credentials of the IdP client authentication method,
+// no shared secret is stored with the private_key_jwt and tls_client_auth methods
+func ScanIdpCredentials(credentials IdpCredentials) error {
+	method, err := oidc.ParseClientAuthMethod(credentials.AuthMethod)
+	if err != nil {
+		return err
+	}
+
+	switch method {
+	case oidc.ClientAuthMethodSecret:
+		// if the client secret is not set, prompt the user for it interactively
+		err = ScanRequiredIfNotSet("IdP client secret", credentials.ClientSecret)
+		if err != nil {
+			return fmt.Errorf("error reading IdP client secret: %w", err)
+		}
+	case oidc.ClientAuthMethodPrivateKeyJWT:
+		*credentials.ClientSecret = ""
+
+		// the assertions are signed by a vault key dedicated to the IdP client
+		err = ScanRequiredIfNotSet("IdP client key ID", credentials.ClientKeyID)
+		if err != nil {
+			return fmt.Errorf("error reading IdP client key ID: %w", err)
+		}
+	case oidc.ClientAuthMethodTLS:
+		*credentials.ClientSecret = ""
+
+		err = ScanRequiredIfNotSet("IdP client certificate file", credentials.ClientCertFile)
+		if err != nil {
+			return fmt.Errorf("error reading IdP client certificate file: %w", err)
+		}
+
+		err = ScanRequiredIfNotSet("IdP client certificate key file", credentials.ClientKeyFile)
+		if err != nil {
+			return fmt.Errorf("error reading IdP client certificate key file: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -31,15 +31,27 @@ func BadRequestError(err error) error {
 	return newStatusWithDetails(codes.InvalidArgument, err)
 }
 
+func TooManyRequestsError(err error) error {
+	return newStatusWithDetails(codes.ResourceExhausted, err)
+}
+
 func InternalError(err error) error {
 	return newStatusWithDetails(codes.Internal, err)
 }
 
 func newStatusWithDetails(c codes.Code, err error) error {
-	st := status.New(c, err.Error())
 	var errInfo errtypes.ErrorInfo
 
-	if errors.As(err, &errInfo) {
+	// The services limiting the rate of the issuers reject their requests
+	// with the same code as the interceptors
+	isErrInfo := errors.As(err, &errInfo)
+	if isErrInfo && errInfo.Reason == errtypes.ERROR_REASON_RATE_LIMIT_EXCEEDED {
+		c = codes.ResourceExhausted
+	}
+
+	st := status.New(c, err.Error())
+
+	if isErrInfo {
 		st, _ = st.WithDetails(&coreapi.ErrorInfo{
 			Reason: ptrutil.Ptr(coreapi.ErrorReason(errInfo.Reason)),
 		})
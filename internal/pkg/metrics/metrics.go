@@ -0,0 +1,149 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metrics defines the Prometheus metrics exposed by the node
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "identity"
+
+// The outcomes of the verification of a Verifiable Credential or Presentation
+const (
+	VerificationValid     = "valid"
+	VerificationRevoked   = "revoked"
+	VerificationSuspended = "suspended"
+	VerificationInvalid   = "invalid"
+)
+
+const (
+	outcomeLabel   = "outcome"
+	outcomeSuccess = "success"
+	outcomeFailure = "failure"
+)
+
+var (
+	rpcDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "rpc",
+			Name:      "duration_seconds",
+			Help:      "The latency of the RPCs by method and gRPC status code.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method", "code"},
+	)
+
+	rpcErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rpc",
+			Name:      "errors_total",
+			Help:      "The number of failed RPCs by method and error reason.",
+		},
+		[]string{"method", "reason"},
+	)
+
+	verifications = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "vc",
+			Name:      "verifications_total",
+			Help:      "The number of Verifiable Credential verifications by outcome.",
+		},
+		[]string{outcomeLabel},
+	)
+
+	presentationVerifications = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "vp",
+			Name:      "verifications_total",
+			Help:      "The number of Verifiable Presentation verifications by outcome.",
+		},
+		[]string{outcomeLabel},
+	)
+
+	jwksCacheRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "oidc",
+			Name:      "jwks_cache_requests_total",
+			Help:      "The number of lookups in the JWKS cache of the OIDC parser by result.",
+		},
+		[]string{"result"},
+	)
+
+	idpFetchDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "oidc",
+			Name:      "idp_fetch_duration_seconds",
+			Help:      "The latency of the requests to the identity providers by operation and outcome.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"operation", outcomeLabel},
+	)
+)
+
+// Register registers the metrics of the node with the registerer
+func Register(reg prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{
+		rpcDuration,
+		rpcErrors,
+		verifications,
+		presentationVerifications,
+		jwksCacheRequests,
+		idpFetchDuration,
+	} {
+		err := reg.Register(collector)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ObserveRPC records the latency of an RPC and its error reason if it failed
+func ObserveRPC(method, code string, duration time.Duration, reason string) {
+	rpcDuration.WithLabelValues(method, code).Observe(duration.Seconds())
+
+	if reason != "" {
+		rpcErrors.WithLabelValues(method, reason).Inc()
+	}
+}
+
+// ObserveVerification records the outcome of the verification of a Verifiable Credential
+func ObserveVerification(outcome string) {
+	verifications.WithLabelValues(outcome).Inc()
+}
+
+// ObservePresentationVerification records the outcome of the verification of a Verifiable Presentation
+func ObservePresentationVerification(outcome string) {
+	presentationVerifications.WithLabelValues(outcome).Inc()
+}
+
+// ObserveJwksCache records a lookup in the JWKS cache
+func ObserveJwksCache(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	jwksCacheRequests.WithLabelValues(result).Inc()
+}
+
+// ObserveIdpFetch records the latency of a request to an identity provider started at start
+func ObserveIdpFetch(operation string, start time.Time, err error) {
+	outcome := outcomeSuccess
+	if err != nil {
+		outcome = outcomeFailure
+	}
+
+	idpFetchDuration.WithLabelValues(operation, outcome).Observe(time.Since(start).Seconds())
+}
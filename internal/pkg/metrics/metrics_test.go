@@ -0,0 +1,55 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity/internal/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegister_Should_Expose_Observed_Metrics(t *testing.T) {
+	t.Parallel()
+
+	registry := prometheus.NewRegistry()
+	assert.NoError(t, metrics.Register(registry))
+
+	metrics.ObserveRPC("/test.Service/Method", "InvalidArgument", time.Millisecond, "ERROR_REASON_INVALID_ISSUER")
+	metrics.ObserveVerification(metrics.VerificationRevoked)
+	metrics.ObserveVerification(metrics.VerificationSuspended)
+	metrics.ObservePresentationVerification(metrics.VerificationInvalid)
+	metrics.ObserveJwksCache(true)
+	metrics.ObserveIdpFetch("jwks", time.Now(), errors.New("unreachable"))
+
+	err := testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP identity_vc_verifications_total The number of Verifiable Credential verifications by outcome.
+# TYPE identity_vc_verifications_total counter
+identity_vc_verifications_total{outcome="revoked"} 1
+identity_vc_verifications_total{outcome="suspended"} 1
+# HELP identity_vp_verifications_total The number of Verifiable Presentation verifications by outcome.
+# TYPE identity_vp_verifications_total counter
+identity_vp_verifications_total{outcome="invalid"} 1
+`), "identity_vc_verifications_total", "identity_vp_verifications_total")
+	assert.NoError(t, err)
+
+	for _, name := range []string{
+		"identity_rpc_duration_seconds",
+		"identity_rpc_errors_total",
+		"identity_oidc_jwks_cache_requests_total",
+		"identity_oidc_idp_fetch_duration_seconds",
+	} {
+		count, err := testutil.GatherAndCount(registry, name)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count, name)
+	}
+
+	// The metrics can only be registered once
+	assert.Error(t, metrics.Register(registry))
+}
@@ -6,14 +6,18 @@ package nodeapi
 import (
 	"context"
 	"errors"
+	"net/http"
 	"net/url"
+	"strconv"
 
 	idsdk "github.com/agntcy/identity/api/client/client/id_service"
 	issuersdk "github.com/agntcy/identity/api/client/client/issuer_service"
+	logsdk "github.com/agntcy/identity/api/client/client/log_service"
 	vcsdk "github.com/agntcy/identity/api/client/client/vc_service"
 	apimodels "github.com/agntcy/identity/api/client/models"
 	idtypes "github.com/agntcy/identity/internal/core/id/types"
 	issuertypes "github.com/agntcy/identity/internal/core/issuer/types"
+	"github.com/agntcy/identity/internal/core/translog"
 	vctypes "github.com/agntcy/identity/internal/core/vc/types"
 	"github.com/agntcy/identity/internal/pkg/convertutil"
 	"github.com/agntcy/identity/pkg/jwk"
@@ -25,14 +29,53 @@ type ClientProvider interface {
 	New(host string) (NodeClient, error)
 }
 
-type clientProvider struct{}
+// The header carrying the API key expected by the nodes requiring one
+const apiKeyHeader = "X-Api-Key"
 
-func NewNodeClientProvider() ClientProvider {
-	return &clientProvider{}
+type clientOptions struct {
+	apiKey string
+	tls    *httptransport.TLSClientOptions
 }
 
-func (clientProvider) New(host string) (NodeClient, error) {
-	return NewNodeClient(host)
+// ClientOption configures the node clients
+type ClientOption func(opts *clientOptions)
+
+// WithApiKey sends the API key with every request to the node
+func WithApiKey(apiKey string) ClientOption {
+	return func(opts *clientOptions) {
+		opts.apiKey = apiKey
+	}
+}
+
+// WithTLS verifies the certificate of the node with the CA certificates of the file,
+// the system CAs are used when the file is empty. The client presents the
+// certificate and the key of the files when both are set.
+func WithTLS(caFile, certFile, keyFile string) ClientOption {
+	return func(opts *clientOptions) {
+		if caFile == "" && certFile == "" && keyFile == "" {
+			return
+		}
+
+		opts.tls = &httptransport.TLSClientOptions{
+			CA:          caFile,
+			Certificate: certFile,
+			Key:         keyFile,
+		}
+	}
+}
+
+type clientProvider struct {
+	opts []ClientOption
+}
+
+func NewNodeClientProvider(opts ...ClientOption) ClientProvider {
+	return &clientProvider{
+		opts: opts,
+	}
+}
+
+func (p *clientProvider) New(host string) (NodeClient, error) {
+	return NewNodeClient(host, p.opts...)
 }
 
 type NodeClient interface {
@@ -41,6 +84,13 @@ type NodeClient interface {
 		issuer *issuertypes.Issuer,
 		proof *vctypes.Proof,
 	) error
+	RotateIssuerKey(
+		ctx context.Context,
+		commonName string,
+		publicKey *jwk.Jwk,
+		proof *vctypes.Proof,
+		retireImmediately bool,
+	) error
 	GenerateID(
 		ctx context.Context,
 		issuer *issuertypes.Issuer,
@@ -50,28 +100,67 @@ type NodeClient interface {
 		vc *vctypes.EnvelopedCredential,
 		proof *vctypes.Proof,
 	) error
+	RevokeVerifiableCredential(
+		vc *vctypes.EnvelopedCredential,
+		proof *vctypes.Proof,
+	) error
 	ResolveMetadataByID(
 		ctx context.Context,
 		id string,
 	) (*idtypes.ResolverMetadata, error)
+	GetInclusionProof(
+		ctx context.Context,
+		leafHash []byte,
+	) (*translog.InclusionProof, error)
+	GetTransparencyLogJwks(ctx context.Context) (*jwk.Jwks, error)
 }
 
 type nodeClient struct {
 	id     idsdk.ClientService
 	issuer issuersdk.ClientService
 	vc     vcsdk.ClientService
+	log    logsdk.ClientService
 }
 
-func NewNodeClient(host string) (NodeClient, error) {
+func NewNodeClient(host string, opts ...ClientOption) (NodeClient, error) {
 	u, err := url.Parse(host)
 	if err != nil {
 		return nil, err
 	}
 
+	options := &clientOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	httpClient := http.DefaultClient
+
+	if options.tls != nil {
+		httpClient, err = httptransport.TLSClient(*options.tls)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newTransport := func() *httptransport.Runtime {
+		transport := httptransport.NewWithClient(u.Host, "", []string{u.Scheme}, httpClient)
+
+		if options.apiKey != "" {
+			transport.DefaultAuthentication = httptransport.APIKeyAuth(
+				apiKeyHeader,
+				"header",
+				options.apiKey,
+			)
+		}
+
+		return transport
+	}
+
 	return &nodeClient{
-		id:     idsdk.New(httptransport.New(u.Host, "", []string{u.Scheme}), strfmt.Default),
-		issuer: issuersdk.New(httptransport.New(u.Host, "", []string{u.Scheme}), strfmt.Default),
-		vc:     vcsdk.New(httptransport.New(u.Host, "", []string{u.Scheme}), strfmt.Default),
+		id:     idsdk.New(newTransport(), strfmt.Default),
+		issuer: issuersdk.New(newTransport(), strfmt.Default),
+		vc:     vcsdk.New(newTransport(), strfmt.Default),
+		log:    logsdk.New(newTransport(), strfmt.Default),
 	}, nil
 }
 
@@ -103,6 +192,31 @@ func (c *nodeClient) RegisterIssuer(
 	return nil
 }
 
+func (c *nodeClient) RotateIssuerKey(
+	ctx context.Context,
+	commonName string,
+	publicKey *jwk.Jwk,
+	proof *vctypes.Proof,
+	retireImmediately bool,
+) error {
+	_, err := c.issuer.RotateIssuerKey(&issuersdk.RotateIssuerKeyParams{
+		CommonName: commonName,
+		Body: &apimodels.IssuerServiceRotateKeyBody{
+			PublicKey: convertutil.Convert[apimodels.V1alpha1Jwk](publicKey.PublicKey()),
+			Proof: &apimodels.V1alpha1Proof{
+				Type:       proof.Type,
+				ProofValue: proof.ProofValue,
+			},
+			RetireImmediately: retireImmediately,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (c *nodeClient) GenerateID(
 	ctx context.Context,
 	issuer *issuertypes.Issuer,
@@ -175,6 +289,35 @@ func (c *nodeClient) PublishVerifiableCredential(
 			},
 		},
 	})
+	if err != nil {
+		return toErrorInfo(err)
+	}
+
+	if resp == nil {
+		return errors.New("empty response payload")
+	}
+
+	return nil
+}
+
+func (c *nodeClient) RevokeVerifiableCredential(
+	vc *vctypes.EnvelopedCredential,
+	proof *vctypes.Proof,
+) error {
+	resp, err := c.vc.RevokeVerifiableCredential(&vcsdk.RevokeVerifiableCredentialParams{
+		Body: &apimodels.V1alpha1RevokeRequest{
+			Vc: &apimodels.V1alpha1EnvelopedCredential{
+				EnvelopeType: apimodels.NewV1alpha1CredentialEnvelopeType(
+					apimodels.V1alpha1CredentialEnvelopeType(vc.EnvelopeType.String()),
+				),
+				Value: vc.Value,
+			},
+			Proof: &apimodels.V1alpha1Proof{
+				Type:       proof.Type,
+				ProofValue: proof.ProofValue,
+			},
+		},
+	})
 	if err != nil {
 		return err
 	}
@@ -227,3 +370,97 @@ func (c *nodeClient) ResolveMetadataByID(
 		AssertionMethod: md.AssertionMethod,
 	}, nil
 }
+
+func (c *nodeClient) GetInclusionProof(
+	ctx context.Context,
+	leafHash []byte,
+) (*translog.InclusionProof, error) {
+	hash := strfmt.Base64(leafHash)
+
+	resp, err := c.log.GetInclusionProof(&logsdk.GetInclusionProofParams{
+		LeafHash: &hash,
+		Context:  ctx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || resp.Payload == nil ||
+		resp.Payload.Leaf == nil || resp.Payload.SignedTreeHead == nil {
+		return nil, errors.New("empty response payload")
+	}
+
+	leafIndex, err := parseUint(resp.Payload.Leaf.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	sth, err := toSignedTreeHead(resp.Payload.SignedTreeHead)
+	if err != nil {
+		return nil, err
+	}
+
+	auditPath := make([][]byte, 0, len(resp.Payload.AuditPath))
+	for _, h := range resp.Payload.AuditPath {
+		auditPath = append(auditPath, h)
+	}
+
+	return &translog.InclusionProof{
+		Leaf: &translog.Leaf{
+			Index: leafIndex,
+			Entry: &translog.Entry{
+				Type:    translog.EntryType(resp.Payload.Leaf.Type),
+				Subject: resp.Payload.Leaf.Subject,
+				Digest:  resp.Payload.Leaf.Digest,
+			},
+			Hash: resp.Payload.Leaf.Hash,
+		},
+		AuditPath:      auditPath,
+		SignedTreeHead: sth,
+	}, nil
+}
+
+func (c *nodeClient) GetTransparencyLogJwks(ctx context.Context) (*jwk.Jwks, error) {
+	resp, err := c.log.GetLogJwks(&logsdk.GetLogJwksParams{
+		Context: ctx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || resp.Payload == nil || resp.Payload.Jwks == nil {
+		return nil, errors.New("empty response payload")
+	}
+
+	return convertutil.Convert[jwk.Jwks](resp.Payload.Jwks), nil
+}
+
+func toSignedTreeHead(src *apimodels.V1alpha1SignedTreeHead) (*translog.SignedTreeHead, error) {
+	treeSize, err := parseUint(src.TreeSize)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp, err := strconv.ParseInt(src.Timestamp, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &translog.SignedTreeHead{
+		TreeHead: translog.TreeHead{
+			TreeSize:  treeSize,
+			RootHash:  src.RootHash,
+			Timestamp: timestamp,
+		},
+		Signature: src.Signature,
+	}, nil
+}
+
+// parseUint parses the 64-bit integers serialized as strings by the API
+func parseUint(value string) (uint64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(value, 10, 64)
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	vctypes "github.com/agntcy/identity/internal/core/vc/types"
+	"github.com/agntcy/identity/internal/pkg/nodeapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublishVerifiableCredential_Should_Return_The_Reason_Of_The_Error(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"code":    3,
+			"message": "the statusListIndex 42 is already in use",
+			"details": []map[string]any{
+				{
+					"@type":  "type.googleapis.com/agntcy.identity.core.v1alpha1.ErrorInfo",
+					"reason": "ERROR_REASON_STATUS_LIST_INDEX_IN_USE",
+				},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := nodeapi.NewNodeClient(server.URL)
+	assert.NoError(t, err)
+
+	err = client.PublishVerifiableCredential(
+		&vctypes.EnvelopedCredential{
+			EnvelopeType: vctypes.CREDENTIAL_ENVELOPE_TYPE_JOSE,
+			Value:        "credential",
+		},
+		&vctypes.Proof{Type: "JWT", ProofValue: "proof"},
+	)
+
+	assert.True(t, errtypes.IsErrorInfo(err, errtypes.ERROR_REASON_STATUS_LIST_INDEX_IN_USE))
+	assert.ErrorContains(t, err, "already in use")
+}
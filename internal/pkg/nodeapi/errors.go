@@ -0,0 +1,41 @@
+// Copyright 2025 Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeapi
+
+import (
+	"errors"
+
+	apimodels "github.com/agntcy/identity/api/client/models"
+	coreapi "github.com/agntcy/identity/api/server/agntcy/identity/core/v1alpha1"
+	errtypes "github.com/agntcy/identity/internal/core/errors/types"
+	"github.com/agntcy/identity/internal/pkg/errutil"
+)
+
+// toErrorInfo converts an error returned by the Identity node with a reason
+// into an ErrorInfo, so the callers can handle it with errtypes.IsErrorInfo
+func toErrorInfo(err error) error {
+	var response interface {
+		GetPayload() *apimodels.RPCStatus
+	}
+
+	if !errors.As(err, &response) || response.GetPayload() == nil {
+		return err
+	}
+
+	status := response.GetPayload()
+
+	for _, detail := range status.Details {
+		if detail == nil {
+			continue
+		}
+
+		name, _ := detail.GoogleprotobufAny["reason"].(string)
+
+		if reason, ok := coreapi.ErrorReason_value[name]; ok {
+			return errutil.ErrInfo(errtypes.ErrorReason(reason), status.Message, err)
+		}
+	}
+
+	return err
+}
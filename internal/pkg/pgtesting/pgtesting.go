@@ -0,0 +1,64 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pgtesting connects the tests of the Postgres repositories to a database
+// migrated to the latest schema. The tests are skipped unless TEST_DB_HOST is set.
+package pgtesting
+
+import (
+	"os"
+	"testing"
+
+	"github.com/agntcy/identity/internal/node/migrations"
+	"github.com/agntcy/identity/pkg/db"
+	"github.com/agntcy/identity/pkg/db/migrate"
+)
+
+// Connect returns a context of the test database, the pending migrations are applied
+func Connect(t *testing.T) db.Context {
+	t.Helper()
+
+	host := os.Getenv("TEST_DB_HOST")
+	if host == "" {
+		t.Skip("TEST_DB_HOST is not set, skipping the Postgres tests")
+	}
+
+	dbContext := db.NewContext(
+		host,
+		getenv("TEST_DB_PORT", "5432"),
+		getenv("TEST_DB_NAME", "identity_test"),
+		getenv("TEST_DB_USERNAME", "postgres"),
+		os.Getenv("TEST_DB_PASSWORD"),
+		false,
+	)
+
+	err := dbContext.Connect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = dbContext.Disconnect()
+	})
+
+	scripts, err := migrations.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The migrations of concurrent test packages are serialized by the migrator
+	_, err = migrate.NewMigrator(dbContext, scripts).Up(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return dbContext
+}
+
+func getenv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return fallback
+}
@@ -0,0 +1,252 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/agntcy/identity/pkg/log"
+)
+
+const (
+	storeDirPerm  = 0o700
+	storeFilePerm = 0o600
+)
+
+// Store is an in-memory database made of named tables.
+// When a file is set, the tables are loaded from the file on creation
+// and the whole store is written back to the file after every update.
+type Store struct {
+	mu     sync.RWMutex
+	path   string
+	loaded map[string]json.RawMessage
+	tables map[string]any
+}
+
+// NewStore creates a store persisted in the file at the path,
+// the store is kept in memory only when the path is empty
+func NewStore(path string) (*Store, error) {
+	s := &Store{
+		path:   path,
+		loaded: make(map[string]json.RawMessage),
+		tables: make(map[string]any),
+	}
+
+	if path == "" {
+		return s, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return s, nil
+		}
+
+		return nil, err
+	}
+
+	err = json.Unmarshal(data, &s.loaded)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the store from %s: %w", path, err)
+	}
+
+	log.Debug("Loaded the in-memory store from ", path)
+
+	return s, nil
+}
+
+// The key of the transaction of a store in the context
+type transactionKey struct{}
+
+// Transaction runs fn holding the lock of the store, the tables accessed with
+// the context passed to fn are part of the transaction. The store is persisted
+// once fn succeeds, the tables are restored when fn or the persistence fails.
+// A transaction started inside another one joins the outer transaction.
+func (s *Store) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.inTransaction(ctx) {
+		return fn(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The updates replace the rows of the tables, a shallow copy keeps the previous rows
+	snapshot := maps.Clone(s.tables)
+
+	err := fn(context.WithValue(ctx, transactionKey{}, s))
+	if err == nil {
+		err = s.persist()
+	}
+
+	if err != nil {
+		s.tables = snapshot
+	}
+
+	return err
+}
+
+// inTransaction returns true when the context runs in a transaction of the store,
+// the lock of the store is then already held
+func (s *Store) inTransaction(ctx context.Context) bool {
+	store, _ := ctx.Value(transactionKey{}).(*Store)
+
+	return store == s
+}
+
+// persist writes the tables to the file of the store,
+// the caller must hold the write lock
+func (s *Store) persist() error {
+	if s.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(s.tables)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(s.path), storeDirPerm)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so a crash never leaves a truncated store
+	tmp := s.path + ".tmp"
+
+	err = os.WriteFile(tmp, data, storeFilePerm)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, s.path)
+}
+
+// Table is a collection of rows of the same type indexed by a key
+type Table[T any] struct {
+	store *Store
+	name  string
+}
+
+// NewTable returns the table with the name, creating it when it does not exist.
+// The rows are loaded from the file of the store if any.
+func NewTable[T any](store *Store, name string) (*Table[T], error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if existing, ok := store.tables[name]; ok {
+		if _, ok := existing.(map[string]T); !ok {
+			return nil, fmt.Errorf("the table %s already exists with another type", name)
+		}
+
+		return &Table[T]{store: store, name: name}, nil
+	}
+
+	rows := make(map[string]T)
+
+	if raw, ok := store.loaded[name]; ok {
+		err := json.Unmarshal(raw, &rows)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the table %s: %w", name, err)
+		}
+	}
+
+	store.tables[name] = rows
+
+	return &Table[T]{store: store, name: name}, nil
+}
+
+// rows returns the current rows of the table,
+// the caller must hold the lock of the store
+func (t *Table[T]) rows() map[string]T {
+	rows, _ := t.store.tables[t.name].(map[string]T)
+
+	return rows
+}
+
+// View runs the function with read access to the rows of the table
+func (t *Table[T]) View(ctx context.Context, fn func(rows map[string]T) error) error {
+	if !t.store.inTransaction(ctx) {
+		t.store.mu.RLock()
+		defer t.store.mu.RUnlock()
+	}
+
+	return fn(t.rows())
+}
+
+// Update runs the function with write access to a copy of the rows of the table.
+// The copy replaces the rows once the function succeeds and the store is persisted,
+// the table is left unchanged otherwise. In a transaction, the store is persisted
+// when the transaction succeeds.
+func (t *Table[T]) Update(ctx context.Context, fn func(rows map[string]T) error) error {
+	if t.store.inTransaction(ctx) {
+		return t.apply(fn)
+	}
+
+	t.store.mu.Lock()
+	defer t.store.mu.Unlock()
+
+	current := t.rows()
+
+	err := t.apply(fn)
+	if err != nil {
+		return err
+	}
+
+	err = t.store.persist()
+	if err != nil {
+		t.store.tables[t.name] = current
+		return err
+	}
+
+	return nil
+}
+
+// apply runs the function on a copy of the rows replacing them once it succeeds,
+// the caller must hold the write lock of the store
+func (t *Table[T]) apply(fn func(rows map[string]T) error) error {
+	current := t.rows()
+
+	rows, err := Clone(&current)
+	if err != nil {
+		return err
+	}
+
+	err = fn(*rows)
+	if err != nil {
+		return err
+	}
+
+	t.store.tables[t.name] = *rows
+
+	return nil
+}
+
+// Clone returns a deep copy of the value so the rows stored in a table
+// are never shared with the callers
+func Clone[T any](src *T) (*T, error) {
+	if src == nil {
+		return nil, nil //nolint:nilnil // A nil value is cloned into nil
+	}
+
+	data, err := json.Marshal(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var dst T
+
+	err = json.Unmarshal(data, &dst)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dst, nil
+}
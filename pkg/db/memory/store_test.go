@@ -0,0 +1,238 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/agntcy/identity/pkg/db/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+type row struct {
+	Name   string
+	Values []int
+}
+
+const rowsTable = "rows"
+
+func TestStore_Should_Reload_Persisted_Tables(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "store", "store.json")
+
+	store, err := memory.NewStore(path)
+	assert.NoError(t, err)
+
+	table, err := memory.NewTable[*row](store, rowsTable)
+	assert.NoError(t, err)
+
+	err = table.Update(t.Context(), func(rows map[string]*row) error {
+		rows["a"] = &row{Name: "a", Values: []int{1, 2}}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	reloaded, err := memory.NewStore(path)
+	assert.NoError(t, err)
+
+	reloadedTable, err := memory.NewTable[*row](reloaded, rowsTable)
+	assert.NoError(t, err)
+
+	_ = reloadedTable.View(t.Context(), func(rows map[string]*row) error {
+		assert.Len(t, rows, 1)
+		assert.Equal(t, &row{Name: "a", Values: []int{1, 2}}, rows["a"])
+
+		return nil
+	})
+}
+
+func TestStore_Should_Not_Persist_Failed_Updates(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "store.json")
+
+	store, _ := memory.NewStore(path)
+	table, _ := memory.NewTable[*row](store, rowsTable)
+
+	err := table.Update(t.Context(), func(rows map[string]*row) error {
+		return errors.New("failed")
+	})
+	assert.Error(t, err)
+	assert.NoFileExists(t, path)
+}
+
+func TestStore_Should_Keep_Data_In_Memory_Without_File(t *testing.T) {
+	t.Parallel()
+
+	store, err := memory.NewStore("")
+	assert.NoError(t, err)
+
+	table, _ := memory.NewTable[*row](store, rowsTable)
+	_ = table.Update(t.Context(), func(rows map[string]*row) error {
+		rows["a"] = &row{Name: "a"}
+		return nil
+	})
+
+	// The same table is returned for the same name
+	same, err := memory.NewTable[*row](store, rowsTable)
+	assert.NoError(t, err)
+
+	_ = same.View(t.Context(), func(rows map[string]*row) error {
+		assert.Contains(t, rows, "a")
+		return nil
+	})
+
+	_, err = memory.NewTable[string](store, rowsTable)
+	assert.Error(t, err)
+}
+
+func TestClone_Should_Deep_Copy(t *testing.T) {
+	t.Parallel()
+
+	src := &row{Name: "a", Values: []int{1}}
+
+	dst, err := memory.Clone(src)
+	assert.NoError(t, err)
+
+	dst.Values[0] = 2
+
+	assert.Equal(t, 1, src.Values[0])
+}
+
+func TestTable_Should_Not_Apply_Updates_That_Fail_To_Persist(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	store, err := memory.NewStore(filepath.Join(dir, "store", "store.json"))
+	assert.NoError(t, err)
+
+	table, _ := memory.NewTable[*row](store, rowsTable)
+
+	// The directory of the store can no longer be created
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "store"), nil, 0o600))
+
+	err = table.Update(t.Context(), func(rows map[string]*row) error {
+		rows["a"] = &row{Name: "a"}
+		return nil
+	})
+	assert.Error(t, err)
+
+	_ = table.View(t.Context(), func(rows map[string]*row) error {
+		assert.Empty(t, rows)
+		return nil
+	})
+}
+
+func TestTable_Should_Not_Apply_Failed_Updates(t *testing.T) {
+	t.Parallel()
+
+	store, _ := memory.NewStore("")
+	table, _ := memory.NewTable[*row](store, rowsTable)
+
+	_ = table.Update(t.Context(), func(rows map[string]*row) error {
+		rows["a"] = &row{Name: "a"}
+		return nil
+	})
+
+	err := table.Update(t.Context(), func(rows map[string]*row) error {
+		rows["a"].Name = "b"
+		delete(rows, "a")
+
+		return errors.New("failed")
+	})
+	assert.Error(t, err)
+
+	_ = table.View(t.Context(), func(rows map[string]*row) error {
+		assert.Equal(t, &row{Name: "a"}, rows["a"])
+		return nil
+	})
+}
+
+func TestStore_Should_Commit_Transactions_Across_Tables(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "store.json")
+
+	store, _ := memory.NewStore(path)
+	table, _ := memory.NewTable[*row](store, rowsTable)
+	other, _ := memory.NewTable[*row](store, "other")
+
+	err := store.Transaction(t.Context(), func(ctx context.Context) error {
+		_ = table.Update(ctx, func(rows map[string]*row) error {
+			rows["a"] = &row{Name: "a"}
+			return nil
+		})
+
+		// The store is persisted once the transaction succeeds
+		assert.NoFileExists(t, path)
+
+		return other.Update(ctx, func(rows map[string]*row) error {
+			rows["b"] = &row{Name: "b"}
+			return nil
+		})
+	})
+	assert.NoError(t, err)
+	assert.FileExists(t, path)
+
+	reloaded, _ := memory.NewStore(path)
+	reloadedOther, _ := memory.NewTable[*row](reloaded, "other")
+
+	_ = reloadedOther.View(t.Context(), func(rows map[string]*row) error {
+		assert.Equal(t, &row{Name: "b"}, rows["b"])
+		return nil
+	})
+}
+
+func TestStore_Should_Roll_Back_Failed_Transactions(t *testing.T) {
+	t.Parallel()
+
+	store, _ := memory.NewStore("")
+	table, _ := memory.NewTable[*row](store, rowsTable)
+	other, _ := memory.NewTable[*row](store, "other")
+
+	_ = table.Update(t.Context(), func(rows map[string]*row) error {
+		rows["a"] = &row{Name: "a"}
+		return nil
+	})
+
+	err := store.Transaction(t.Context(), func(ctx context.Context) error {
+		_ = table.Update(ctx, func(rows map[string]*row) error {
+			delete(rows, "a")
+			return nil
+		})
+
+		_ = other.Update(ctx, func(rows map[string]*row) error {
+			rows["b"] = &row{Name: "b"}
+			return nil
+		})
+
+		// The updates are visible inside the transaction
+		_ = table.View(ctx, func(rows map[string]*row) error {
+			assert.Empty(t, rows)
+			return nil
+		})
+
+		// A nested transaction joins the outer one
+		return store.Transaction(ctx, func(ctx context.Context) error {
+			return errors.New("failed")
+		})
+	})
+	assert.Error(t, err)
+
+	_ = table.View(t.Context(), func(rows map[string]*row) error {
+		assert.Equal(t, &row{Name: "a"}, rows["a"])
+		return nil
+	})
+
+	_ = other.View(t.Context(), func(rows map[string]*row) error {
+		assert.Empty(t, rows)
+		return nil
+	})
+}
@@ -0,0 +1,376 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package migrate
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/agntcy/identity/pkg/db"
+	"github.com/agntcy/identity/pkg/log"
+	"gorm.io/gorm"
+)
+
+// The key of the advisory lock serializing the migrations of concurrent instances
+const migrationLockKey = 7_263_517_413
+
+var (
+	ErrSchemaVersionMismatch = errors.New("unexpected database schema version")
+	ErrNoMigration           = errors.New("no migration to apply")
+)
+
+// The migration files are named <version>_<name>.up.sql and <version>_<name>.down.sql
+var migrationFileRegexp = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration is a versioned change of the database schema
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// SchemaMigration is a migration applied to the database
+type SchemaMigration struct {
+	Version   uint64 `gorm:"primaryKey;autoIncrement:false"`
+	Name      string `gorm:"not null"`
+	AppliedAt time.Time
+}
+
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Load reads the migration scripts at the root of the file system.
+// Every migration must have an up and a down script.
+func Load(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[uint64]*Migration)
+
+	for _, entry := range entries {
+		matches := migrationFileRegexp.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || matches == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(matches[1], 10, 64)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("invalid migration version: %s", entry.Name())
+		}
+
+		script, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = migration
+		} else if migration.Name != matches[2] {
+			return nil, fmt.Errorf("the migration %d has two names", version)
+		}
+
+		if matches[3] == "up" {
+			migration.Up = string(script)
+		} else {
+			migration.Down = string(script)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf(
+				"the migration %d_%s must have an up and a down script",
+				migration.Version,
+				migration.Name,
+			)
+		}
+
+		migrations = append(migrations, migration)
+	}
+
+	slices.SortFunc(migrations, func(a, b *Migration) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+
+	return migrations, nil
+}
+
+// Migrator applies and rolls back the migrations of the database schema.
+// The applied migrations are recorded in the schema_migrations table.
+type Migrator struct {
+	dbContext  db.Context
+	migrations []*Migration
+}
+
+// NewMigrator creates a migrator for the migrations ordered by version
+func NewMigrator(dbContext db.Context, migrations []*Migration) *Migrator {
+	return &Migrator{
+		dbContext:  dbContext,
+		migrations: migrations,
+	}
+}
+
+// LatestVersion returns the version of the last migration known by the migrator
+func (m *Migrator) LatestVersion() uint64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the version of the last migration applied to the database,
+// zero when no migration has been applied
+func (m *Migrator) Version(ctx context.Context) (uint64, error) {
+	client := m.dbContext.Client().WithContext(ctx)
+
+	err := m.ensureTable(client)
+	if err != nil {
+		return 0, err
+	}
+
+	return currentVersion(client)
+}
+
+// Check returns ErrSchemaVersionMismatch when the database schema
+// is not at the latest version
+func (m *Migrator) Check(ctx context.Context) error {
+	version, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+
+	if version != m.LatestVersion() {
+		return fmt.Errorf(
+			"%w: the database is at version %d, expected %d",
+			ErrSchemaVersionMismatch,
+			version,
+			m.LatestVersion(),
+		)
+	}
+
+	return nil
+}
+
+// Up applies the pending migrations in order and returns them
+func (m *Migrator) Up(ctx context.Context) ([]*Migration, error) {
+	client := m.dbContext.Client().WithContext(ctx)
+
+	err := m.ensureTable(client)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make([]*Migration, 0)
+
+	for _, migration := range m.migrations {
+		done, err := m.apply(client, migration)
+		if err != nil {
+			return applied, fmt.Errorf(
+				"unable to apply the migration %d_%s: %w",
+				migration.Version,
+				migration.Name,
+				err,
+			)
+		}
+
+		if done {
+			log.Info("Applied the migration ", migration.Version, "_", migration.Name)
+
+			applied = append(applied, migration)
+		}
+	}
+
+	return applied, nil
+}
+
+// Down rolls back the last applied migrations, at most steps, and returns them
+func (m *Migrator) Down(ctx context.Context, steps int) ([]*Migration, error) {
+	client := m.dbContext.Client().WithContext(ctx)
+
+	err := m.ensureTable(client)
+	if err != nil {
+		return nil, err
+	}
+
+	rolledBack := make([]*Migration, 0, steps)
+
+	for range steps {
+		migration, err := m.rollback(client)
+		if err != nil {
+			if errors.Is(err, ErrNoMigration) && len(rolledBack) > 0 {
+				break
+			}
+
+			return rolledBack, err
+		}
+
+		log.Info("Rolled back the migration ", migration.Version, "_", migration.Name)
+
+		rolledBack = append(rolledBack, migration)
+	}
+
+	return rolledBack, nil
+}
+
+// Baseline records the first migration as applied without running its script.
+// It adopts the databases already holding the initial schema, created before
+// the schema was versioned, and fails when a migration has been applied.
+func (m *Migrator) Baseline(ctx context.Context) (*Migration, error) {
+	if len(m.migrations) == 0 {
+		return nil, ErrNoMigration
+	}
+
+	client := m.dbContext.Client().WithContext(ctx)
+
+	err := m.ensureTable(client)
+	if err != nil {
+		return nil, err
+	}
+
+	migration := m.migrations[0]
+
+	err = client.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockKey).Error
+		if err != nil {
+			return err
+		}
+
+		version, err := currentVersion(tx)
+		if err != nil {
+			return err
+		}
+
+		if version != 0 {
+			return fmt.Errorf(
+				"%w: the database is already at version %d",
+				ErrSchemaVersionMismatch,
+				version,
+			)
+		}
+
+		return tx.Create(&SchemaMigration{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			AppliedAt: time.Now(),
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Recorded the migration ", migration.Version, "_", migration.Name, " as applied")
+
+	return migration, nil
+}
+
+func (m *Migrator) ensureTable(client *gorm.DB) error {
+	return client.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version bigint PRIMARY KEY,
+		name text NOT NULL,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`).Error
+}
+
+// apply runs the up script of the migration in a transaction,
+// returns false when the migration is already applied
+func (m *Migrator) apply(client *gorm.DB, migration *Migration) (bool, error) {
+	applied := false
+
+	err := client.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockKey).Error
+		if err != nil {
+			return err
+		}
+
+		var count int64
+
+		err = tx.Model(&SchemaMigration{}).Where("version = ?", migration.Version).Count(&count).Error
+		if err != nil || count > 0 {
+			return err
+		}
+
+		err = tx.Exec(migration.Up).Error
+		if err != nil {
+			return err
+		}
+
+		applied = true
+
+		return tx.Create(&SchemaMigration{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			AppliedAt: time.Now(),
+		}).Error
+	})
+
+	return applied, err
+}
+
+// rollback runs the down script of the last applied migration in a transaction
+func (m *Migrator) rollback(client *gorm.DB) (*Migration, error) {
+	var migration *Migration
+
+	err := client.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockKey).Error
+		if err != nil {
+			return err
+		}
+
+		version, err := currentVersion(tx)
+		if err != nil {
+			return err
+		}
+
+		if version == 0 {
+			return ErrNoMigration
+		}
+
+		idx := slices.IndexFunc(m.migrations, func(mig *Migration) bool {
+			return mig.Version == version
+		})
+		if idx < 0 {
+			return fmt.Errorf("%w: the migration %d is unknown", ErrSchemaVersionMismatch, version)
+		}
+
+		migration = m.migrations[idx]
+
+		err = tx.Exec(migration.Down).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Delete(&SchemaMigration{}, "version = ?", version).Error
+	})
+
+	return migration, err
+}
+
+func currentVersion(client *gorm.DB) (uint64, error) {
+	var version uint64
+
+	err := client.Model(&SchemaMigration{}).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&version).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package migrate_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/agntcy/identity/pkg/db/migrate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad_Should_Sort_Migrations_By_Version(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"0010_add_index.up.sql":      {Data: []byte("CREATE INDEX")},
+		"0010_add_index.down.sql":    {Data: []byte("DROP INDEX")},
+		"0002_add_table.up.sql":      {Data: []byte("CREATE TABLE")},
+		"0002_add_table.down.sql":    {Data: []byte("DROP TABLE")},
+		"README.md":                  {Data: []byte("ignored")},
+		"0003_subdir/ignored.up.sql": {Data: []byte("ignored")},
+	}
+
+	actual, err := migrate.Load(fsys)
+
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, uint64(2), actual[0].Version)
+	assert.Equal(t, "add_table", actual[0].Name)
+	assert.Equal(t, "CREATE TABLE", actual[0].Up)
+	assert.Equal(t, "DROP TABLE", actual[0].Down)
+	assert.Equal(t, uint64(10), actual[1].Version)
+}
+
+func TestLoad_Should_Require_Down_Script(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"0001_add_table.up.sql": {Data: []byte("CREATE TABLE")},
+	}
+
+	_, err := migrate.Load(fsys)
+
+	assert.Error(t, err)
+}
+
+func TestLoad_Should_Reject_Version_Zero(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"0000_add_table.up.sql":   {Data: []byte("CREATE TABLE")},
+		"0000_add_table.down.sql": {Data: []byte("DROP TABLE")},
+	}
+
+	_, err := migrate.Load(fsys)
+
+	assert.Error(t, err)
+}
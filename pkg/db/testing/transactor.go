@@ -0,0 +1,25 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package testing
+
+import (
+	"context"
+
+	"github.com/agntcy/identity/pkg/db"
+)
+
+// FakeTransactor runs the functions without transaction,
+// for the fake repositories that do not support them
+type FakeTransactor struct{}
+
+func NewFakeTransactor() db.Transactor {
+	return &FakeTransactor{}
+}
+
+func (t *FakeTransactor) Transaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	stdcontext "context"
+
+	"gorm.io/gorm"
+)
+
+// Transactor runs functions in a transaction of a storage backend
+type Transactor interface {
+	// Transaction runs fn in a transaction, the repositories called with the context
+	// passed to fn take part in it. The changes are rolled back when fn fails.
+	// A transaction started inside another one joins the outer transaction.
+	Transaction(ctx stdcontext.Context, fn func(ctx stdcontext.Context) error) error
+}
+
+// The key of the transaction in the context
+type transactionKey struct{}
+
+type transactor struct {
+	dbContext Context
+}
+
+// NewTransactor creates a Transactor running the transactions in the database
+func NewTransactor(dbContext Context) Transactor {
+	return &transactor{
+		dbContext: dbContext,
+	}
+}
+
+func (t *transactor) Transaction(
+	ctx stdcontext.Context,
+	fn func(ctx stdcontext.Context) error,
+) error {
+	if _, ok := ctx.Value(transactionKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return t.dbContext.Client().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(stdcontext.WithValue(ctx, transactionKey{}, tx))
+	})
+}
+
+// Client returns the client of the transaction running in the context,
+// or the client of the database bound to the context
+func Client(ctx stdcontext.Context, dbContext Context) *gorm.DB {
+	if tx, ok := ctx.Value(transactionKey{}).(*gorm.DB); ok {
+		return tx
+	}
+
+	return dbContext.Client().WithContext(ctx)
+}
@@ -11,10 +11,17 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+const (
+	algES256 = "ES256"
+	algEdDSA = "EdDSA"
+)
+
 func TestGenerateAndValidateKeys(t *testing.T) {
 	t.Parallel()
 
-	algorithms := []string{"RS256", "RS384", "RS512"}
+	algorithms := []string{
+		"RS256", "RS384", "RS512", algES256, "ES384", "ES512", algEdDSA, "ML-DSA-44", "ML-DSA-65", "ML-DSA-87",
+	}
 
 	for _, alg := range algorithms {
 		t.Run(alg, func(t *testing.T) {
@@ -67,7 +74,9 @@ func TestGenerateJWK_UnsupportedAlgorithm(t *testing.T) {
 func TestSignAndVerify(t *testing.T) {
 	t.Parallel()
 
-	algorithms := []string{"RS256", "RS384", "RS512"}
+	algorithms := []string{
+		"RS256", "RS384", "RS512", algES256, "ES384", "ES512", algEdDSA, "ML-DSA-44", "ML-DSA-65", "ML-DSA-87",
+	}
 	payload := []byte(`{"test":"data"}`)
 
 	for _, alg := range algorithms {
@@ -100,6 +109,21 @@ func TestSignAndVerify(t *testing.T) {
 	}
 }
 
+func TestSupportedKeys_Should_Leave_Out_The_AKP_Keys(t *testing.T) {
+	t.Parallel()
+
+	ecKey, err := joseutil.GenerateJWK(algES256, "sig", "")
+	assert.NoError(t, err)
+
+	pqKey, err := joseutil.GenerateJWK("ML-DSA-44", "sig", "")
+	assert.NoError(t, err)
+
+	jwks := &jwk.Jwks{Keys: []*jwk.Jwk{pqKey.PublicKey(), ecKey.PublicKey()}}
+
+	assert.Equal(t, []*jwk.Jwk{ecKey.PublicKey()}, joseutil.SupportedKeys(jwks).Keys)
+	assert.Nil(t, joseutil.SupportedKeys(nil))
+}
+
 func TestSignAndVerifyErrors(t *testing.T) {
 	t.Parallel()
 
@@ -126,3 +150,41 @@ func TestSignAndVerifyErrors(t *testing.T) {
 	_, err = joseutil.Verify(publicKey, payload)
 	assert.Error(t, err, "Verify should fail with unsupported algorithm")
 }
+
+func TestValidatePrivKey_MismatchedKeys(t *testing.T) {
+	t.Parallel()
+
+	for _, alg := range []string{algES256, algEdDSA, "ML-DSA-44"} {
+		t.Run(alg, func(t *testing.T) {
+			t.Parallel()
+
+			priv, err := joseutil.GenerateJWK(alg, "sig", "")
+			assert.NoError(t, err)
+
+			other, err := joseutil.GenerateJWK(alg, "sig", "")
+			assert.NoError(t, err)
+
+			// The private key of another key pair must be rejected
+			priv.D, priv.PRIV, priv.SEED = other.D, other.PRIV, other.SEED
+			err = joseutil.ValidatePrivKey(priv)
+			assert.Error(t, err, "ValidatePrivKey should fail with mismatched keys for %s", alg)
+		})
+	}
+}
+
+func TestValidatePubKey_InvalidCurve(t *testing.T) {
+	t.Parallel()
+
+	priv, err := joseutil.GenerateJWK("ES512", "sig", "")
+	assert.NoError(t, err)
+
+	// The algorithm must match the curve
+	pub := priv.PublicKey()
+	pub.ALG = "RS256"
+	assert.Error(t, joseutil.ValidatePubKey(pub))
+
+	// The point must be on the curve
+	pub = priv.PublicKey()
+	pub.Y = pub.X
+	assert.Error(t, joseutil.ValidatePubKey(pub))
+}
@@ -4,6 +4,9 @@
 package joseutil
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/base64"
@@ -19,16 +22,44 @@ const (
 	rsaBits384 = 3072
 	rsaBits512 = 4096
 	KeyTypeRSA = "RSA"
+	KeyTypeEC  = "EC"
+	KeyTypeOKP = "OKP"
+
+	algRS256 = "RS256"
+	algRS384 = "RS384"
+	algRS512 = "RS512"
+	algES256 = "ES256"
+	algES384 = "ES384"
+	algES512 = "ES512"
+	algEdDSA = "EdDSA"
+
+	CurveP256    = "P-256"
+	CurveP384    = "P-384"
+	CurveP521    = "P-521"
+	CurveEd25519 = "Ed25519"
 )
 
+// The curves of the ECDSA algorithms
+var ecCurves = map[string]string{
+	algES256: CurveP256,
+	algES384: CurveP384,
+	algES512: CurveP521,
+}
+
 func GenerateJWK(alg, use, id string) (*jwk.Jwk, error) {
 	if id == "" {
 		id = uuid.NewString()
 	}
 
 	switch alg {
-	case "RS256", "RS384", "RS512":
+	case algRS256, algRS384, algRS512:
 		return generateRSAJWK(alg, use, id)
+	case algES256, algES384, algES512:
+		return generateECJWK(alg, use, id)
+	case algEdDSA:
+		return generateEd25519JWK(alg, use, id)
+	case algMLDSA44, algMLDSA65, algMLDSA87:
+		return generateAKPJWK(alg, use, id)
 	default:
 		return nil, errors.New("unsupported algorithm")
 	}
@@ -36,9 +67,9 @@ func GenerateJWK(alg, use, id string) (*jwk.Jwk, error) {
 
 func generateRSAJWK(alg, use, id string) (*jwk.Jwk, error) {
 	bits := map[string]int{
-		"RS256": rsaBits256,
-		"RS384": rsaBits384,
-		"RS512": rsaBits512,
+		algRS256: rsaBits256,
+		algRS384: rsaBits384,
+		algRS512: rsaBits512,
 	}[alg]
 
 	privateKey, err := rsa.GenerateKey(rand.Reader, bits)
@@ -61,3 +92,60 @@ func generateRSAJWK(alg, use, id string) (*jwk.Jwk, error) {
 		QI:  base64.RawURLEncoding.EncodeToString(privateKey.Precomputed.Qinv.Bytes()),
 	}, nil
 }
+
+func generateECJWK(alg, use, id string) (*jwk.Jwk, error) {
+	crv := ecCurves[alg]
+
+	privateKey, err := ecdsa.GenerateKey(ellipticCurve(crv), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	ecdhKey, err := privateKey.ECDH()
+	if err != nil {
+		return nil, err
+	}
+
+	// The public key is encoded as 0x04 || X || Y
+	point := ecdhKey.PublicKey().Bytes()
+	size := (len(point) - 1) / 2 //nolint:mnd // the point contains both coordinates
+
+	return &jwk.Jwk{
+		KID: id,
+		ALG: alg,
+		KTY: KeyTypeEC,
+		USE: use,
+		CRV: crv,
+		X:   base64.RawURLEncoding.EncodeToString(point[1 : 1+size]),
+		Y:   base64.RawURLEncoding.EncodeToString(point[1+size:]),
+		D:   base64.RawURLEncoding.EncodeToString(ecdhKey.Bytes()),
+	}, nil
+}
+
+func generateEd25519JWK(alg, use, id string) (*jwk.Jwk, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jwk.Jwk{
+		KID: id,
+		ALG: alg,
+		KTY: KeyTypeOKP,
+		USE: use,
+		CRV: CurveEd25519,
+		X:   base64.RawURLEncoding.EncodeToString(publicKey),
+		D:   base64.RawURLEncoding.EncodeToString(privateKey.Seed()),
+	}, nil
+}
+
+func ellipticCurve(crv string) elliptic.Curve {
+	switch crv {
+	case CurveP384:
+		return elliptic.P384()
+	case CurveP521:
+		return elliptic.P521()
+	default:
+		return elliptic.P256()
+	}
+}
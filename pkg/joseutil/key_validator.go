@@ -4,6 +4,9 @@
 package joseutil
 
 import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"encoding/base64"
 	"errors"
@@ -19,13 +22,25 @@ func ValidatePubKey(j *jwk.Jwk) error {
 		return errors.New("jwk is nil")
 	}
 
-	switch strings.ToUpper(j.KTY) {
-	case KeyTypeRSA:
-		if j.D != "" || j.P != "" || j.Q != "" || j.DP != "" || j.DQ != "" || j.QI != "" {
-			return errors.New("private key fields must not be present in RSA public key")
-		}
+	kty := strings.ToUpper(j.KTY)
+
+	if hasPrivateFields(j) {
+		return errors.New("private key fields must not be present in " + kty + " public key")
+	}
 
+	switch kty {
+	case KeyTypeRSA:
 		return validateRSAPubKey(j)
+	case KeyTypeEC:
+		_, err := validateECPubKey(j)
+
+		return err
+	case KeyTypeOKP:
+		_, err := validateOKPPubKey(j)
+
+		return err
+	case KeyTypeAKP:
+		return validateAKPPubKey(j)
 	default:
 		return errors.New("unsupported key type for public key validation")
 	}
@@ -40,11 +55,22 @@ func ValidatePrivKey(j *jwk.Jwk) error {
 	switch strings.ToUpper(j.KTY) {
 	case KeyTypeRSA:
 		return validateRSAPrivKey(j)
+	case KeyTypeEC:
+		return validateECPrivKey(j)
+	case KeyTypeOKP:
+		return validateOKPPrivKey(j)
+	case KeyTypeAKP:
+		return validateAKPPrivKey(j)
 	default:
 		return errors.New("unsupported key type for private key validation")
 	}
 }
 
+func hasPrivateFields(j *jwk.Jwk) bool {
+	return j.D != "" || j.P != "" || j.Q != "" || j.DP != "" || j.DQ != "" || j.QI != "" ||
+		j.PRIV != "" || j.SEED != ""
+}
+
 // --- RSA Validation ---
 
 func validateRSAPubKey(j *jwk.Jwk) error {
@@ -132,3 +158,122 @@ func validateRSAPrivKey(j *jwk.Jwk) error {
 
 	return nil
 }
+
+// --- EC Validation ---
+
+func validateECPubKey(j *jwk.Jwk) (ecdh.Curve, error) {
+	var curve ecdh.Curve
+
+	switch j.CRV {
+	case CurveP256:
+		curve = ecdh.P256()
+	case CurveP384:
+		curve = ecdh.P384()
+	case CurveP521:
+		curve = ecdh.P521()
+	default:
+		return nil, errors.New("unsupported curve for EC key: " + j.CRV)
+	}
+
+	if expected, ok := ecCurves[j.ALG]; j.ALG != "" && (!ok || expected != j.CRV) {
+		return nil, errors.New("the algorithm " + j.ALG + " does not match the curve " + j.CRV)
+	}
+
+	if j.X == "" || j.Y == "" {
+		return nil, errors.New("missing coordinates (x) or (y) for EC public key")
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(j.X)
+	if err != nil {
+		return nil, errors.New("invalid base64url encoding for coordinate (x)")
+	}
+
+	y, err := base64.RawURLEncoding.DecodeString(j.Y)
+	if err != nil {
+		return nil, errors.New("invalid base64url encoding for coordinate (y)")
+	}
+
+	// NewPublicKey checks that the uncompressed point is on the curve
+	_, err = curve.NewPublicKey(append(append([]byte{0x04}, x...), y...))
+	if err != nil {
+		return nil, errors.New("invalid EC public key: " + err.Error())
+	}
+
+	return curve, nil
+}
+
+func validateECPrivKey(j *jwk.Jwk) error {
+	curve, err := validateECPubKey(j)
+	if err != nil {
+		return err
+	}
+
+	if j.D == "" {
+		return errors.New("missing private key (d) for EC private key")
+	}
+
+	d, err := base64.RawURLEncoding.DecodeString(j.D)
+	if err != nil {
+		return errors.New("invalid base64url encoding for private key (d)")
+	}
+
+	priv, err := curve.NewPrivateKey(d)
+	if err != nil {
+		return errors.New("invalid EC private key: " + err.Error())
+	}
+
+	x, _ := base64.RawURLEncoding.DecodeString(j.X)
+	y, _ := base64.RawURLEncoding.DecodeString(j.Y)
+
+	if !bytes.Equal(priv.PublicKey().Bytes()[1:], append(x, y...)) {
+		return errors.New("the EC private key does not match the public key")
+	}
+
+	return nil
+}
+
+// --- OKP Validation ---
+
+func validateOKPPubKey(j *jwk.Jwk) ([]byte, error) {
+	if j.CRV != CurveEd25519 {
+		return nil, errors.New("unsupported curve for OKP key: " + j.CRV)
+	}
+
+	if j.ALG != "" && j.ALG != algEdDSA {
+		return nil, errors.New("the algorithm " + j.ALG + " does not match the curve " + j.CRV)
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(j.X)
+	if err != nil {
+		return nil, errors.New("invalid base64url encoding for public key (x)")
+	}
+
+	if len(x) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid size for Ed25519 public key (x)")
+	}
+
+	return x, nil
+}
+
+func validateOKPPrivKey(j *jwk.Jwk) error {
+	x, err := validateOKPPubKey(j)
+	if err != nil {
+		return err
+	}
+
+	d, err := base64.RawURLEncoding.DecodeString(j.D)
+	if err != nil {
+		return errors.New("invalid base64url encoding for private key (d)")
+	}
+
+	if len(d) != ed25519.SeedSize {
+		return errors.New("invalid size for Ed25519 private key (d)")
+	}
+
+	pub, _ := ed25519.NewKeyFromSeed(d).Public().(ed25519.PublicKey)
+	if !bytes.Equal(pub, x) {
+		return errors.New("the Ed25519 private key does not match the public key")
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package joseutil
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/cloudflare/circl/sign"
+	"github.com/cloudflare/circl/sign/mldsa/mldsa44"
+	"github.com/cloudflare/circl/sign/mldsa/mldsa65"
+	"github.com/cloudflare/circl/sign/mldsa/mldsa87"
+	jwav3 "github.com/lestrrat-go/jwx/v3/jwa"
+)
+
+// The key type of the post-quantum algorithms as defined [here]
+//
+// [here]: https://datatracker.ietf.org/doc/draft-ietf-cose-dilithium/
+const KeyTypeAKP = "AKP"
+
+const (
+	algMLDSA44 = "ML-DSA-44"
+	algMLDSA65 = "ML-DSA-65"
+	algMLDSA87 = "ML-DSA-87"
+
+	jwsParts = 3
+)
+
+// The ML-DSA parameter sets defined in FIPS 204
+var mldsaSchemes = map[string]sign.Scheme{
+	algMLDSA44: mldsa44.Scheme(),
+	algMLDSA65: mldsa65.Scheme(),
+	algMLDSA87: mldsa87.Scheme(),
+}
+
+// The JOSE header of the JWS signed with ML-DSA
+type mldsaHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid,omitempty"`
+}
+
+//nolint:gochecknoinits // The algorithms must be known before parsing any JWS
+func init() {
+	// Register the ML-DSA algorithms so the JWS headers using them can be parsed.
+	// The signatures are computed and verified by this package.
+	for alg := range mldsaSchemes {
+		jwav3.RegisterSignatureAlgorithm(jwav3.NewSignatureAlgorithm(alg))
+	}
+}
+
+// IsMLDSA returns true if the algorithm belongs to the ML-DSA family
+func IsMLDSA(alg string) bool {
+	_, ok := mldsaSchemes[alg]
+	return ok
+}
+
+func generateAKPJWK(alg, use, id string) (*jwk.Jwk, error) {
+	scheme := mldsaSchemes[alg]
+
+	seed := make([]byte, scheme.SeedSize())
+
+	_, err := rand.Read(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, privateKey := scheme.DeriveKey(seed)
+
+	pub, err := publicKey.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	priv, err := privateKey.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &jwk.Jwk{
+		KID:  id,
+		ALG:  alg,
+		KTY:  KeyTypeAKP,
+		USE:  use,
+		PUB:  base64.RawURLEncoding.EncodeToString(pub),
+		PRIV: base64.RawURLEncoding.EncodeToString(priv),
+		SEED: base64.RawURLEncoding.EncodeToString(seed),
+	}, nil
+}
+
+// signAKP creates a JWS in the compact serialization signed with ML-DSA
+func signAKP(privateJwk *jwk.Jwk, payload []byte, headers map[string]any) ([]byte, error) {
+	scheme, privateKey, err := akpPrivateKey(privateJwk)
+	if err != nil {
+		return nil, err
+	}
+
+	members := maps.Clone(headers)
+	if members == nil {
+		members = make(map[string]any)
+	}
+
+	members["alg"] = privateJwk.ALG
+	if privateJwk.KID != "" {
+		members["kid"] = privateJwk.KID
+	}
+
+	header, err := json.Marshal(members)
+	if err != nil {
+		return nil, err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(payload)
+
+	signature := scheme.Sign(privateKey, []byte(signingInput), nil)
+
+	return []byte(signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)), nil
+}
+
+// verifyAKP verifies a JWS in the compact serialization signed with ML-DSA
+func verifyAKP(publicJwk *jwk.Jwk, signedPayload []byte) ([]byte, error) {
+	scheme, publicKey, err := akpPublicKey(publicJwk)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Split(string(signedPayload), ".")
+	if len(parts) != jwsParts {
+		return nil, errors.New("invalid JWS compact serialization")
+	}
+
+	rawHeader, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWS header: %w", err)
+	}
+
+	var header mldsaHeader
+
+	err = json.Unmarshal(rawHeader, &header)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWS header: %w", err)
+	}
+
+	if header.Algorithm != publicJwk.ALG {
+		return nil, fmt.Errorf("the algorithm %s does not match the key algorithm", header.Algorithm)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWS payload: %w", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWS signature: %w", err)
+	}
+
+	if !scheme.Verify(publicKey, []byte(parts[0]+"."+parts[1]), signature, nil) {
+		return nil, errors.New("signature verification failed")
+	}
+
+	return payload, nil
+}
+
+func akpScheme(j *jwk.Jwk) (sign.Scheme, error) {
+	scheme, ok := mldsaSchemes[j.ALG]
+	if !ok {
+		return nil, fmt.Errorf("unsupported algorithm for AKP key: %s", j.ALG)
+	}
+
+	return scheme, nil
+}
+
+func akpPublicKey(j *jwk.Jwk) (sign.Scheme, sign.PublicKey, error) {
+	scheme, err := akpScheme(j)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if j.PUB == "" {
+		return nil, nil, errors.New("missing public key (pub) for AKP key")
+	}
+
+	pub, err := base64.RawURLEncoding.DecodeString(j.PUB)
+	if err != nil {
+		return nil, nil, errors.New("invalid base64url encoding for public key (pub)")
+	}
+
+	publicKey, err := scheme.UnmarshalBinaryPublicKey(pub)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid %s public key: %w", j.ALG, err)
+	}
+
+	return scheme, publicKey, nil
+}
+
+// akpPrivateKey returns the private key derived from the seed,
+// or the expanded private key when the seed is not set
+func akpPrivateKey(j *jwk.Jwk) (sign.Scheme, sign.PrivateKey, error) {
+	scheme, err := akpScheme(j)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if j.SEED != "" {
+		seed, err := base64.RawURLEncoding.DecodeString(j.SEED)
+		if err != nil {
+			return nil, nil, errors.New("invalid base64url encoding for seed")
+		}
+
+		if len(seed) != scheme.SeedSize() {
+			return nil, nil, fmt.Errorf("invalid seed size for %s key", j.ALG)
+		}
+
+		_, privateKey := scheme.DeriveKey(seed)
+
+		return scheme, privateKey, nil
+	}
+
+	if j.PRIV == "" {
+		return nil, nil, errors.New("missing private key (priv) or seed for AKP key")
+	}
+
+	priv, err := base64.RawURLEncoding.DecodeString(j.PRIV)
+	if err != nil {
+		return nil, nil, errors.New("invalid base64url encoding for private key (priv)")
+	}
+
+	privateKey, err := scheme.UnmarshalBinaryPrivateKey(priv)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid %s private key: %w", j.ALG, err)
+	}
+
+	return scheme, privateKey, nil
+}
+
+// --- AKP Validation ---
+
+func validateAKPPubKey(j *jwk.Jwk) error {
+	_, _, err := akpPublicKey(j)
+
+	return err
+}
+
+func validateAKPPrivKey(j *jwk.Jwk) error {
+	_, publicKey, err := akpPublicKey(j)
+	if err != nil {
+		return err
+	}
+
+	_, privateKey, err := akpPrivateKey(j)
+	if err != nil {
+		return err
+	}
+
+	// The private key (and the seed) must match the public key
+	if !publicKey.Equal(privateKey.Public()) {
+		return errors.New("the AKP private key does not match the public key")
+	}
+
+	if j.SEED != "" && j.PRIV != "" {
+		priv, err := base64.RawURLEncoding.DecodeString(j.PRIV)
+		if err != nil {
+			return errors.New("invalid base64url encoding for private key (priv)")
+		}
+
+		expanded, err := privateKey.MarshalBinary()
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(priv, expanded) {
+			return errors.New("the AKP private key does not match the seed")
+		}
+	}
+
+	return nil
+}
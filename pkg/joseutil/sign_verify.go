@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	jwktype "github.com/agntcy/identity/pkg/jwk"
 	"github.com/lestrrat-go/jwx/v2/jwa"
@@ -16,10 +17,21 @@ import (
 
 // Sign creates a JWS signature for the provided payload using the specified key
 func Sign(privateJwk *jwktype.Jwk, payload []byte) ([]byte, error) {
+	return SignWithHeaders(privateJwk, payload, nil)
+}
+
+// SignWithHeaders creates a JWS signature for the provided payload using the specified key,
+// the headers are added to the protected header
+func SignWithHeaders(privateJwk *jwktype.Jwk, payload []byte, headers map[string]any) ([]byte, error) {
 	if privateJwk == nil {
 		return nil, errors.New("private key is nil")
 	}
 
+	// The ML-DSA algorithms are not supported by the jwx library
+	if strings.EqualFold(privateJwk.KTY, KeyTypeAKP) {
+		return signAKP(privateJwk, payload, headers)
+	}
+
 	// Convert our custom JWK to the jwx library's JWK
 	key, err := customJwkToLibraryJwk(privateJwk)
 	if err != nil {
@@ -32,8 +44,17 @@ func Sign(privateJwk *jwktype.Jwk, payload []byte) ([]byte, error) {
 		return nil, err
 	}
 
+	protected := jws.NewHeaders()
+
+	for name, value := range headers {
+		err = protected.Set(name, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid header %s: %w", name, err)
+		}
+	}
+
 	// Create and sign
-	signed, err := jws.Sign(payload, jws.WithKey(alg, key))
+	signed, err := jws.Sign(payload, jws.WithKey(alg, key, jws.WithProtectedHeaders(protected)))
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign payload: %w", err)
 	}
@@ -47,6 +68,10 @@ func Verify(publicJwk *jwktype.Jwk, signedPayload []byte) ([]byte, error) {
 		return nil, errors.New("public key is nil")
 	}
 
+	if strings.EqualFold(publicJwk.KTY, KeyTypeAKP) {
+		return verifyAKP(publicJwk, signedPayload)
+	}
+
 	// Convert our custom JWK to the jwx library's JWK
 	key, err := customJwkToLibraryJwk(publicJwk)
 	if err != nil {
@@ -68,6 +93,46 @@ func Verify(publicJwk *jwktype.Jwk, signedPayload []byte) ([]byte, error) {
 	return payload, nil
 }
 
+// RawKey converts the JWK to the corresponding crypto key,
+// e.g. *rsa.PrivateKey, *ecdsa.PublicKey or ed25519.PrivateKey
+func RawKey(jwkObj *jwktype.Jwk) (any, error) {
+	if jwkObj == nil {
+		return nil, errors.New("key is nil")
+	}
+
+	key, err := customJwkToLibraryJwk(jwkObj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert key: %w", err)
+	}
+
+	var raw any
+
+	err = key.Raw(&raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export key: %w", err)
+	}
+
+	return raw, nil
+}
+
+// SupportedKeys returns the keys of the JWKS supported by the jwx library.
+// The AKP keys are left out, they must be verified with Verify.
+func SupportedKeys(jwks *jwktype.Jwks) *jwktype.Jwks {
+	if jwks == nil {
+		return nil
+	}
+
+	supported := &jwktype.Jwks{}
+
+	for _, key := range jwks.Keys {
+		if key != nil && !strings.EqualFold(key.KTY, KeyTypeAKP) {
+			supported.Keys = append(supported.Keys, key)
+		}
+	}
+
+	return supported
+}
+
 // customJwkToLibraryJwk converts our custom JWK type to the jwx library's JWK
 func customJwkToLibraryJwk(jwkObj *jwktype.Jwk) (jwk.Key, error) {
 	// Convert to a JSON representation first
@@ -87,12 +152,20 @@ func customJwkToLibraryJwk(jwkObj *jwktype.Jwk) (jwk.Key, error) {
 // determineAlgorithm maps algorithm string to jwa.SignatureAlgorithm
 func determineAlgorithm(algStr string) (jwa.SignatureAlgorithm, error) {
 	switch algStr {
-	case "RS256":
+	case algRS256:
 		return jwa.RS256, nil
-	case "RS384":
+	case algRS384:
 		return jwa.RS384, nil
-	case "RS512":
+	case algRS512:
 		return jwa.RS512, nil
+	case algES256:
+		return jwa.ES256, nil
+	case algES384:
+		return jwa.ES384, nil
+	case algES512:
+		return jwa.ES512, nil
+	case algEdDSA:
+		return jwa.EdDSA, nil
 	default:
 		return "", fmt.Errorf("unsupported algorithm: %s", algStr)
 	}
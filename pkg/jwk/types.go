@@ -4,24 +4,28 @@
 package jwk
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"strings"
 )
 
 // JWK represents:
-// - a JSON Web Key (JWK) with the respective fields specific to RSA algorithms.
+// - a JSON Web Key (JWK) with the respective fields specific to RSA, EC and OKP algorithms.
 // - a Quantum JSON Web Key (QJWK) with the respective fields specific to AKP algorithms.
 type Jwk struct {
 	// ALG represents the algorithm intended for use with the key.
 	// Example algorithms for Post-Quantum ML-DSA family:
 	// "ML-DSA-44", "ML-DSA-65", "ML-DSA-87".
-	// Some example algorithms are "RS256", "RS384", "RS512" for RSA algorithms.
+	// Some example algorithms are "RS256", "RS384", "RS512" for RSA algorithms,
+	// "ES256", "ES384", "ES512" for EC algorithms and "EdDSA" for OKP algorithms.
 	ALG string `json:"alg,omitempty"`
 
 	// KTY represents the key type parameter.
 	// It specifies the family of quantum algorithms used with the key,
 	// such as "AKP" for post quantum algorithms
-	// or "RSA" for non quantum algorithms.
+	// or "RSA", "EC" and "OKP" for non quantum algorithms.
 	KTY string `json:"kty,omitempty"`
 
 	// Use represents the intended use of the key.
@@ -64,6 +68,16 @@ type Jwk struct {
 
 	// The first CRT coefficient for the RSA private key.
 	QI string `json:"qi,omitempty"`
+
+	// The curve for the EC and OKP ktys.
+	// Some example values are "P-256", "P-384" and "Ed25519".
+	CRV string `json:"crv,omitempty"`
+
+	// The x coordinate for the EC kty or the public key for the OKP kty.
+	X string `json:"x,omitempty"`
+
+	// The y coordinate for the EC kty.
+	Y string `json:"y,omitempty"`
 }
 
 // PublicKey returns a copy of the private Jwk containing only the public fields.
@@ -81,11 +95,49 @@ func (j *Jwk) PublicKey() *Jwk {
 		pub.E = j.E
 	case "AKP":
 		pub.PUB = j.PUB
+	case "EC":
+		pub.CRV = j.CRV
+		pub.X = j.X
+		pub.Y = j.Y
+	case "OKP":
+		pub.CRV = j.CRV
+		pub.X = j.X
 	}
 
 	return pub
 }
 
+// Thumbprint returns the base64url encoded SHA-256 JWK Thumbprint
+// of the public key as defined in RFC 7638
+func (j *Jwk) Thumbprint() (string, error) {
+	var members map[string]string
+
+	switch strings.ToUpper(j.KTY) {
+	case "RSA":
+		members = map[string]string{"e": j.E, "n": j.N}
+	case "AKP":
+		members = map[string]string{"alg": j.ALG, "pub": j.PUB}
+	case "EC":
+		members = map[string]string{"crv": j.CRV, "x": j.X, "y": j.Y}
+	case "OKP":
+		members = map[string]string{"crv": j.CRV, "x": j.X}
+	default:
+		return "", fmt.Errorf("unsupported key type: %s", j.KTY)
+	}
+
+	members["kty"] = j.KTY
+
+	// The keys of the maps are marshaled in lexicographic order, without whitespace
+	raw, err := json.Marshal(members)
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(raw)
+
+	return base64.RawURLEncoding.EncodeToString(digest[:]), nil
+}
+
 func (j *Jwk) ToJSON() []byte {
 	raw, err := json.Marshal(j)
 	if err != nil {
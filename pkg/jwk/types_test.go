@@ -0,0 +1,34 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package jwk_test
+
+import (
+	"testing"
+
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThumbprint(t *testing.T) {
+	t.Parallel()
+
+	// The example of RFC 7638, section 3.1
+	key := &jwk.Jwk{
+		KTY: "RSA",
+		N: "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKR" +
+			"XjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaS" +
+			"qzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHa" +
+			"Q-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+		E:   "AQAB",
+		ALG: "RS256",
+		KID: "2011-04-29",
+	}
+
+	thumbprint, err := key.Thumbprint()
+	assert.NoError(t, err)
+	assert.Equal(t, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", thumbprint)
+
+	_, err = (&jwk.Jwk{KTY: "oct"}).Thumbprint()
+	assert.Error(t, err)
+}
@@ -5,16 +5,84 @@ package oidc
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
 
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 )
 
+// ClientAuthMethod is the authentication of a client to the token endpoint
+// of an identity provider
+type ClientAuthMethod string
+
+const (
+	// The client authenticates with a shared secret
+	ClientAuthMethodSecret ClientAuthMethod = "client_secret"
+
+	// The client authenticates with a JWT signed by its private key (RFC 7523)
+	ClientAuthMethodPrivateKeyJWT ClientAuthMethod = "private_key_jwt"
+
+	// The client authenticates with its certificate (RFC 8705)
+	ClientAuthMethodTLS ClientAuthMethod = "tls_client_auth"
+)
+
+const (
+	// The type of the client assertions of private_key_jwt
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+	// The client assertions are only sent with a single token request
+	clientAssertionLifetime = time.Minute
+)
+
+// ParseClientAuthMethod parses the authentication of a client,
+// the client secret is used by default
+func ParseClientAuthMethod(method string) (ClientAuthMethod, error) {
+	switch ClientAuthMethod(method) {
+	case ClientAuthMethodSecret, ClientAuthMethodPrivateKeyJWT, ClientAuthMethodTLS:
+		return ClientAuthMethod(method), nil
+	case "":
+		return ClientAuthMethodSecret, nil
+	default:
+		return "", fmt.Errorf("unknown client authentication method: %s", method)
+	}
+}
+
+// ClientCredentials authenticate a client to the token endpoint
+// of an identity provider with the AuthMethod
+type ClientCredentials struct {
+	ClientID   string
+	AuthMethod ClientAuthMethod
+
+	// The secret of the client_secret method
+	ClientSecret string
+
+	// The private key signing the client assertions of the private_key_jwt method,
+	// a key dedicated to the client whose ID is sent in the kid header
+	PrivateKey *jwk.Jwk
+
+	// The certificate of the tls_client_auth method
+	Certificate *tls.Certificate
+}
+
 type Authenticator interface {
+	// Token requests an access token with the client credentials grant.
+	// The audience, when not empty, is requested with the audience parameter.
 	Token(
 		ctx context.Context,
 		issuer string,
-		clientID string,
-		clientSecret string,
+		credentials *ClientCredentials,
+		audience string,
 	) (string, error)
 }
 
@@ -27,8 +95,8 @@ func NewAuthenticator() Authenticator {
 func (oidcAuthenticator) Token(
 	ctx context.Context,
 	issuer string,
-	clientID string,
-	clientSecret string,
+	credentials *ClientCredentials,
+	audience string,
 ) (string, error) {
 	provider, err := getProviderMetadata(ctx, issuer)
 	if err != nil {
@@ -36,10 +104,45 @@ func (oidcAuthenticator) Token(
 	}
 
 	conf := clientcredentials.Config{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
-		TokenURL:     provider.TokenURL,
-		Scopes:       []string{},
+		ClientID:       credentials.ClientID,
+		TokenURL:       provider.TokenURL,
+		Scopes:         []string{},
+		EndpointParams: url.Values{},
+	}
+
+	if audience != "" {
+		conf.EndpointParams.Set("audience", audience)
+	}
+
+	switch credentials.AuthMethod {
+	case ClientAuthMethodSecret, "":
+		conf.ClientSecret = credentials.ClientSecret
+	case ClientAuthMethodPrivateKeyJWT:
+		assertion, err := newClientAssertion(credentials, provider.TokenURL)
+		if err != nil {
+			return "", err
+		}
+
+		// The client is authenticated by the assertion, the client_id is sent alongside
+		conf.AuthStyle = oauth2.AuthStyleInParams
+		conf.EndpointParams.Set("client_assertion_type", clientAssertionType)
+		conf.EndpointParams.Set("client_assertion", assertion)
+	case ClientAuthMethodTLS:
+		if credentials.Certificate == nil {
+			return "", errors.New("a client certificate is required for tls_client_auth")
+		}
+
+		// The identity providers may serve the certificate-bound endpoints on other URLs
+		if provider.MTLSEndpointAliases.TokenURL != "" {
+			conf.TokenURL = provider.MTLSEndpointAliases.TokenURL
+		}
+
+		conf.AuthStyle = oauth2.AuthStyleInParams
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{
+			Transport: newClientCertificateTransport(ctx, credentials.Certificate),
+		})
+	default:
+		return "", fmt.Errorf("unknown client authentication method: %s", credentials.AuthMethod)
 	}
 
 	token, err := conf.Token(ctx)
@@ -49,3 +152,69 @@ func (oidcAuthenticator) Token(
 
 	return token.AccessToken, nil
 }
+
+// newClientCertificateTransport returns a transport presenting the client certificate.
+// The transport of the HTTP client of the context, or the default transport, is cloned
+// so the proxy, the timeouts and the root CAs of the requests are kept.
+func newClientCertificateTransport(ctx context.Context, certificate *tls.Certificate) *http.Transport {
+	base, _ := http.DefaultTransport.(*http.Transport)
+
+	if client, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); ok {
+		if transport, ok := client.Transport.(*http.Transport); ok {
+			base = transport
+		}
+	}
+
+	transport := base.Clone()
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	transport.TLSClientConfig.Certificates = []tls.Certificate{*certificate}
+
+	return transport
+}
+
+// newClientAssertion issues the JWT authenticating the client
+// to the token endpoint, as defined by RFC 7523.
+// The kid header identifies the key among the keys registered with the client.
+func newClientAssertion(credentials *ClientCredentials, tokenURL string) (string, error) {
+	if credentials.PrivateKey == nil {
+		return "", errors.New("a private key is required for private_key_jwt")
+	}
+
+	if credentials.PrivateKey.KID == "" {
+		return "", errors.New("the private key of private_key_jwt must have a key ID")
+	}
+
+	now := time.Now()
+
+	tok, err := jwt.NewBuilder().
+		Issuer(credentials.ClientID).
+		Subject(credentials.ClientID).
+		Audience([]string{tokenURL}).
+		IssuedAt(now).
+		Expiration(now.Add(clientAssertionLifetime)).
+		JwtID(uuid.NewString()).
+		Build()
+	if err != nil {
+		return "", fmt.Errorf("failed to build the client assertion: %w", err)
+	}
+
+	buf, err := json.Marshal(tok)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize the client assertion: %w", err)
+	}
+
+	assertion, err := joseutil.SignWithHeaders(
+		credentials.PrivateKey,
+		buf,
+		map[string]any{jws.KeyIDKey: credentials.PrivateKey.KID},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return string(assertion), nil
+}
@@ -0,0 +1,280 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package oidc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/oidc"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+const (
+	clientID    = "client-id"
+	accessToken = "access-token"
+
+	// The metadata of the token endpoint of an issuer
+	tokenEndpointMetadata = "token_endpoint"
+)
+
+func TestParseClientAuthMethod(t *testing.T) {
+	t.Parallel()
+
+	method, err := oidc.ParseClientAuthMethod("")
+	assert.NoError(t, err)
+	assert.Equal(t, oidc.ClientAuthMethodSecret, method)
+
+	method, err = oidc.ParseClientAuthMethod("private_key_jwt")
+	assert.NoError(t, err)
+	assert.Equal(t, oidc.ClientAuthMethodPrivateKeyJWT, method)
+
+	method, err = oidc.ParseClientAuthMethod("tls_client_auth")
+	assert.NoError(t, err)
+	assert.Equal(t, oidc.ClientAuthMethodTLS, method)
+
+	_, err = oidc.ParseClientAuthMethod("client_secret_jwt")
+	assert.Error(t, err)
+}
+
+func TestToken_Should_Authenticate_With_A_Private_Key_JWT(t *testing.T) {
+	t.Parallel()
+
+	key, err := joseutil.GenerateJWK("RS256", "sig", "my-id")
+	assert.NoError(t, err)
+
+	server, form := newTokenServer(t)
+
+	token, err := oidc.NewAuthenticator().Token(
+		t.Context(),
+		server.URL,
+		&oidc.ClientCredentials{
+			ClientID:   clientID,
+			AuthMethod: oidc.ClientAuthMethodPrivateKeyJWT,
+			PrivateKey: key,
+		},
+		"https://node.example.com",
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, accessToken, token)
+
+	params := form.Load()
+	assert.Equal(t, clientID, params.Get("client_id"))
+	assert.Equal(t, "https://node.example.com", params.Get("audience"))
+	assert.Empty(t, params.Get("client_secret"))
+	assert.Equal(
+		t,
+		"urn:ietf:params:oauth:client-assertion-type:jwt-bearer",
+		params.Get("client_assertion_type"),
+	)
+
+	// The assertion is verified with the public key of the client identified by the kid header
+	message, err := jws.Parse([]byte(params.Get("client_assertion")))
+	assert.NoError(t, err)
+
+	kid, ok := message.Signatures()[0].ProtectedHeaders().KeyID()
+	assert.True(t, ok)
+	assert.Equal(t, key.KID, kid)
+
+	payload, err := joseutil.Verify(key.PublicKey(), []byte(params.Get("client_assertion")))
+	assert.NoError(t, err)
+
+	var claims struct {
+		Issuer   string `json:"iss"`
+		Subject  string `json:"sub"`
+		Audience any    `json:"aud"`
+		JwtID    string `json:"jti"`
+	}
+
+	assert.NoError(t, json.Unmarshal(payload, &claims))
+	assert.Equal(t, clientID, claims.Issuer)
+	assert.Equal(t, clientID, claims.Subject)
+	assert.Contains(t, claims.Audience, server.URL+"/token")
+	assert.NotEmpty(t, claims.JwtID)
+}
+
+func TestToken_Should_Authenticate_With_A_Client_Certificate(t *testing.T) {
+	t.Parallel()
+
+	certificate := newClientCertificate(t)
+
+	var form atomic.Pointer[url.Values]
+
+	// The token endpoint requires a client certificate
+	tokenServer := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Len(t, r.TLS.PeerCertificates, 1)
+		assert.Equal(t, clientID, r.TLS.PeerCertificates[0].Subject.CommonName)
+
+		form.Store(&r.PostForm)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": accessToken,
+			"token_type":   "Bearer",
+		})
+	}))
+	tokenServer.TLS = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.RequireAnyClientCert,
+	}
+	tokenServer.StartTLS()
+	t.Cleanup(tokenServer.Close)
+
+	// The metadata points to the certificate-bound alias of the token endpoint
+	metadataServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			tokenEndpointMetadata: "http://" + r.Host + "/token",
+			"mtls_endpoint_aliases": map[string]string{
+				tokenEndpointMetadata: tokenServer.URL + "/token",
+			},
+		})
+	}))
+	t.Cleanup(metadataServer.Close)
+
+	// The transport of the client of the context trusts the token endpoint
+	ctx := context.WithValue(t.Context(), oauth2.HTTPClient, tokenServer.Client())
+
+	token, err := oidc.NewAuthenticator().Token(
+		ctx,
+		metadataServer.URL,
+		&oidc.ClientCredentials{
+			ClientID:    clientID,
+			AuthMethod:  oidc.ClientAuthMethodTLS,
+			Certificate: certificate,
+		},
+		"",
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, accessToken, token)
+
+	params := form.Load()
+	assert.Equal(t, clientID, params.Get("client_id"))
+	assert.Empty(t, params.Get("client_secret"))
+	assert.Empty(t, params.Get("client_assertion"))
+}
+
+func TestToken_Should_Require_The_Credentials_Of_The_Method(t *testing.T) {
+	t.Parallel()
+
+	server, form := newTokenServer(t)
+
+	for _, method := range []oidc.ClientAuthMethod{
+		oidc.ClientAuthMethodPrivateKeyJWT,
+		oidc.ClientAuthMethodTLS,
+	} {
+		_, err := oidc.NewAuthenticator().Token(
+			t.Context(),
+			server.URL,
+			&oidc.ClientCredentials{
+				ClientID:     clientID,
+				AuthMethod:   method,
+				ClientSecret: "secret",
+			},
+			"",
+		)
+		assert.Error(t, err, method)
+	}
+
+	// No token request is sent without the credentials
+	assert.Nil(t, form.Load())
+
+	// The private key must be identified by a key ID
+	key, err := joseutil.GenerateJWK("ES256", "sig", "")
+	assert.NoError(t, err)
+
+	key.KID = ""
+
+	_, err = oidc.NewAuthenticator().Token(
+		t.Context(),
+		server.URL,
+		&oidc.ClientCredentials{
+			ClientID:   clientID,
+			AuthMethod: oidc.ClientAuthMethodPrivateKeyJWT,
+			PrivateKey: key,
+		},
+		"",
+	)
+	assert.ErrorContains(t, err, "key ID")
+	assert.Nil(t, form.Load())
+}
+
+// newClientCertificate creates a self-signed certificate of the client
+func newClientCertificate(t *testing.T) *tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: clientID},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// newTokenServer serves the metadata and the token endpoint of an issuer,
+// the form of the last token request is stored
+func newTokenServer(t *testing.T) (*httptest.Server, *atomic.Pointer[url.Values]) {
+	t.Helper()
+
+	var (
+		server *httptest.Server
+		form   atomic.Pointer[url.Values]
+	)
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/token" {
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"issuer":              server.URL,
+				"jwks_uri":            server.URL + "/jwks",
+				tokenEndpointMetadata: server.URL + "/token",
+			})
+
+			return
+		}
+
+		err := r.ParseForm()
+		assert.NoError(t, err)
+
+		// The client secret must never be sent through basic authentication either
+		_, _, basic := r.BasicAuth()
+		assert.False(t, basic)
+
+		form.Store(&r.PostForm)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": accessToken,
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &form
+}
@@ -6,6 +6,7 @@ package oidc
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strings"
 	"time"
@@ -13,6 +14,9 @@ import (
 	identitycache "github.com/agntcy/identity/internal/pkg/cache"
 	"github.com/agntcy/identity/internal/pkg/errutil"
 	"github.com/agntcy/identity/internal/pkg/httputil"
+	"github.com/agntcy/identity/internal/pkg/metrics"
+	"github.com/agntcy/identity/pkg/joseutil"
+	jwktype "github.com/agntcy/identity/pkg/jwk"
 	"github.com/agntcy/identity/pkg/log"
 	freecache "github.com/coocood/freecache"
 	"github.com/eko/gocache/lib/v4/cache"
@@ -24,34 +28,37 @@ import (
 )
 
 type Claims struct {
-	Issuer  string `json:"iss"`
-	Subject string `json:"sub"`
-	SubJWK  string `json:"sub_jwk"` // used for self-issued tokens
+	Issuer string `json:"iss"`
+	// The subject read from the subject claim of the provider, sub by default
+	Subject    string    `json:"sub"`
+	SubJWK     string    `json:"sub_jwk"` // used for self-issued tokens
+	Audience   []string  `json:"aud,omitempty"`
+	IssuedAt   time.Time `json:"iat,omitzero"`
+	Expiration time.Time `json:"exp,omitzero"`
+	JwtID      string    `json:"jti,omitempty"`
+
+	// The operation a self-issued token is restricted to and,
+	// for a key rotation, the thumbprint of the new key
+	Purpose          string `json:"purpose,omitempty"`
+	NewKeyThumbprint string `json:"new_key_jkt,omitempty"`
 }
 
-type ProviderName int
-
-const (
-	UnknownProviderName ProviderName = iota
-	OktaProviderName
-	DuoProviderName
-	OryProviderName
-	IdpProviderName
-	SelfProviderName
-)
-
 type ParsedJWT struct {
-	Claims           *Claims
-	Provider         ProviderName
-	CommonName       string
-	providerMetadata *providerMetadata
-	jwt              *string
+	Claims     *Claims
+	Provider   ProviderName
+	CommonName string
+	jwt        *string
 }
 
 type providerMetadata struct {
 	Issuer   string `json:"issuer"`
 	TokenURL string `json:"token_endpoint"`
 	JWKSURL  string `json:"jwks_uri"`
+
+	// The endpoints requiring a client certificate (RFC 8705)
+	MTLSEndpointAliases struct {
+		TokenURL string `json:"token_endpoint"`
+	} `json:"mtls_endpoint_aliases"`
 }
 
 const defaultCacheSize = 10 * 1024 * 1024     // 10MB
@@ -70,18 +77,21 @@ type Parser interface {
 	// Else, it will attempt to retrieve the JWKS from the issuer's metadata.
 	VerifyJwt(ctx context.Context, jwt *ParsedJWT) error
 
-	// Get the parsed JWT including the issuer, the subject claims
-	// the common name and the provider metadata
+	// Get the parsed JWT including the issuer, the subject claims,
+	// the common name and the provider. No request is sent to the issuer,
+	// the issuer can be checked before VerifyJwt fetches its metadata.
 	ParseJwt(ctx context.Context, jwtString *string) (*ParsedJWT, error)
 }
 
 // The parser struct implements the Parser interface
 type parser struct {
 	jwksCache *cache.Cache[[]byte]
+	registry  *Registry
 }
 
-// NewParser creates a new instance of the Parser
-func NewParser() Parser {
+// NewParser creates a new instance of the Parser accepting
+// the tokens of the identity providers of the registry
+func NewParser(registry *Registry) Parser {
 	jwksCache := cache.New[[]byte](
 		freecache_store.NewFreecache(
 			freecache.NewCache(defaultCacheSize),
@@ -90,6 +100,7 @@ func NewParser() Parser {
 
 	return &parser{
 		jwksCache,
+		registry,
 	}
 }
 
@@ -105,14 +116,33 @@ func (p *parser) VerifyJwt(ctx context.Context, parsedJwt *ParsedJWT) error {
 	var jwks jwk.Set
 
 	if parsedJwt.Provider != SelfProviderName {
+		providerMD, err := getProviderMetadata(ctx, parsedJwt.Claims.Issuer)
+		if err != nil {
+			return err
+		}
+
 		// Get the JWKS from the issuer
-		jwks, err = p.getJwks(ctx, parsedJwt.providerMetadata)
+		jwks, err = p.getJwks(ctx, providerMD)
 		if err != nil {
 			return errutil.Err(err, "failed to get JWKS from issuer")
 		}
 	} else {
 		log.Debug("Using issuer's self generated JWKS")
 
+		var subJwk jwktype.Jwk
+
+		err = json.Unmarshal([]byte(parsedJwt.Claims.SubJWK), &subJwk)
+		if err != nil {
+			return errutil.Err(err, "failed to parse JWKS")
+		}
+
+		// The post-quantum AKP keys are not supported by the jwx library
+		if strings.EqualFold(subJwk.KTY, joseutil.KeyTypeAKP) {
+			_, err = joseutil.Verify(&subJwk, []byte(*parsedJwt.jwt))
+
+			return err
+		}
+
 		key, err := jwk.ParseKey([]byte(parsedJwt.Claims.SubJWK))
 		if err != nil {
 			return errutil.Err(err, "failed to parse JWKS")
@@ -139,7 +169,12 @@ func (p *parser) ParseJwt(
 		return nil, errutil.Err(nil, "JWT string is empty")
 	}
 
-	claims, err := p.GetClaims(ctx, jwtString)
+	token, err := parseToken(jwtString)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := getClaims(token)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +183,6 @@ func (p *parser) ParseJwt(
 
 	var providerName ProviderName
 	var commonName string
-	var providerMD *providerMetadata
 
 	selfIssued, err := p.isSelfIssuedToken(claims)
 	if err != nil {
@@ -163,32 +197,36 @@ func (p *parser) ParseJwt(
 		// Remove the self-issued scheme from the issuer to get the common name
 		commonName, _ = strings.CutPrefix(claims.Issuer, SelfIssuedIssScheme+":")
 	} else {
-		providerMD, err = getProviderMetadata(ctx, claims.Issuer)
-		if err != nil {
-			return nil, err
+		provider, ok := p.registry.Match(claims.Issuer)
+		if !ok {
+			return nil, errutil.Err(
+				nil,
+				fmt.Sprintf("the issuer %s does not match any identity provider", claims.Issuer),
+			)
 		}
 
-		providerName, err = p.detectProviderName(ctx, providerMD)
+		log.Debug("The JWT is issued by the provider: ", provider.Name)
+
+		err = applyProviderClaims(token, claims, provider)
 		if err != nil {
 			return nil, err
 		}
 
+		providerName = provider.Name
 		commonName = httputil.Hostname(claims.Issuer)
 	}
 
 	return &ParsedJWT{
-		Claims:           claims,
-		Provider:         providerName,
-		CommonName:       commonName,
-		providerMetadata: providerMD,
-		jwt:              jwtString,
+		Claims:     claims,
+		Provider:   providerName,
+		CommonName: commonName,
+		jwt:        jwtString,
 	}, nil
 }
 
-func (p *parser) GetClaims(
-	ctx context.Context,
-	jwtString *string,
-) (*Claims, error) {
+// parseToken decodes the JWT and validates its time claims,
+// the signature is verified by VerifyJwt
+func parseToken(jwtString *string) (jwt.Token, error) {
 	jwtToken, err := jwt.Parse(
 		[]byte(*jwtString),
 		jwt.WithVerify(false),
@@ -199,6 +237,10 @@ func (p *parser) GetClaims(
 		return nil, errutil.Err(err, "failed to parse JWT")
 	}
 
+	return jwtToken, nil
+}
+
+func getClaims(jwtToken jwt.Token) (*Claims, error) {
 	issuer, ok := jwtToken.Issuer()
 	if !ok {
 		return nil, errutil.Err(nil, "failed to decode JWT: missing 'iss' claim")
@@ -212,7 +254,7 @@ func (p *parser) GetClaims(
 	var subJWK map[string]any
 	var jsonSubJWK []byte
 
-	err = jwtToken.Get(SelfIssuedTokenSubJwkClaimName, &subJWK)
+	err := jwtToken.Get(SelfIssuedTokenSubJwkClaimName, &subJWK)
 	if err == nil {
 		jsonSubJWK, err = json.Marshal(subJWK)
 		if err != nil {
@@ -220,13 +262,62 @@ func (p *parser) GetClaims(
 		}
 	}
 
+	// The optional claims are checked by the verifiers of the proofs
+	audience, _ := jwtToken.Audience()
+	issuedAt, _ := jwtToken.IssuedAt()
+	expiration, _ := jwtToken.Expiration()
+	jwtID, _ := jwtToken.JwtID()
+
+	var purpose, newKeyThumbprint string
+
+	_ = jwtToken.Get(SelfIssuedTokenPurposeClaimName, &purpose)
+	_ = jwtToken.Get(SelfIssuedTokenNewKeyClaimName, &newKeyThumbprint)
+
 	return &Claims{
-		Issuer:  issuer,
-		Subject: subject,
-		SubJWK:  string(jsonSubJWK),
+		Issuer:           issuer,
+		Subject:          subject,
+		SubJWK:           string(jsonSubJWK),
+		Audience:         audience,
+		IssuedAt:         issuedAt,
+		Expiration:       expiration,
+		JwtID:            jwtID,
+		Purpose:          purpose,
+		NewKeyThumbprint: newKeyThumbprint,
 	}, nil
 }
 
+// applyProviderClaims checks the claims required by the provider
+// and reads the subject from the subject claim of the provider
+func applyProviderClaims(jwtToken jwt.Token, claims *Claims, provider *Provider) error {
+	for _, name := range provider.RequiredClaims {
+		if !jwtToken.Has(name) {
+			return errutil.Err(
+				nil,
+				fmt.Sprintf("failed to decode JWT: missing '%s' claim required by %s", name, provider.Name),
+			)
+		}
+	}
+
+	subjectClaim := provider.subjectClaim()
+	if subjectClaim == defaultSubjectClaim {
+		return nil
+	}
+
+	var subject string
+
+	err := jwtToken.Get(subjectClaim, &subject)
+	if err != nil || subject == "" {
+		return errutil.Err(
+			err,
+			fmt.Sprintf("failed to decode JWT: invalid '%s' claim", subjectClaim),
+		)
+	}
+
+	claims.Subject = subject
+
+	return nil
+}
+
 func (p *parser) isSelfIssuedToken(claims *Claims) (bool, error) {
 	u, err := url.Parse(claims.Issuer)
 	if err != nil {
@@ -238,13 +329,19 @@ func (p *parser) isSelfIssuedToken(claims *Claims) (bool, error) {
 
 func (p *parser) getJwks(ctx context.Context, provider *providerMetadata) (jwk.Set, error) {
 	cachedEntry, found := identitycache.GetFromCache[CachedJwks](ctx, p.jwksCache, provider.Issuer)
+	metrics.ObserveJwksCache(found)
+
 	if found {
 		return p.parseJwks(&cachedEntry.Jwks)
 	}
 
 	var jwksString string
 
+	start := time.Now()
 	err := httputil.GetWithRawBody(ctx, provider.JWKSURL, nil, &jwksString)
+
+	metrics.ObserveIdpFetch("jwks", start, err)
+
 	if err != nil {
 		return nil, errutil.Err(err, "failed to get JWKS from issuer")
 	}
@@ -7,53 +7,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"net/url"
-	"strings"
+	"time"
 
 	"github.com/agntcy/identity/internal/pkg/errutil"
 	"github.com/agntcy/identity/internal/pkg/httputil"
+	"github.com/agntcy/identity/internal/pkg/metrics"
 	"github.com/agntcy/identity/pkg/log"
 )
 
-func (p *parser) detectProviderName(
-	ctx context.Context,
-	provider *providerMetadata,
-) (ProviderName, error) {
-	_, headers, err := httputil.Get(ctx, provider.JWKSURL, nil)
-	if err != nil {
-		return UnknownProviderName, err
-	}
-
-	providerUrl, _ := url.Parse(provider.JWKSURL)
-
-	switch {
-	case isOry(headers, providerUrl.Host):
-		return OryProviderName, nil
-	case isOkta(headers, providerUrl.Host):
-		return OktaProviderName, nil
-	case isDuo(headers, providerUrl.Host):
-		return DuoProviderName, nil
-	default:
-		return IdpProviderName, nil
-	}
-}
-
-func isOry(headers http.Header, host string) bool {
-	return headers != nil &&
-		(headers.Get("Ory-Network-Region") != "" || strings.HasSuffix(host, "oryapis.com"))
-}
-
-func isOkta(headers http.Header, _ string) bool {
-	return headers != nil && headers.Get("X-Okta-Request-Id") != ""
-}
-
-func isDuo(headers http.Header, host string) bool {
-	return headers != nil &&
-		(strings.HasPrefix(strings.ToLower(headers.Get("Server")), "duo") ||
-			strings.HasPrefix(host, "duosecurity.com"))
-}
-
 func getProviderMetadata(ctx context.Context, issuer string) (*providerMetadata, error) {
 	metadata, oidcErr := getOidcProviderMetadata(ctx, issuer)
 	if oidcErr == nil {
@@ -96,7 +58,11 @@ func getAuthSrvMetadata(ctx context.Context, wellKnownURL string) (*providerMeta
 
 	log.Debug("Getting metadata for the autorization server:", wellKnownURL)
 
+	start := time.Now()
 	err := httputil.GetJSON(ctx, wellKnownURL, &metadata)
+
+	metrics.ObserveIdpFetch("metadata", start, err)
+
 	if err != nil {
 		return nil, errutil.Err(err, "failed to get metadata from issuer")
 	}
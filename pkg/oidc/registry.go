@@ -0,0 +1,302 @@
+// Copyright 2025 Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package oidc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// ProviderName is the name of an identity provider of the registry
+type ProviderName string
+
+const (
+	UnknownProviderName  ProviderName = ""
+	OktaProviderName     ProviderName = "okta"
+	DuoProviderName      ProviderName = "duo"
+	OryProviderName      ProviderName = "ory"
+	KeycloakProviderName ProviderName = "keycloak"
+	Auth0ProviderName    ProviderName = "auth0"
+	EntraIDProviderName  ProviderName = "entra-id"
+	GoogleProviderName   ProviderName = "google"
+	PingProviderName     ProviderName = "ping"
+	CognitoProviderName  ProviderName = "cognito"
+	IdpProviderName      ProviderName = "idp"
+
+	// The self-issued tokens are recognized by their issuer scheme,
+	// they are not part of the registry
+	SelfProviderName ProviderName = "self"
+)
+
+// The ID schemes of the default providers
+const (
+	OktaIDScheme = "OKTA-"
+	DuoIDScheme  = "DUO-"
+	OryIDScheme  = "ORY-"
+	IdpIDScheme  = "IDP-"
+)
+
+// The claim holding the subject when the provider does not define one
+const defaultSubjectClaim = "sub"
+
+// Provider describes an identity provider accepted by the Node
+type Provider struct {
+	// The unique name of the provider
+	Name ProviderName `json:"name"`
+
+	// The patterns of the issuer URLs of the provider, for instance
+	// https://*.okta.com. A * matches any part of the host or of a path
+	// segment, the issuer may have more path segments than the pattern.
+	IssuerPatterns []string `json:"issuerPatterns"`
+
+	// The prefix of the IDs generated from the proofs of the provider,
+	// for instance OKTA-
+	IDScheme string `json:"idScheme"`
+
+	// The prefixes of the IDs previous versions of the Node generated from
+	// the proofs of the provider. The proofs of the provider are accepted
+	// for these IDs and no new ID is generated for a subject already owning one.
+	LegacyIDSchemes []string `json:"legacyIdSchemes,omitempty"`
+
+	// The claim holding the subject of the IDs, sub when empty
+	SubjectClaim string `json:"subjectClaim,omitempty"`
+
+	// The claims the proofs of the provider must contain
+	RequiredClaims []string `json:"requiredClaims,omitempty"`
+
+	patterns []*regexp.Regexp
+}
+
+// Matches reports whether the issuer URL matches one of the patterns of the provider.
+// Issuers with credentials, a query or a fragment never match.
+func (p *Provider) Matches(issuer string) bool {
+	u, err := url.Parse(issuer)
+	if err != nil || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return false
+	}
+
+	for _, pattern := range p.patterns {
+		if pattern.MatchString(issuer) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *Provider) subjectClaim() string {
+	if p.SubjectClaim == "" {
+		return defaultSubjectClaim
+	}
+
+	return p.SubjectClaim
+}
+
+// compile validates the provider and compiles its issuer patterns
+func (p *Provider) compile() error {
+	if p.Name == UnknownProviderName || p.Name == SelfProviderName {
+		return fmt.Errorf("invalid provider name: %q", p.Name)
+	}
+
+	if p.IDScheme == "" {
+		return fmt.Errorf("the provider %s has no ID scheme", p.Name)
+	}
+
+	for _, scheme := range p.LegacyIDSchemes {
+		if scheme == "" || scheme == p.IDScheme {
+			return fmt.Errorf("invalid legacy ID scheme of the provider %s: %q", p.Name, scheme)
+		}
+	}
+
+	if len(p.IssuerPatterns) == 0 {
+		return fmt.Errorf("the provider %s has no issuer pattern", p.Name)
+	}
+
+	p.patterns = make([]*regexp.Regexp, 0, len(p.IssuerPatterns))
+
+	for _, pattern := range p.IssuerPatterns {
+		if !strings.Contains(pattern, "://") {
+			return fmt.Errorf("the issuer pattern %s of the provider %s has no scheme", pattern, p.Name)
+		}
+
+		expr := strings.ReplaceAll(
+			regexp.QuoteMeta(strings.TrimSuffix(pattern, "/")),
+			`\*`,
+			`[^/?#@]+`,
+		)
+
+		p.patterns = append(p.patterns, regexp.MustCompile("^"+expr+"(/.*)?$"))
+	}
+
+	return nil
+}
+
+// Registry holds the identity providers accepted by the Node.
+// An issuer belongs to the first provider matching it, in the order
+// of the registry, so the detection never depends on the identity provider.
+type Registry struct {
+	providers []*Provider
+	byName    map[ProviderName]*Provider
+}
+
+// NewRegistry validates the providers and creates the registry
+func NewRegistry(providers []*Provider) (*Registry, error) {
+	r := &Registry{
+		providers: make([]*Provider, 0, len(providers)),
+		byName:    make(map[ProviderName]*Provider, len(providers)),
+	}
+
+	for _, provider := range providers {
+		if provider == nil {
+			continue
+		}
+
+		if _, ok := r.byName[provider.Name]; ok {
+			return nil, fmt.Errorf("the provider %s is defined more than once", provider.Name)
+		}
+
+		compiled := *provider
+
+		err := compiled.compile()
+		if err != nil {
+			return nil, err
+		}
+
+		r.providers = append(r.providers, &compiled)
+		r.byName[compiled.Name] = &compiled
+	}
+
+	if len(r.providers) == 0 {
+		return nil, errors.New("the registry has no provider")
+	}
+
+	return r, nil
+}
+
+// NewDefaultRegistry creates the registry of the DefaultProviders
+func NewDefaultRegistry() *Registry {
+	registry, err := NewRegistry(DefaultProviders())
+	if err != nil {
+		panic(err)
+	}
+
+	return registry
+}
+
+// LoadRegistry creates the registry of the providers
+// defined in the JSON file at the path
+func LoadRegistry(path string) (*Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var providers []*Provider
+
+	err = json.Unmarshal(data, &providers)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the providers of %s: %w", path, err)
+	}
+
+	return NewRegistry(providers)
+}
+
+// Match returns the first provider matching the issuer URL
+func (r *Registry) Match(issuer string) (*Provider, bool) {
+	for _, provider := range r.providers {
+		if provider.Matches(issuer) {
+			return provider, true
+		}
+	}
+
+	return nil, false
+}
+
+// Get returns the provider with the name
+func (r *Registry) Get(name ProviderName) (*Provider, bool) {
+	provider, ok := r.byName[name]
+
+	return provider, ok
+}
+
+// DefaultProviders returns the well-known identity providers followed by
+// a generic provider accepting any other issuer.
+// The providers keep the ID schemes of the previous versions of the Node, which
+// generated OKTA-, DUO- and ORY- IDs and IDP- IDs for every other provider.
+// The new providers can be given their own scheme in a registry file of a new Node.
+func DefaultProviders() []*Provider {
+	return []*Provider{
+		{
+			Name: OktaProviderName,
+			IssuerPatterns: []string{
+				"https://*.okta.com",
+				"https://*.oktapreview.com",
+				"https://*.okta-emea.com",
+			},
+			IDScheme: OktaIDScheme,
+		},
+		{
+			Name:           DuoProviderName,
+			IssuerPatterns: []string{"https://*.duosecurity.com"},
+			IDScheme:       DuoIDScheme,
+		},
+		{
+			Name:           OryProviderName,
+			IssuerPatterns: []string{"https://*.oryapis.com"},
+			IDScheme:       OryIDScheme,
+		},
+		{
+			Name:           Auth0ProviderName,
+			IssuerPatterns: []string{"https://*.auth0.com"},
+			IDScheme:       IdpIDScheme,
+		},
+		{
+			Name: EntraIDProviderName,
+			IssuerPatterns: []string{
+				"https://login.microsoftonline.com/*/v2.0",
+				"https://sts.windows.net/*",
+			},
+			IDScheme: IdpIDScheme,
+		},
+		{
+			Name:           GoogleProviderName,
+			IssuerPatterns: []string{"https://accounts.google.com"},
+			IDScheme:       IdpIDScheme,
+		},
+		{
+			Name: PingProviderName,
+			IssuerPatterns: []string{
+				"https://auth.pingone.com/*/as",
+				"https://auth.pingone.eu/*/as",
+				"https://auth.pingone.ca/*/as",
+				"https://auth.pingone.asia/*/as",
+			},
+			IDScheme: IdpIDScheme,
+		},
+		{
+			Name:           CognitoProviderName,
+			IssuerPatterns: []string{"https://cognito-idp.*.amazonaws.com/*"},
+			IDScheme:       IdpIDScheme,
+		},
+		{
+			// Keycloak is self-hosted, its issuers are recognized by their path
+			Name:           KeycloakProviderName,
+			IssuerPatterns: []string{"https://*/realms/*", "https://*/auth/realms/*"},
+			IDScheme:       IdpIDScheme,
+		},
+		{
+			// Previous versions of the Node recognized Okta, Duo and Ory
+			// on custom domains from the headers of their responses
+			Name:            IdpProviderName,
+			IssuerPatterns:  []string{"https://*", "http://*"},
+			IDScheme:        IdpIDScheme,
+			LegacyIDSchemes: []string{OktaIDScheme, DuoIDScheme, OryIDScheme},
+		},
+	}
+}
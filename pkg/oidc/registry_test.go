@@ -0,0 +1,256 @@
+// Copyright 2025 Copyright AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package oidc_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity/pkg/oidc"
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+const customProviderName oidc.ProviderName = "custom"
+
+const objectIDClaim = "oid"
+
+func TestDefaultRegistry_Should_Match_The_Providers(t *testing.T) {
+	t.Parallel()
+
+	sut := oidc.NewDefaultRegistry()
+
+	issuers := map[string]oidc.ProviderName{
+		"https://dev-123.okta.com/oauth2/default":                  oidc.OktaProviderName,
+		"https://sso-tenant.sso.duosecurity.com/oauth/MY-ID":       oidc.DuoProviderName,
+		"https://project.projects.oryapis.com":                     oidc.OryProviderName,
+		"https://tenant.eu.auth0.com/":                             oidc.Auth0ProviderName,
+		"https://login.microsoftonline.com/tenant-id/v2.0":         oidc.EntraIDProviderName,
+		"https://accounts.google.com":                              oidc.GoogleProviderName,
+		"https://auth.pingone.eu/env-id/as":                        oidc.PingProviderName,
+		"https://cognito-idp.us-east-1.amazonaws.com/us-east-1_id": oidc.CognitoProviderName,
+		"https://sso.example.com/realms/agents":                    oidc.KeycloakProviderName,
+		"https://idp.example.com":                                  oidc.IdpProviderName,
+	}
+
+	for issuer, expected := range issuers {
+		provider, ok := sut.Match(issuer)
+
+		assert.True(t, ok, issuer)
+		assert.Equal(t, expected, provider.Name, issuer)
+	}
+}
+
+func TestDefaultRegistry_Should_Keep_The_Legacy_ID_Schemes(t *testing.T) {
+	t.Parallel()
+
+	sut := oidc.NewDefaultRegistry()
+
+	// The IDs generated by previous versions of the Node do not change
+	issuers := map[string]string{
+		"https://dev-123.okta.com/oauth2/default":          oidc.OktaIDScheme,
+		"https://sso-tenant.sso.duosecurity.com/oauth/ID":  oidc.DuoIDScheme,
+		"https://project.projects.oryapis.com":             oidc.OryIDScheme,
+		"https://tenant.eu.auth0.com/":                     oidc.IdpIDScheme,
+		"https://login.microsoftonline.com/tenant-id/v2.0": oidc.IdpIDScheme,
+		"https://accounts.google.com":                      oidc.IdpIDScheme,
+		"https://sso.example.com/realms/agents":            oidc.IdpIDScheme,
+		"https://idp.example.com":                          oidc.IdpIDScheme,
+	}
+
+	for issuer, scheme := range issuers {
+		provider, ok := sut.Match(issuer)
+
+		assert.True(t, ok, issuer)
+		assert.Equal(t, scheme, provider.IDScheme, issuer)
+		assert.Empty(t, provider.SubjectClaim, issuer)
+	}
+
+	// Okta, Duo and Ory on custom domains were recognized from their responses
+	provider, _ := sut.Match("https://login.example.com")
+	assert.ElementsMatch(t, []string{oidc.OktaIDScheme, oidc.DuoIDScheme, "ORY-"}, provider.LegacyIDSchemes)
+}
+
+func TestRegistry_Should_Not_Match_Lookalike_Issuers(t *testing.T) {
+	t.Parallel()
+
+	sut, err := oidc.NewRegistry([]*oidc.Provider{
+		{
+			Name:           oidc.OktaProviderName,
+			IssuerPatterns: []string{"https://*.okta.com"},
+			IDScheme:       "OKTA-",
+		},
+	})
+	assert.NoError(t, err)
+
+	for _, issuer := range []string{
+		"https://okta.com.example.com",
+		"https://example.com/.okta.com",
+		"https://example.com?.okta.com",
+		"https://user@tenant.okta.com",
+		"http://tenant.okta.com",
+		"https://tenant.okta.community",
+	} {
+		_, ok := sut.Match(issuer)
+		assert.False(t, ok, issuer)
+	}
+}
+
+func TestNewRegistry_Should_Reject_Invalid_Providers(t *testing.T) {
+	t.Parallel()
+
+	invalid := [][]*oidc.Provider{
+		{},
+		{{Name: customProviderName, IssuerPatterns: []string{"https://*"}}},
+		{{Name: customProviderName, IDScheme: "CUSTOM-"}},
+		{{Name: customProviderName, IssuerPatterns: []string{"*.example.com"}, IDScheme: "CUSTOM-"}},
+		{{Name: oidc.SelfProviderName, IssuerPatterns: []string{"https://*"}, IDScheme: "SELF-"}},
+		{{
+			Name:            customProviderName,
+			IssuerPatterns:  []string{"https://a.com"},
+			IDScheme:        "SAME-",
+			LegacyIDSchemes: []string{"SAME-"},
+		}},
+		{
+			{Name: customProviderName, IssuerPatterns: []string{"https://a.com"}, IDScheme: "A-"},
+			{Name: customProviderName, IssuerPatterns: []string{"https://b.com"}, IDScheme: "B-"},
+		},
+	}
+
+	for _, providers := range invalid {
+		_, err := oidc.NewRegistry(providers)
+		assert.Error(t, err)
+	}
+}
+
+func TestLoadRegistry_Should_Load_The_Providers_In_Order(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "providers.json")
+	err := os.WriteFile(path, []byte(`[
+		{"name": "corp", "issuerPatterns": ["https://login.corp.example"], "idScheme": "CORP-"},
+		{"name": "idp", "issuerPatterns": ["https://*"], "idScheme": "IDP-"}
+	]`), 0o600)
+	assert.NoError(t, err)
+
+	sut, err := oidc.LoadRegistry(path)
+	assert.NoError(t, err)
+
+	provider, ok := sut.Match("https://login.corp.example/tenant")
+	assert.True(t, ok)
+	assert.Equal(t, "CORP-", provider.IDScheme)
+
+	provider, ok = sut.Match("https://other.example")
+	assert.True(t, ok)
+	assert.Equal(t, oidc.IdpProviderName, provider.Name)
+
+	_, ok = sut.Get(oidc.OktaProviderName)
+	assert.False(t, ok)
+}
+
+func TestParseJwt_Should_Reject_Unknown_Issuers_Without_Fetching(t *testing.T) {
+	t.Parallel()
+
+	server, requests := newIssuerServer(t)
+	registry, err := oidc.NewRegistry([]*oidc.Provider{
+		{
+			Name:           oidc.OktaProviderName,
+			IssuerPatterns: []string{"https://*.okta.com"},
+			IDScheme:       "OKTA-",
+		},
+	})
+	assert.NoError(t, err)
+
+	token := newToken(t, server.URL, nil)
+
+	_, err = oidc.NewParser(registry).ParseJwt(t.Context(), &token)
+
+	assert.ErrorContains(t, err, "does not match any identity provider")
+	assert.Zero(t, requests.Load())
+}
+
+func TestParseJwt_Should_Apply_The_Claims_Of_The_Provider(t *testing.T) {
+	t.Parallel()
+
+	server, requests := newIssuerServer(t)
+	registry, err := oidc.NewRegistry([]*oidc.Provider{
+		{
+			Name:           customProviderName,
+			IssuerPatterns: []string{"http://127.0.0.1:*"},
+			IDScheme:       "CORP-",
+			SubjectClaim:   objectIDClaim,
+			RequiredClaims: []string{"tid"},
+		},
+	})
+	assert.NoError(t, err)
+
+	sut := oidc.NewParser(registry)
+
+	token := newToken(t, server.URL, map[string]any{objectIDClaim: "object-id", "tid": "tenant"})
+
+	parsed, err := sut.ParseJwt(t.Context(), &token)
+
+	assert.NoError(t, err)
+	assert.Equal(t, customProviderName, parsed.Provider)
+	assert.Equal(t, "object-id", parsed.Claims.Subject)
+
+	token = newToken(t, server.URL, map[string]any{objectIDClaim: "object-id"})
+
+	_, err = sut.ParseJwt(t.Context(), &token)
+
+	assert.ErrorContains(t, err, "'tid'")
+
+	// The metadata of the issuer is only requested to verify the signature
+	assert.Zero(t, requests.Load())
+}
+
+// newIssuerServer serves the metadata of an issuer and counts the requests
+func newIssuerServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var (
+		server   *httptest.Server
+		requests atomic.Int32
+	)
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   server.URL,
+			"jwks_uri": server.URL + "/jwks",
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func newToken(t *testing.T, issuer string, claims map[string]any) string {
+	t.Helper()
+
+	builder := jwt.NewBuilder().
+		Issuer(issuer).
+		Subject("subject").
+		Expiration(time.Now().Add(time.Hour))
+
+	for name, value := range claims {
+		builder = builder.Claim(name, value)
+	}
+
+	tok, err := builder.Build()
+	assert.NoError(t, err)
+
+	signed, err := jwt.Sign(tok, jwt.WithKey(jwa.HS256(), []byte("secret")))
+	assert.NoError(t, err)
+
+	return string(signed)
+}
@@ -17,18 +17,57 @@ import (
 const (
 	SelfIssuedTokenSubJwkClaimName string = "sub_jwk"
 	SelfIssuedIssScheme            string = "agntcy"
+
+	// The claims of the self-issued tokens restricted to an operation,
+	// the key rotation tokens carry the thumbprint of the new key
+	SelfIssuedTokenPurposeClaimName = "purpose"
+	SelfIssuedTokenNewKeyClaimName  = "new_key_jkt" //nolint:gosec // This is the name of the claim
+
+	// The purpose of the tokens authorizing the rotation of the key of an issuer
+	SelfIssuedTokenPurposeRotateKey = "rotate_key"
+
+	// The self-issued tokens are short-lived since they are issued
+	// for a single request to the Node
+	SelfIssuedTokenLifetime = 5 * time.Minute
 )
 
-func SelfIssueJWT(issuer, sub string, key *jwk.Jwk) (string, error) {
-	tok, _ := jwt.NewBuilder().
+// SelfIssueJWT issues a JWT signed by the key of the issuer
+// for the audience, the identifier of the Node the token is sent to
+func SelfIssueJWT(issuer, sub, audience string, key *jwk.Jwk) (string, error) {
+	return selfIssueJWT(issuer, sub, audience, key, nil)
+}
+
+// SelfIssueKeyRotationJWT issues a JWT signed by the current key of the issuer
+// authorizing only the rotation of the key of the issuer to the new key
+func SelfIssueKeyRotationJWT(issuer, sub, audience string, key, newKey *jwk.Jwk) (string, error) {
+	thumbprint, err := newKey.Thumbprint()
+	if err != nil {
+		return "", err
+	}
+
+	return selfIssueJWT(issuer, sub, audience, key, map[string]any{
+		SelfIssuedTokenPurposeClaimName: SelfIssuedTokenPurposeRotateKey,
+		SelfIssuedTokenNewKeyClaimName:  thumbprint,
+	})
+}
+
+func selfIssueJWT(issuer, sub, audience string, key *jwk.Jwk, claims map[string]any) (string, error) {
+	now := time.Now()
+
+	builder := jwt.NewBuilder().
 		Issuer(fmt.Sprintf("%s:%s", SelfIssuedIssScheme, issuer)).
 		Subject(sub).
-		Audience([]string{sub}).
-		Expiration(time.Now().Add(1*time.Hour)).
-		IssuedAt(time.Now()).
+		Audience([]string{audience}).
+		Expiration(now.Add(SelfIssuedTokenLifetime)).
+		IssuedAt(now).
 		JwtID(uuid.NewString()).
-		Claim(SelfIssuedTokenSubJwkClaimName, key.PublicKey()).
-		Build()
+		Claim(SelfIssuedTokenSubJwkClaimName, key.PublicKey())
+
+	for name, value := range claims {
+		builder = builder.Claim(name, value)
+	}
+
+	tok, _ := builder.Build()
 
 	buf, err := json.Marshal(tok)
 	if err != nil {
@@ -4,7 +4,9 @@
 package oidc_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/agntcy/identity/pkg/joseutil"
 	"github.com/agntcy/identity/pkg/oidc"
@@ -22,7 +24,7 @@ func TestSelfIssueJWT_Should_Generate_Unique_JWTs(t *testing.T) {
 	tokens := make([]*string, 0)
 
 	for idx := 0; idx < 10; idx++ {
-		token, err := oidc.SelfIssueJWT("issuer", "sub", jwk)
+		token, err := oidc.SelfIssueJWT("issuer", "sub", "https://node.example.com", jwk)
 
 		assert.NoError(t, err)
 
@@ -34,3 +36,71 @@ func TestSelfIssueJWT_Should_Generate_Unique_JWTs(t *testing.T) {
 		assert.NotContains(t, tokens[idx+1:], token)
 	}
 }
+
+func TestSelfIssueJWT_Should_Be_Verified_With_Mldsa_Key(t *testing.T) {
+	t.Parallel()
+
+	jwk, err := joseutil.GenerateJWK("ML-DSA-44", "sig", "my-id")
+	assert.NoError(t, err)
+
+	token, err := oidc.SelfIssueJWT("issuer", "sub", "https://node.example.com", jwk)
+	assert.NoError(t, err)
+
+	parser := oidc.NewParser(oidc.NewDefaultRegistry())
+
+	parsedJwt, err := parser.ParseJwt(t.Context(), &token)
+	assert.NoError(t, err)
+	assert.Equal(t, oidc.SelfProviderName, parsedJwt.Provider)
+	assert.Equal(t, []string{"https://node.example.com"}, parsedJwt.Claims.Audience)
+	assert.NotEmpty(t, parsedJwt.Claims.JwtID)
+	assert.WithinDuration(t, time.Now(), parsedJwt.Claims.IssuedAt, time.Minute)
+
+	err = parser.VerifyJwt(t.Context(), parsedJwt)
+	assert.NoError(t, err)
+
+	// A token signed by another key must be rejected
+	otherJwk, err := joseutil.GenerateJWK("ML-DSA-44", "sig", "my-id")
+	assert.NoError(t, err)
+
+	otherToken, err := oidc.SelfIssueJWT("issuer", "sub", "https://node.example.com", otherJwk)
+	assert.NoError(t, err)
+
+	parts := strings.Split(otherToken, ".")
+	forged := strings.Join([]string{strings.Split(token, ".")[0], strings.Split(token, ".")[1], parts[2]}, ".")
+
+	parsedJwt, err = parser.ParseJwt(t.Context(), &forged)
+	assert.NoError(t, err)
+
+	err = parser.VerifyJwt(t.Context(), parsedJwt)
+	assert.Error(t, err)
+}
+
+func TestSelfIssueKeyRotationJWT_Should_Bind_The_New_Key(t *testing.T) {
+	t.Parallel()
+
+	key, err := joseutil.GenerateJWK("RS256", "sig", "my-id")
+	assert.NoError(t, err)
+
+	newKey, err := joseutil.GenerateJWK("ES256", "sig", "my-new-id")
+	assert.NoError(t, err)
+
+	token, err := oidc.SelfIssueKeyRotationJWT("issuer", "sub", "https://node.example.com", key, newKey)
+	assert.NoError(t, err)
+
+	parsedJwt, err := oidc.NewParser(oidc.NewDefaultRegistry()).ParseJwt(t.Context(), &token)
+	assert.NoError(t, err)
+
+	thumbprint, err := newKey.PublicKey().Thumbprint()
+	assert.NoError(t, err)
+	assert.Equal(t, oidc.SelfIssuedTokenPurposeRotateKey, parsedJwt.Claims.Purpose)
+	assert.Equal(t, thumbprint, parsedJwt.Claims.NewKeyThumbprint)
+
+	// The other self-issued tokens are not restricted to an operation
+	token, err = oidc.SelfIssueJWT("issuer", "sub", "https://node.example.com", key)
+	assert.NoError(t, err)
+
+	parsedJwt, err = oidc.NewParser(oidc.NewDefaultRegistry()).ParseJwt(t.Context(), &token)
+	assert.NoError(t, err)
+	assert.Empty(t, parsedJwt.Claims.Purpose)
+	assert.Empty(t, parsedJwt.Claims.NewKeyThumbprint)
+}
@@ -0,0 +1,343 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tlsutil builds the TLS configurations of the servers and keeps
+// their certificates up to date with the files they are loaded from.
+package tlsutil
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/agntcy/identity/pkg/log"
+	"github.com/fsnotify/fsnotify"
+)
+
+const (
+	loopbackCommonName       = "identity-loopback"
+	loopbackValidity         = 10 * 365 * 24 * time.Hour
+	loopbackSerialNumberBits = 128
+)
+
+// ClientAuth is the verification of the client certificates
+type ClientAuth string
+
+const (
+	// The clients are not asked for a certificate
+	ClientAuthNone ClientAuth = "none"
+
+	// The certificate of a client is verified when the client presents one
+	ClientAuthOptional ClientAuth = "optional"
+
+	// The clients must present a certificate signed by the CA
+	ClientAuthRequire ClientAuth = "require"
+)
+
+// ParseClientAuth parses the verification of the client certificates
+func ParseClientAuth(clientAuth string) (ClientAuth, error) {
+	switch ClientAuth(clientAuth) {
+	case ClientAuthNone, ClientAuthOptional, ClientAuthRequire:
+		return ClientAuth(clientAuth), nil
+	case "":
+		return ClientAuthNone, nil
+	default:
+		return "", fmt.Errorf("unknown client authentication: %s", clientAuth)
+	}
+}
+
+// Config holds the files of the TLS configuration of a server
+type Config struct {
+	// The PEM encoded certificate chain of the server
+	CertFile string
+
+	// The PEM encoded private key of the server
+	KeyFile string
+
+	// The PEM encoded CA certificates verifying the client certificates
+	CAFile string
+
+	// The verification of the client certificates
+	ClientAuth ClientAuth
+}
+
+// Reloader holds the certificate and the CA of a server,
+// they are reloaded when their files change
+type Reloader struct {
+	config  Config
+	watcher *fsnotify.Watcher
+
+	// The certificate presented by the loopback clients,
+	// generated for the lifetime of the process
+	loopbackCertificate *tls.Certificate
+
+	mu          sync.RWMutex
+	certificate *tls.Certificate
+	clientCAs   *x509.CertPool
+}
+
+// NewReloader loads the files of the configuration and watches them for changes
+func NewReloader(config *Config) (*Reloader, error) {
+	if config.CertFile == "" || config.KeyFile == "" {
+		return nil, errors.New("the certificate and the key files are required")
+	}
+
+	if config.ClientAuth != ClientAuthNone && config.CAFile == "" {
+		return nil, errors.New("the CA file is required to verify the client certificates")
+	}
+
+	loopbackCertificate, err := newLoopbackCertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Reloader{
+		config:              *config,
+		loopbackCertificate: loopbackCertificate,
+	}
+
+	err = r.reload()
+	if err != nil {
+		return nil, err
+	}
+
+	r.watcher, err = fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	// Watch the directories since the files are often replaced
+	// rather than written, for instance when mounted from a Kubernetes secret
+	for _, dir := range r.dirs() {
+		err = r.watcher.Add(dir)
+		if err != nil {
+			_ = r.watcher.Close()
+			return nil, err
+		}
+	}
+
+	go r.watch()
+
+	return r, nil
+}
+
+// Close stops watching the files
+func (r *Reloader) Close() error {
+	if r == nil {
+		return nil
+	}
+
+	return r.watcher.Close()
+}
+
+// Certificate returns the current certificate of the server
+func (r *Reloader) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.certificate
+}
+
+// ServerTLSConfig returns the TLS configuration of the server,
+// the connections always use the latest files
+func (r *Reloader) ServerTLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.certificate},
+				ClientCAs:    r.clientCAs,
+				ClientAuth:   r.clientAuthType(),
+				NextProtos:   []string{"h2", "http/1.1"},
+			}, nil
+		},
+	}
+}
+
+// LoopbackTLSConfig returns the TLS configuration of a client connecting to
+// the server itself, for instance a gateway in the same process.
+// The server is authenticated by its certificate and the client presents
+// a certificate generated for the process, trusted by the server next to its CA.
+func (r *Reloader) LoopbackTLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// The certificate is pinned instead of verified against the host name
+		InsecureSkipVerify: true, //nolint:gosec // The certificate is verified below
+		VerifyConnection: func(state tls.ConnectionState) error {
+			current := r.Certificate()
+			if len(state.PeerCertificates) == 0 ||
+				!bytes.Equal(state.PeerCertificates[0].Raw, current.Certificate[0]) {
+				return errors.New("the server does not present its own certificate")
+			}
+
+			return nil
+		},
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.loopbackCertificate, nil
+		},
+	}
+}
+
+func (r *Reloader) clientAuthType() tls.ClientAuthType {
+	switch r.config.ClientAuth {
+	case ClientAuthOptional:
+		return tls.VerifyClientCertIfGiven
+	case ClientAuthRequire:
+		return tls.RequireAndVerifyClientCert
+	case ClientAuthNone:
+	}
+
+	return tls.NoClientCert
+}
+
+func (r *Reloader) reload() error {
+	certificate, err := tls.LoadX509KeyPair(r.config.CertFile, r.config.KeyFile)
+	if err != nil {
+		return fmt.Errorf("unable to load the certificate: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+
+	if r.config.CAFile != "" {
+		pem, err := os.ReadFile(r.config.CAFile)
+		if err != nil {
+			return fmt.Errorf("unable to load the CA: %w", err)
+		}
+
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificate found in %s", r.config.CAFile)
+		}
+
+		// The self-signed loopback certificate is verified as its own root
+		clientCAs.AddCert(r.loopbackCertificate.Leaf)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.certificate = &certificate
+	r.clientCAs = clientCAs
+
+	return nil
+}
+
+// newLoopbackCertificate generates the self-signed client certificate of the loopback clients,
+// its private key never leaves the process
+func newLoopbackCertificate() (*tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), loopbackSerialNumberBits))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: loopbackCommonName},
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.Add(loopbackValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the loopback certificate: %w", err)
+	}
+
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}, nil
+}
+
+func (r *Reloader) watch() {
+	for {
+		select {
+		case event, ok := <-r.watcher.Events:
+			if !ok {
+				return
+			}
+
+			if !r.watches(event.Name) {
+				continue
+			}
+
+			err := r.reload()
+			if err != nil {
+				// Keep serving with the previous files, the change may be partial
+				log.Warn("Unable to reload the TLS files: ", err)
+				continue
+			}
+
+			log.Info("Reloaded the TLS files after a change of ", event.Name)
+		case err, ok := <-r.watcher.Errors:
+			if !ok {
+				return
+			}
+
+			log.Warn("Unable to watch the TLS files: ", err)
+		}
+	}
+}
+
+// watches tells whether the file is one of the configuration
+// or a file of their directories replaced atomically, such as ..data
+func (r *Reloader) watches(name string) bool {
+	for _, file := range r.files() {
+		if filepath.Clean(name) == filepath.Clean(file) {
+			return true
+		}
+	}
+
+	return filepath.Base(name) == "..data"
+}
+
+func (r *Reloader) files() []string {
+	files := []string{r.config.CertFile, r.config.KeyFile}
+	if r.config.CAFile != "" {
+		files = append(files, r.config.CAFile)
+	}
+
+	return files
+}
+
+func (r *Reloader) dirs() []string {
+	dirs := make([]string, 0)
+
+	for _, file := range r.files() {
+		dir := filepath.Dir(file)
+		if !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	return dirs
+}
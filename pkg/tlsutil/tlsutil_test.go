@@ -0,0 +1,206 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package tlsutil_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity/pkg/tlsutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReloader_Should_Reload_Certificate_On_File_Change(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	config := &tlsutil.Config{
+		CertFile:   filepath.Join(dir, "tls.crt"),
+		KeyFile:    filepath.Join(dir, "tls.key"),
+		ClientAuth: tlsutil.ClientAuthNone,
+	}
+
+	writeCertificate(t, config, "first")
+
+	sut, err := tlsutil.NewReloader(config)
+	assert.NoError(t, err)
+
+	defer func() {
+		_ = sut.Close()
+	}()
+
+	assert.Equal(t, "first", sut.Certificate().Leaf.Subject.CommonName)
+
+	writeCertificate(t, config, "second")
+
+	assert.Eventually(t, func() bool {
+		return sut.Certificate().Leaf.Subject.CommonName == "second"
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestReloader_Should_Require_CA_To_Verify_Clients(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	config := &tlsutil.Config{
+		CertFile:   filepath.Join(dir, "tls.crt"),
+		KeyFile:    filepath.Join(dir, "tls.key"),
+		ClientAuth: tlsutil.ClientAuthRequire,
+	}
+
+	writeCertificate(t, config, "server")
+
+	_, err := tlsutil.NewReloader(config)
+	assert.Error(t, err)
+}
+
+func TestLoopbackTLSConfig_Should_Authenticate_To_Server_Requiring_Client_Certificates(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	config := &tlsutil.Config{
+		CertFile:   filepath.Join(dir, "tls.crt"),
+		KeyFile:    filepath.Join(dir, "tls.key"),
+		CAFile:     filepath.Join(dir, "ca.crt"),
+		ClientAuth: tlsutil.ClientAuthRequire,
+	}
+
+	writeCertificate(t, config, "server")
+
+	// The CA of the clients does not sign the certificate of the server
+	writeCertificate(t, &tlsutil.Config{
+		CertFile: config.CAFile,
+		KeyFile:  filepath.Join(dir, "ca.key"),
+	}, "ca")
+
+	sut, err := tlsutil.NewReloader(config)
+	assert.NoError(t, err)
+
+	defer func() {
+		_ = sut.Close()
+	}()
+
+	state, err := handshake(t, sut.ServerTLSConfig(), sut.LoopbackTLSConfig())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, state.VerifiedChains)
+
+	// The other clients still need a certificate signed by the CA
+	anonymous := sut.LoopbackTLSConfig()
+	anonymous.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return &tls.Certificate{}, nil
+	}
+
+	_, err = handshake(t, sut.ServerTLSConfig(), anonymous)
+	assert.Error(t, err)
+
+	untrusted := sut.LoopbackTLSConfig()
+	untrusted.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return sut.Certificate(), nil
+	}
+
+	_, err = handshake(t, sut.ServerTLSConfig(), untrusted)
+	assert.Error(t, err)
+}
+
+func TestParseClientAuth(t *testing.T) {
+	t.Parallel()
+
+	clientAuth, err := tlsutil.ParseClientAuth("")
+	assert.NoError(t, err)
+	assert.Equal(t, tlsutil.ClientAuthNone, clientAuth)
+
+	clientAuth, err = tlsutil.ParseClientAuth("optional")
+	assert.NoError(t, err)
+	assert.Equal(t, tlsutil.ClientAuthOptional, clientAuth)
+
+	_, err = tlsutil.ParseClientAuth("always")
+	assert.Error(t, err)
+}
+
+// handshake runs a TLS handshake between the configurations over an in-memory connection
+// and returns the connection state of the server
+func handshake(t *testing.T, serverConfig, clientConfig *tls.Config) (tls.ConnectionState, error) {
+	t.Helper()
+
+	serverConn, clientConn := net.Pipe()
+
+	defer func() {
+		_ = serverConn.Close()
+		_ = clientConn.Close()
+	}()
+
+	server := tls.Server(serverConn, serverConfig)
+	client := tls.Client(clientConn, clientConfig)
+
+	clientErr := make(chan error, 1)
+
+	go func() {
+		err := client.HandshakeContext(t.Context())
+		if err == nil {
+			// The server reports the verification of the client certificate
+			// after the client completes its handshake
+			_, err = client.Read(make([]byte, 1))
+		}
+
+		clientErr <- err
+	}()
+
+	err := server.HandshakeContext(t.Context())
+	if err == nil {
+		_, err = server.Write([]byte{0})
+	}
+
+	// Unblock the client when the server fails
+	_ = serverConn.Close()
+
+	<-clientErr
+
+	return server.ConnectionState(), err
+}
+
+// writeCertificate writes a self-signed certificate and its key,
+// the key is written first so the pair is always consistent once the certificate changes
+func writeCertificate(t *testing.T, config *tlsutil.Config, commonName string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	writeFile(t, config.KeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+	writeFile(t, config.CertFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// writeFile replaces the file atomically
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+
+	tmp := path + ".tmp"
+
+	assert.NoError(t, os.WriteFile(tmp, data, 0o600))
+	assert.NoError(t, os.Rename(tmp, path))
+}
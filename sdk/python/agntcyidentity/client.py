@@ -49,15 +49,25 @@ class Client:  # pylint: disable=too-few-public-methods
         logger.debug("Using SSL: %s, Insecure: %s", use_ssl, use_ssl_insecure)
 
         if use_ssl == 1:
-            channel_credentials = None
+            root_cert = None
 
             if use_ssl_insecure == 1:
                 root_cert = base64.b64decode(
                     os.environ["IDENTITY_NODE_INSECURE_ROOT_CA"])
-                channel_credentials = grpc.ssl_channel_credentials(
-                    root_certificates=root_cert)
-            else:
-                channel_credentials = grpc.ssl_channel_credentials()
+            elif os.environ.get("IDENTITY_NODE_CA_FILE"):
+                root_cert = _read_file(os.environ["IDENTITY_NODE_CA_FILE"])
+
+            # Present a client certificate to the nodes verifying them
+            cert_file = os.environ.get("IDENTITY_NODE_CLIENT_CERT_FILE")
+            key_file = os.environ.get("IDENTITY_NODE_CLIENT_KEY_FILE")
+            certificate_chain = _read_file(cert_file) if cert_file else None
+            private_key = _read_file(key_file) if key_file else None
+
+            channel_credentials = grpc.ssl_channel_credentials(
+                root_certificates=root_cert,
+                private_key=private_key,
+                certificate_chain=certificate_chain,
+            )
 
             # Set if async
             secure_channel = (grpc.aio.secure_channel
@@ -74,3 +84,9 @@ class Client:  # pylint: disable=too-few-public-methods
                                 if async_mode else grpc.insecure_channel)
 
             self.channel = insecure_channel(grpc_server_url, options=options)
+
+
+def _read_file(path):
+    """Read the content of a PEM file."""
+    with open(path, "rb") as file:
+        return file.read()